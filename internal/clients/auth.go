@@ -6,7 +6,9 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"sync"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/claims"
@@ -140,3 +142,87 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 
 	return &account, nil
 }
+
+// keyVaultAuthorizerCallback returns a BearerAuthorizerCallback for the Key Vault Data Plane API. The Tenant ID from the
+// authentication challenge is used to obtain a token for an Auxiliary Tenant when the Key Vault exists within one,
+// otherwise a token from the primary Tenant is used.
+func keyVaultAuthorizerCallback(ctx context.Context, config auth.Credentials, authorizer auth.Authorizer) *autorest.BearerAuthorizerCallback {
+	tenantAuthorizers := newKeyVaultTenantAuthorizers(config, authorizer, func(ctx context.Context, config auth.Credentials) (auth.Authorizer, error) {
+		return auth.NewAuthorizerFromCredentials(ctx, config, config.Environment.KeyVault)
+	})
+
+	return autorest.NewBearerAuthorizerCallback(nil, func(tenantId, _ string) (*autorest.BearerAuthorizer, error) {
+		tenantAuthorizer, err := tenantAuthorizers.authorizerForTenant(ctx, tenantId)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := tenantAuthorizer.Token(ctx, &http.Request{})
+		if err != nil {
+			return nil, fmt.Errorf("obtaining token: %v", err)
+		}
+
+		return autorest.NewBearerAuthorizer(staticTokenProvider(token.AccessToken)), nil
+	})
+}
+
+// keyVaultTenantAuthorizers selects the Authorizer to use for the Tenant requested in a Key Vault authentication
+// challenge, building (and caching) an Authorizer for each Auxiliary Tenant as it's first requested
+type keyVaultTenantAuthorizers struct {
+	config        auth.Credentials
+	primary       auth.Authorizer
+	newAuthorizer func(ctx context.Context, config auth.Credentials) (auth.Authorizer, error)
+
+	lock      sync.Mutex
+	auxiliary map[string]auth.Authorizer
+}
+
+func newKeyVaultTenantAuthorizers(config auth.Credentials, primary auth.Authorizer, newAuthorizer func(ctx context.Context, config auth.Credentials) (auth.Authorizer, error)) *keyVaultTenantAuthorizers {
+	return &keyVaultTenantAuthorizers{
+		config:        config,
+		primary:       primary,
+		newAuthorizer: newAuthorizer,
+		auxiliary:     make(map[string]auth.Authorizer),
+	}
+}
+
+func (k *keyVaultTenantAuthorizers) authorizerForTenant(ctx context.Context, tenantId string) (auth.Authorizer, error) {
+	if tenantId == "" || strings.EqualFold(tenantId, k.config.TenantID) {
+		return k.primary, nil
+	}
+
+	for _, auxiliaryTenantId := range k.config.AuxiliaryTenantIDs {
+		if !strings.EqualFold(auxiliaryTenantId, tenantId) {
+			continue
+		}
+
+		k.lock.Lock()
+		defer k.lock.Unlock()
+
+		key := strings.ToLower(auxiliaryTenantId)
+		if v, ok := k.auxiliary[key]; ok {
+			return v, nil
+		}
+
+		auxiliaryConfig := k.config
+		auxiliaryConfig.TenantID = auxiliaryTenantId
+		auxiliaryConfig.AuxiliaryTenantIDs = nil
+		auxiliaryAuthorizer, err := k.newAuthorizer(ctx, auxiliaryConfig)
+		if err != nil {
+			return nil, fmt.Errorf("building Key Vault authorizer for auxiliary Tenant %q: %+v", auxiliaryTenantId, err)
+		}
+		k.auxiliary[key] = auxiliaryAuthorizer
+		return auxiliaryAuthorizer, nil
+	}
+
+	// the Tenant isn't one we can authenticate against, so fall back to the primary Tenant and let the API surface the error
+	log.Printf("[DEBUG] Key Vault requested a token for Tenant %q which is neither the primary nor an auxiliary Tenant", tenantId)
+	return k.primary, nil
+}
+
+// staticTokenProvider implements adal.OAuthTokenProvider for an access token which has already been obtained
+type staticTokenProvider string
+
+func (s staticTokenProvider) OAuthToken() string {
+	return string(s)
+}
@@ -0,0 +1,97 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"golang.org/x/oauth2"
+)
+
+type fakeKeyVaultAuthorizer struct {
+	tenantId string
+}
+
+func (f fakeKeyVaultAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: f.tenantId}, nil
+}
+
+func (f fakeKeyVaultAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+func TestKeyVaultTenantAuthorizers(t *testing.T) {
+	config := auth.Credentials{
+		TenantID:           "00000000-0000-0000-0000-000000000000",
+		AuxiliaryTenantIDs: []string{"aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"},
+	}
+
+	testData := []struct {
+		name             string
+		challengeTenant  string
+		expectedTenant   string
+		expectedBuilders int
+	}{
+		{
+			name:            "no tenant in challenge",
+			challengeTenant: "",
+			expectedTenant:  "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			name:            "primary tenant",
+			challengeTenant: "00000000-0000-0000-0000-000000000000",
+			expectedTenant:  "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			name:             "auxiliary tenant",
+			challengeTenant:  "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			expectedTenant:   "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			expectedBuilders: 1,
+		},
+		{
+			name:             "auxiliary tenant with a different casing",
+			challengeTenant:  "AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA",
+			expectedTenant:   "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			expectedBuilders: 1,
+		},
+		{
+			name:            "unknown tenant",
+			challengeTenant: "22222222-2222-2222-2222-222222222222",
+			expectedTenant:  "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		builders := 0
+		authorizers := newKeyVaultTenantAuthorizers(config, fakeKeyVaultAuthorizer{tenantId: config.TenantID}, func(_ context.Context, config auth.Credentials) (auth.Authorizer, error) {
+			builders++
+			if len(config.AuxiliaryTenantIDs) != 0 {
+				t.Fatalf("expected no Auxiliary Tenants when building the Authorizer for an Auxiliary Tenant but got %+v", config.AuxiliaryTenantIDs)
+			}
+			return fakeKeyVaultAuthorizer{tenantId: config.TenantID}, nil
+		})
+
+		// retrieve the Authorizer twice to confirm the Auxiliary Tenant Authorizer is cached
+		for i := 0; i < 2; i++ {
+			authorizer, err := authorizers.authorizerForTenant(context.TODO(), v.challengeTenant)
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+
+			token, err := authorizer.Token(context.TODO(), &http.Request{})
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			if token.AccessToken != v.expectedTenant {
+				t.Fatalf("expected the Authorizer for Tenant %q but got %q", v.expectedTenant, token.AccessToken)
+			}
+		}
+
+		if builders != v.expectedBuilders {
+			t.Fatalf("expected %d Authorizers to be built but got %d", v.expectedBuilders, builders)
+		}
+	}
+}
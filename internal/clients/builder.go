@@ -25,6 +25,7 @@ type ClientBuilder struct {
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 
+	ApiVersionOverrides        map[string]string
 	CustomCorrelationRequestID string
 	MetadataHost               string
 	PartnerID                  string
@@ -123,17 +124,19 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		Environment: builder.AuthConfig.Environment,
 		Features:    builder.Features,
 
+		ObjectId:         account.ObjectId,
 		SubscriptionId:   account.SubscriptionId,
 		TenantId:         account.TenantId,
 		PartnerId:        builder.PartnerID,
 		TerraformVersion: builder.TerraformVersion,
 
 		BatchManagementAuthorizer: authWrapper.AutorestAuthorizer(batchManagementAuth),
-		KeyVaultAuthorizer:        authWrapper.AutorestAuthorizer(keyVaultAuth).BearerAuthorizerCallback(),
+		KeyVaultAuthorizer:        keyVaultAuthorizerCallback(ctx, *builder.AuthConfig, keyVaultAuth),
 		ResourceManagerAuthorizer: authWrapper.AutorestAuthorizer(resourceManagerAuth),
 		StorageAuthorizer:         authWrapper.AutorestAuthorizer(storageAuth),
 		SynapseAuthorizer:         authWrapper.AutorestAuthorizer(synapseAuth),
 
+		ApiVersionOverrides:         builder.ApiVersionOverrides,
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
@@ -8,7 +8,6 @@ import (
 	"github.com/Azure/go-autorest/autorest/validation"
 	aadb2c_v2021_04_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview"
 	analysisservices_v2017_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01"
-	azurestackhci_v2022_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2022-12-01"
 	datadog_v2021_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01"
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
 	fluidrelay_2022_05_26 "github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26"
@@ -118,6 +117,7 @@ import (
 	serviceConnector "github.com/hashicorp/terraform-provider-azurerm/internal/services/serviceconnector/client"
 	serviceFabric "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabric/client"
 	serviceFabricManaged "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/client"
+	serviceNetworking "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/client"
 	signalr "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/client"
 	appPlatform "github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/client"
 	sql "github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/client"
@@ -131,6 +131,7 @@ import (
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
+	workloads "github.com/hashicorp/terraform-provider-azurerm/internal/services/workloads/client"
 )
 
 type Client struct {
@@ -154,7 +155,7 @@ type Client struct {
 	Attestation           *attestation.Client
 	Authorization         *authorization.Client
 	Automation            *automation.Client
-	AzureStackHCI         *azurestackhci_v2022_12_01.Client
+	AzureStackHCI         *azureStackHCI.Client
 	Batch                 *batch.Client
 	Blueprints            *blueprints.Client
 	Bot                   *bot.Client
@@ -244,6 +245,7 @@ type Client struct {
 	ServiceConnector      *serviceConnector.Client
 	ServiceFabric         *serviceFabric.Client
 	ServiceFabricManaged  *serviceFabricManaged.Client
+	ServiceNetworking     *serviceNetworking.Client
 	SignalR               *signalr.Client
 	Storage               *storage.Client
 	StorageMover          *storageMover.Client
@@ -256,6 +258,7 @@ type Client struct {
 	Vmware                *vmware.Client
 	VoiceServices         *voiceServices.Client
 	Web                   *web.Client
+	Workloads             *workloads.Client
 }
 
 // NOTE: it should be possible for this method to become Private once the top level Client's removed
@@ -302,7 +305,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	}
 	client.Blueprints = blueprints.NewClient(o)
 	client.Bot = bot.NewClient(o)
-	client.Cdn = cdn.NewClient(o)
+	if client.Cdn, err = cdn.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Cdn: %+v", err)
+	}
 	if client.Cognitive, err = cognitiveServices.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Cognitive: %+v", err)
 	}
@@ -365,7 +370,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Eventhub, err = eventhub.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Eventhub: %+v", err)
 	}
-	client.Firewall = firewall.NewClient(o)
+	if client.Firewall, err = firewall.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Firewall: %+v", err)
+	}
 	client.FluidRelay = fluidrelay.NewClient(o)
 	client.Frontdoor = frontdoor.NewClient(o)
 	client.HPCCache = hpccache.NewClient(o)
@@ -391,7 +398,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Logz = logz.NewClient(o)
 	client.MachineLearning = machinelearning.NewClient(o)
 	client.Maintenance = maintenance.NewClient(o)
-	client.ManagedApplication = managedapplication.NewClient(o)
+	if client.ManagedApplication, err = managedapplication.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Managed Application: %+v", err)
+	}
 	client.ManagementGroups = managementgroup.NewClient(o)
 	if client.Maps, err = maps.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Maps: %+v", err)
@@ -424,7 +433,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Redis = redis.NewClient(o)
 	client.RedisEnterprise = redisenterprise.NewClient(o)
 	client.Relay = relay.NewClient(o)
-	client.Resource = resource.NewClient(o)
+	if client.Resource, err = resource.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Resource: %+v", err)
+	}
 	if client.Search, err = search.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Search: %+v", err)
 	}
@@ -436,6 +447,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.ServiceConnector = serviceConnector.NewClient(o)
 	client.ServiceFabric = serviceFabric.NewClient(o)
 	client.ServiceFabricManaged = serviceFabricManaged.NewClient(o)
+	if client.ServiceNetworking, err = serviceNetworking.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for ServiceNetworking: %+v", err)
+	}
 	if client.SignalR, err = signalr.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for SignalR: %+v", err)
 	}
@@ -452,6 +466,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Vmware = vmware.NewClient(o)
 	client.VoiceServices = voiceServices.NewClient(o)
 	client.Web = web.NewClient(o)
+	if client.Workloads, err = workloads.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Workloads: %+v", err)
+	}
 
 	return nil
 }
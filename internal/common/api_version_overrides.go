@@ -0,0 +1,113 @@
+package common
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+var apiVersionRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
+
+// ValidateApiVersionOverrides validates a map of Resource Provider Namespace (e.g. `Microsoft.Network`) to the API
+// Version which should be used for requests to that Resource Provider.
+//
+// Overriding the API Version is unsafe: only the `api-version` query string parameter is replaced, the request and
+// response payloads continue to use the models for the API Version the Provider was built against - as such a warning
+// is returned for each override.
+func ValidateApiVersionOverrides(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return
+	}
+
+	for namespace, raw := range v {
+		if parts := strings.Split(namespace, "."); len(parts) < 2 || strings.Contains(namespace, "/") {
+			errors = append(errors, fmt.Errorf("the key %q in %q must be a Resource Provider Namespace (e.g. `Microsoft.Network`)", namespace, k))
+		}
+
+		apiVersion, ok := raw.(string)
+		if !ok || !apiVersionRegex.MatchString(apiVersion) {
+			errors = append(errors, fmt.Errorf("the API Version %q for %q in %q must be in the format `YYYY-MM-DD` or `YYYY-MM-DD-preview`", raw, namespace, k))
+			continue
+		}
+
+		warnings = append(warnings, fmt.Sprintf("%q overrides the API Version used for %q to %q - this is unsupported since the request and response payloads are unchanged, which can cause fields to be silently dropped or misinterpreted", k, namespace, apiVersion))
+	}
+
+	return
+}
+
+// apiVersionOverrideForRequest returns the API Version which should be used for the specified request, based on the
+// Resource Provider Namespace of the (last) `providers` segment within the URI
+func apiVersionOverrideForRequest(request *http.Request, overrides map[string]string) (string, bool) {
+	if request == nil || request.URL == nil || len(overrides) == 0 {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	namespace := ""
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			namespace = segments[i+1]
+		}
+	}
+	if namespace == "" {
+		return "", false
+	}
+
+	for key, apiVersion := range overrides {
+		if strings.EqualFold(key, namespace) {
+			return apiVersion, true
+		}
+	}
+
+	return "", false
+}
+
+func overrideApiVersion(request *http.Request, overrides map[string]string) {
+	apiVersion, ok := apiVersionOverrideForRequest(request, overrides)
+	if !ok {
+		return
+	}
+
+	query := request.URL.Query()
+	existing := query.Get("api-version")
+	if existing == "" || existing == apiVersion {
+		return
+	}
+
+	log.Printf("[WARN] Overriding the API Version for %s %s from %q to %q", request.Method, request.URL.Path, existing, apiVersion)
+	query.Set("api-version", apiVersion)
+	request.URL.RawQuery = query.Encode()
+}
+
+func apiVersionOverridesMiddleware(overrides map[string]string) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		overrideApiVersion(request, overrides)
+		return request, nil
+	}
+}
+
+// withApiVersionOverrides returns a PrepareDecorator which overrides the API Version for matching requests, after
+// running the existing PrepareDecorator (if any)
+func withApiVersionOverrides(overrides map[string]string, existing autorest.PrepareDecorator) autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		if existing != nil {
+			p = existing(p)
+		}
+
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err == nil {
+				overrideApiVersion(r, overrides)
+			}
+			return r, err
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestApiVersionOverridesMiddleware(t *testing.T) {
+	overrides := map[string]string{
+		"Microsoft.Network": "2023-02-01",
+		"microsoft.storage": "2023-01-01-preview",
+	}
+
+	testData := []struct {
+		Url      string
+		Expected string
+	}{
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example?api-version=2022-07-01",
+			Expected: "2023-02-01",
+		},
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example?api-version=2022-09-01",
+			Expected: "2023-01-01-preview",
+		},
+		{
+			// the last providers segment determines the Resource Provider
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/providers/Microsoft.Insights/diagnosticSettings/example?api-version=2021-05-01-preview",
+			Expected: "2021-05-01-preview",
+		},
+		{
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example?api-version=2020-06-01",
+			Expected: "2020-06-01",
+		},
+		{
+			// requests without an API Version aren't modified
+			Url:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Expected: "",
+		},
+	}
+
+	middleware := apiVersionOverridesMiddleware(overrides)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Url)
+
+		u, err := url.Parse(v.Url)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Url, err)
+		}
+
+		request, err := middleware(&http.Request{Method: http.MethodGet, URL: u})
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if actual := request.URL.Query().Get("api-version"); actual != v.Expected {
+			t.Fatalf("expected the API Version to be %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithApiVersionOverrides(t *testing.T) {
+	overrides := map[string]string{
+		"Microsoft.Network": "2023-02-01",
+	}
+	u, _ := url.Parse("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example?api-version=2022-07-01")
+
+	request, err := autorest.Prepare(&http.Request{URL: u}, withApiVersionOverrides(overrides, withCorrelationRequestID("example")))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	if actual := request.URL.Query().Get("api-version"); actual != "2023-02-01" {
+		t.Fatalf("expected the API Version to be %q but got %q", "2023-02-01", actual)
+	}
+	if actual := request.Header.Get(HeaderCorrelationRequestID); actual != "example" {
+		t.Fatalf("expected the existing PrepareDecorator to be run but the Correlation Request ID was %q", actual)
+	}
+}
+
+func TestValidateApiVersionOverrides(t *testing.T) {
+	testData := []struct {
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"Microsoft.Network":          "2023-02-01",
+				"Microsoft.ContainerService": "2023-03-02-preview",
+			},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"Network": "2023-02-01",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"Microsoft.Network/virtualNetworks": "2023-02-01",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"Microsoft.Network": "latest",
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v..", v.Input)
+
+		warnings, errors := ValidateApiVersionOverrides(v.Input, "api_version_overrides")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("expected %t but got %t: %+v", v.Valid, valid, errors)
+		}
+
+		// every valid override is unsafe, so should be surfaced as a warning
+		if v.Valid && len(warnings) != len(v.Input) {
+			t.Fatalf("expected %d warnings but got %d: %+v", len(v.Input), len(warnings), warnings)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+var (
+	authorizationFailedActionRegex   = regexp.MustCompile(`perform action '([^']+)'`)
+	authorizationFailedObjectIdRegex = regexp.MustCompile(`object id '([^']+)'`)
+	authorizationFailedScopeRegex    = regexp.MustCompile(`over scope '([^']+)'`)
+)
+
+// builtInRoleSuggestions maps the (lower-cased) prefix of an action to the least-privileged built-in role
+// which grants it, the first matching prefix is used - so these must be ordered from most to least specific
+var builtInRoleSuggestions = []struct {
+	actionPrefix string
+	role         string
+}{
+	{actionPrefix: "microsoft.authorization/policy", role: "Resource Policy Contributor"},
+	{actionPrefix: "microsoft.authorization/", role: "User Access Administrator"},
+	{actionPrefix: "microsoft.apimanagement/", role: "API Management Service Contributor"},
+	{actionPrefix: "microsoft.compute/virtualmachines/", role: "Virtual Machine Contributor"},
+	{actionPrefix: "microsoft.containerservice/", role: "Azure Kubernetes Service Contributor Role"},
+	{actionPrefix: "microsoft.documentdb/", role: "DocumentDB Account Contributor"},
+	{actionPrefix: "microsoft.insights/", role: "Monitoring Contributor"},
+	{actionPrefix: "microsoft.keyvault/", role: "Key Vault Contributor"},
+	{actionPrefix: "microsoft.managedidentity/", role: "Managed Identity Contributor"},
+	{actionPrefix: "microsoft.network/", role: "Network Contributor"},
+	{actionPrefix: "microsoft.operationalinsights/", role: "Log Analytics Contributor"},
+	{actionPrefix: "microsoft.sql/", role: "SQL Server Contributor"},
+	{actionPrefix: "microsoft.storage/", role: "Storage Account Contributor"},
+	{actionPrefix: "microsoft.web/", role: "Website Contributor"},
+}
+
+// AuthorizationFailedDetails contains the information parsed from an `AuthorizationFailed` error returned by
+// Resource Manager, which is used to tell users which permission the identity in use is missing
+type AuthorizationFailedDetails struct {
+	Action        string
+	ObjectId      string
+	Scope         string
+	SuggestedRole string
+}
+
+func (d AuthorizationFailedDetails) String() string {
+	lines := []string{
+		"The identity used by Terraform doesn't have permission to perform this operation:",
+		"",
+	}
+	if d.Action != "" {
+		lines = append(lines, fmt.Sprintf("  Required Action: %s", d.Action))
+	}
+	if d.Scope != "" {
+		lines = append(lines, fmt.Sprintf("  Scope:           %s", d.Scope))
+	}
+	if d.ObjectId != "" {
+		lines = append(lines, fmt.Sprintf("  Object ID:       %s", d.ObjectId))
+	}
+	lines = append(lines, fmt.Sprintf("  Suggested Role:  %s", d.SuggestedRole))
+	lines = append(lines, "", "Assign a role containing this action to the identity (for example the Suggested Role) at this scope or above, note that new role assignments can take several minutes to propagate.")
+
+	return strings.Join(lines, "\n")
+}
+
+// parseAuthorizationFailedMessage parses the details from the message of an `AuthorizationFailed` error, falling
+// back to the Object ID of the authenticated identity when the message doesn't include it
+func parseAuthorizationFailedMessage(message, objectId string) AuthorizationFailedDetails {
+	details := AuthorizationFailedDetails{
+		ObjectId: objectId,
+	}
+
+	if v := authorizationFailedActionRegex.FindStringSubmatch(message); len(v) == 2 {
+		details.Action = v[1]
+	}
+	if v := authorizationFailedObjectIdRegex.FindStringSubmatch(message); len(v) == 2 {
+		details.ObjectId = v[1]
+	}
+	if v := authorizationFailedScopeRegex.FindStringSubmatch(message); len(v) == 2 {
+		details.Scope = v[1]
+	}
+	details.SuggestedRole = suggestBuiltInRole(details.Action)
+
+	return details
+}
+
+func suggestBuiltInRole(action string) string {
+	action = strings.ToLower(action)
+	if strings.HasSuffix(action, "/read") {
+		return "Reader"
+	}
+
+	for _, v := range builtInRoleSuggestions {
+		if strings.HasPrefix(action, v.actionPrefix) {
+			return v.role
+		}
+	}
+
+	return "Contributor"
+}
+
+// withAuthorizationFailedDetails appends the details of the missing permission to the message of an
+// `AuthorizationFailed` error within the response body, so that these are surfaced in the resulting error
+func withAuthorizationFailedDetails(response *http.Response, objectId string) error {
+	if response == nil || response.StatusCode != http.StatusForbidden || response.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return fmt.Errorf("reading response body: %+v", err)
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		// not a Resource Manager error, so leave this as-is
+		return nil
+	}
+
+	// Resource Manager nests the error within an `error` object, however some APIs return this at the top-level
+	errorObject := payload
+	if v, ok := payload["error"].(map[string]interface{}); ok {
+		errorObject = v
+	}
+
+	if code, ok := errorObject["code"].(string); !ok || !strings.EqualFold(code, "AuthorizationFailed") {
+		return nil
+	}
+
+	message, _ := errorObject["message"].(string)
+	details := parseAuthorizationFailedMessage(message, objectId)
+	errorObject["message"] = fmt.Sprintf("%s\n\n%s", message, details)
+
+	updated, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("[DEBUG] unable to append the details of the AuthorizationFailed error: %+v", err)
+		return nil
+	}
+
+	response.Body = io.NopCloser(bytes.NewReader(updated))
+	response.ContentLength = int64(len(updated))
+	return nil
+}
+
+func authorizationFailedResponseMiddleware(objectId string) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if err := withAuthorizationFailedDetails(response, objectId); err != nil {
+			return response, err
+		}
+		return response, nil
+	}
+}
+
+func authorizationFailedResponseInspector(objectId string) autorest.RespondDecorator {
+	return func(r autorest.Responder) autorest.Responder {
+		return autorest.ResponderFunc(func(response *http.Response) error {
+			if err := withAuthorizationFailedDetails(response, objectId); err != nil {
+				return err
+			}
+			return r.Respond(response)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package common
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const authorizationFailedMessage = "The client 'aaaaaaaa-0000-0000-0000-000000000000' with object id '11111111-0000-0000-0000-000000000000' does not have authorization to perform action 'Microsoft.Network/virtualNetworks/write' over scope '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example' or the scope is invalid. If access was recently granted, please refresh your credentials."
+
+func TestParseAuthorizationFailedMessage(t *testing.T) {
+	testData := []struct {
+		Message  string
+		ObjectId string
+		Expected AuthorizationFailedDetails
+	}{
+		{
+			Message:  authorizationFailedMessage,
+			ObjectId: "22222222-0000-0000-0000-000000000000",
+			Expected: AuthorizationFailedDetails{
+				Action:        "Microsoft.Network/virtualNetworks/write",
+				ObjectId:      "11111111-0000-0000-0000-000000000000",
+				Scope:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+				SuggestedRole: "Network Contributor",
+			},
+		},
+		{
+			Message:  "The client does not have authorization to perform action 'Microsoft.Resources/subscriptions/resourceGroups/write'.",
+			ObjectId: "22222222-0000-0000-0000-000000000000",
+			Expected: AuthorizationFailedDetails{
+				Action:        "Microsoft.Resources/subscriptions/resourceGroups/write",
+				ObjectId:      "22222222-0000-0000-0000-000000000000",
+				SuggestedRole: "Contributor",
+			},
+		},
+		{
+			Message: "The client does not have authorization to perform action 'Microsoft.Storage/storageAccounts/read'.",
+			Expected: AuthorizationFailedDetails{
+				Action:        "Microsoft.Storage/storageAccounts/read",
+				SuggestedRole: "Reader",
+			},
+		},
+		{
+			Message: "The client does not have authorization to perform action 'Microsoft.Authorization/roleAssignments/write'.",
+			Expected: AuthorizationFailedDetails{
+				Action:        "Microsoft.Authorization/roleAssignments/write",
+				SuggestedRole: "User Access Administrator",
+			},
+		},
+		{
+			Message: "The client does not have authorization to perform action 'Microsoft.Authorization/policyAssignments/write'.",
+			Expected: AuthorizationFailedDetails{
+				Action:        "Microsoft.Authorization/policyAssignments/write",
+				SuggestedRole: "Resource Policy Contributor",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Message)
+
+		actual := parseAuthorizationFailedMessage(v.Message, v.ObjectId)
+		if actual != v.Expected {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestAuthorizationFailedResponseMiddleware(t *testing.T) {
+	testData := []struct {
+		StatusCode int
+		Body       string
+		Modified   bool
+	}{
+		{
+			StatusCode: http.StatusForbidden,
+			Body:       `{"error":{"code":"AuthorizationFailed","message":"` + authorizationFailedMessage + `"}}`,
+			Modified:   true,
+		},
+		{
+			StatusCode: http.StatusForbidden,
+			Body:       `{"code":"AuthorizationFailed","message":"` + authorizationFailedMessage + `"}`,
+			Modified:   true,
+		},
+		{
+			StatusCode: http.StatusForbidden,
+			Body:       `{"error":{"code":"LinkedAuthorizationFailed","message":"nope"}}`,
+			Modified:   false,
+		},
+		{
+			StatusCode: http.StatusForbidden,
+			Body:       `not json`,
+			Modified:   false,
+		},
+		{
+			StatusCode: http.StatusNotFound,
+			Body:       `{"error":{"code":"AuthorizationFailed","message":"` + authorizationFailedMessage + `"}}`,
+			Modified:   false,
+		},
+	}
+
+	middleware := authorizationFailedResponseMiddleware("22222222-0000-0000-0000-000000000000")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %d: %s..", v.StatusCode, v.Body)
+
+		response := &http.Response{
+			StatusCode: v.StatusCode,
+			Body:       io.NopCloser(strings.NewReader(v.Body)),
+		}
+		response, err := middleware(&http.Request{}, response)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			t.Fatalf("reading body: %+v", err)
+		}
+
+		if modified := string(body) != v.Body; modified != v.Modified {
+			t.Fatalf("expected modified to be %t but got %t: %s", v.Modified, modified, body)
+		}
+		if v.Modified && !strings.Contains(string(body), "Suggested Role:  Network Contributor") {
+			t.Fatalf("expected the body to contain the suggested role but got %s", body)
+		}
+	}
+}
+
+func TestAuthorizationFailedResponseInspector(t *testing.T) {
+	response := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Body:       io.NopCloser(strings.NewReader(`{"error":{"code":"AuthorizationFailed","message":"` + authorizationFailedMessage + `"}}`)),
+	}
+
+	err := autorest.Respond(response, authorizationFailedResponseInspector(""), azure.WithErrorUnlessStatusCode(http.StatusOK), autorest.ByClosing())
+	if err == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+
+	if !strings.Contains(err.Error(), "Required Action: Microsoft.Network/virtualNetworks/write") {
+		t.Fatalf("expected the error to contain the required action but got %+v", err)
+	}
+}
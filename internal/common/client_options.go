@@ -35,6 +35,7 @@ type ClientOptions struct {
 	Environment environments.Environment
 	Features    features.UserFeatures
 
+	ObjectId         string
 	SubscriptionId   string
 	TenantId         string
 	PartnerId        string
@@ -43,6 +44,10 @@ type ClientOptions struct {
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
 
+	// ApiVersionOverrides is a map of Resource Provider Namespace to the API Version which should be used instead
+	// of the API Version the SDK was generated against
+	ApiVersionOverrides map[string]string
+
 	DisableTerraformPartnerID bool
 	SkipProviderReg           bool
 	StorageUseAzureAD         bool
@@ -73,11 +78,15 @@ func (o ClientOptions) Configure(c *resourcemanager.Client, authorizer auth.Auth
 		}
 		requestMiddlewares = append(requestMiddlewares, correlationRequestIDMiddleware(id))
 	}
+	if len(o.ApiVersionOverrides) > 0 {
+		requestMiddlewares = append(requestMiddlewares, apiVersionOverridesMiddleware(o.ApiVersionOverrides))
+	}
 	requestMiddlewares = append(requestMiddlewares, requestLoggerMiddleware("AzureRM"))
 	c.RequestMiddlewares = &requestMiddlewares
 
 	c.ResponseMiddlewares = &[]client.ResponseMiddleware{
 		responseLoggerMiddleware("AzureRM"),
+		authorizationFailedResponseMiddleware(o.ObjectId),
 	}
 }
 
@@ -95,6 +104,10 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 		}
 		c.RequestInspector = withCorrelationRequestID(id)
 	}
+	if len(o.ApiVersionOverrides) > 0 {
+		c.RequestInspector = withApiVersionOverrides(o.ApiVersionOverrides, c.RequestInspector)
+	}
+	c.ResponseInspector = authorizationFailedResponseInspector(o.ObjectId)
 }
 
 func userAgent(userAgent, tfVersion, partnerID string, disableTerraformPartnerID bool) string {
@@ -16,6 +16,7 @@ func Default() UserFeatures {
 		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
+			RecoverSoftDeleted:       true,
 		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:         true,
@@ -1,5 +1,7 @@
 package features
 
+import "time"
+
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
 	AppConfiguration       AppConfigurationFeatures
@@ -12,10 +14,14 @@ type UserFeatures struct {
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
 	ManagedDisk            ManagedDiskFeatures
+	Naming                 NamingFeatures
+	Timeouts               TimeoutsFeatures
+	DriftDetectionOnly     DriftDetectionOnlyFeatures
 }
 
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
 }
 
 type VirtualMachineFeatures struct {
@@ -71,3 +77,24 @@ type AppConfigurationFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 	RecoverSoftDeleted       bool
 }
+
+type NamingFeatures struct {
+	// ValidationRegex is a map of Resource Type (or Resource Type prefix ending in `*`) to the
+	// Regular Expression which the `name` of matching Resources must satisfy
+	ValidationRegex map[string]string
+}
+
+type TimeoutsFeatures struct {
+	// these override the default timeouts for all Resources which don't specify an explicit
+	// timeout for the operation, a zero value means the Resource's own default is used
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+type DriftDetectionOnlyFeatures struct {
+	// ResourceTypes is a list of Resource Types which are read (and so report drift) but are never
+	// created, updated or deleted by the Provider - for resources co-managed by an external controller
+	ResourceTypes []string
+}
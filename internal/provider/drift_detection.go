@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// applyDriftDetectionOnly wraps the Create, Update and Delete functions of the specified Resource so that, when the
+// Resource Type is listed in `drift_detection_only` within the Provider's `features` block, the Resource is never
+// modified:
+//
+// * Create returns an error, since these Resources must be imported.
+// * Update refreshes the Resource from Azure rather than applying the changes, meaning the drift continues to be
+// reported in the plan until the configuration is updated to match.
+// * Delete returns an error, both when the Resource is destroyed and when it'd be replaced.
+//
+// The check is made at runtime against the Features of the Client used for the operation, so Resources which aren't
+// configured for drift detection only (including when there's more than one instance of the Provider) are unaffected,
+// and plans are never blocked - the drift is reported as any other difference between the configuration and Azure.
+func applyDriftDetectionOnly(resourceType string, resource *pluginsdk.Resource) {
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *pluginsdk.ResourceData, meta interface{}) error {
+			if driftDetectionOnlyEnabled(meta, resourceType) {
+				return driftDetectionOnlyCreateError(resourceType)
+			}
+			return create(d, meta)
+		}
+	}
+	if create := resource.CreateWithoutTimeout; create != nil {
+		resource.CreateWithoutTimeout = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			if driftDetectionOnlyEnabled(meta, resourceType) {
+				return diag.FromErr(driftDetectionOnlyCreateError(resourceType))
+			}
+			return create(ctx, d, meta)
+		}
+	}
+
+	if update, read := resource.Update, resource.Read; update != nil && read != nil {
+		resource.Update = func(d *pluginsdk.ResourceData, meta interface{}) error {
+			if driftDetectionOnlyEnabled(meta, resourceType) {
+				log.Printf("[WARN] %s", driftDetectionOnlyUpdateWarning(resourceType, d.Id()))
+				return read(d, meta)
+			}
+			return update(d, meta)
+		}
+	}
+	if update, read := resource.UpdateWithoutTimeout, resource.ReadWithoutTimeout; update != nil && read != nil {
+		resource.UpdateWithoutTimeout = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			if driftDetectionOnlyEnabled(meta, resourceType) {
+				diags := diag.Diagnostics{
+					{
+						Severity: diag.Warning,
+						Summary:  driftDetectionOnlyUpdateWarning(resourceType, d.Id()),
+					},
+				}
+				return append(diags, read(ctx, d, meta)...)
+			}
+			return update(ctx, d, meta)
+		}
+	}
+
+	if del := resource.Delete; del != nil {
+		resource.Delete = func(d *pluginsdk.ResourceData, meta interface{}) error {
+			if driftDetectionOnlyEnabled(meta, resourceType) {
+				return driftDetectionOnlyDeleteError(resourceType, d.Id())
+			}
+			return del(d, meta)
+		}
+	}
+	if del := resource.DeleteWithoutTimeout; del != nil {
+		resource.DeleteWithoutTimeout = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			if driftDetectionOnlyEnabled(meta, resourceType) {
+				return diag.FromErr(driftDetectionOnlyDeleteError(resourceType, d.Id()))
+			}
+			return del(ctx, d, meta)
+		}
+	}
+}
+
+func driftDetectionOnlyEnabled(meta interface{}, resourceType string) bool {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil {
+		return false
+	}
+
+	return driftDetectionOnlyForResourceType(client.Features.DriftDetectionOnly.ResourceTypes, resourceType)
+}
+
+func driftDetectionOnlyCreateError(resourceType string) error {
+	return fmt.Errorf("%q is configured for drift detection only within the `features` block, so new resources of this type can't be created - the existing resource should be imported instead", resourceType)
+}
+
+func driftDetectionOnlyUpdateWarning(resourceType, id string) string {
+	return fmt.Sprintf("%q is configured for drift detection only within the `features` block, so the changes to %q weren't applied and it has been refreshed from Azure instead", resourceType, id)
+}
+
+func driftDetectionOnlyDeleteError(resourceType, id string) error {
+	return fmt.Errorf("%q is configured for drift detection only within the `features` block, so %q can't be deleted or replaced - to stop managing it, remove it from the state using `terraform state rm` instead", resourceType, id)
+}
+
+func driftDetectionOnlyForResourceType(resourceTypes []string, resourceType string) bool {
+	for _, v := range resourceTypes {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateDriftDetectionOnlyResourceTypes ensures each of the Resource Types specified in `drift_detection_only`
+// within the `features` block is supported by this Provider
+func validateDriftDetectionOnlyResourceTypes(resources map[string]*pluginsdk.Resource, resourceTypes []string) error {
+	for _, resourceType := range resourceTypes {
+		if _, ok := resources[resourceType]; !ok {
+			return fmt.Errorf("the Resource Type %q specified in `drift_detection_only` within the `features` block isn't supported by this Provider", resourceType)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type driftDetectionOnlyCalls struct {
+	create bool
+	read   bool
+	update bool
+	delete bool
+}
+
+func TestApplyDriftDetectionOnly(t *testing.T) {
+	client := &clients.Client{
+		Features: features.UserFeatures{
+			DriftDetectionOnly: features.DriftDetectionOnlyFeatures{
+				ResourceTypes: []string{"azurerm_example"},
+			},
+		},
+	}
+
+	testData := []struct {
+		name          string
+		resourceType  string
+		meta          interface{}
+		expectError   bool
+		expectedCalls driftDetectionOnlyCalls
+	}{
+		{
+			name:         "configured for drift detection only",
+			resourceType: "azurerm_example",
+			meta:         client,
+			expectError:  true,
+			expectedCalls: driftDetectionOnlyCalls{
+				// Update refreshes the resource rather than applying the changes
+				read: true,
+			},
+		},
+		{
+			name:         "not configured for drift detection only",
+			resourceType: "azurerm_other_example",
+			meta:         client,
+			expectedCalls: driftDetectionOnlyCalls{
+				create: true,
+				update: true,
+				delete: true,
+			},
+		},
+		{
+			name:         "without a configured client",
+			resourceType: "azurerm_example",
+			meta:         nil,
+			expectedCalls: driftDetectionOnlyCalls{
+				create: true,
+				update: true,
+				delete: true,
+			},
+		},
+	}
+
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		// untyped resources
+		calls := driftDetectionOnlyCalls{}
+		resource := &pluginsdk.Resource{
+			Schema: resourceSchema,
+			Create: func(d *pluginsdk.ResourceData, meta interface{}) error {
+				calls.create = true
+				return nil
+			},
+			Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+				calls.read = true
+				return nil
+			},
+			Update: func(d *pluginsdk.ResourceData, meta interface{}) error {
+				calls.update = true
+				return nil
+			},
+			Delete: func(d *pluginsdk.ResourceData, meta interface{}) error {
+				calls.delete = true
+				return nil
+			},
+		}
+		applyDriftDetectionOnly(v.resourceType, resource)
+
+		d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "example"})
+		createErr := resource.Create(d, v.meta)
+		updateErr := resource.Update(d, v.meta)
+		deleteErr := resource.Delete(d, v.meta)
+
+		if v.expectError != (createErr != nil) || v.expectError != (deleteErr != nil) {
+			t.Fatalf("expected errors from Create and Delete to be %t but got %+v and %+v", v.expectError, createErr, deleteErr)
+		}
+		if updateErr != nil {
+			t.Fatalf("expected no error from Update but got: %+v", updateErr)
+		}
+		if calls != v.expectedCalls {
+			t.Fatalf("expected the calls to be %+v but got %+v", v.expectedCalls, calls)
+		}
+
+		// typed resources
+		calls = driftDetectionOnlyCalls{}
+		resource = &pluginsdk.Resource{
+			Schema: resourceSchema,
+			CreateWithoutTimeout: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+				calls.create = true
+				return nil
+			},
+			ReadWithoutTimeout: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+				calls.read = true
+				return nil
+			},
+			UpdateWithoutTimeout: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+				calls.update = true
+				return nil
+			},
+			DeleteWithoutTimeout: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+				calls.delete = true
+				return nil
+			},
+		}
+		applyDriftDetectionOnly(v.resourceType, resource)
+
+		createDiags := resource.CreateWithoutTimeout(context.TODO(), d, v.meta)
+		updateDiags := resource.UpdateWithoutTimeout(context.TODO(), d, v.meta)
+		deleteDiags := resource.DeleteWithoutTimeout(context.TODO(), d, v.meta)
+
+		if v.expectError != createDiags.HasError() || v.expectError != deleteDiags.HasError() {
+			t.Fatalf("expected errors from Create and Delete to be %t but got %+v and %+v", v.expectError, createDiags, deleteDiags)
+		}
+		if updateDiags.HasError() {
+			t.Fatalf("expected no error from Update but got: %+v", updateDiags)
+		}
+		if v.expectError && len(updateDiags) == 0 {
+			t.Fatalf("expected a warning from Update but didn't get one")
+		}
+		if calls != v.expectedCalls {
+			t.Fatalf("expected the calls to be %+v but got %+v", v.expectedCalls, calls)
+		}
+	}
+}
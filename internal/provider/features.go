@@ -2,10 +2,13 @@ package provider
 
 import (
 	"os"
+	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
@@ -81,6 +84,12 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  true,
 					},
+
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
 				},
 			},
 		},
@@ -265,6 +274,66 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"naming": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_regex": {
+						Type:         pluginsdk.TypeMap,
+						Required:     true,
+						ValidateFunc: validateNamingValidationRegex,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"timeouts": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"create": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeout,
+					},
+
+					"read": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeout,
+					},
+
+					"update": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeout,
+					},
+
+					"delete": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validateFeaturesTimeout,
+					},
+				},
+			},
+		},
+
+		"drift_detection_only": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^azurerm_[a-z0-9_]+$`), "must be the name of an `azurerm_` Resource Type"),
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -343,6 +412,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := cognitiveRaw["purge_soft_delete_on_destroy"]; ok {
 				featuresMap.CognitiveAccount.PurgeSoftDeleteOnDestroy = v.(bool)
 			}
+			if v, ok := cognitiveRaw["recover_soft_deleted"]; ok {
+				featuresMap.CognitiveAccount.RecoverSoftDeleted = v.(bool)
+			}
 		}
 	}
 
@@ -452,5 +524,50 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["naming"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			namingRaw := items[0].(map[string]interface{})
+			if v, ok := namingRaw["validation_regex"]; ok {
+				for resourceType, regex := range v.(map[string]interface{}) {
+					if featuresMap.Naming.ValidationRegex == nil {
+						featuresMap.Naming.ValidationRegex = make(map[string]string)
+					}
+					featuresMap.Naming.ValidationRegex[resourceType] = regex.(string)
+				}
+			}
+		}
+	}
+
+	if raw, ok := val["timeouts"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			timeoutsRaw := items[0].(map[string]interface{})
+			if v, ok := timeoutsRaw["create"]; ok {
+				featuresMap.Timeouts.Create = parseFeaturesTimeout(v.(string))
+			}
+			if v, ok := timeoutsRaw["read"]; ok {
+				featuresMap.Timeouts.Read = parseFeaturesTimeout(v.(string))
+			}
+			if v, ok := timeoutsRaw["update"]; ok {
+				featuresMap.Timeouts.Update = parseFeaturesTimeout(v.(string))
+			}
+			if v, ok := timeoutsRaw["delete"]; ok {
+				featuresMap.Timeouts.Delete = parseFeaturesTimeout(v.(string))
+			}
+		}
+	}
+
+	if raw, ok := val["drift_detection_only"]; ok {
+		if items := raw.(*pluginsdk.Set).List(); len(items) > 0 {
+			resourceTypes := make([]string, 0)
+			for _, v := range items {
+				resourceTypes = append(resourceTypes, v.(string))
+			}
+			sort.Strings(resourceTypes)
+			featuresMap.DriftDetectionOnly.ResourceTypes = resourceTypes
+		}
+	}
+
 	return featuresMap
 }
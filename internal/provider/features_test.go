@@ -3,8 +3,10 @@ package provider
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func TestExpandFeatures(t *testing.T) {
@@ -31,6 +33,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
@@ -91,6 +94,7 @@ func TestExpandFeatures(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
 						},
 					},
 					"key_vault": []interface{}{
@@ -156,6 +160,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
@@ -216,6 +221,7 @@ func TestExpandFeatures(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
 						},
 					},
 					"key_vault": []interface{}{
@@ -281,6 +287,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   false,
@@ -550,16 +557,18 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
 		{
-			Name: "Purge on Destroy Enabled",
+			Name: "Purge Soft Delete On Destroy and Recover Soft Deleted Cognitive Account Enabled",
 			Input: []interface{}{
 				map[string]interface{}{
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
 						},
 					},
 				},
@@ -567,16 +576,18 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
 		{
-			Name: "Purge on Destroy Disabled",
+			Name: "Purge Soft Delete On Destroy and Recover Soft Deleted Cognitive Account Disabled",
 			Input: []interface{}{
 				map[string]interface{}{
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
 						},
 					},
 				},
@@ -584,6 +595,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
 				},
 			},
 		},
@@ -1193,3 +1205,176 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesNaming(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"naming": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Naming: features.NamingFeatures{},
+			},
+		},
+		{
+			Name: "Validation Regex Specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"naming": []interface{}{
+						map[string]interface{}{
+							"validation_regex": map[string]interface{}{
+								"azurerm_resource_group": "^rg-",
+								"azurerm_storage_*":      "^st",
+							},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Naming: features.NamingFeatures{
+					ValidationRegex: map[string]string{
+						"azurerm_resource_group": "^rg-",
+						"azurerm_storage_*":      "^st",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Naming, testCase.Expected.Naming) {
+			t.Fatalf("Expected %+v but got %+v", result.Naming, testCase.Expected.Naming)
+		}
+	}
+}
+
+func TestExpandFeaturesTimeouts(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: features.TimeoutsFeatures{},
+			},
+		},
+		{
+			Name: "Create and Delete Specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{
+						map[string]interface{}{
+							"create": "2h",
+							"read":   "",
+							"update": "",
+							"delete": "90m",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: features.TimeoutsFeatures{
+					Create: 2 * time.Hour,
+					Delete: 90 * time.Minute,
+				},
+			},
+		},
+		{
+			Name: "All Specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{
+						map[string]interface{}{
+							"create": "3h",
+							"read":   "10m",
+							"update": "2h30m",
+							"delete": "1h",
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: features.TimeoutsFeatures{
+					Create: 3 * time.Hour,
+					Read:   10 * time.Minute,
+					Update: 150 * time.Minute,
+					Delete: time.Hour,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Timeouts, testCase.Expected.Timeouts) {
+			t.Fatalf("Expected %+v but got %+v", result.Timeouts, testCase.Expected.Timeouts)
+		}
+	}
+}
+
+func TestExpandFeaturesDriftDetectionOnly(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"drift_detection_only": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+				},
+			},
+			Expected: features.UserFeatures{
+				DriftDetectionOnly: features.DriftDetectionOnlyFeatures{},
+			},
+		},
+		{
+			Name: "Resource Types Specified",
+			Input: []interface{}{
+				map[string]interface{}{
+					"drift_detection_only": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{
+						"azurerm_virtual_network",
+						"azurerm_kubernetes_cluster_node_pool",
+					}),
+				},
+			},
+			Expected: features.UserFeatures{
+				DriftDetectionOnly: features.DriftDetectionOnlyFeatures{
+					ResourceTypes: []string{
+						"azurerm_kubernetes_cluster_node_pool",
+						"azurerm_virtual_network",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.DriftDetectionOnly, testCase.Expected.DriftDetectionOnly) {
+			t.Fatalf("Expected %+v but got %+v", result.DriftDetectionOnly, testCase.Expected.DriftDetectionOnly)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// applyNamingValidation wraps the CustomizeDiff of the specified Resource so that the `name` argument is validated
+// against the Regular Expressions defined in the `naming` block within the Provider's `features` block at plan time.
+func applyNamingValidation(resourceType string, resource *pluginsdk.Resource) {
+	v, ok := resource.Schema["name"]
+	if !ok || v.Type != pluginsdk.TypeString || (!v.Required && !v.Optional) {
+		return
+	}
+
+	validate := validateNameMatchesConvention(resourceType)
+	if existing := resource.CustomizeDiff; existing != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(validate, existing)
+		return
+	}
+
+	resource.CustomizeDiff = validate
+}
+
+func validateNameMatchesConvention(resourceType string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || len(client.Features.Naming.ValidationRegex) == 0 {
+			return nil
+		}
+
+		// existing resources are only validated when they're renamed, so that introducing a naming convention
+		// doesn't block changes to resources which pre-date it
+		if d.Id() != "" && !d.HasChange("name") {
+			return nil
+		}
+
+		if !d.NewValueKnown("name") {
+			return nil
+		}
+
+		name := d.Get("name").(string)
+		if name == "" {
+			return nil
+		}
+
+		key, expression, ok := namingValidationRegexForResourceType(client.Features.Naming.ValidationRegex, resourceType)
+		if !ok {
+			return nil
+		}
+
+		regex, err := regexp.Compile(expression)
+		if err != nil {
+			return fmt.Errorf("compiling the naming validation regex %q for %q: %+v", expression, key, err)
+		}
+
+		if !regex.MatchString(name) {
+			return fmt.Errorf("the `name` %q does not match the naming convention %q configured for %q within the `naming` block of the Provider's `features` block - please update the `name` to match this Regular Expression", name, expression, key)
+		}
+
+		return nil
+	}
+}
+
+// namingValidationRegexForResourceType returns the naming validation rule which applies to the specified Resource Type.
+// A rule for the exact Resource Type takes precedence, otherwise the rule with the longest matching prefix (e.g.
+// `azurerm_storage_*`) is used.
+func namingValidationRegexForResourceType(rules map[string]string, resourceType string) (string, string, bool) {
+	if v, ok := rules[resourceType]; ok {
+		return resourceType, v, true
+	}
+
+	matchedKey := ""
+	for key := range rules {
+		if !strings.HasSuffix(key, "*") {
+			continue
+		}
+
+		if strings.HasPrefix(resourceType, strings.TrimSuffix(key, "*")) && len(key) > len(matchedKey) {
+			matchedKey = key
+		}
+	}
+
+	if matchedKey == "" {
+		return "", "", false
+	}
+
+	return matchedKey, rules[matchedKey], true
+}
+
+func validateNamingValidationRegex(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return
+	}
+
+	for key, raw := range v {
+		if !strings.HasPrefix(key, "azurerm_") {
+			errors = append(errors, fmt.Errorf("the key %q in %q must be a Resource Type (e.g. `azurerm_resource_group`) or a Resource Type prefix (e.g. `azurerm_storage_*`)", key, k))
+		}
+
+		if strings.Contains(strings.TrimSuffix(key, "*"), "*") {
+			errors = append(errors, fmt.Errorf("the key %q in %q can only contain a wildcard (`*`) as the last character", key, k))
+		}
+
+		expression, ok := raw.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected the value for %q in %q to be a string", key, k))
+			continue
+		}
+
+		if _, err := regexp.Compile(expression); err != nil {
+			errors = append(errors, fmt.Errorf("the value for %q in %q is not a valid Regular Expression: %+v", key, k, err))
+		}
+	}
+
+	return
+}
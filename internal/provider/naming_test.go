@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestNamingValidationRegexForResourceType(t *testing.T) {
+	rules := map[string]string{
+		"azurerm_resource_group":   "^rg-",
+		"azurerm_storage_*":        "^st",
+		"azurerm_storage_account*": "^sa",
+	}
+
+	testData := []struct {
+		ResourceType string
+		ExpectedKey  string
+		Matched      bool
+	}{
+		{
+			ResourceType: "azurerm_resource_group",
+			ExpectedKey:  "azurerm_resource_group",
+			Matched:      true,
+		},
+		{
+			ResourceType: "azurerm_resource_group_template_deployment",
+			Matched:      false,
+		},
+		{
+			ResourceType: "azurerm_storage_container",
+			ExpectedKey:  "azurerm_storage_*",
+			Matched:      true,
+		},
+		{
+			ResourceType: "azurerm_storage_account",
+			ExpectedKey:  "azurerm_storage_account*",
+			Matched:      true,
+		},
+		{
+			ResourceType: "azurerm_virtual_network",
+			Matched:      false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.ResourceType)
+
+		key, expression, ok := namingValidationRegexForResourceType(rules, v.ResourceType)
+		if ok != v.Matched {
+			t.Fatalf("expected matched to be %t but got %t", v.Matched, ok)
+		}
+		if key != v.ExpectedKey {
+			t.Fatalf("expected key %q but got %q", v.ExpectedKey, key)
+		}
+		if ok && expression != rules[v.ExpectedKey] {
+			t.Fatalf("expected expression %q but got %q", rules[v.ExpectedKey], expression)
+		}
+	}
+}
+
+func TestValidateNamingValidationRegex(t *testing.T) {
+	testData := []struct {
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"azurerm_resource_group": "^rg-[a-z0-9-]+$",
+				"azurerm_storage_*":      "^st[a-z0-9]+$",
+			},
+			Valid: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"resource_group": "^rg-",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"azurerm_*_account": "^sa",
+			},
+			Valid: false,
+		},
+		{
+			Input: map[string]interface{}{
+				"azurerm_resource_group": "^rg-[",
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v..", v.Input)
+
+		_, errors := validateNamingValidationRegex(v.Input, "validation_regex")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("expected %t but got %t: %+v", v.Valid, valid, errors)
+		}
+	}
+}
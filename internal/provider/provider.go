@@ -16,8 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -162,6 +164,11 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	for resourceType, resource := range resources {
+		applyNamingValidation(resourceType, resource)
+		applyDriftDetectionOnly(resourceType, resource)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -305,6 +312,23 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description:  "A GUID/UUID that is registered with Microsoft to facilitate partner resource usage attribution.",
 			},
 
+			"api_version_overrides": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: common.ValidateApiVersionOverrides,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A mapping of Resource Provider Namespace (e.g. Microsoft.Network) to the API Version which should be used for requests to that Resource Provider, instead of the API Version supported by the Provider. This is unsafe since only the API Version is changed, the request and response payloads are not.",
+			},
+
+			"correlation_request_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CORRELATION_REQUEST_ID", ""),
+				Description: "A custom value for the x-ms-correlation-request-id header which is sent with every request made to Azure Resource Manager during this run. A random UUID is generated when this isn't specified.",
+			},
+
 			"disable_correlation_request_id": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -419,14 +443,29 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			EnableAuthenticationUsingGitHubOIDC:        enableOidc,
 		}
 
-		return buildClient(ctx, p, d, authConfig)
+		client, diags := buildClient(ctx, p, d, authConfig)
+		if diags.HasError() {
+			return client, diags
+		}
+
+		if err := validateDriftDetectionOnlyResourceTypes(p.ResourcesMap, client.Features.DriftDetectionOnly.ResourceTypes); err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		return client, diags
 	}
 }
 
 func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
 	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 
+	apiVersionOverrides := make(map[string]string)
+	for namespace, apiVersion := range d.Get("api_version_overrides").(map[string]interface{}) {
+		apiVersionOverrides[namespace] = apiVersion.(string)
+	}
+
 	clientBuilder := clients.ClientBuilder{
+		ApiVersionOverrides:         apiVersionOverrides,
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
@@ -438,9 +477,8 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
 
-		// this field is intentionally not exposed in the provider block, since it's only used for
-		// platform level tracing
-		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
+		// allows platform teams to correlate the Activity Log entries for the requests made during this run
+		CustomCorrelationRequestID: d.Get("correlation_request_id").(string),
 	}
 
 	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
@@ -454,7 +492,9 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		return nil, diag.FromErr(err)
 	}
 
-	client.StopContext = stopCtx
+	// the default timeouts within the `features` block are resolved from the context for each operation (rather than
+	// being applied to the Resources) since the Resources are shared between each instance of the Provider
+	client.StopContext = timeouts.WithProviderDefaults(stopCtx, client.Features.Timeouts)
 
 	if !skipProviderRegistration {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+)
+
+func parseFeaturesTimeout(input string) time.Duration {
+	if input == "" {
+		return 0
+	}
+
+	// the value has been validated by the schema, so this can't fail
+	v, _ := time.ParseDuration(input)
+	return v
+}
+
+func validateFeaturesTimeout(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration (e.g. `90m` or `2h`): %+v", k, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero", k))
+	}
+
+	return
+}
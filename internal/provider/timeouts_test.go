@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestValidateFeaturesTimeout(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "2h",
+			Valid: true,
+		},
+		{
+			Input: "90m",
+			Valid: true,
+		},
+		{
+			Input: "1h30m",
+			Valid: true,
+		},
+		{
+			Input: "0s",
+			Valid: false,
+		},
+		{
+			Input: "PT2H",
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		_, errors := validateFeaturesTimeout(v.Input, "create")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("expected %t but got %t: %+v", v.Valid, valid, errors)
+		}
+	}
+}
@@ -16,8 +16,15 @@ func (rmd ResourceMetaData) Encode(input interface{}) error {
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 
+	// the debug logger is only configured by the SDK wrappers, so fall back to a NullLogger when Encode is called
+	// with ResourceMetaData built elsewhere (e.g. in unit tests of the Services)
+	debugLogger := rmd.serializationDebugLogger
+	if debugLogger == nil {
+		debugLogger = NullLogger{}
+	}
+
 	fieldName := reflect.ValueOf(input).Elem().String()
-	serialized, err := recurse(objType, objVal, fieldName, rmd.serializationDebugLogger)
+	serialized, err := recurse(objType, objVal, fieldName, debugLogger)
 	if err != nil {
 		return err
 	}
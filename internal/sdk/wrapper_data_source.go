@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 // DataSourceWrapper is a wrapper for converting a DataSource implementation
@@ -43,7 +44,10 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 
 	resource := schema.Resource{
 		Schema: *resourceSchema,
-		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		ReadWithoutTimeout: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			ctx, cancel := timeouts.ForRead(withProviderDefaultTimeouts(ctx, meta), d)
+			defer cancel()
+
 			metaData := runArgs(d, meta, dw.logger)
 			return dw.dataSource.Read().Func(ctx, metaData)
 		}),
@@ -1,10 +1,12 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 // combineSchema combines the arguments (user-configurable) and attributes (read-only) schema fields
@@ -55,3 +57,9 @@ func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) ResourceMe
 
 	return metaData
 }
+
+// withProviderDefaultTimeouts returns the context containing the default timeouts defined within the Provider's
+// `features` block, which are used when the Resource's `timeouts` block doesn't specify a value for the operation
+func withProviderDefaultTimeouts(ctx context.Context, meta interface{}) context.Context {
+	return timeouts.WithProviderDefaults(ctx, meta.(*clients.Client).Features.Timeouts)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 // ResourceWrapper is a wrapper for converting a Resource implementation
@@ -47,7 +48,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		CreateWithoutTimeout: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			ctx, cancel := timeouts.ForCreate(withProviderDefaultTimeouts(ctx, meta), d)
+			defer cancel()
+
 			metaData := runArgs(d, meta, rw.logger)
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
@@ -60,11 +64,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		ReadWithoutTimeout: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			ctx, cancel := timeouts.ForRead(withProviderDefaultTimeouts(ctx, meta), d)
+			defer cancel()
+
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		DeleteWithoutTimeout: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			ctx, cancel := timeouts.ForDelete(withProviderDefaultTimeouts(ctx, meta), d)
+			defer cancel()
+
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
@@ -110,7 +120,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		resource.UpdateWithoutTimeout = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			ctx, cancel := timeouts.ForUpdate(withProviderDefaultTimeouts(ctx, meta), d)
+			defer cancel()
+
 			metaData := runArgs(d, meta, rw.logger)
 
 			err := v.Update().Func(ctx, metaData)
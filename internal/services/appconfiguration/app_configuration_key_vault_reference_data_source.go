@@ -0,0 +1,161 @@
+package appconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/appconfiguration/1.0/appconfiguration"
+)
+
+type KeyVaultReferenceDataSource struct{}
+
+var _ sdk.DataSource = KeyVaultReferenceDataSource{}
+
+type KeyVaultReferenceDataSourceModel struct {
+	ConfigurationStoreId string `tfschema:"configuration_store_id"`
+	Key                  string `tfschema:"key"`
+	Label                string `tfschema:"label"`
+	ContentType          string `tfschema:"content_type"`
+	Value                string `tfschema:"value"`
+	VaultKeyReference    string `tfschema:"vault_key_reference"`
+	Version              string `tfschema:"version"`
+}
+
+func (k KeyVaultReferenceDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+		"key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"label": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "",
+		},
+	}
+}
+
+func (k KeyVaultReferenceDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"value": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"vault_key_reference": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (k KeyVaultReferenceDataSource) ModelObject() interface{} {
+	return &KeyVaultReferenceDataSourceModel{}
+}
+
+func (k KeyVaultReferenceDataSource) ResourceType() string {
+	return "azurerm_app_configuration_key_vault_reference"
+}
+
+func (k KeyVaultReferenceDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeyVaultReferenceDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for key %q in %q: %s", model.Key, *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			nestedItemId, err := parse.NewNestedItemID(client.Endpoint, model.Key, model.Label)
+			if err != nil {
+				return err
+			}
+
+			kv, err := client.GetKeyValue(ctx, model.Key, model.Label, "", "", "", []appconfiguration.KeyValueFields{})
+			if err != nil {
+				if v, ok := err.(autorest.DetailedError); ok {
+					if utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+						return fmt.Errorf("key %s was not found", model.Key)
+					}
+				}
+				return fmt.Errorf("while checking for key's %q existence: %+v", model.Key, err)
+			}
+
+			if contentType := utils.NormalizeNilableString(kv.ContentType); contentType != VaultKeyContentType {
+				return fmt.Errorf("%s is not a Key Vault reference (content type was %q)", *nestedItemId, contentType)
+			}
+
+			var ref VaultKeyReference
+			if err := json.Unmarshal([]byte(utils.NormalizeNilableString(kv.Value)), &ref); err != nil {
+				return fmt.Errorf("while unmarshalling vault reference: %+v", err)
+			}
+
+			secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(ref.URI)
+			if err != nil {
+				return fmt.Errorf("parsing Key Vault reference %q for %s: %+v", ref.URI, *nestedItemId, err)
+			}
+
+			// an unversioned reference resolves to the latest version of the secret
+			secret, err := metadata.Client.KeyVault.ManagementClient.GetSecret(ctx, secretId.KeyVaultBaseUrl, secretId.Name, secretId.Version)
+			if err != nil {
+				if utils.ResponseWasNotFound(secret.Response) {
+					return fmt.Errorf("the Key Vault Secret %q referenced by %s was not found", ref.URI, *nestedItemId)
+				}
+				return fmt.Errorf("retrieving the Key Vault Secret %q referenced by %s: %+v", ref.URI, *nestedItemId, err)
+			}
+
+			model.VaultKeyReference = ref.URI
+			model.Value = utils.NormalizeNilableString(secret.Value)
+			model.ContentType = utils.NormalizeNilableString(secret.ContentType)
+			if secret.ID != nil {
+				resolvedId, err := keyVaultParse.ParseNestedItemID(*secret.ID)
+				if err != nil {
+					return err
+				}
+				model.Version = resolvedId.Version
+			}
+
+			metadata.SetID(nestedItemId)
+			return metadata.Encode(&model)
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AppConfigurationKeyVaultReferenceDataSource struct{}
+
+func TestAccAppConfigurationKeyVaultReferenceDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_app_configuration_key_vault_reference", "test")
+	d := AppConfigurationKeyVaultReferenceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("value").HasValue("szechuan"),
+				check.That(data.ResourceName).Key("vault_key_reference").IsSet(),
+				check.That(data.ResourceName).Key("version").IsSet(),
+			),
+		},
+	})
+}
+
+func (AppConfigurationKeyVaultReferenceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_app_configuration_key_vault_reference" "test" {
+  key                    = azurerm_app_configuration_key.test.key
+  label                  = azurerm_app_configuration_key.test.label
+  configuration_store_id = azurerm_app_configuration.test.id
+}
+`, AppConfigurationKeyResource{}.vaultKeyBasic(data))
+}
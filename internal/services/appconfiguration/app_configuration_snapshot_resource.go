@@ -0,0 +1,366 @@
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+type SnapshotResourceModel struct {
+	ConfigurationStoreId     string                 `tfschema:"configuration_store_id"`
+	Name                     string                 `tfschema:"name"`
+	CompositionType          string                 `tfschema:"composition_type"`
+	Filter                   []SnapshotFilterModel  `tfschema:"filter"`
+	RetentionPeriodInSeconds int64                  `tfschema:"retention_period_in_seconds"`
+	Tags                     map[string]interface{} `tfschema:"tags"`
+	CreatedAt                string                 `tfschema:"created_at"`
+	Etag                     string                 `tfschema:"etag"`
+	ExpiresAt                string                 `tfschema:"expires_at"`
+	ItemsCount               int64                  `tfschema:"items_count"`
+	SizeInBytes              int64                  `tfschema:"size_in_bytes"`
+	Status                   string                 `tfschema:"status"`
+}
+
+type SnapshotFilterModel struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (r SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: configurationstores.ValidateConfigurationStoreID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+
+					"label": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		},
+
+		"composition_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(azuresdkhacks.SnapshotCompositionTypeKey),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.SnapshotCompositionTypeKey),
+				string(azuresdkhacks.SnapshotCompositionTypeKeyLabel),
+			}, false),
+		},
+
+		"retention_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+
+		"tags": tags.ForceNewSchema(),
+	}
+}
+
+func (r SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"created_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"etag": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expires_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"items_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (r SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (r SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SnapshotId
+}
+
+func (r SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			configurationStoreEndpoint, err := metadata.Client.AppConfiguration.EndpointForConfigurationStore(ctx, *configurationStoreId)
+			if err != nil {
+				return fmt.Errorf("retrieving Endpoint for snapshot %q in %q: %s", model.Name, *configurationStoreId, err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(*configurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.NewSnapshotID(*configurationStoreEndpoint, model.Name)
+			if err != nil {
+				return err
+			}
+
+			// NOTE: an archived Snapshot continues to exist until it expires, during which time the name can't be reused
+			existing, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			filters := make([]azuresdkhacks.KeyValueFilter, 0)
+			for _, v := range model.Filter {
+				filter := azuresdkhacks.KeyValueFilter{
+					Key: utils.String(v.Key),
+				}
+				if v.Label != "" {
+					filter.Label = utils.String(v.Label)
+				}
+				filters = append(filters, filter)
+			}
+
+			entity := azuresdkhacks.Snapshot{
+				CompositionType: azuresdkhacks.SnapshotCompositionType(model.CompositionType),
+				Filters:         &filters,
+				Tags:            tags.Expand(model.Tags),
+			}
+			if model.RetentionPeriodInSeconds != 0 {
+				entity.RetentionPeriod = utils.Int64(model.RetentionPeriodInSeconds)
+			}
+
+			if _, err := client.CreateSnapshot(ctx, id.Name, entity); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{string(azuresdkhacks.SnapshotStatusProvisioning)},
+				Target:     []string{string(azuresdkhacks.SnapshotStatusReady)},
+				Refresh:    appConfigurationSnapshotStatusRefreshFunc(ctx, client, id.Name),
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to become ready: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resourceClient := metadata.Client.Resource
+			configurationStoreIdRaw, err := metadata.Client.AppConfiguration.ConfigurationStoreIDFromEndpoint(ctx, resourceClient, id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return fmt.Errorf("while retrieving the Resource ID of Configuration Store at Endpoint: %q: %s", id.ConfigurationStoreEndpoint, err)
+			}
+			if configurationStoreIdRaw == nil {
+				// if the AppConfiguration is gone then all the data inside it is too
+				log.Printf("[DEBUG] Unable to determine the Resource ID for Configuration Store at Endpoint %q - removing from state", id.ConfigurationStoreEndpoint)
+				return metadata.MarkAsGone(id)
+			}
+
+			configurationStoreId, err := configurationstores.ParseConfigurationStoreID(*configurationStoreIdRaw)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetSnapshot(ctx, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// an archived Snapshot is pending removal, so is treated as deleted
+			if resp.Status == azuresdkhacks.SnapshotStatusArchived {
+				log.Printf("[DEBUG] %s has been archived - removing from state", id)
+				return metadata.MarkAsGone(id)
+			}
+
+			model := SnapshotResourceModel{
+				ConfigurationStoreId:     configurationStoreId.ID(),
+				Name:                     id.Name,
+				CompositionType:          string(resp.CompositionType),
+				Filter:                   flattenAppConfigurationSnapshotFilters(resp.Filters),
+				RetentionPeriodInSeconds: utils.NormaliseNilableInt64(resp.RetentionPeriod),
+				Tags:                     tags.Flatten(resp.Tags),
+				Etag:                     utils.NormalizeNilableString(resp.Etag),
+				ItemsCount:               utils.NormaliseNilableInt64(resp.ItemsCount),
+				SizeInBytes:              utils.NormaliseNilableInt64(resp.Size),
+				Status:                   string(resp.Status),
+			}
+
+			if resp.Created != nil {
+				model.CreatedAt = resp.Created.Format(time.RFC3339)
+			}
+			if resp.Expires != nil {
+				model.ExpiresAt = resp.Expires.Format(time.RFC3339)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+			if err != nil {
+				return err
+			}
+
+			// Snapshots can't be deleted, instead they're archived and then removed once the retention period expires
+			update := azuresdkhacks.SnapshotUpdateParameters{
+				Status: azuresdkhacks.SnapshotStatusArchived,
+			}
+			if _, err := client.UpdateSnapshot(ctx, id.Name, update); err != nil {
+				return fmt.Errorf("archiving %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func appConfigurationSnapshotStatusRefreshFunc(ctx context.Context, client *azuresdkhacks.DataPlaneClient, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetSnapshot(ctx, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, string(azuresdkhacks.SnapshotStatusProvisioning), nil
+			}
+			return nil, "", fmt.Errorf("retrieving snapshot %q: %+v", name, err)
+		}
+
+		if resp.Status == azuresdkhacks.SnapshotStatusFailed {
+			return resp, string(resp.Status), fmt.Errorf("snapshot %q failed to provision", name)
+		}
+
+		return resp, string(resp.Status), nil
+	}
+}
+
+func flattenAppConfigurationSnapshotFilters(input *[]azuresdkhacks.KeyValueFilter) []SnapshotFilterModel {
+	output := make([]SnapshotFilterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, SnapshotFilterModel{
+			Key:   utils.NormalizeNilableString(v.Key),
+			Label: utils.NormalizeNilableString(v.Label),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,144 @@
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationSnapshotResource struct{}
+
+func TestAccAppConfigurationSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("ready"),
+				check.That(data.ResourceName).Key("items_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t AppConfigurationSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.AppConfiguration.LinkWorkaroundDataPlaneClientWithEndpoint(id.ConfigurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSnapshot(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Status != azuresdkhacks.SnapshotStatusArchived), nil
+}
+
+func (t AppConfigurationSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  name                   = "acctest-snapshot-%d"
+
+  filter {
+    key = azurerm_app_configuration_key.test.key
+  }
+}
+`, AppConfigurationKeyResource{}.basicNoLabel(data), data.RandomInteger)
+}
+
+func (t AppConfigurationSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "import" {
+  configuration_store_id = azurerm_app_configuration_snapshot.test.configuration_store_id
+  name                   = azurerm_app_configuration_snapshot.test.name
+
+  filter {
+    key = azurerm_app_configuration_snapshot.test.filter.0.key
+  }
+}
+`, t.basic(data))
+}
+
+func (t AppConfigurationSnapshotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key" "test2" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest-ackey2-%d"
+  label                  = "acctest-ackeylabel-%d"
+  value                  = "a test"
+}
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  configuration_store_id      = azurerm_app_configuration.test.id
+  name                        = "acctest-snapshot-%d"
+  composition_type            = "key_label"
+  retention_period_in_seconds = 7200
+
+  filter {
+    key = azurerm_app_configuration_key.test.key
+  }
+
+  filter {
+    key   = azurerm_app_configuration_key.test2.key
+    label = azurerm_app_configuration_key.test2.label
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, AppConfigurationKeyResource{}.basicNoLabel(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,163 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// NOTE: Snapshots aren't available in the 1.0 Data Plane API used by the kermit SDK, as such
+// these are implemented here against the 2023-10-01 Data Plane API until the SDK is updated
+
+const snapshotsAPIVersion = "2023-10-01"
+
+type SnapshotStatus string
+
+const (
+	SnapshotStatusArchived     SnapshotStatus = "archived"
+	SnapshotStatusFailed       SnapshotStatus = "failed"
+	SnapshotStatusProvisioning SnapshotStatus = "provisioning"
+	SnapshotStatusReady        SnapshotStatus = "ready"
+)
+
+type SnapshotCompositionType string
+
+const (
+	SnapshotCompositionTypeKey      SnapshotCompositionType = "key"
+	SnapshotCompositionTypeKeyLabel SnapshotCompositionType = "key_label"
+)
+
+type KeyValueFilter struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+type Snapshot struct {
+	autorest.Response `json:"-"`
+	Name              *string                 `json:"name,omitempty"`
+	Status            SnapshotStatus          `json:"status,omitempty"`
+	Filters           *[]KeyValueFilter       `json:"filters,omitempty"`
+	CompositionType   SnapshotCompositionType `json:"composition_type,omitempty"`
+	Created           *date.Time              `json:"created,omitempty"`
+	Expires           *date.Time              `json:"expires,omitempty"`
+	RetentionPeriod   *int64                  `json:"retention_period,omitempty"`
+	Size              *int64                  `json:"size,omitempty"`
+	ItemsCount        *int64                  `json:"items_count,omitempty"`
+	Tags              map[string]*string      `json:"tags,omitempty"`
+	Etag              *string                 `json:"etag,omitempty"`
+}
+
+type SnapshotUpdateParameters struct {
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+func (c DataPlaneClient) CreateSnapshot(ctx context.Context, name string, entity Snapshot) (result Snapshot, err error) {
+	req, err := c.snapshotPreparer(ctx, name,
+		autorest.AsPut(),
+		autorest.AsContentType("application/vnd.microsoft.appconfig.snapshot+json"),
+		autorest.WithJSON(entity))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "CreateSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.snapshotSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "CreateSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.snapshotResponder(resp, http.StatusCreated)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "CreateSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c DataPlaneClient) GetSnapshot(ctx context.Context, name string) (result Snapshot, err error) {
+	req, err := c.snapshotPreparer(ctx, name, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.snapshotSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.snapshotResponder(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "GetSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c DataPlaneClient) UpdateSnapshot(ctx context.Context, name string, entity SnapshotUpdateParameters) (result Snapshot, err error) {
+	req, err := c.snapshotPreparer(ctx, name,
+		autorest.AsPatch(),
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.WithJSON(entity))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshot", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := c.snapshotSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshot", resp, "Failure sending request")
+		return
+	}
+
+	result, err = c.snapshotResponder(resp, http.StatusOK)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "appconfiguration.BaseClient", "UpdateSnapshot", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (c DataPlaneClient) snapshotPreparer(ctx context.Context, name string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": c.client.Endpoint,
+	}
+
+	pathParameters := map[string]interface{}{
+		"name": autorest.Encode("path", name),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": snapshotsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPathParameters("/snapshots/{name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	if len(c.client.SyncToken) > 0 {
+		decorators = append(decorators, autorest.WithHeader("Sync-Token", autorest.String(c.client.SyncToken)))
+	}
+
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c DataPlaneClient) snapshotSender(req *http.Request) (*http.Response, error) {
+	return c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+func (c DataPlaneClient) snapshotResponder(resp *http.Response, expectedStatusCodes ...int) (result Snapshot, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(expectedStatusCodes...),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
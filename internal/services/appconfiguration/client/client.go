@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/deletedconfigurationstores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/replicas"
 	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -18,6 +19,7 @@ import (
 type Client struct {
 	ConfigurationStoresClient        *configurationstores.ConfigurationStoresClient
 	DeletedConfigurationStoresClient *deletedconfigurationstores.DeletedConfigurationStoresClient
+	ReplicasClient                   *replicas.ReplicasClient
 	authorizerFunc                   common.ApiAuthorizerFunc
 	configureClientFunc              func(c *autorest.Client, authorizer autorest.Authorizer)
 }
@@ -31,6 +33,7 @@ func (c Client) DataPlaneClientWithEndpoint(configurationStoreEndpoint string) (
 
 	client := appconfiguration.NewWithoutDefaults("", configurationStoreEndpoint)
 	c.configureClientFunc(&client.Client, authWrapper.AutorestAuthorizer(appConfigAuth))
+	c.configureReplicaFailover(&client.Client, configurationStoreEndpoint)
 
 	return &client, nil
 }
@@ -44,6 +47,7 @@ func (c Client) LinkWorkaroundDataPlaneClientWithEndpoint(configurationStoreEndp
 
 	client := appconfiguration.NewWithoutDefaults("", configurationStoreEndpoint)
 	c.configureClientFunc(&client.Client, authWrapper.AutorestAuthorizer(appConfigAuth))
+	c.configureReplicaFailover(&client.Client, configurationStoreEndpoint)
 	workaroundClient := azuresdkhacks.NewDataPlaneClient(client)
 
 	return &workaroundClient, nil
@@ -79,6 +83,7 @@ func (c Client) DataPlaneClient(ctx context.Context, configurationStoreId string
 
 	client := appconfiguration.NewWithoutDefaults("", endpoint)
 	c.configureClientFunc(&client.Client, authWrapper.AutorestAuthorizer(appConfigAuth))
+	c.configureReplicaFailover(&client.Client, endpoint)
 
 	return &client, nil
 }
@@ -111,6 +116,7 @@ func (c Client) LinkWorkaroundDataPlaneClient(ctx context.Context, configuration
 
 	client := appconfiguration.NewWithoutDefaults("", *appConfig.Model.Properties.Endpoint)
 	c.configureClientFunc(&client.Client, authWrapper.AutorestAuthorizer(appConfigAuth))
+	c.configureReplicaFailover(&client.Client, *appConfig.Model.Properties.Endpoint)
 	workaroundClient := azuresdkhacks.NewDataPlaneClient(client)
 
 	return &workaroundClient, nil
@@ -129,9 +135,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(deletedConfigurationStores.Client, o.Authorizers.ResourceManager)
 
+	replicasClient, err := replicas.NewReplicasClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Replicas client: %+v", err)
+	}
+	o.Configure(replicasClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ConfigurationStoresClient:        configurationStores,
 		DeletedConfigurationStoresClient: deletedConfigurationStores,
+		ReplicasClient:                   replicasClient,
 		authorizerFunc:                   o.Authorizers.AuthorizerFunc,
 		configureClientFunc:              o.ConfigureClient,
 	}, nil
@@ -164,6 +164,10 @@ func (c Client) Purge(configurationStoreId configurationstores.ConfigurationStor
 	lock[cacheKey].Lock()
 	delete(ConfigurationStoreCache, cacheKey)
 	lock[cacheKey].Unlock()
+
+	replicaEndpointsLock.Lock()
+	delete(replicaEndpointsCache, cacheKey)
+	replicaEndpointsLock.Unlock()
 }
 
 func (c Client) cacheKeyForConfigurationStore(name string) string {
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/replicas"
+	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+var (
+	replicaEndpointsCache = map[string][]string{}
+	replicaEndpointsLock  = &sync.RWMutex{}
+)
+
+// configureReplicaFailover configures the Data Plane client so that requests which fail against the
+// primary endpoint (e.g. during a replica failover) are retried against each of the replicas of the
+// Configuration Store in turn, with the usual retries being applied to each endpoint
+func (c Client) configureReplicaFailover(client *autorest.Client, configurationStoreEndpoint string) {
+	client.SendDecorators = []autorest.SendDecorator{
+		autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...),
+		c.withReplicaFailover(configurationStoreEndpoint),
+	}
+}
+
+func (c Client) withReplicaFailover(configurationStoreEndpoint string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// the request body can only be read once, so buffer it to allow the request to be resent
+			var body []byte
+			if r.Body != nil && r.Body != http.NoBody {
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					return nil, fmt.Errorf("reading request body: %+v", err)
+				}
+				r.Body.Close()
+				body = b
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+
+			resp, err := s.Do(r)
+			if !shouldFailoverToReplica(resp, err) {
+				return resp, err
+			}
+
+			replicaEndpoints, rerr := c.replicaEndpointsForConfigurationStore(r.Context(), configurationStoreEndpoint)
+			if rerr != nil {
+				log.Printf("[DEBUG] unable to determine the replicas for the Configuration Store at %q: %+v", configurationStoreEndpoint, rerr)
+				return resp, err
+			}
+
+			for _, replicaEndpoint := range replicaEndpoints {
+				replicaRequest, rerr := c.requestForReplica(r, body, replicaEndpoint)
+				if rerr != nil {
+					log.Printf("[DEBUG] unable to build a request for the replica at %q: %+v", replicaEndpoint, rerr)
+					continue
+				}
+
+				log.Printf("[DEBUG] request to %q failed - retrying against the replica at %q", r.URL.Host, replicaRequest.URL.Host)
+				if resp != nil && resp.Body != nil {
+					resp.Body.Close()
+				}
+				resp, err = s.Do(replicaRequest)
+				if !shouldFailoverToReplica(resp, err) {
+					return resp, err
+				}
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// requestForReplica returns a copy of the request targeting the specified replica endpoint, authorized for that endpoint
+func (c Client) requestForReplica(r *http.Request, body []byte, replicaEndpoint string) (*http.Request, error) {
+	endpoint, err := url.ParseRequestURI(replicaEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", replicaEndpoint, err)
+	}
+
+	req := r.Clone(r.Context())
+	req.URL.Scheme = endpoint.Scheme
+	req.URL.Host = endpoint.Host
+	req.Host = endpoint.Host
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	api := environments.NewApiEndpoint("AppConfiguration", replicaEndpoint, nil)
+	replicaAuth, err := c.authorizerFunc(api)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", replicaEndpoint, err)
+	}
+
+	return autorest.Prepare(req, authWrapper.AutorestAuthorizer(replicaAuth).WithAuthorization())
+}
+
+// replicaEndpointsForConfigurationStore returns the endpoints for the provisioned replicas of the Configuration Store
+// at the specified endpoint - which must have been previously cached (e.g. via EndpointForConfigurationStore)
+func (c Client) replicaEndpointsForConfigurationStore(ctx context.Context, configurationStoreEndpoint string) ([]string, error) {
+	configurationStoreName, err := c.parseNameFromEndpoint(configurationStoreEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := c.cacheKeyForConfigurationStore(*configurationStoreName)
+	replicaEndpointsLock.RLock()
+	if v, ok := replicaEndpointsCache[cacheKey]; ok {
+		replicaEndpointsLock.RUnlock()
+		return v, nil
+	}
+	replicaEndpointsLock.RUnlock()
+
+	keysmith.Lock()
+	details, ok := ConfigurationStoreCache[cacheKey]
+	keysmith.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("the Resource ID for the Configuration Store at %q is not known", configurationStoreEndpoint)
+	}
+
+	configurationStoreId, err := replicas.ParseConfigurationStoreID(details.configurationStoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := c.ReplicasClient.ListByConfigurationStoreComplete(ctx, *configurationStoreId)
+	if err != nil {
+		return nil, fmt.Errorf("listing replicas for %s: %+v", *configurationStoreId, err)
+	}
+
+	endpoints := make([]string, 0)
+	for _, item := range result.Items {
+		if item.Properties == nil || item.Properties.Endpoint == nil {
+			continue
+		}
+		if item.Properties.ProvisioningState != nil && *item.Properties.ProvisioningState != replicas.ReplicaProvisioningStateSucceeded {
+			continue
+		}
+		endpoints = append(endpoints, *item.Properties.Endpoint)
+	}
+
+	replicaEndpointsLock.Lock()
+	replicaEndpointsCache[cacheKey] = endpoints
+	replicaEndpointsLock.Unlock()
+
+	return endpoints, nil
+}
+
+func shouldFailoverToReplica(resp *http.Response, err error) bool {
+	if resp == nil {
+		return err != nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	}
+
+	return resp.StatusCode >= http.StatusInternalServerError
+}
@@ -20,6 +20,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		KeyDataSource{},
 		KeysDataSource{},
+		KeyVaultReferenceDataSource{},
 	}
 }
 
@@ -27,6 +28,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyResource{},
 		FeatureResource{},
+		SnapshotResource{},
 	}
 }
 
@@ -0,0 +1,83 @@
+package applicationinsights
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	workbookDataJsonTrackingModeFull   = "Full"
+	workbookDataJsonTrackingModeSha256 = "Sha256"
+)
+
+// normalizeWorkbookDataJson parses the serialized data of a Workbook, removing any properties with a `null` value
+// since these are omitted by the API - meaning that two documents which only differ in whitespace, the ordering of
+// properties or the presence of `null` properties are considered equal
+func normalizeWorkbookDataJson(input string) (interface{}, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		return nil, err
+	}
+
+	return removeNullWorkbookDataJsonValues(v), nil
+}
+
+func removeNullWorkbookDataJsonValues(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{})
+		for key, value := range v {
+			if value == nil {
+				continue
+			}
+			output[key] = removeNullWorkbookDataJsonValues(value)
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, value := range v {
+			output = append(output, removeNullWorkbookDataJsonValues(value))
+		}
+		return output
+	}
+
+	return input
+}
+
+// workbookDataJsonSha256 returns the hex-encoded SHA256 hash of the normalized serialized data of a Workbook
+func workbookDataJsonSha256(input string) string {
+	normalized, err := normalizeWorkbookDataJson(input)
+	if err != nil {
+		// not valid JSON, so hash the raw value so that any change is still detected
+		sum := sha256.Sum256([]byte(input))
+		return hex.EncodeToString(sum[:])
+	}
+
+	// json.Marshal sorts the keys within maps, so this is deterministic
+	b, _ := json.Marshal(normalized)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func suppressWorkbookDataJsonDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldValue, err := normalizeWorkbookDataJson(old)
+	if err != nil {
+		return false
+	}
+
+	newValue, err := normalizeWorkbookDataJson(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, newValue)
+}
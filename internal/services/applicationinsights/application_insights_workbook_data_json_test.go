@@ -0,0 +1,81 @@
+package applicationinsights
+
+import (
+	"testing"
+)
+
+func TestSuppressWorkbookDataJsonDiff(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "identical",
+			Old:      `{"version":"Notebook/1.0","items":[]}`,
+			New:      `{"version":"Notebook/1.0","items":[]}`,
+			Suppress: true,
+		},
+		{
+			Name:     "whitespace and ordering",
+			Old:      `{"version":"Notebook/1.0","items":[{"type":1,"name":"text"}]}`,
+			New:      "{\n  \"items\": [\n    {\"name\": \"text\", \"type\": 1}\n  ],\n  \"version\": \"Notebook/1.0\"\n}",
+			Suppress: true,
+		},
+		{
+			Name:     "null properties",
+			Old:      `{"version":"Notebook/1.0","items":[{"type":1}]}`,
+			New:      `{"version":"Notebook/1.0","fallbackResourceIds":null,"items":[{"type":1,"name":null}]}`,
+			Suppress: true,
+		},
+		{
+			Name:     "array ordering is significant",
+			Old:      `{"items":[{"type":1},{"type":2}]}`,
+			New:      `{"items":[{"type":2},{"type":1}]}`,
+			Suppress: false,
+		},
+		{
+			Name:     "changed value",
+			Old:      `{"version":"Notebook/1.0"}`,
+			New:      `{"version":"Notebook/2.0"}`,
+			Suppress: false,
+		},
+		{
+			Name:     "invalid json",
+			Old:      `{"version":"Notebook/1.0"}`,
+			New:      `{"version":`,
+			Suppress: false,
+		},
+		{
+			Name:     "empty",
+			Old:      "",
+			New:      `{}`,
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := suppressWorkbookDataJsonDiff("data_json", tc.Old, tc.New, nil); actual != tc.Suppress {
+				t.Fatalf("expected %t but got %t", tc.Suppress, actual)
+			}
+		})
+	}
+}
+
+func TestWorkbookDataJsonSha256(t *testing.T) {
+	first := workbookDataJsonSha256(`{"version":"Notebook/1.0","items":[{"type":1,"name":null}]}`)
+	second := workbookDataJsonSha256("{\n  \"items\": [{\"type\": 1}],\n  \"version\": \"Notebook/1.0\"\n}")
+	if first != second {
+		t.Fatalf("expected semantically equal documents to have the same hash but got %q and %q", first, second)
+	}
+
+	if len(first) != 64 {
+		t.Fatalf("expected a hex-encoded SHA256 hash but got %q", first)
+	}
+
+	if changed := workbookDataJsonSha256(`{"version":"Notebook/2.0","items":[{"type":1}]}`); changed == first {
+		t.Fatalf("expected a different document to have a different hash")
+	}
+}
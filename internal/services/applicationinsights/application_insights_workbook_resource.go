@@ -18,16 +18,18 @@ import (
 )
 
 type ApplicationInsightsWorkbookModel struct {
-	Name               string            `tfschema:"name"`
-	ResourceGroupName  string            `tfschema:"resource_group_name"`
-	Category           string            `tfschema:"category"`
-	Description        string            `tfschema:"description"`
-	DisplayName        string            `tfschema:"display_name"`
-	Location           string            `tfschema:"location"`
-	DataJson           string            `tfschema:"data_json"`
-	SourceId           string            `tfschema:"source_id"`
-	StorageContainerId string            `tfschema:"storage_container_id"`
-	Tags               map[string]string `tfschema:"tags"`
+	Name                 string            `tfschema:"name"`
+	ResourceGroupName    string            `tfschema:"resource_group_name"`
+	Category             string            `tfschema:"category"`
+	Description          string            `tfschema:"description"`
+	DisplayName          string            `tfschema:"display_name"`
+	Location             string            `tfschema:"location"`
+	DataJson             string            `tfschema:"data_json"`
+	DataJsonSha256       string            `tfschema:"data_json_sha256"`
+	DataJsonTrackingMode string            `tfschema:"data_json_tracking_mode"`
+	SourceId             string            `tfschema:"source_id"`
+	StorageContainerId   string            `tfschema:"storage_container_id"`
+	Tags                 map[string]string `tfschema:"tags"`
 }
 
 type ApplicationInsightsWorkbookResource struct{}
@@ -72,7 +74,17 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 			Type:             pluginsdk.TypeString,
 			Required:         true,
 			ValidateFunc:     validation.StringIsJSON,
-			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			DiffSuppressFunc: suppressWorkbookDataJsonDiff,
+		},
+
+		"data_json_tracking_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  workbookDataJsonTrackingModeFull,
+			ValidateFunc: validation.StringInSlice([]string{
+				workbookDataJsonTrackingModeFull,
+				workbookDataJsonTrackingModeSha256,
+			}, false),
 		},
 
 		"source_id": {
@@ -119,7 +131,12 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 }
 
 func (r ApplicationInsightsWorkbookResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"data_json_sha256": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
@@ -176,6 +193,11 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if err := setWorkbookDataJsonSha256(ctx, metadata, id); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -231,6 +253,12 @@ func (r ApplicationInsightsWorkbookResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
+			if metadata.ResourceData.HasChange("data_json") {
+				if err := setWorkbookDataJsonSha256(ctx, metadata, *id); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -262,9 +290,13 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 			}
 
 			state := ApplicationInsightsWorkbookModel{
-				Name:              id.WorkbookName,
-				ResourceGroupName: id.ResourceGroupName,
-				Location:          location.NormalizeNilable(model.Location),
+				Name:                 id.WorkbookName,
+				ResourceGroupName:    id.ResourceGroupName,
+				Location:             location.NormalizeNilable(model.Location),
+				DataJsonTrackingMode: workbookDataJsonTrackingModeFull,
+			}
+			if v, ok := metadata.ResourceData.GetOk("data_json_tracking_mode"); ok {
+				state.DataJsonTrackingMode = v.(string)
 			}
 
 			identityValue, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
@@ -286,6 +318,13 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 				state.DisplayName = properties.DisplayName
 
 				state.DataJson = properties.SerializedData
+				state.DataJsonSha256 = workbookDataJsonSha256(properties.SerializedData)
+
+				// when tracking by hash, the configured value is kept unless the Workbook has been changed outside of
+				// Terraform - since Azure reformats the serialized data when it's saved, which otherwise shows as a diff
+				if state.DataJsonTrackingMode == workbookDataJsonTrackingModeSha256 && state.DataJsonSha256 == metadata.ResourceData.Get("data_json_sha256").(string) {
+					state.DataJson = metadata.ResourceData.Get("data_json").(string)
+				}
 
 				if properties.SourceId != nil {
 					state.SourceId = *properties.SourceId
@@ -326,3 +365,25 @@ func (r ApplicationInsightsWorkbookResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// setWorkbookDataJsonSha256 records the hash of the serialized data as saved by Azure, which is used to detect when
+// the Workbook has been changed outside of Terraform when `data_json_tracking_mode` is `Sha256`
+func setWorkbookDataJsonSha256(ctx context.Context, metadata sdk.ResourceMetaData, id workbooks.WorkbookId) error {
+	client := metadata.Client.AppInsights.WorkbookClient
+
+	resp, err := client.WorkbooksGet(ctx, id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: utils.Bool(true)})
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	dataJsonSha256 := ""
+	if model := resp.Model; model != nil && model.Properties != nil {
+		dataJsonSha256 = workbookDataJsonSha256(model.Properties.SerializedData)
+	}
+
+	if err := metadata.ResourceData.Set("data_json_sha256", dataJsonSha256); err != nil {
+		return fmt.Errorf("setting `data_json_sha256`: %+v", err)
+	}
+
+	return nil
+}
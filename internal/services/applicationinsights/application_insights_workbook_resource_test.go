@@ -80,6 +80,30 @@ func TestAccApplicationInsightsWorkbook_update(t *testing.T) {
 	})
 }
 
+func TestAccApplicationInsightsWorkbook_dataJsonTrackingModeSha256(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := ApplicationInsightsWorkbookResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataJsonTrackingModeSha256(data, "Test2022"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_json_sha256").IsNotEmpty(),
+			),
+		},
+		// the tracking mode can't be determined when importing
+		data.ImportStep("data_json_tracking_mode"),
+		{
+			Config: r.dataJsonTrackingModeSha256(data, "Test2023"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_json_sha256").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("data_json_tracking_mode"),
+	})
+}
+
 func TestAccApplicationInsightsWorkbook_hiddenTitleInTags(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
 	r := ApplicationInsightsWorkbookResource{}
@@ -151,6 +175,37 @@ resource "azurerm_application_insights_workbook" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ApplicationInsightsWorkbookResource) dataJsonTrackingModeSha256(data acceptance.TestData, content string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                    = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  display_name            = "acctest-amw-%d"
+  data_json_tracking_mode = "Sha256"
+  data_json = jsonencode({
+    "version" = "Notebook/1.0",
+    "items" = [
+      {
+        "type" = 1,
+        "content" = {
+          "json" = "%s"
+        },
+        "name" = "text - 0"
+      }
+    ],
+    "isLocked" = false,
+    "fallbackResourceIds" = [
+      "Azure Monitor"
+    ]
+  })
+}
+`, template, data.RandomInteger, content)
+}
+
 func (r ApplicationInsightsWorkbookResource) hiddenTitleInTags(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
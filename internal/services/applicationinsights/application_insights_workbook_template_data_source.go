@@ -0,0 +1,175 @@
+package applicationinsights
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	workbooktemplates "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationInsightsWorkbookTemplateDataSource struct{}
+
+var _ sdk.DataSource = ApplicationInsightsWorkbookTemplateDataSource{}
+
+func (r ApplicationInsightsWorkbookTemplateDataSource) ResourceType() string {
+	return "azurerm_application_insights_workbook_template"
+}
+
+func (r ApplicationInsightsWorkbookTemplateDataSource) ModelObject() interface{} {
+	return &ApplicationInsightsWorkbookTemplateModel{}
+}
+
+func (r ApplicationInsightsWorkbookTemplateDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workbooktemplates.ValidateWorkbookTemplateID
+}
+
+func (r ApplicationInsightsWorkbookTemplateDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+	}
+}
+
+func (r ApplicationInsightsWorkbookTemplateDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"author": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"galleries": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"order": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"resource_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"localized": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"priority": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"template_data": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": tags.SchemaDataSource(),
+	}
+}
+
+func (r ApplicationInsightsWorkbookTemplateDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppInsights.WorkbookTemplateClient
+
+			var state ApplicationInsightsWorkbookTemplateModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := workbooktemplates.NewWorkbookTemplateID(metadata.Client.Account.SubscriptionId, state.ResourceGroupName, state.Name)
+			resp, err := client.WorkbookTemplatesGet(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state.Location = location.Normalize(model.Location)
+
+			if properties := model.Properties; properties != nil {
+				if properties.Author != nil {
+					state.Author = *properties.Author
+				}
+
+				state.Galleries = flattenWorkbookTemplateGalleryModel(&properties.Galleries)
+
+				if properties.Priority != nil {
+					state.Priority = *properties.Priority
+				}
+
+				if properties.TemplateData != nil {
+					templateDataValue, err := json.Marshal(properties.TemplateData)
+					if err != nil {
+						return err
+					}
+
+					state.TemplateData = string(templateDataValue)
+				}
+
+				if properties.Localized != nil {
+					localizedValue, err := json.Marshal(properties.Localized)
+					if err != nil {
+						return err
+					}
+
+					state.Localized = string(localizedValue)
+				}
+			}
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
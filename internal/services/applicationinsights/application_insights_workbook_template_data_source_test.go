@@ -0,0 +1,42 @@
+package applicationinsights_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApplicationInsightsWorkbookTemplateDataSource struct{}
+
+func TestAccApplicationInsightsWorkbookTemplateDataSource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_application_insights_workbook_template", "test")
+	d := ApplicationInsightsWorkbookTemplateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("author").Exists(),
+				check.That(data.ResourceName).Key("galleries.#").HasValue("1"),
+				check.That(data.ResourceName).Key("galleries.0.category").Exists(),
+				check.That(data.ResourceName).Key("localized").Exists(),
+				check.That(data.ResourceName).Key("template_data").Exists(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d ApplicationInsightsWorkbookTemplateDataSource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_application_insights_workbook_template" "test" {
+  name                = azurerm_application_insights_workbook_template.test.name
+  resource_group_name = azurerm_application_insights_workbook_template.test.resource_group_name
+}
+`, ApplicationInsightsWorkbookTemplateResource{}.complete(data))
+}
@@ -120,9 +120,10 @@ func (r ApplicationInsightsWorkbookTemplateResource) Arguments() map[string]*plu
 		},
 
 		"localized": {
-			Type:         pluginsdk.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 		},
 
 		"priority": {
@@ -256,12 +257,16 @@ func (r ApplicationInsightsWorkbookTemplateResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("localized") {
-				var localizedValue map[string][]workbooktemplates.WorkbookTemplateLocalizedGallery
-				if err := json.Unmarshal([]byte(model.Localized), &localizedValue); err != nil {
-					return err
-				}
+				if model.Localized != "" {
+					var localizedValue map[string][]workbooktemplates.WorkbookTemplateLocalizedGallery
+					if err := json.Unmarshal([]byte(model.Localized), &localizedValue); err != nil {
+						return err
+					}
 
-				properties.Properties.Localized = &localizedValue
+					properties.Properties.Localized = &localizedValue
+				} else {
+					properties.Properties.Localized = nil
+				}
 			}
 
 			if metadata.ResourceData.HasChange("tags") {
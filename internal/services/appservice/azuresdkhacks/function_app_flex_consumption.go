@@ -0,0 +1,227 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+)
+
+// NOTE: Function Apps hosted on a Flex Consumption plan are configured using the `functionAppConfig` property, which
+// isn't available in the 2021-03-01 API used by the vendored SDK, as such these are retrieved and created/updated here
+// against the 2023-12-01 API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const flexConsumptionApiVersion = "2023-12-01"
+
+type FunctionsDeploymentStorageType string
+
+const (
+	FunctionsDeploymentStorageTypeBlobContainer FunctionsDeploymentStorageType = "blobContainer"
+)
+
+type AuthenticationType string
+
+const (
+	AuthenticationTypeStorageAccountConnectionString AuthenticationType = "StorageAccountConnectionString"
+	AuthenticationTypeSystemAssignedIdentity         AuthenticationType = "SystemAssignedIdentity"
+	AuthenticationTypeUserAssignedIdentity           AuthenticationType = "UserAssignedIdentity"
+)
+
+type RuntimeName string
+
+const (
+	RuntimeNameCustom         RuntimeName = "custom"
+	RuntimeNameDotnetIsolated RuntimeName = "dotnet-isolated"
+	RuntimeNameJava           RuntimeName = "java"
+	RuntimeNameNode           RuntimeName = "node"
+	RuntimeNamePowershell     RuntimeName = "powershell"
+	RuntimeNamePython         RuntimeName = "python"
+)
+
+type FlexConsumptionSite struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Kind       *string                                  `json:"kind,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *FlexConsumptionSiteProperties           `json:"properties,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type FlexConsumptionSiteProperties struct {
+	DefaultHostName     *string            `json:"defaultHostName,omitempty"`
+	Enabled             *bool              `json:"enabled,omitempty"`
+	FunctionAppConfig   *FunctionAppConfig `json:"functionAppConfig,omitempty"`
+	HTTPSOnly           *bool              `json:"httpsOnly,omitempty"`
+	OutboundIPAddresses *string            `json:"outboundIpAddresses,omitempty"`
+	ServerFarmId        *string            `json:"serverFarmId,omitempty"`
+	SiteConfig          *SiteConfig        `json:"siteConfig,omitempty"`
+}
+
+type SiteConfig struct {
+	AppSettings *[]NameValuePair `json:"appSettings,omitempty"`
+}
+
+type NameValuePair struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type FunctionAppConfig struct {
+	Deployment          *FunctionsDeployment          `json:"deployment,omitempty"`
+	Runtime             *FunctionsRuntime             `json:"runtime,omitempty"`
+	ScaleAndConcurrency *FunctionsScaleAndConcurrency `json:"scaleAndConcurrency,omitempty"`
+}
+
+type FunctionsDeployment struct {
+	Storage *FunctionsDeploymentStorage `json:"storage,omitempty"`
+}
+
+type FunctionsDeploymentStorage struct {
+	Authentication *FunctionsDeploymentStorageAuthentication `json:"authentication,omitempty"`
+	Type           *FunctionsDeploymentStorageType           `json:"type,omitempty"`
+	Value          *string                                   `json:"value,omitempty"`
+}
+
+type FunctionsDeploymentStorageAuthentication struct {
+	StorageAccountConnectionStringName *string             `json:"storageAccountConnectionStringName,omitempty"`
+	Type                               *AuthenticationType `json:"type,omitempty"`
+	UserAssignedIdentityResourceId     *string             `json:"userAssignedIdentityResourceId,omitempty"`
+}
+
+type FunctionsRuntime struct {
+	Name    *RuntimeName `json:"name,omitempty"`
+	Version *string      `json:"version,omitempty"`
+}
+
+type FunctionsScaleAndConcurrency struct {
+	AlwaysReady          *[]FunctionsAlwaysReadyConfig         `json:"alwaysReady,omitempty"`
+	InstanceMemoryMB     *int64                                `json:"instanceMemoryMB,omitempty"`
+	MaximumInstanceCount *int64                                `json:"maximumInstanceCount,omitempty"`
+	Triggers             *FunctionsScaleAndConcurrencyTriggers `json:"triggers,omitempty"`
+}
+
+type FunctionsAlwaysReadyConfig struct {
+	InstanceCount *int64  `json:"instanceCount,omitempty"`
+	Name          *string `json:"name,omitempty"`
+}
+
+type FunctionsScaleAndConcurrencyTriggers struct {
+	HTTP *FunctionsScaleAndConcurrencyTriggersHTTP `json:"http,omitempty"`
+}
+
+type FunctionsScaleAndConcurrencyTriggersHTTP struct {
+	PerInstanceConcurrency *int64 `json:"perInstanceConcurrency,omitempty"`
+}
+
+type FlexConsumptionSitesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFlexConsumptionSitesClientWithBaseURI(endpoint string) FlexConsumptionSitesClient {
+	return FlexConsumptionSitesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/webapps/%s", flexConsumptionApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type FlexConsumptionSiteGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *FlexConsumptionSite
+}
+
+type FlexConsumptionSiteCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Get ...
+func (c FlexConsumptionSitesClient) Get(ctx context.Context, id parse.FunctionAppId) (result FlexConsumptionSiteGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.FlexConsumptionSitesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.FlexConsumptionSitesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.FlexConsumptionSitesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdate ...
+func (c FlexConsumptionSitesClient) CreateOrUpdate(ctx context.Context, id parse.FunctionAppId, input FlexConsumptionSite) (result FlexConsumptionSiteCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.FlexConsumptionSitesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.FlexConsumptionSitesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.FlexConsumptionSitesClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c FlexConsumptionSitesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.FunctionAppId, input FlexConsumptionSite) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func (c FlexConsumptionSitesClient) prepare(ctx context.Context, id parse.FunctionAppId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": flexConsumptionApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
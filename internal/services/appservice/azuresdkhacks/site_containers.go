@@ -0,0 +1,200 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: Sidecar containers are managed using the `sitecontainers` child resource of a Site (or Site Slot), which isn't
+// available in the 2021-03-01 API used by the vendored SDK, as such these are managed here against the 2024-04-01 API
+// until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const siteContainersApiVersion = "2024-04-01"
+
+// SiteContainerParentId is implemented by both the Web App and Web App Slot IDs
+type SiteContainerParentId interface {
+	ID() string
+}
+
+type SiteContainerAuthType string
+
+const (
+	SiteContainerAuthTypeAnonymous       SiteContainerAuthType = "Anonymous"
+	SiteContainerAuthTypeSystemIdentity  SiteContainerAuthType = "SystemIdentity"
+	SiteContainerAuthTypeUserAssigned    SiteContainerAuthType = "UserAssigned"
+	SiteContainerAuthTypeUserCredentials SiteContainerAuthType = "UserCredentials"
+)
+
+type SiteContainer struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *SiteContainerProperties `json:"properties,omitempty"`
+}
+
+type SiteContainerProperties struct {
+	AuthType                    *SiteContainerAuthType `json:"authType,omitempty"`
+	EnvironmentVariables        *[]EnvironmentVariable `json:"environmentVariables,omitempty"`
+	Image                       string                 `json:"image"`
+	IsMain                      bool                   `json:"isMain"`
+	PasswordSecret              *string                `json:"passwordSecret,omitempty"`
+	StartUpCommand              *string                `json:"startUpCommand,omitempty"`
+	TargetPort                  *string                `json:"targetPort,omitempty"`
+	UserManagedIdentityClientId *string                `json:"userManagedIdentityClientId,omitempty"`
+	UserName                    *string                `json:"userName,omitempty"`
+	VolumeMounts                *[]VolumeMount         `json:"volumeMounts,omitempty"`
+}
+
+type EnvironmentVariable struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type VolumeMount struct {
+	ContainerMountPath string  `json:"containerMountPath"`
+	Data               *string `json:"data,omitempty"`
+	ReadOnly           *bool   `json:"readOnly,omitempty"`
+	VolumeSubPath      string  `json:"volumeSubPath"`
+}
+
+type siteContainerCollection struct {
+	NextLink *string         `json:"nextLink,omitempty"`
+	Value    []SiteContainer `json:"value"`
+}
+
+type SiteContainersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSiteContainersClientWithBaseURI(endpoint string) SiteContainersClient {
+	return SiteContainersClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/webapps/%s", siteContainersApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type SiteContainerListOperationResponse struct {
+	HttpResponse *http.Response
+	Items        []SiteContainer
+}
+
+type SiteContainerCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *SiteContainer
+}
+
+type SiteContainerDeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// List ...
+func (c SiteContainersClient) List(ctx context.Context, id SiteContainerParentId) (result SiteContainerListOperationResponse, err error) {
+	req, err := c.prepare(ctx, fmt.Sprintf("%s/sitecontainers", id.ID()), autorest.AsGet())
+	for {
+		if err != nil {
+			err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "List", nil, "Failure preparing request")
+			return
+		}
+
+		result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+		if err != nil {
+			err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "List", result.HttpResponse, "Failure sending request")
+			return
+		}
+
+		var page siteContainerCollection
+		err = autorest.Respond(
+			result.HttpResponse,
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "List", result.HttpResponse, "Failure responding to request")
+			return
+		}
+
+		result.Items = append(result.Items, page.Value...)
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			return
+		}
+
+		// the next link is an absolute URI which already contains the api-version
+		req, err = autorest.CreatePreparer(autorest.AsGet(), autorest.WithBaseURL(*page.NextLink)).Prepare((&http.Request{}).WithContext(ctx))
+	}
+}
+
+// CreateOrUpdate ...
+func (c SiteContainersClient) CreateOrUpdate(ctx context.Context, id SiteContainerParentId, containerName string, input SiteContainer) (result SiteContainerCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, fmt.Sprintf("%s/sitecontainers/%s", id.ID(), containerName), autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c SiteContainersClient) Delete(ctx context.Context, id SiteContainerParentId, containerName string) (result SiteContainerDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, fmt.Sprintf("%s/sitecontainers/%s", id.ID(), containerName), autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webapps.SiteContainersClient", "Delete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c SiteContainersClient) prepare(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": siteContainersApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
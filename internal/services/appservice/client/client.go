@@ -3,12 +3,15 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 )
 
 type Client struct {
 	AppServiceEnvironmentClient *web.AppServiceEnvironmentsClient
 	BaseClient                  *web.BaseClient
+	FlexConsumptionSitesClient  *azuresdkhacks.FlexConsumptionSitesClient
 	ServicePlanClient           *web.AppServicePlansClient
+	SiteContainersClient        *azuresdkhacks.SiteContainersClient
 	WebAppsClient               *web.AppsClient
 }
 
@@ -19,16 +22,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	baseClient := web.NewWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&baseClient.Client, o.ResourceManagerAuthorizer)
 
+	flexConsumptionSitesClient := azuresdkhacks.NewFlexConsumptionSitesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexConsumptionSitesClient.Client, o.ResourceManagerAuthorizer)
+
 	webAppServiceClient := web.NewAppsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&webAppServiceClient.Client, o.ResourceManagerAuthorizer)
 
 	servicePlanClient := web.NewAppServicePlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicePlanClient.Client, o.ResourceManagerAuthorizer)
 
+	siteContainersClient := azuresdkhacks.NewSiteContainersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&siteContainersClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AppServiceEnvironmentClient: &appServiceEnvironmentClient,
 		BaseClient:                  &baseClient,
+		FlexConsumptionSitesClient:  &flexConsumptionSitesClient,
 		ServicePlanClient:           &servicePlanClient,
+		SiteContainersClient:        &siteContainersClient,
 		WebAppsClient:               &webAppServiceClient,
 	}
 }
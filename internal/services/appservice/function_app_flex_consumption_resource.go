@@ -0,0 +1,626 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FunctionAppFlexConsumptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = FunctionAppFlexConsumptionResource{}
+
+const (
+	flexConsumptionDeploymentStorageAppSetting = "DEPLOYMENT_STORAGE_CONNECTION_STRING"
+	flexConsumptionWebJobsStorageAppSetting    = "AzureWebJobsStorage"
+	flexConsumptionWebJobsAccountAppSetting    = "AzureWebJobsStorage__accountName"
+)
+
+type FunctionAppFlexConsumptionModel struct {
+	Name                        string                                  `tfschema:"name"`
+	ResourceGroup               string                                  `tfschema:"resource_group_name"`
+	Location                    string                                  `tfschema:"location"`
+	ServicePlanId               string                                  `tfschema:"service_plan_id"`
+	StorageContainerEndpoint    string                                  `tfschema:"storage_container_endpoint"`
+	StorageAuthenticationType   string                                  `tfschema:"storage_authentication_type"`
+	StorageAccessKey            string                                  `tfschema:"storage_access_key"`
+	StorageUserAssignedIdentity string                                  `tfschema:"storage_user_assigned_identity_id"`
+	RuntimeName                 string                                  `tfschema:"runtime_name"`
+	RuntimeVersion              string                                  `tfschema:"runtime_version"`
+	MaximumInstanceCount        int                                     `tfschema:"maximum_instance_count"`
+	InstanceMemoryInMB          int                                     `tfschema:"instance_memory_in_mb"`
+	HttpConcurrency             int                                     `tfschema:"http_concurrency"`
+	AlwaysReady                 []FunctionAppFlexConsumptionAlwaysReady `tfschema:"always_ready"`
+	AppSettings                 map[string]string                       `tfschema:"app_settings"`
+	Enabled                     bool                                    `tfschema:"enabled"`
+	HttpsOnly                   bool                                    `tfschema:"https_only"`
+	Tags                        map[string]string                       `tfschema:"tags"`
+	DefaultHostname             string                                  `tfschema:"default_hostname"`
+	Kind                        string                                  `tfschema:"kind"`
+	OutboundIPAddresses         string                                  `tfschema:"outbound_ip_addresses"`
+}
+
+type FunctionAppFlexConsumptionAlwaysReady struct {
+	Name          string `tfschema:"name"`
+	InstanceCount int    `tfschema:"instance_count"`
+}
+
+func (r FunctionAppFlexConsumptionResource) ModelObject() interface{} {
+	return &FunctionAppFlexConsumptionModel{}
+}
+
+func (r FunctionAppFlexConsumptionResource) ResourceType() string {
+	return "azurerm_function_app_flex_consumption"
+}
+
+func (r FunctionAppFlexConsumptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FunctionAppID
+}
+
+func (r FunctionAppFlexConsumptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"service_plan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ServicePlanID,
+		},
+
+		"storage_container_endpoint": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+			Description:  "The URL of the Blob Container used to store the deployment package of the Function App.",
+		},
+
+		"storage_authentication_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.AuthenticationTypeStorageAccountConnectionString),
+				string(azuresdkhacks.AuthenticationTypeSystemAssignedIdentity),
+				string(azuresdkhacks.AuthenticationTypeUserAssignedIdentity),
+			}, false),
+		},
+
+		"storage_access_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+
+		"storage_user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+
+		"runtime_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.RuntimeNameCustom),
+				string(azuresdkhacks.RuntimeNameDotnetIsolated),
+				string(azuresdkhacks.RuntimeNameJava),
+				string(azuresdkhacks.RuntimeNameNode),
+				string(azuresdkhacks.RuntimeNamePowershell),
+				string(azuresdkhacks.RuntimeNamePython),
+			}, false),
+		},
+
+		"runtime_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"maximum_instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(40, 1000),
+		},
+
+		"instance_memory_in_mb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2048,
+			ValidateFunc: validation.IntInSlice([]int{2048, 4096}),
+		},
+
+		"http_concurrency": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 1000),
+		},
+
+		"always_ready": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the per-function scale group, such as `http`, `blob`, `durable` or `function:<function name>`.",
+					},
+
+					"instance_count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A map of key-value pairs for [App Settings](https://docs.microsoft.com/en-us/azure/azure-functions/functions-app-settings) and custom values.",
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.FlexConsumptionSitesClient
+			servicePlanClient := metadata.Client.AppService.ServicePlanClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model FunctionAppFlexConsumptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewFunctionAppID(subscriptionId, model.ResourceGroup, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			servicePlanId, err := parse.ServicePlanID(model.ServicePlanId)
+			if err != nil {
+				return err
+			}
+
+			servicePlan, err := servicePlanClient.Get(ctx, servicePlanId.ResourceGroup, servicePlanId.ServerfarmName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", servicePlanId, err)
+			}
+
+			if sku := servicePlan.Sku; sku == nil || !helpers.PlanIsFlexConsumption(sku.Name) {
+				return fmt.Errorf("%s must use a Flex Consumption SKU (`FC1`)", servicePlanId)
+			}
+
+			storageDomainSuffix, ok := metadata.Client.Account.Environment.Storage.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine Storage domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			functionAppConfig, appSettings, err := expandFunctionAppFlexConsumptionConfig(model, *storageDomainSuffix)
+			if err != nil {
+				return err
+			}
+
+			expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := azuresdkhacks.FlexConsumptionSite{
+				Identity: expandedIdentity,
+				Kind:     pointer.To("functionapp,linux"),
+				Location: location.Normalize(model.Location),
+				Properties: &azuresdkhacks.FlexConsumptionSiteProperties{
+					Enabled:           pointer.To(model.Enabled),
+					FunctionAppConfig: functionAppConfig,
+					HTTPSOnly:         pointer.To(model.HttpsOnly),
+					ServerFarmId:      pointer.To(servicePlanId.ID()),
+					SiteConfig: &azuresdkhacks.SiteConfig{
+						AppSettings: appSettings,
+					},
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.FlexConsumptionSitesClient
+			webAppsClient := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			appSettingsResp, err := webAppsClient.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading App Settings for %s: %+v", *id, err)
+			}
+
+			state := FunctionAppFlexConsumptionModel{
+				Name:          id.SiteName,
+				ResourceGroup: id.ResourceGroup,
+				// the access key isn't returned by the API
+				StorageAccessKey: metadata.ResourceData.Get("storage_access_key").(string),
+				AppSettings:      flattenFunctionAppFlexConsumptionAppSettings(appSettingsResp.Properties),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Kind = pointer.From(model.Kind)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.ServerFarmId != nil {
+						servicePlanId, err := parse.ServicePlanIDInsensitively(*props.ServerFarmId)
+						if err != nil {
+							return err
+						}
+						state.ServicePlanId = servicePlanId.ID()
+					}
+
+					state.DefaultHostname = pointer.From(props.DefaultHostName)
+					state.Enabled = pointer.From(props.Enabled)
+					state.HttpsOnly = pointer.From(props.HTTPSOnly)
+					state.OutboundIPAddresses = pointer.From(props.OutboundIPAddresses)
+
+					flattenFunctionAppFlexConsumptionConfig(props.FunctionAppConfig, &state)
+				}
+
+				flattenedIdentity, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.FlexConsumptionSitesClient
+
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model FunctionAppFlexConsumptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := existing.Model
+			props := payload.Properties
+
+			storageDomainSuffix, ok := metadata.Client.Account.Environment.Storage.DomainSuffix()
+			if !ok {
+				return fmt.Errorf("could not determine Storage domain suffix for environment %q", metadata.Client.Account.Environment.Name)
+			}
+
+			// App Settings aren't returned when retrieving the Site, so these are always sent to avoid them being removed
+			functionAppConfig, appSettings, err := expandFunctionAppFlexConsumptionConfig(model, *storageDomainSuffix)
+			if err != nil {
+				return err
+			}
+			props.FunctionAppConfig = functionAppConfig
+			props.SiteConfig = &azuresdkhacks.SiteConfig{
+				AppSettings: appSettings,
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				props.Enabled = pointer.To(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("https_only") {
+				props.HTTPSOnly = pointer.To(model.HttpsOnly)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppFlexConsumptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", *id)
+
+			deleteMetrics := true
+			deleteEmptyServerFarm := false
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.SiteName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandFunctionAppFlexConsumptionConfig(model FunctionAppFlexConsumptionModel, storageDomainSuffix string) (*azuresdkhacks.FunctionAppConfig, *[]azuresdkhacks.NameValuePair, error) {
+	endpoint, err := url.Parse(model.StorageContainerEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing `storage_container_endpoint`: %+v", err)
+	}
+	accountName := strings.Split(endpoint.Host, ".")[0]
+
+	appSettings := make(map[string]string)
+	for k, v := range model.AppSettings {
+		appSettings[k] = v
+	}
+
+	authType := azuresdkhacks.AuthenticationType(model.StorageAuthenticationType)
+	authentication := &azuresdkhacks.FunctionsDeploymentStorageAuthentication{
+		Type: pointer.To(authType),
+	}
+
+	switch authType {
+	case azuresdkhacks.AuthenticationTypeStorageAccountConnectionString:
+		if model.StorageAccessKey == "" {
+			return nil, nil, fmt.Errorf("`storage_access_key` must be specified when `storage_authentication_type` is `%s`", authType)
+		}
+		if model.StorageUserAssignedIdentity != "" {
+			return nil, nil, fmt.Errorf("`storage_user_assigned_identity_id` can only be specified when `storage_authentication_type` is `%s`", azuresdkhacks.AuthenticationTypeUserAssignedIdentity)
+		}
+
+		connectionString := fmt.Sprintf(helpers.StorageStringFmt, accountName, model.StorageAccessKey, storageDomainSuffix)
+		appSettings[flexConsumptionDeploymentStorageAppSetting] = connectionString
+		appSettings[flexConsumptionWebJobsStorageAppSetting] = connectionString
+		authentication.StorageAccountConnectionStringName = pointer.To(flexConsumptionDeploymentStorageAppSetting)
+
+	case azuresdkhacks.AuthenticationTypeUserAssignedIdentity:
+		if model.StorageUserAssignedIdentity == "" {
+			return nil, nil, fmt.Errorf("`storage_user_assigned_identity_id` must be specified when `storage_authentication_type` is `%s`", authType)
+		}
+		if model.StorageAccessKey != "" {
+			return nil, nil, fmt.Errorf("`storage_access_key` can only be specified when `storage_authentication_type` is `%s`", azuresdkhacks.AuthenticationTypeStorageAccountConnectionString)
+		}
+
+		appSettings[flexConsumptionWebJobsAccountAppSetting] = accountName
+		authentication.UserAssignedIdentityResourceId = pointer.To(model.StorageUserAssignedIdentity)
+
+	case azuresdkhacks.AuthenticationTypeSystemAssignedIdentity:
+		if model.StorageAccessKey != "" || model.StorageUserAssignedIdentity != "" {
+			return nil, nil, fmt.Errorf("neither `storage_access_key` or `storage_user_assigned_identity_id` can be specified when `storage_authentication_type` is `%s`", authType)
+		}
+
+		appSettings[flexConsumptionWebJobsAccountAppSetting] = accountName
+	}
+
+	alwaysReady := make([]azuresdkhacks.FunctionsAlwaysReadyConfig, 0)
+	for _, v := range model.AlwaysReady {
+		alwaysReady = append(alwaysReady, azuresdkhacks.FunctionsAlwaysReadyConfig{
+			Name:          pointer.To(v.Name),
+			InstanceCount: pointer.To(int64(v.InstanceCount)),
+		})
+	}
+
+	scaleAndConcurrency := &azuresdkhacks.FunctionsScaleAndConcurrency{
+		AlwaysReady:          &alwaysReady,
+		InstanceMemoryMB:     pointer.To(int64(model.InstanceMemoryInMB)),
+		MaximumInstanceCount: pointer.To(int64(model.MaximumInstanceCount)),
+	}
+
+	if model.HttpConcurrency != 0 {
+		scaleAndConcurrency.Triggers = &azuresdkhacks.FunctionsScaleAndConcurrencyTriggers{
+			HTTP: &azuresdkhacks.FunctionsScaleAndConcurrencyTriggersHTTP{
+				PerInstanceConcurrency: pointer.To(int64(model.HttpConcurrency)),
+			},
+		}
+	}
+
+	config := &azuresdkhacks.FunctionAppConfig{
+		Deployment: &azuresdkhacks.FunctionsDeployment{
+			Storage: &azuresdkhacks.FunctionsDeploymentStorage{
+				Authentication: authentication,
+				Type:           pointer.To(azuresdkhacks.FunctionsDeploymentStorageTypeBlobContainer),
+				Value:          pointer.To(model.StorageContainerEndpoint),
+			},
+		},
+		Runtime: &azuresdkhacks.FunctionsRuntime{
+			Name:    pointer.To(azuresdkhacks.RuntimeName(model.RuntimeName)),
+			Version: pointer.To(model.RuntimeVersion),
+		},
+		ScaleAndConcurrency: scaleAndConcurrency,
+	}
+
+	settings := make([]azuresdkhacks.NameValuePair, 0)
+	for k, v := range appSettings {
+		settings = append(settings, azuresdkhacks.NameValuePair{
+			Name:  pointer.To(k),
+			Value: pointer.To(v),
+		})
+	}
+
+	return config, &settings, nil
+}
+
+func flattenFunctionAppFlexConsumptionConfig(input *azuresdkhacks.FunctionAppConfig, state *FunctionAppFlexConsumptionModel) {
+	if input == nil {
+		return
+	}
+
+	if deployment := input.Deployment; deployment != nil && deployment.Storage != nil {
+		state.StorageContainerEndpoint = pointer.From(deployment.Storage.Value)
+		if auth := deployment.Storage.Authentication; auth != nil {
+			state.StorageAuthenticationType = string(pointer.From(auth.Type))
+			state.StorageUserAssignedIdentity = pointer.From(auth.UserAssignedIdentityResourceId)
+		}
+	}
+
+	if runtime := input.Runtime; runtime != nil {
+		state.RuntimeName = string(pointer.From(runtime.Name))
+		state.RuntimeVersion = pointer.From(runtime.Version)
+	}
+
+	if scale := input.ScaleAndConcurrency; scale != nil {
+		state.InstanceMemoryInMB = int(pointer.From(scale.InstanceMemoryMB))
+		state.MaximumInstanceCount = int(pointer.From(scale.MaximumInstanceCount))
+
+		if triggers := scale.Triggers; triggers != nil && triggers.HTTP != nil {
+			state.HttpConcurrency = int(pointer.From(triggers.HTTP.PerInstanceConcurrency))
+		}
+
+		alwaysReady := make([]FunctionAppFlexConsumptionAlwaysReady, 0)
+		if scale.AlwaysReady != nil {
+			for _, v := range *scale.AlwaysReady {
+				alwaysReady = append(alwaysReady, FunctionAppFlexConsumptionAlwaysReady{
+					Name:          pointer.From(v.Name),
+					InstanceCount: int(pointer.From(v.InstanceCount)),
+				})
+			}
+		}
+		state.AlwaysReady = alwaysReady
+	}
+}
+
+func flattenFunctionAppFlexConsumptionAppSettings(input map[string]*string) map[string]string {
+	result := make(map[string]string)
+	for k, v := range input {
+		switch k {
+		// these are managed by the storage_* arguments
+		case flexConsumptionDeploymentStorageAppSetting, flexConsumptionWebJobsStorageAppSetting, flexConsumptionWebJobsAccountAppSetting:
+			continue
+		}
+		result[k] = utils.NormalizeNilableString(v)
+	}
+
+	return result
+}
@@ -0,0 +1,136 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// healthCheckRequestTimeout is the maximum time a single request to the Health Check path can take, so that a hung
+// connection is retried at the next poll rather than blocking until the overall `timeout_in_minutes` elapses
+const healthCheckRequestTimeout = 30 * time.Second
+
+type WaitForHealthy struct {
+	TimeoutInMinutes int `tfschema:"timeout_in_minutes"`
+}
+
+func WaitForHealthySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"timeout_in_minutes": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      10,
+					ValidateFunc: validation.IntBetween(1, 60),
+					Description:  "The number of minutes to wait for the Health Check endpoint to report healthy after the App is created or updated. Defaults to `10`.",
+				},
+			},
+		},
+		Description: "When specified, the App's `health_check_path` is polled after create and update until it reports healthy, failing the apply if it does not.",
+	}
+}
+
+// ExpandWaitForHealthy returns the configured `wait_for_healthy` block from config, since this is never returned by the API.
+func ExpandWaitForHealthy(input []interface{}) []WaitForHealthy {
+	if len(input) == 0 || input[0] == nil {
+		return []WaitForHealthy{}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return []WaitForHealthy{
+		{
+			TimeoutInMinutes: raw["timeout_in_minutes"].(int),
+		},
+	}
+}
+
+// WaitForAppHealthy polls the Health Check path of the App until it returns a successful status code or the timeout is reached.
+func WaitForAppHealthy(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, healthCheckPath string, input []WaitForHealthy) error {
+	if len(input) == 0 {
+		return nil
+	}
+
+	if healthCheckPath == "" {
+		return fmt.Errorf("`site_config.0.health_check_path` must be set when `wait_for_healthy` is specified")
+	}
+
+	site, err := client.Get(ctx, resourceGroup, siteName)
+	if err != nil {
+		return fmt.Errorf("reading site %s (Resource Group %s) to check health: %+v", siteName, resourceGroup, err)
+	}
+	if site.SiteProperties == nil || site.SiteProperties.DefaultHostName == nil {
+		return fmt.Errorf("could not determine the default hostname for site %s (Resource Group %s)", siteName, resourceGroup)
+	}
+
+	timeout := time.Duration(input[0].TimeoutInMinutes) * time.Minute
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/%s", *site.SiteProperties.DefaultHostName, strings.TrimPrefix(healthCheckPath, "/"))
+
+	lastStatus := "no response"
+	healthWait := &pluginsdk.StateChangeConf{
+		Pending:      []string{"unhealthy"},
+		Target:       []string{"healthy"},
+		PollInterval: 10 * time.Second,
+		Timeout:      timeout,
+		Refresh:      checkAppHealthRefresh(ctx, newHealthCheckHttpClient(), endpoint, client.UserAgent, &lastStatus),
+	}
+
+	if _, err := healthWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for site %s (Resource Group %s) to report healthy at %q, last response was %s: %+v", siteName, resourceGroup, endpoint, lastStatus, err)
+	}
+
+	return nil
+}
+
+// newHealthCheckHttpClient returns an HTTP Client which honours the proxy configured in the environment (as used by the
+// rest of the Provider) and which times out each individual Health Check request
+func newHealthCheckHttpClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+		Timeout: healthCheckRequestTimeout,
+	}
+}
+
+func checkAppHealthRefresh(ctx context.Context, httpClient *http.Client, endpoint string, userAgent string, lastStatus *string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+		if err != nil {
+			return nil, "", fmt.Errorf("preparing health check request: %+v", err)
+		}
+		req.Header["Cache-Control"] = []string{"no-cache"}
+		req.Header["User-Agent"] = []string{userAgent}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			// the App may not be reachable yet, so this is retried until the timeout
+			*lastStatus = err.Error()
+			return "unhealthy", "unhealthy", nil
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return resp.StatusCode, "healthy", nil
+		}
+
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		*lastStatus = fmt.Sprintf("%s %q", resp.Status, strings.TrimSpace(string(body)))
+
+		return resp.StatusCode, "unhealthy", nil
+	}
+}
@@ -0,0 +1,62 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckAppHealthRefresh(t *testing.T) {
+	testData := []struct {
+		name          string
+		handler       http.HandlerFunc
+		expectedState string
+	}{
+		{
+			name: "healthy",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			},
+			expectedState: "healthy",
+		},
+		{
+			name: "unhealthy",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			expectedState: "unhealthy",
+		},
+		{
+			name: "request times out",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(5 * time.Second):
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+			expectedState: "unhealthy",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		server := httptest.NewServer(v.handler)
+		httpClient := newHealthCheckHttpClient()
+		httpClient.Timeout = 100 * time.Millisecond
+
+		lastStatus := "no response"
+		_, state, err := checkAppHealthRefresh(context.TODO(), httpClient, server.URL, "test", &lastStatus)()
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if state != v.expectedState {
+			t.Fatalf("expected state %q but got %q (last status: %s)", v.expectedState, state, lastStatus)
+		}
+	}
+}
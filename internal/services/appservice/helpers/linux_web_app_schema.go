@@ -764,41 +764,12 @@ func ExpandSiteConfigLinux(siteConfig []SiteConfigLinux, existing *web.SiteConfi
 
 	if metadata.ResourceData.HasChange("site_config.0.application_stack") {
 		if len(linuxSiteConfig.ApplicationStack) == 1 {
-			linuxAppStack := linuxSiteConfig.ApplicationStack[0]
-			if linuxAppStack.NetFrameworkVersion != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("DOTNETCORE|%s", linuxAppStack.NetFrameworkVersion))
+			linuxFxVersion, err := EncodeLinuxFxVersion(linuxSiteConfig.ApplicationStack[0])
+			if err != nil {
+				return nil, err
 			}
-
-			if linuxAppStack.GoVersion != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("GO|%s", linuxAppStack.GoVersion))
-			}
-
-			if linuxAppStack.PhpVersion != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("PHP|%s", linuxAppStack.PhpVersion))
-			}
-
-			if linuxAppStack.NodeVersion != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("NODE|%s", linuxAppStack.NodeVersion))
-			}
-
-			if linuxAppStack.RubyVersion != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("RUBY|%s", linuxAppStack.RubyVersion))
-			}
-
-			if linuxAppStack.PythonVersion != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("PYTHON|%s", linuxAppStack.PythonVersion))
-			}
-
-			if linuxAppStack.JavaServer != "" {
-				javaString, err := JavaLinuxFxStringBuilder(linuxAppStack.JavaVersion, linuxAppStack.JavaServer, linuxAppStack.JavaServerVersion)
-				if err != nil {
-					return nil, fmt.Errorf("could not build linuxFxVersion string: %+v", err)
-				}
-				expanded.LinuxFxVersion = javaString
-			}
-
-			if linuxAppStack.DockerImage != "" {
-				expanded.LinuxFxVersion = pointer.To(fmt.Sprintf("DOCKER|%s:%s", linuxAppStack.DockerImage, linuxAppStack.DockerImageTag))
+			if linuxFxVersion != nil {
+				expanded.LinuxFxVersion = linuxFxVersion
 			}
 		} else {
 			expanded.LinuxFxVersion = pointer.To("")
@@ -898,6 +869,49 @@ func ExpandSiteConfigLinux(siteConfig []SiteConfigLinux, existing *web.SiteConfi
 	return expanded, nil
 }
 
+// EncodeLinuxFxVersion returns the `linuxFxVersion` for the specified Application Stack, or nil if no stack is specified
+func EncodeLinuxFxVersion(linuxAppStack ApplicationStackLinux) (*string, error) {
+	var linuxFxVersion *string
+
+	if linuxAppStack.NetFrameworkVersion != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("DOTNETCORE|%s", linuxAppStack.NetFrameworkVersion))
+	}
+
+	if linuxAppStack.GoVersion != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("GO|%s", linuxAppStack.GoVersion))
+	}
+
+	if linuxAppStack.PhpVersion != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("PHP|%s", linuxAppStack.PhpVersion))
+	}
+
+	if linuxAppStack.NodeVersion != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("NODE|%s", linuxAppStack.NodeVersion))
+	}
+
+	if linuxAppStack.RubyVersion != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("RUBY|%s", linuxAppStack.RubyVersion))
+	}
+
+	if linuxAppStack.PythonVersion != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("PYTHON|%s", linuxAppStack.PythonVersion))
+	}
+
+	if linuxAppStack.JavaServer != "" {
+		javaString, err := JavaLinuxFxStringBuilder(linuxAppStack.JavaVersion, linuxAppStack.JavaServer, linuxAppStack.JavaServerVersion)
+		if err != nil {
+			return nil, fmt.Errorf("could not build linuxFxVersion string: %+v", err)
+		}
+		linuxFxVersion = javaString
+	}
+
+	if linuxAppStack.DockerImage != "" {
+		linuxFxVersion = pointer.To(fmt.Sprintf("DOCKER|%s:%s", linuxAppStack.DockerImage, linuxAppStack.DockerImageTag))
+	}
+
+	return linuxFxVersion, nil
+}
+
 func FlattenSiteConfigLinux(appSiteConfig *web.SiteConfig, healthCheckCount *int) []SiteConfigLinux {
 	if appSiteConfig == nil {
 		return []SiteConfigLinux{}
@@ -13,6 +13,7 @@ import (
 const (
 	ServicePlanTypeConsumption = "consumption"
 	ServicePlanTypeElastic     = "elastic"
+	ServicePlanTypeFlex        = "flexconsumption"
 	ServicePlanTypeIsolated    = "isolated"
 	ServicePlanTypeAppPlan     = "app"
 )
@@ -38,6 +39,10 @@ var consumptionSkus = []string{
 	"Y1",
 }
 
+var flexConsumptionSkus = []string{
+	"FC1",
+}
+
 var elasticSkus = []string{
 	"EP1", "EP2", "EP3",
 }
@@ -57,6 +62,7 @@ func AllKnownServicePlanSkus() []string {
 	allSkus = append(allSkus, appServicePlanSkus...)
 	allSkus = append(allSkus, consumptionSkus...)
 	allSkus = append(allSkus, elasticSkus...)
+	allSkus = append(allSkus, flexConsumptionSkus...)
 	allSkus = append(allSkus, freeSkus...)
 	allSkus = append(allSkus, isolatedSkus...)
 	allSkus = append(allSkus, sharedSkus...)
@@ -78,6 +84,19 @@ func PlanIsConsumption(input *string) bool {
 	return false
 }
 
+func PlanIsFlexConsumption(input *string) bool {
+	if input == nil {
+		return false
+	}
+	for _, v := range flexConsumptionSkus {
+		if strings.EqualFold(*input, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func PlanIsElastic(input *string) bool {
 	if input == nil {
 		return false
@@ -126,6 +145,10 @@ func PlanTypeFromSku(input string) string {
 		return ServicePlanTypeElastic
 	}
 
+	if PlanIsFlexConsumption(&input) {
+		return ServicePlanTypeFlex
+	}
+
 	if PlanIsIsolated(&input) {
 		return ServicePlanTypeIsolated
 	}
@@ -168,6 +168,10 @@ func TestPlanTypeFromSku(t *testing.T) {
 			name:     "EP1",
 			expected: "elastic",
 		},
+		{
+			name:     "FC1",
+			expected: "flexconsumption",
+		},
 		{
 			name:     "B1",
 			expected: "app",
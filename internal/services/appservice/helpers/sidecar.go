@@ -0,0 +1,410 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// LinuxFxVersionSiteContainers is the `linuxFxVersion` used when the main container of an App is defined as a sidecar
+const LinuxFxVersionSiteContainers = "SITECONTAINERS"
+
+type Sidecar struct {
+	Name                        string                       `tfschema:"name"`
+	Image                       string                       `tfschema:"image"`
+	IsMain                      bool                         `tfschema:"is_main"`
+	TargetPort                  string                       `tfschema:"target_port"`
+	StartupCommand              string                       `tfschema:"startup_command"`
+	AuthenticationType          string                       `tfschema:"authentication_type"`
+	Username                    string                       `tfschema:"username"`
+	PasswordSecret              string                       `tfschema:"password_secret"`
+	UserManagedIdentityClientId string                       `tfschema:"user_managed_identity_client_id"`
+	EnvironmentVariables        []SidecarEnvironmentVariable `tfschema:"environment_variable"`
+	VolumeMounts                []SidecarVolumeMount         `tfschema:"volume_mount"`
+}
+
+type SidecarEnvironmentVariable struct {
+	Name           string `tfschema:"name"`
+	AppSettingName string `tfschema:"app_setting_name"`
+}
+
+type SidecarVolumeMount struct {
+	VolumeSubPath      string `tfschema:"volume_sub_path"`
+	ContainerMountPath string `tfschema:"container_mount_path"`
+	Data               string `tfschema:"data"`
+	ReadOnly           bool   `tfschema:"read_only"`
+}
+
+func SidecarSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"image": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The full image reference of the container, including the registry and tag. e.g. `mcr.microsoft.com/appsvc/staticsite:latest`.",
+				},
+
+				"is_main": {
+					Type:        pluginsdk.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Is this the main container of the App, which receives the incoming traffic?",
+				},
+
+				"target_port": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"startup_command": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"authentication_type": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(azuresdkhacks.SiteContainerAuthTypeAnonymous),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(azuresdkhacks.SiteContainerAuthTypeAnonymous),
+						string(azuresdkhacks.SiteContainerAuthTypeSystemIdentity),
+						string(azuresdkhacks.SiteContainerAuthTypeUserAssigned),
+						string(azuresdkhacks.SiteContainerAuthTypeUserCredentials),
+					}, false),
+				},
+
+				"username": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"password_secret": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"user_managed_identity_client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"environment_variable": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"app_setting_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+								Description:  "The name of the App Setting which contains the value of this Environment Variable.",
+							},
+						},
+					},
+				},
+
+				"volume_mount": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"volume_sub_path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"container_mount_path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"data": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"read_only": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateSidecars checks the `sidecar` blocks against the Docker configuration of the App, since the main container is
+// either the code based `application_stack`, or is specified as a `sidecar` - and can't be a `docker_image`
+func ValidateSidecars(sidecars []Sidecar, applicationStack []ApplicationStackLinux) error {
+	if len(sidecars) == 0 {
+		return nil
+	}
+
+	codeStackSet := false
+	for _, v := range applicationStack {
+		if v.DockerImage != "" {
+			return fmt.Errorf("`sidecar` cannot be used with `site_config.0.application_stack.0.docker_image`, the main container should instead be specified as a `sidecar` with `is_main` set to `true`")
+		}
+		if v.NetFrameworkVersion != "" || v.GoVersion != "" || v.PhpVersion != "" || v.PythonVersion != "" || v.NodeVersion != "" || v.JavaServer != "" || v.RubyVersion != "" {
+			codeStackSet = true
+		}
+	}
+
+	names := make(map[string]struct{})
+	mainCount := 0
+	for _, v := range sidecars {
+		key := strings.ToLower(v.Name)
+		if _, exists := names[key]; exists {
+			return fmt.Errorf("the `sidecar` name %q must be unique", v.Name)
+		}
+		names[key] = struct{}{}
+
+		if v.IsMain {
+			mainCount++
+		}
+
+		switch azuresdkhacks.SiteContainerAuthType(v.AuthenticationType) {
+		case azuresdkhacks.SiteContainerAuthTypeUserCredentials:
+			if v.Username == "" || v.PasswordSecret == "" {
+				return fmt.Errorf("`username` and `password_secret` must be specified for the `sidecar` %q when `authentication_type` is `%s`", v.Name, v.AuthenticationType)
+			}
+		case azuresdkhacks.SiteContainerAuthTypeUserAssigned:
+			if v.UserManagedIdentityClientId == "" {
+				return fmt.Errorf("`user_managed_identity_client_id` must be specified for the `sidecar` %q when `authentication_type` is `%s`", v.Name, v.AuthenticationType)
+			}
+		}
+	}
+
+	if codeStackSet && mainCount > 0 {
+		return fmt.Errorf("a `sidecar` cannot have `is_main` set to `true` when a code based `site_config.0.application_stack` is specified")
+	}
+
+	if !codeStackSet && mainCount != 1 {
+		return fmt.Errorf("exactly one `sidecar` must have `is_main` set to `true` when no `site_config.0.application_stack` is specified")
+	}
+
+	return nil
+}
+
+// SidecarsUseSiteContainers returns whether the main container of the App is specified as a `sidecar`
+func SidecarsUseSiteContainers(sidecars []Sidecar) bool {
+	for _, v := range sidecars {
+		if v.IsMain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SidecarsShouldBeRead returns whether the Site Containers of an App need to be listed, which is only the case when
+// `sidecar` is present in the state or the App is configured to use Site Containers (e.g. when it's being imported)
+func SidecarsShouldBeRead(siteConfig *web.SiteConfig, existing []interface{}) bool {
+	if len(existing) > 0 {
+		return true
+	}
+
+	return siteConfig != nil && strings.EqualFold(pointer.From(siteConfig.LinuxFxVersion), LinuxFxVersionSiteContainers)
+}
+
+// UpdateSidecars creates or updates the specified sidecars and removes any which are no longer specified
+func UpdateSidecars(ctx context.Context, client *azuresdkhacks.SiteContainersClient, id azuresdkhacks.SiteContainerParentId, sidecars []Sidecar) error {
+	existing, err := client.List(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing Sidecars for %s: %+v", id, err)
+	}
+
+	// the main container is created first, so that it's present before any other sidecars are added
+	sorted := make([]Sidecar, len(sidecars))
+	copy(sorted, sidecars)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].IsMain && !sorted[j].IsMain
+	})
+
+	specified := make(map[string]struct{})
+	for _, v := range sorted {
+		specified[strings.ToLower(v.Name)] = struct{}{}
+		if _, err := client.CreateOrUpdate(ctx, id, v.Name, expandSidecar(v)); err != nil {
+			return fmt.Errorf("creating/updating Sidecar %q for %s: %+v", v.Name, id, err)
+		}
+	}
+
+	for _, v := range existing.Items {
+		name := pointer.From(v.Name)
+		// the name is returned as `{siteName}/{containerName}`
+		if parts := strings.Split(name, "/"); len(parts) > 1 {
+			name = parts[len(parts)-1]
+		}
+		if _, ok := specified[strings.ToLower(name)]; ok {
+			continue
+		}
+		if _, err := client.Delete(ctx, id, name); err != nil {
+			return fmt.Errorf("removing Sidecar %q from %s: %+v", name, id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandSidecar(input Sidecar) azuresdkhacks.SiteContainer {
+	props := &azuresdkhacks.SiteContainerProperties{
+		AuthType: pointer.To(azuresdkhacks.SiteContainerAuthType(input.AuthenticationType)),
+		Image:    input.Image,
+		IsMain:   input.IsMain,
+	}
+
+	if input.TargetPort != "" {
+		props.TargetPort = pointer.To(input.TargetPort)
+	}
+	if input.StartupCommand != "" {
+		props.StartUpCommand = pointer.To(input.StartupCommand)
+	}
+	if input.Username != "" {
+		props.UserName = pointer.To(input.Username)
+	}
+	if input.PasswordSecret != "" {
+		props.PasswordSecret = pointer.To(input.PasswordSecret)
+	}
+	if input.UserManagedIdentityClientId != "" {
+		props.UserManagedIdentityClientId = pointer.To(input.UserManagedIdentityClientId)
+	}
+
+	environmentVariables := make([]azuresdkhacks.EnvironmentVariable, 0)
+	for _, v := range input.EnvironmentVariables {
+		environmentVariables = append(environmentVariables, azuresdkhacks.EnvironmentVariable{
+			Name:  v.Name,
+			Value: v.AppSettingName,
+		})
+	}
+	props.EnvironmentVariables = &environmentVariables
+
+	volumeMounts := make([]azuresdkhacks.VolumeMount, 0)
+	for _, v := range input.VolumeMounts {
+		mount := azuresdkhacks.VolumeMount{
+			ContainerMountPath: v.ContainerMountPath,
+			ReadOnly:           pointer.To(v.ReadOnly),
+			VolumeSubPath:      v.VolumeSubPath,
+		}
+		if v.Data != "" {
+			mount.Data = pointer.To(v.Data)
+		}
+		volumeMounts = append(volumeMounts, mount)
+	}
+	props.VolumeMounts = &volumeMounts
+
+	return azuresdkhacks.SiteContainer{
+		Properties: props,
+	}
+}
+
+// FlattenSidecars flattens the Site Containers of an App, the `password_secret` isn't returned by the API so is taken
+// from the existing `sidecar` blocks in the state
+func FlattenSidecars(input []azuresdkhacks.SiteContainer, existing []interface{}) []Sidecar {
+	passwords := make(map[string]string)
+	order := make(map[string]int)
+	for i, raw := range existing {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := strings.ToLower(v["name"].(string))
+		passwords[name] = v["password_secret"].(string)
+		order[name] = i
+	}
+
+	result := make([]Sidecar, 0)
+	for _, v := range input {
+		name := pointer.From(v.Name)
+		if parts := strings.Split(name, "/"); len(parts) > 1 {
+			name = parts[len(parts)-1]
+		}
+
+		sidecar := Sidecar{
+			Name:           name,
+			PasswordSecret: passwords[strings.ToLower(name)],
+		}
+
+		if props := v.Properties; props != nil {
+			sidecar.Image = props.Image
+			sidecar.IsMain = props.IsMain
+			sidecar.TargetPort = pointer.From(props.TargetPort)
+			sidecar.StartupCommand = pointer.From(props.StartUpCommand)
+			sidecar.AuthenticationType = string(pointer.From(props.AuthType))
+			sidecar.Username = pointer.From(props.UserName)
+			sidecar.UserManagedIdentityClientId = pointer.From(props.UserManagedIdentityClientId)
+
+			environmentVariables := make([]SidecarEnvironmentVariable, 0)
+			if props.EnvironmentVariables != nil {
+				for _, e := range *props.EnvironmentVariables {
+					environmentVariables = append(environmentVariables, SidecarEnvironmentVariable{
+						Name:           e.Name,
+						AppSettingName: e.Value,
+					})
+				}
+			}
+			sidecar.EnvironmentVariables = environmentVariables
+
+			volumeMounts := make([]SidecarVolumeMount, 0)
+			if props.VolumeMounts != nil {
+				for _, m := range *props.VolumeMounts {
+					volumeMounts = append(volumeMounts, SidecarVolumeMount{
+						VolumeSubPath:      m.VolumeSubPath,
+						ContainerMountPath: m.ContainerMountPath,
+						Data:               pointer.From(m.Data),
+						ReadOnly:           pointer.From(m.ReadOnly),
+					})
+				}
+			}
+			sidecar.VolumeMounts = volumeMounts
+		}
+
+		result = append(result, sidecar)
+	}
+
+	// the API doesn't guarantee ordering, so these are returned in the order they're specified in the config
+	sort.SliceStable(result, func(i, j int) bool {
+		oi, iok := order[strings.ToLower(result[i].Name)]
+		oj, jok := order[strings.ToLower(result[j].Name)]
+		if iok && jok {
+			return oi < oj
+		}
+		return iok && !jok
+	})
+
+	return result
+}
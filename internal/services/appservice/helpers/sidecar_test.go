@@ -0,0 +1,90 @@
+package helpers
+
+import (
+	"testing"
+)
+
+func TestValidateSidecars(t *testing.T) {
+	testData := []struct {
+		name             string
+		sidecars         []Sidecar
+		applicationStack []ApplicationStackLinux
+		expectError      bool
+	}{
+		{
+			name: "no sidecars",
+		},
+		{
+			name: "main container as a sidecar",
+			sidecars: []Sidecar{
+				{Name: "main", Image: "mcr.microsoft.com/appsvc/staticsite:latest", IsMain: true},
+				{Name: "otel", Image: "mcr.microsoft.com/oss/otel/opentelemetry-collector:latest"},
+			},
+		},
+		{
+			name: "no main container",
+			sidecars: []Sidecar{
+				{Name: "otel", Image: "mcr.microsoft.com/oss/otel/opentelemetry-collector:latest"},
+			},
+			expectError: true,
+		},
+		{
+			name: "multiple main containers",
+			sidecars: []Sidecar{
+				{Name: "main", Image: "mcr.microsoft.com/appsvc/staticsite:latest", IsMain: true},
+				{Name: "other", Image: "mcr.microsoft.com/appsvc/staticsite:latest", IsMain: true},
+			},
+			expectError: true,
+		},
+		{
+			name: "duplicate names",
+			sidecars: []Sidecar{
+				{Name: "main", Image: "mcr.microsoft.com/appsvc/staticsite:latest", IsMain: true},
+				{Name: "Main", Image: "mcr.microsoft.com/appsvc/staticsite:latest"},
+			},
+			expectError: true,
+		},
+		{
+			name: "code based stack with sidecar",
+			sidecars: []Sidecar{
+				{Name: "otel", Image: "mcr.microsoft.com/oss/otel/opentelemetry-collector:latest"},
+			},
+			applicationStack: []ApplicationStackLinux{{NodeVersion: "18-lts"}},
+		},
+		{
+			name: "code based stack with main sidecar",
+			sidecars: []Sidecar{
+				{Name: "main", Image: "mcr.microsoft.com/appsvc/staticsite:latest", IsMain: true},
+			},
+			applicationStack: []ApplicationStackLinux{{NodeVersion: "18-lts"}},
+			expectError:      true,
+		},
+		{
+			name: "docker stack with sidecar",
+			sidecars: []Sidecar{
+				{Name: "main", Image: "mcr.microsoft.com/appsvc/staticsite:latest", IsMain: true},
+			},
+			applicationStack: []ApplicationStackLinux{{DockerImage: "mcr.microsoft.com/appsvc/staticsite", DockerImageTag: "latest"}},
+			expectError:      true,
+		},
+		{
+			name: "user credentials without a password",
+			sidecars: []Sidecar{
+				{Name: "main", Image: "example.azurecr.io/app:latest", IsMain: true, AuthenticationType: "UserCredentials", Username: "admin"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := ValidateSidecars(v.sidecars, v.applicationStack)
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", v.name)
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error for %q but got: %+v", v.name, err)
+		}
+	}
+}
@@ -60,6 +60,7 @@ type LinuxFunctionAppModel struct {
 	Tags                        map[string]string                    `tfschema:"tags"`
 	VirtualNetworkSubnetID      string                               `tfschema:"virtual_network_subnet_id"`
 	ZipDeployFile               string                               `tfschema:"zip_deploy_file"`
+	WaitForHealthy              []helpers.WaitForHealthy             `tfschema:"wait_for_healthy"`
 
 	// Computed
 	CustomDomainVerificationId    string   `tfschema:"custom_domain_verification_id"`
@@ -267,6 +268,8 @@ func (r LinuxFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: networkValidate.SubnetID,
 		},
 
+		"wait_for_healthy": helpers.WaitForHealthySchema(),
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
@@ -345,6 +348,10 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if len(functionApp.WaitForHealthy) > 0 && functionApp.SiteConfig[0].HealthCheckPath == "" {
+				return fmt.Errorf("`site_config.0.health_check_path` must be set when `wait_for_healthy` is specified")
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			aseClient := metadata.Client.AppService.AppServiceEnvironmentClient
 			servicePlanClient := metadata.Client.AppService.ServicePlanClient
@@ -589,6 +596,13 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if functionApp.Enabled {
+				if err := helpers.WaitForAppHealthy(ctx, client, id.ResourceGroup, id.SiteName, functionApp.SiteConfig[0].HealthCheckPath, functionApp.WaitForHealthy); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -744,6 +758,9 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 				state.ZipDeployFile = deployFile
 			}
 
+			// `wait_for_healthy` is only used during apply, so it's persisted from config
+			state.WaitForHealthy = helpers.ExpandWaitForHealthy(metadata.ResourceData.Get("wait_for_healthy").([]interface{}))
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -1073,6 +1090,12 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if state.Enabled {
+				if err := helpers.WaitForAppHealthy(ctx, client, id.ResourceGroup, id.SiteName, state.SiteConfig[0].HealthCheckPath, state.WaitForHealthy); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
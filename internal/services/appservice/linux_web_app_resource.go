@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -45,9 +46,11 @@ type LinuxWebAppModel struct {
 	KeyVaultReferenceIdentityID   string                     `tfschema:"key_vault_reference_identity_id"`
 	LogsConfig                    []helpers.LogsConfig       `tfschema:"logs"`
 	SiteConfig                    []helpers.SiteConfigLinux  `tfschema:"site_config"`
+	Sidecars                      []helpers.Sidecar          `tfschema:"sidecar"`
 	StorageAccounts               []helpers.StorageAccount   `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString `tfschema:"connection_string"`
 	ZipDeployFile                 string                     `tfschema:"zip_deploy_file"`
+	WaitForHealthy                []helpers.WaitForHealthy   `tfschema:"wait_for_healthy"`
 	Tags                          map[string]string          `tfschema:"tags"`
 	CustomDomainVerificationId    string                     `tfschema:"custom_domain_verification_id"`
 	HostingEnvId                  string                     `tfschema:"hosting_environment_id"`
@@ -62,6 +65,8 @@ type LinuxWebAppModel struct {
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
+
 var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
@@ -161,10 +166,14 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaLinux(),
 
+		"sidecar": helpers.SidecarSchema(),
+
 		"sticky_settings": helpers.StickySettingsSchema(),
 
 		"storage_account": helpers.StorageAccountSchema(),
 
+		"wait_for_healthy": helpers.WaitForHealthySchema(),
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
@@ -249,6 +258,10 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if len(webApp.WaitForHealthy) > 0 && webApp.SiteConfig[0].HealthCheckPath == "" {
+				return fmt.Errorf("`site_config.0.health_check_path` must be set when `wait_for_healthy` is specified")
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			aseClient := metadata.Client.AppService.AppServiceEnvironmentClient
 			servicePlanClient := metadata.Client.AppService.ServicePlanClient
@@ -316,6 +329,10 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if helpers.SidecarsUseSiteContainers(webApp.Sidecars) {
+				siteConfig.LinuxFxVersion = pointer.To(helpers.LinuxFxVersionSiteContainers)
+			}
+
 			expandedIdentity, err := expandIdentity(metadata.ResourceData.Get("identity").([]interface{}))
 			if err != nil {
 				return fmt.Errorf("expanding `identity`: %+v", err)
@@ -430,12 +447,24 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webApp.Sidecars) > 0 {
+				if err := helpers.UpdateSidecars(ctx, metadata.Client.AppService.SiteContainersClient, id, webApp.Sidecars); err != nil {
+					return err
+				}
+			}
+
 			if webApp.ZipDeployFile != "" {
 				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile); err != nil {
 					return err
 				}
 			}
 
+			if webApp.Enabled {
+				if err := helpers.WaitForAppHealthy(ctx, client, id.ResourceGroup, id.SiteName, webApp.SiteConfig[0].HealthCheckPath, webApp.WaitForHealthy); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -514,6 +543,15 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
 			}
 
+			existingSidecars := metadata.ResourceData.Get("sidecar").([]interface{})
+			sidecars := azuresdkhacks.SiteContainerListOperationResponse{}
+			if helpers.SidecarsShouldBeRead(webAppSiteConfig.SiteConfig, existingSidecars) {
+				sidecars, err = metadata.Client.AppService.SiteContainersClient.List(ctx, id)
+				if err != nil {
+					return fmt.Errorf("reading Sidecars for Linux %s: %+v", id, err)
+				}
+			}
+
 			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
 			if err != nil {
 				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
@@ -584,6 +622,8 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
+			state.Sidecars = helpers.FlattenSidecars(sidecars.Items, existingSidecars)
+
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			// Zip Deploys are not retrievable, so attempt to get from config. This doesn't matter for imports as an unexpected value here could break the deployment.
@@ -591,6 +631,9 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 				state.ZipDeployFile = deployFile
 			}
 
+			// `wait_for_healthy` is only used during apply, so it's persisted from config
+			state.WaitForHealthy = helpers.ExpandWaitForHealthy(metadata.ResourceData.Get("wait_for_healthy").([]interface{}))
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -630,6 +673,25 @@ func (r LinuxWebAppResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r LinuxWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LinuxWebAppModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var applicationStack []helpers.ApplicationStackLinux
+			if len(model.SiteConfig) > 0 {
+				applicationStack = model.SiteConfig[0].ApplicationStack
+			}
+
+			return helpers.ValidateSidecars(model.Sidecars, applicationStack)
+		},
+	}
+}
+
 func (r LinuxWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.WebAppID
 }
@@ -721,6 +783,25 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig = siteConfig
 			}
 
+			if metadata.ResourceData.HasChange("sidecar") {
+				if existing.SiteConfig == nil {
+					existing.SiteConfig = &web.SiteConfig{}
+				}
+				if helpers.SidecarsUseSiteContainers(state.Sidecars) {
+					existing.SiteConfig.LinuxFxVersion = pointer.To(helpers.LinuxFxVersionSiteContainers)
+				} else if strings.EqualFold(pointer.From(existing.SiteConfig.LinuxFxVersion), helpers.LinuxFxVersionSiteContainers) {
+					// the main container was removed, so fall back to the `application_stack`
+					existing.SiteConfig.LinuxFxVersion = pointer.To("")
+					if stack := state.SiteConfig[0].ApplicationStack; len(stack) > 0 {
+						linuxFxVersion, err := helpers.EncodeLinuxFxVersion(stack[0])
+						if err != nil {
+							return err
+						}
+						existing.SiteConfig.LinuxFxVersion = linuxFxVersion
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
 				subnetId := metadata.ResourceData.Get("virtual_network_subnet_id").(string)
 				if subnetId == "" {
@@ -851,12 +932,24 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("sidecar") {
+				if err := helpers.UpdateSidecars(ctx, metadata.Client.AppService.SiteContainersClient, id, state.Sidecars); err != nil {
+					return err
+				}
+			}
+
 			if metadata.ResourceData.HasChange("zip_deploy_file") {
 				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
 					return err
 				}
 			}
 
+			if state.Enabled {
+				if err := helpers.WaitForAppHealthy(ctx, client, id.ResourceGroup, id.SiteName, state.SiteConfig[0].HealthCheckPath, state.WaitForHealthy); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
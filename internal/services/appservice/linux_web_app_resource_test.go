@@ -32,6 +32,21 @@ func TestAccLinuxWebApp_basic(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_waitForHealthy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.waitForHealthy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wait_for_healthy"),
+	})
+}
+
 func TestAccLinuxWebApp_freeSkuAlwaysOnShouldFail(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
 	r := LinuxWebAppResource{}
@@ -1021,6 +1036,31 @@ func TestAccLinuxWebApp_withDocker(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebApp_sidecar(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app", "test")
+	r := LinuxWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sidecar(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.linux_fx_version").HasValue("SITECONTAINERS"),
+				check.That(data.ResourceName).Key("sidecar.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.sidecarUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sidecar.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 // Change Application stack of an app?
 
 func TestAccLinuxWebApp_updateAppStack(t *testing.T) {
@@ -1345,6 +1385,32 @@ resource "azurerm_linux_web_app" "test" {
 `, r.baseTemplate(data), data.RandomInteger)
 }
 
+func (r LinuxWebAppResource) waitForHealthy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {
+    health_check_path                 = "/"
+    health_check_eviction_time_in_min = 5
+  }
+
+  wait_for_healthy {
+    timeout_in_minutes = 15
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
 func (r LinuxWebAppResource) linuxFreeSku(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -2634,6 +2700,80 @@ resource "azurerm_linux_web_app" "test" {
 `, r.baseTemplate(data), data.RandomInteger, containerImage, containerTag)
 }
 
+func (r LinuxWebAppResource) sidecar(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    "SIDECAR_MESSAGE" = "hello"
+  }
+
+  site_config {}
+
+  sidecar {
+    name        = "main"
+    image       = "mcr.microsoft.com/appsvc/staticsite:latest"
+    is_main     = true
+    target_port = "80"
+  }
+
+  sidecar {
+    name        = "otel"
+    image       = "mcr.microsoft.com/oss/otel/opentelemetry-collector:latest"
+    target_port = "4317"
+
+    environment_variable {
+      name             = "MESSAGE"
+      app_setting_name = "SIDECAR_MESSAGE"
+    }
+
+    volume_mount {
+      volume_sub_path      = "otel"
+      container_mount_path = "/etc/otel"
+      read_only            = true
+    }
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
+func (r LinuxWebAppResource) sidecarUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  sidecar {
+    name            = "main"
+    image           = "mcr.microsoft.com/appsvc/staticsite:latest"
+    is_main         = true
+    target_port     = "8080"
+    startup_command = "/opt/startup/startup.sh"
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
 func (r LinuxWebAppResource) autoHealRules(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
@@ -43,6 +44,7 @@ type LinuxWebAppSlotModel struct {
 	LogsConfig                    []helpers.LogsConfig                `tfschema:"logs"`
 	MetaData                      map[string]string                   `tfschema:"app_metadata"`
 	SiteConfig                    []helpers.SiteConfigLinuxWebAppSlot `tfschema:"site_config"`
+	Sidecars                      []helpers.Sidecar                   `tfschema:"sidecar"`
 	StorageAccounts               []helpers.StorageAccount            `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString          `tfschema:"connection_string"`
 	ZipDeployFile                 string                              `tfschema:"zip_deploy_file"`
@@ -61,6 +63,8 @@ type LinuxWebAppSlotModel struct {
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppSlotResource{}
+
 func (r LinuxWebAppSlotResource) ModelObject() interface{} {
 	return &LinuxWebAppSlotModel{}
 }
@@ -69,6 +73,25 @@ func (r LinuxWebAppSlotResource) ResourceType() string {
 	return "azurerm_linux_web_app_slot"
 }
 
+func (r LinuxWebAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LinuxWebAppSlotModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var applicationStack []helpers.ApplicationStackLinux
+			if len(model.SiteConfig) > 0 {
+				applicationStack = model.SiteConfig[0].ApplicationStack
+			}
+
+			return helpers.ValidateSidecars(model.Sidecars, applicationStack)
+		},
+	}
+}
+
 func (r LinuxWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.WebAppSlotID
 }
@@ -172,6 +195,8 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"site_config": helpers.SiteConfigSchemaLinuxWebAppSlot(),
 
+		"sidecar": helpers.SidecarSchema(),
+
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"zip_deploy_file": {
@@ -304,6 +329,10 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if helpers.SidecarsUseSiteContainers(webAppSlot.Sidecars) {
+				siteConfig.LinuxFxVersion = pointer.To(helpers.LinuxFxVersionSiteContainers)
+			}
+
 			siteConfig.AppSettings = helpers.ExpandAppSettingsForCreate(webAppSlot.AppSettings)
 
 			expandedIdentity, err := expandIdentity(metadata.ResourceData.Get("identity").([]interface{}))
@@ -409,6 +438,12 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if len(webAppSlot.Sidecars) > 0 {
+				if err := helpers.UpdateSidecars(ctx, metadata.Client.AppService.SiteContainersClient, id, webAppSlot.Sidecars); err != nil {
+					return err
+				}
+			}
+
 			if webAppSlot.ZipDeployFile != "" {
 				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile); err != nil {
 					return err
@@ -489,6 +524,15 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading Connection String information for Linux %s: %+v", id, err)
 			}
 
+			existingSidecars := metadata.ResourceData.Get("sidecar").([]interface{})
+			sidecars := azuresdkhacks.SiteContainerListOperationResponse{}
+			if helpers.SidecarsShouldBeRead(webAppSiteConfig.SiteConfig, existingSidecars) {
+				sidecars, err = metadata.Client.AppService.SiteContainersClient.List(ctx, id)
+				if err != nil {
+					return fmt.Errorf("reading Sidecars for Linux %s: %+v", id, err)
+				}
+			}
+
 			siteCredentialsFuture, err := client.ListPublishingCredentialsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
 			if err != nil {
 				return fmt.Errorf("listing Site Publishing Credential information for Linux %s: %+v", id, err)
@@ -572,6 +616,8 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 
 			state.ConnectionStrings = helpers.FlattenConnectionStrings(connectionStrings)
 
+			state.Sidecars = helpers.FlattenSidecars(sidecars.Items, existingSidecars)
+
 			state.SiteCredentials = helpers.FlattenSiteCredentials(siteCredentials)
 
 			// Zip Deploys are not retrievable, so attempt to get from config. This doesn't matter for imports as an unexpected value here could break the deployment.
@@ -703,6 +749,25 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				existing.SiteConfig = siteConfig
 			}
 
+			if metadata.ResourceData.HasChange("sidecar") {
+				if existing.SiteConfig == nil {
+					existing.SiteConfig = &web.SiteConfig{}
+				}
+				if helpers.SidecarsUseSiteContainers(state.Sidecars) {
+					existing.SiteConfig.LinuxFxVersion = pointer.To(helpers.LinuxFxVersionSiteContainers)
+				} else if strings.EqualFold(pointer.From(existing.SiteConfig.LinuxFxVersion), helpers.LinuxFxVersionSiteContainers) {
+					// the main container was removed, so fall back to the `application_stack`
+					existing.SiteConfig.LinuxFxVersion = pointer.To("")
+					if stack := state.SiteConfig[0].ApplicationStack; len(stack) > 0 {
+						linuxFxVersion, err := helpers.EncodeLinuxFxVersion(stack[0])
+						if err != nil {
+							return err
+						}
+						existing.SiteConfig.LinuxFxVersion = linuxFxVersion
+					}
+				}
+			}
+
 			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
 				subnetId := metadata.ResourceData.Get("virtual_network_subnet_id").(string)
 				if subnetId == "" {
@@ -808,6 +873,12 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if metadata.ResourceData.HasChange("sidecar") {
+				if err := helpers.UpdateSidecars(ctx, metadata.Client.AppService.SiteContainersClient, id, state.Sidecars); err != nil {
+					return err
+				}
+			}
+
 			if metadata.ResourceData.HasChange("zip_deploy_file") {
 				if err = helpers.GetCredentialsAndPublishSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, id.SlotName); err != nil {
 					return err
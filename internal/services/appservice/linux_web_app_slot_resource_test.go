@@ -1000,6 +1000,22 @@ func TestAccLinuxWebAppSlot_withDocker(t *testing.T) {
 	})
 }
 
+func TestAccLinuxWebAppSlot_sidecar(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_web_app_slot", "test")
+	r := LinuxWebAppSlotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sidecar(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("site_config.0.linux_fx_version").HasValue("SITECONTAINERS"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 // Deployments
 
 func TestAccLinuxWebAppSlot_zipDeploy(t *testing.T) {
@@ -1121,6 +1137,35 @@ resource "azurerm_linux_web_app_slot" "test" {
 `, r.baseTemplate(data), data.RandomInteger)
 }
 
+func (r LinuxWebAppSlotResource) sidecar(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "acctestWAS-%d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {}
+
+  sidecar {
+    name        = "main"
+    image       = "mcr.microsoft.com/appsvc/staticsite:latest"
+    is_main     = true
+    target_port = "80"
+  }
+
+  sidecar {
+    name  = "redis"
+    image = "mcr.microsoft.com/oss/bitnami/redis:latest"
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger)
+}
+
 func (r LinuxWebAppSlotResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 
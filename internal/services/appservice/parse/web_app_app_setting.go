@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WebAppAppSettingId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	AppSettingName string
+}
+
+func NewWebAppAppSettingID(subscriptionId, resourceGroup, siteName, appSettingName string) WebAppAppSettingId {
+	return WebAppAppSettingId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		AppSettingName: appSettingName,
+	}
+}
+
+func (id WebAppAppSettingId) String() string {
+	segments := []string{
+		fmt.Sprintf("App Setting Name %q", id.AppSettingName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Web App App Setting", segmentsStr)
+}
+
+func (id WebAppAppSettingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/appSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.AppSettingName)
+}
+
+// WebAppAppSettingID parses a WebAppAppSetting ID into an WebAppAppSettingId struct
+func WebAppAppSettingID(input string) (*WebAppAppSettingId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an WebAppAppSetting ID: %+v", input, err)
+	}
+
+	resourceId := WebAppAppSettingId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.AppSettingName, err = id.PopSegment("appSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
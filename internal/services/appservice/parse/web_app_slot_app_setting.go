@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WebAppSlotAppSettingId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	SlotName       string
+	AppSettingName string
+}
+
+func NewWebAppSlotAppSettingID(subscriptionId, resourceGroup, siteName, slotName, appSettingName string) WebAppSlotAppSettingId {
+	return WebAppSlotAppSettingId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		SlotName:       slotName,
+		AppSettingName: appSettingName,
+	}
+}
+
+func (id WebAppSlotAppSettingId) String() string {
+	segments := []string{
+		fmt.Sprintf("App Setting Name %q", id.AppSettingName),
+		fmt.Sprintf("Slot Name %q", id.SlotName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Web App Slot App Setting", segmentsStr)
+}
+
+func (id WebAppSlotAppSettingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s/appSettings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.AppSettingName)
+}
+
+// WebAppSlotAppSettingID parses a WebAppSlotAppSetting ID into an WebAppSlotAppSettingId struct
+func WebAppSlotAppSettingID(input string) (*WebAppSlotAppSettingId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an WebAppSlotAppSetting ID: %+v", input, err)
+	}
+
+	resourceId := WebAppSlotAppSettingId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+		return nil, err
+	}
+	if resourceId.AppSettingName, err = id.PopSegment("appSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
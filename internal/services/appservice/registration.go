@@ -35,6 +35,7 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AppServiceSourceControlTokenResource{},
 		FunctionAppActiveSlotResource{},
+		FunctionAppFlexConsumptionResource{},
 		FunctionAppFunctionResource{},
 		FunctionAppHybridConnectionResource{},
 		LinuxFunctionAppResource{},
@@ -45,7 +46,9 @@ func (r Registration) Resources() []sdk.Resource {
 		SourceControlResource{},
 		SourceControlSlotResource{},
 		WebAppActiveSlotResource{},
+		WebAppAppSettingResource{},
 		WebAppHybridConnectionResource{},
+		WebAppSlotAppSettingResource{},
 		WindowsFunctionAppResource{},
 		WindowsFunctionAppSlotResource{},
 		WindowsWebAppResource{},
@@ -183,6 +183,13 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if helpers.PlanIsFlexConsumption(&servicePlan.Sku) {
+				if servicePlan.OSType != OSTypeLinux {
+					return fmt.Errorf("Flex Consumption Service Plans can only be used with an `os_type` of `Linux`")
+				}
+				appServicePlan.Sku.Tier = utils.String("FlexConsumption")
+			}
+
 			if servicePlan.MaximumElasticWorkerCount > 0 {
 				if !isServicePlanSupportScaleOut(servicePlan.Sku) {
 					return fmt.Errorf("`maximum_elastic_worker_count` can only be specified with Elastic Premium Skus")
@@ -0,0 +1,239 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppAppSettingResource struct{}
+
+type WebAppAppSettingModel struct {
+	WebAppId string `tfschema:"web_app_id"`
+	Name     string `tfschema:"name"`
+	Value    string `tfschema:"value"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppAppSettingResource{}
+
+func (r WebAppAppSettingResource) ModelObject() interface{} {
+	return &WebAppAppSettingModel{}
+}
+
+func (r WebAppAppSettingResource) ResourceType() string {
+	return "azurerm_web_app_app_setting"
+}
+
+func (r WebAppAppSettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppAppSettingID
+}
+
+func (r WebAppAppSettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppID,
+			Description:  "The ID of the Web App or Function App to manage the App Setting on.",
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: appSettingNameValidation,
+			Description:  "The name of the App Setting.",
+		},
+
+		"value": {
+			Type:        pluginsdk.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The value of the App Setting.",
+		},
+	}
+}
+
+func (r WebAppAppSettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppAppSettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var setting WebAppAppSettingModel
+			if err := metadata.Decode(&setting); err != nil {
+				return err
+			}
+
+			appId, err := parse.WebAppID(setting.WebAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWebAppAppSettingID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, setting.Name)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("listing App Settings for %s: %+v", appId, err)
+			}
+			if _, ok := existing.Properties[id.AppSettingName]; ok {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, withAppSetting(existing, id.AppSettingName, &setting.Value)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppAppSettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppAppSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing App Settings for %s: %+v", id, err)
+			}
+
+			value, ok := existing.Properties[id.AppSettingName]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			setting := WebAppAppSettingModel{
+				WebAppId: parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+				Name:     id.AppSettingName,
+				Value:    utils.NormalizeNilableString(value),
+			}
+
+			return metadata.Encode(&setting)
+		},
+	}
+}
+
+func (r WebAppAppSettingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppAppSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var setting WebAppAppSettingModel
+			if err := metadata.Decode(&setting); err != nil {
+				return err
+			}
+
+			appId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("listing App Settings for %s: %+v", appId, err)
+			}
+
+			if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, withAppSetting(existing, id.AppSettingName, &setting.Value)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppAppSettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppAppSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("listing App Settings for %s: %+v", appId, err)
+			}
+			if _, ok := existing.Properties[id.AppSettingName]; !ok {
+				return nil
+			}
+
+			if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, withAppSetting(existing, id.AppSettingName, nil)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// appSettingNameValidation rejects characters which can't be used as part of the Resource ID
+var appSettingNameValidation = validation.All(
+	validation.StringIsNotEmpty,
+	validation.StringDoesNotContainAny("/"),
+)
+
+// withAppSetting returns a copy of the existing App Settings with the named setting set to value,
+// or removed when value is nil. The remaining settings are left untouched.
+func withAppSetting(existing web.StringDictionary, name string, value *string) web.StringDictionary {
+	props := make(map[string]*string)
+	for k, v := range existing.Properties {
+		props[k] = v
+	}
+
+	if value == nil {
+		delete(props, name)
+	} else {
+		props[name] = utils.String(*value)
+	}
+
+	return web.StringDictionary{
+		Properties: props,
+	}
+}
@@ -0,0 +1,183 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppAppSettingResource struct{}
+
+func TestAccWebAppAppSetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_app_setting", "test")
+	r := WebAppAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppAppSetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_app_setting", "test")
+	r := WebAppAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "baz"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").HasValue("baz"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppAppSetting_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_app_setting", "test")
+	r := WebAppAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_web_app_app_setting.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppAppSetting_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_app_setting", "test")
+	r := WebAppAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WebAppAppSettingResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppAppSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing App Settings for %s: %+v", id, err)
+	}
+
+	_, ok := resp.Properties[id.AppSettingName]
+	return utils.Bool(ok), nil
+}
+
+func (r WebAppAppSettingResource) basic(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_app_setting" "test" {
+  web_app_id = azurerm_linux_web_app.test.id
+  name       = "foo"
+  value      = "%s"
+}
+`, r.template(data), value)
+}
+
+func (r WebAppAppSettingResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_app_setting" "test" {
+  web_app_id = azurerm_linux_web_app.test.id
+  name       = "foo"
+  value      = "bar"
+}
+
+resource "azurerm_web_app_app_setting" "second" {
+  web_app_id = azurerm_linux_web_app.test.id
+  name       = "hello"
+  value      = "world"
+}
+`, r.template(data))
+}
+
+func (r WebAppAppSettingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_app_setting" "import" {
+  web_app_id = azurerm_web_app_app_setting.test.web_app_id
+  name       = azurerm_web_app_app_setting.test.name
+  value      = azurerm_web_app_app_setting.test.value
+}
+`, r.basic(data, "bar"))
+}
+
+func (WebAppAppSettingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [app_settings]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
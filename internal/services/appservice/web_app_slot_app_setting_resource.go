@@ -0,0 +1,211 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppSlotAppSettingResource struct{}
+
+type WebAppSlotAppSettingModel struct {
+	WebAppSlotId string `tfschema:"web_app_slot_id"`
+	Name         string `tfschema:"name"`
+	Value        string `tfschema:"value"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppSlotAppSettingResource{}
+
+func (r WebAppSlotAppSettingResource) ModelObject() interface{} {
+	return &WebAppSlotAppSettingModel{}
+}
+
+func (r WebAppSlotAppSettingResource) ResourceType() string {
+	return "azurerm_web_app_slot_app_setting"
+}
+
+func (r WebAppSlotAppSettingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppSlotAppSettingID
+}
+
+func (r WebAppSlotAppSettingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"web_app_slot_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppSlotID,
+			Description:  "The ID of the Web App Slot or Function App Slot to manage the App Setting on.",
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: appSettingNameValidation,
+			Description:  "The name of the App Setting.",
+		},
+
+		"value": {
+			Type:        pluginsdk.TypeString,
+			Required:    true,
+			Description: "The value of the App Setting.",
+		},
+	}
+}
+
+func (r WebAppSlotAppSettingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppSlotAppSettingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var setting WebAppSlotAppSettingModel
+			if err := metadata.Decode(&setting); err != nil {
+				return err
+			}
+
+			appId, err := parse.WebAppSlotID(setting.WebAppSlotId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWebAppSlotAppSettingID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, appId.SlotName, setting.Name)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("listing App Settings for %s: %+v", appId, err)
+			}
+			if _, ok := existing.Properties[id.AppSettingName]; ok {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			if _, err := client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, withAppSetting(existing, id.AppSettingName, &setting.Value), id.SlotName); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotAppSettingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppSlotAppSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing App Settings for %s: %+v", id, err)
+			}
+
+			value, ok := existing.Properties[id.AppSettingName]
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			setting := WebAppSlotAppSettingModel{
+				WebAppSlotId: parse.NewWebAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName).ID(),
+				Name:         id.AppSettingName,
+				Value:        utils.NormalizeNilableString(value),
+			}
+
+			return metadata.Encode(&setting)
+		},
+	}
+}
+
+func (r WebAppSlotAppSettingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppSlotAppSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var setting WebAppSlotAppSettingModel
+			if err := metadata.Decode(&setting); err != nil {
+				return err
+			}
+
+			appId := parse.NewWebAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("listing App Settings for %s: %+v", appId, err)
+			}
+
+			if _, err := client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, withAppSetting(existing, id.AppSettingName, &setting.Value), id.SlotName); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotAppSettingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppSlotAppSettingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := parse.NewWebAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			existing, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("listing App Settings for %s: %+v", appId, err)
+			}
+			if _, ok := existing.Properties[id.AppSettingName]; !ok {
+				return nil
+			}
+
+			if _, err := client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, withAppSetting(existing, id.AppSettingName, nil), id.SlotName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
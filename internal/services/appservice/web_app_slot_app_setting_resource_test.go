@@ -0,0 +1,152 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppSlotAppSettingResource struct{}
+
+func TestAccWebAppSlotAppSetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_app_setting", "test")
+	r := WebAppSlotAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppSlotAppSetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_app_setting", "test")
+	r := WebAppSlotAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "baz"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("value").HasValue("baz"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppSlotAppSetting_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_app_setting", "test")
+	r := WebAppSlotAppSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "bar"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WebAppSlotAppSettingResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppSlotAppSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing App Settings for %s: %+v", id, err)
+	}
+
+	_, ok := resp.Properties[id.AppSettingName]
+	return utils.Bool(ok), nil
+}
+
+func (r WebAppSlotAppSettingResource) basic(data acceptance.TestData, value string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_slot_app_setting" "test" {
+  web_app_slot_id = azurerm_linux_web_app_slot.test.id
+  name            = "foo"
+  value           = "%s"
+}
+`, r.template(data), value)
+}
+
+func (r WebAppSlotAppSettingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_slot_app_setting" "import" {
+  web_app_slot_id = azurerm_web_app_slot_app_setting.test.web_app_slot_id
+  name            = azurerm_web_app_slot_app_setting.test.name
+  value           = azurerm_web_app_slot_app_setting.test.value
+}
+`, r.basic(data, "bar"))
+}
+
+func (WebAppSlotAppSettingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "acctestWAS-%[1]d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [app_settings]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -56,6 +56,7 @@ type WindowsWebAppModel struct {
 	PossibleOutboundIPAddressList []string                    `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential    `tfschema:"site_credential"`
 	ZipDeployFile                 string                      `tfschema:"zip_deploy_file"`
+	WaitForHealthy                []helpers.WaitForHealthy    `tfschema:"wait_for_healthy"`
 	Tags                          map[string]string           `tfschema:"tags"`
 	VirtualNetworkSubnetID        string                      `tfschema:"virtual_network_subnet_id"`
 }
@@ -157,6 +158,8 @@ func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"storage_account": helpers.StorageAccountSchemaWindows(),
 
+		"wait_for_healthy": helpers.WaitForHealthySchema(),
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
@@ -247,6 +250,10 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if len(webApp.WaitForHealthy) > 0 && webApp.SiteConfig[0].HealthCheckPath == "" {
+				return fmt.Errorf("`site_config.0.health_check_path` must be set when `wait_for_healthy` is specified")
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			servicePlanClient := metadata.Client.AppService.ServicePlanClient
 			aseClient := metadata.Client.AppService.AppServiceEnvironmentClient
@@ -451,6 +458,12 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if webApp.Enabled {
+				if err := helpers.WaitForAppHealthy(ctx, client, id.ResourceGroup, id.SiteName, webApp.SiteConfig[0].HealthCheckPath, webApp.WaitForHealthy); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 
@@ -623,6 +636,9 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				state.ZipDeployFile = deployFile
 			}
 
+			// `wait_for_healthy` is only used during apply, so it's persisted from config
+			state.WaitForHealthy = helpers.ExpandWaitForHealthy(metadata.ResourceData.Get("wait_for_healthy").([]interface{}))
+
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
 			}
@@ -901,6 +917,12 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
+			if state.Enabled {
+				if err := helpers.WaitForAppHealthy(ctx, client, id.ResourceGroup, id.SiteName, state.SiteConfig[0].HealthCheckPath, state.WaitForHealthy); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -0,0 +1,177 @@
+package attestation
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/attestation/2020-10-01/attestationproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/attestation/2022-08-01/attestation"
+)
+
+// unsecuredEmptyPolicyJWS is an unsigned JWS with an empty body, which is used to reset a policy to its default value
+const unsecuredEmptyPolicyJWS = "eyJhbGciOiJub25lIn0.."
+
+func resourceAttestationPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAttestationPolicyCreateUpdate,
+		Read:   resourceAttestationPolicyRead,
+		Update: resourceAttestationPolicyCreateUpdate,
+		Delete: resourceAttestationPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.AttestationPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"attestation_provider_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: attestationproviders.ValidateAttestationProvidersID,
+			},
+
+			"attestation_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(attestation.TypeOpenEnclave),
+					string(attestation.TypeSevSnpVM),
+					string(attestation.TypeSgxEnclave),
+					string(attestation.TypeTpm),
+				}, false),
+			},
+
+			"policy_base64": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ContainsABase64UriEncodedJWTOfAStoredAttestationPolicy,
+			},
+		},
+	}
+}
+
+func resourceAttestationPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	attestationClients := meta.(*clients.Client).Attestation
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	providerId, err := attestationproviders.ParseAttestationProvidersID(d.Get("attestation_provider_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewAttestationPolicyID(providerId.SubscriptionId, providerId.ResourceGroupName, providerId.AttestationProviderName, d.Get("attestation_type").(string))
+
+	// NOTE: every Attestation Type always has a policy (the default policy is used when none has been set), as such
+	// there's no existing resource to check for here - setting the policy replaces whatever is currently in place
+
+	dataPlaneUri, err := attestationClients.DataPlaneEndpointForProvider(ctx, *providerId)
+	if err != nil {
+		return fmt.Errorf("determining Data Plane URI for %s: %+v", *providerId, err)
+	}
+	dataPlaneClient, err := attestationClients.DataPlaneClientWithEndpoint(*dataPlaneUri)
+	if err != nil {
+		return fmt.Errorf("building Data Plane Client for %s: %+v", *providerId, err)
+	}
+
+	if _, err := dataPlaneClient.Set(ctx, *dataPlaneUri, attestation.Type(id.PolicyName), d.Get("policy_base64").(string)); err != nil {
+		return fmt.Errorf("setting %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceAttestationPolicyRead(d, meta)
+}
+
+func resourceAttestationPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	attestationClients := meta.(*clients.Client).Attestation
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AttestationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	providerId := attestationproviders.NewAttestationProvidersID(id.SubscriptionId, id.ResourceGroup, id.AttestationProviderName)
+	provider, err := attestationClients.ProviderClient.Get(ctx, providerId)
+	if err != nil {
+		if response.WasNotFound(provider.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing %s from state", providerId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", providerId, err)
+	}
+
+	dataPlaneUri, err := attestationClients.DataPlaneEndpointForProvider(ctx, providerId)
+	if err != nil {
+		return fmt.Errorf("determining Data Plane URI for %s: %+v", providerId, err)
+	}
+	dataPlaneClient, err := attestationClients.DataPlaneClientWithEndpoint(*dataPlaneUri)
+	if err != nil {
+		return fmt.Errorf("building Data Plane Client for %s: %+v", providerId, err)
+	}
+
+	resp, err := dataPlaneClient.Get(ctx, *dataPlaneUri, attestation.Type(id.PolicyName))
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	policyData, err := base64DataFromAttestationJWT(resp.Token)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %+v", *id, err)
+	}
+
+	d.Set("attestation_provider_id", providerId.ID())
+	d.Set("attestation_type", id.PolicyName)
+	d.Set("policy_base64", utils.NormalizeNilableString(policyData))
+
+	return nil
+}
+
+func resourceAttestationPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	attestationClients := meta.(*clients.Client).Attestation
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.AttestationPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	providerId := attestationproviders.NewAttestationProvidersID(id.SubscriptionId, id.ResourceGroup, id.AttestationProviderName)
+	dataPlaneUri, err := attestationClients.DataPlaneEndpointForProvider(ctx, providerId)
+	if err != nil {
+		return fmt.Errorf("determining Data Plane URI for %s: %+v", providerId, err)
+	}
+	dataPlaneClient, err := attestationClients.DataPlaneClientWithEndpoint(*dataPlaneUri)
+	if err != nil {
+		return fmt.Errorf("building Data Plane Client for %s: %+v", providerId, err)
+	}
+
+	// a policy can't be removed, instead it's reset back to the default policy for this Attestation Type
+	if _, err := dataPlaneClient.Reset(ctx, *dataPlaneUri, attestation.Type(id.PolicyName), unsecuredEmptyPolicyJWS); err != nil {
+		return fmt.Errorf("resetting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package attestation_test
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/attestation/2020-10-01/attestationproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/attestation/2022-08-01/attestation"
+)
+
+type AttestationPolicyResource struct {
+	name string
+}
+
+func TestAccAttestationPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_attestation_policy", "test")
+	r := AttestationPolicyResource{
+		name: fmt.Sprintf("acctestap%s", data.RandomStringOfLength(10)),
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 100),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAttestationPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_attestation_policy", "test")
+	r := AttestationPolicyResource{
+		name: fmt.Sprintf("acctestap%s", data.RandomStringOfLength(10)),
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 100),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 50),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AttestationPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.AttestationPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	providerId := attestationproviders.NewAttestationProvidersID(id.SubscriptionId, id.ResourceGroup, id.AttestationProviderName)
+	provider, err := clients.Attestation.ProviderClient.Get(ctx, providerId)
+	if err != nil {
+		if response.WasNotFound(provider.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", providerId, err)
+	}
+
+	dataPlaneUri, err := clients.Attestation.DataPlaneEndpointForProvider(ctx, providerId)
+	if err != nil {
+		return nil, err
+	}
+	dataPlaneClient, err := clients.Attestation.DataPlaneClientWithEndpoint(*dataPlaneUri)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dataPlaneClient.Get(ctx, *dataPlaneUri, attestation.Type(id.PolicyName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Token != nil), nil
+}
+
+func (r AttestationPolicyResource) basic(data acceptance.TestData, securityLevel int) string {
+	// currently only supported in "East US 2", "West Central US" & "UK South"
+	data.Locations.Primary = "uksouth"
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-attestation-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_attestation_provider" "test" {
+  name                = %[3]q
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lifecycle {
+    ignore_changes = [
+      "open_enclave_policy_base64",
+      "sgx_enclave_policy_base64",
+      "tpm_policy_base64",
+    ]
+  }
+}
+
+resource "azurerm_attestation_policy" "test" {
+  attestation_provider_id = azurerm_attestation_provider.test.id
+  attestation_type        = "SgxEnclave"
+  policy_base64           = %[4]q
+}
+`, data.RandomInteger, data.Locations.Primary, r.name, r.genJWT(securityLevel))
+}
+
+func (r AttestationPolicyResource) genJWT(securityLevel int) string {
+	// document about create policy: https://learn.microsoft.com/en-us/azure/attestation/author-sign-policy
+	policyContent := fmt.Sprintf(`version=1.0;
+authorizationrules
+{
+[type=="secureBootEnabled", value==true, issuer=="AttestationService"]=>permit();
+};
+
+issuancerules
+{
+=> issue(type="SecurityLevelValue", value=%d);
+};`, securityLevel)
+	b64Encoded := base64.RawURLEncoding.EncodeToString([]byte(policyContent))
+	token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"AttestationPolicy": b64Encoded,
+	})
+	token.Header["jku"] = fmt.Sprintf("https://%s.uks.attest.azure.net/certs", r.name)
+	token.Header["kid"] = "xxx"
+
+	str, _ := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	return str
+}
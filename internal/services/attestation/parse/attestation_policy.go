@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type AttestationPolicyId struct {
+	SubscriptionId          string
+	ResourceGroup           string
+	AttestationProviderName string
+	PolicyName              string
+}
+
+func NewAttestationPolicyID(subscriptionId, resourceGroup, attestationProviderName, policyName string) AttestationPolicyId {
+	return AttestationPolicyId{
+		SubscriptionId:          subscriptionId,
+		ResourceGroup:           resourceGroup,
+		AttestationProviderName: attestationProviderName,
+		PolicyName:              policyName,
+	}
+}
+
+func (id AttestationPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Policy Name %q", id.PolicyName),
+		fmt.Sprintf("Attestation Provider Name %q", id.AttestationProviderName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Attestation Policy", segmentsStr)
+}
+
+func (id AttestationPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Attestation/attestationProviders/%s/policies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AttestationProviderName, id.PolicyName)
+}
+
+// AttestationPolicyID parses a AttestationPolicy ID into an AttestationPolicyId struct
+func AttestationPolicyID(input string) (*AttestationPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an AttestationPolicy ID: %+v", input, err)
+	}
+
+	resourceId := AttestationPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.AttestationProviderName, err = id.PopSegment("attestationProviders"); err != nil {
+		return nil, err
+	}
+	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
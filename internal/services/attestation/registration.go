@@ -35,6 +35,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_attestation_policy":   resourceAttestationPolicy(),
 		"azurerm_attestation_provider": resourceAttestationProvider(),
 	}
 }
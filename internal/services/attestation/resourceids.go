@@ -0,0 +1,3 @@
+package attestation
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AttestationPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Attestation/attestationProviders/provider1/policies/SgxEnclave
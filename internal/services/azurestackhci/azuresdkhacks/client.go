@@ -0,0 +1,63 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: Virtual Machine Instances, Network Interfaces and Gallery Images for Azure Stack HCI (Azure Local) aren't
+// available in the API versions included in the vendored SDK, as such these clients are implemented here against
+// the 2024-01-01 API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2024-01-01"
+
+const ExtendedLocationTypeCustomLocation = "CustomLocation"
+
+type ExtendedLocation struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+type ResourceReference struct {
+	Id *string `json:"id,omitempty"`
+}
+
+type GalleryImagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGalleryImagesClientWithBaseURI(endpoint string) GalleryImagesClient {
+	return GalleryImagesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/galleryimages/%s", defaultApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type NetworkInterfacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkInterfacesClientWithBaseURI(endpoint string) NetworkInterfacesClient {
+	return NetworkInterfacesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/networkinterfaces/%s", defaultApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type VirtualMachineInstancesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVirtualMachineInstancesClientWithBaseURI(endpoint string) VirtualMachineInstancesClient {
+	return VirtualMachineInstancesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/virtualmachineinstances/%s", defaultApiVersion)),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,187 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type GalleryImage struct {
+	ExtendedLocation *ExtendedLocation       `json:"extendedLocation,omitempty"`
+	Id               *string                 `json:"id,omitempty"`
+	Location         string                  `json:"location"`
+	Name             *string                 `json:"name,omitempty"`
+	Properties       *GalleryImageProperties `json:"properties,omitempty"`
+	Tags             *map[string]string      `json:"tags,omitempty"`
+	Type             *string                 `json:"type,omitempty"`
+}
+
+type GalleryImageProperties struct {
+	CloudInitDataSource *string                 `json:"cloudInitDataSource,omitempty"`
+	ContainerId         *string                 `json:"containerId,omitempty"`
+	HyperVGeneration    *string                 `json:"hyperVGeneration,omitempty"`
+	Identifier          *GalleryImageIdentifier `json:"identifier,omitempty"`
+	ImagePath           *string                 `json:"imagePath,omitempty"`
+	OsType              string                  `json:"osType"`
+	ProvisioningState   *string                 `json:"provisioningState,omitempty"`
+	Status              *GalleryImageStatus     `json:"status,omitempty"`
+}
+
+type GalleryImageIdentifier struct {
+	Offer     string `json:"offer"`
+	Publisher string `json:"publisher"`
+	Sku       string `json:"sku"`
+}
+
+type GalleryImageStatus struct {
+	DownloadStatus     *GalleryImageStatusDownloadStatus `json:"downloadStatus,omitempty"`
+	ErrorCode          *string                           `json:"errorCode,omitempty"`
+	ErrorMessage       *string                           `json:"errorMessage,omitempty"`
+	ProgressPercentage *int64                            `json:"progressPercentage,omitempty"`
+}
+
+type GalleryImageStatusDownloadStatus struct {
+	DownloadSizeInMB *int64 `json:"downloadSizeInMB,omitempty"`
+}
+
+type GalleryImageCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type GalleryImageGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *GalleryImage
+}
+
+type GalleryImageDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate ...
+func (c GalleryImagesClient) CreateOrUpdate(ctx context.Context, id GalleryImageId, input GalleryImage) (result GalleryImageCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c GalleryImagesClient) CreateOrUpdateThenPoll(ctx context.Context, id GalleryImageId, input GalleryImage) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c GalleryImagesClient) Get(ctx context.Context, id GalleryImageId) (result GalleryImageGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c GalleryImagesClient) Delete(ctx context.Context, id GalleryImageId) (result GalleryImageDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "galleryimages.GalleryImagesClient", "Delete", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c GalleryImagesClient) DeleteThenPoll(ctx context.Context, id GalleryImageId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c GalleryImagesClient) prepare(ctx context.Context, id GalleryImageId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
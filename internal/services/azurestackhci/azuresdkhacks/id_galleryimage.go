@@ -0,0 +1,116 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = GalleryImageId{}
+
+// GalleryImageId is a struct representing the Resource ID for a Gallery Image
+type GalleryImageId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	GalleryImageName  string
+}
+
+// NewGalleryImageID returns a new GalleryImageId struct
+func NewGalleryImageID(subscriptionId string, resourceGroupName string, galleryImageName string) GalleryImageId {
+	return GalleryImageId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		GalleryImageName:  galleryImageName,
+	}
+}
+
+// ParseGalleryImageID parses 'input' into a GalleryImageId
+func ParseGalleryImageID(input string) (*GalleryImageId, error) {
+	parser := resourceids.NewParserFromResourceIdType(GalleryImageId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return galleryImageIdFromParsed(parsed)
+}
+
+// ParseGalleryImageIDInsensitively parses 'input' case-insensitively into a GalleryImageId
+// note: this method should only be used for API response data and not user input
+func ParseGalleryImageIDInsensitively(input string) (*GalleryImageId, error) {
+	parser := resourceids.NewParserFromResourceIdType(GalleryImageId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return galleryImageIdFromParsed(parsed)
+}
+
+func galleryImageIdFromParsed(parsed *resourceids.ParseResult) (*GalleryImageId, error) {
+	var ok bool
+	id := GalleryImageId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", *parsed)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", *parsed)
+	}
+
+	if id.GalleryImageName, ok = parsed.Parsed["galleryImageName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "galleryImageName", *parsed)
+	}
+
+	return &id, nil
+}
+
+// ValidateGalleryImageID checks that 'input' can be parsed as a Gallery Image ID
+func ValidateGalleryImageID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGalleryImageID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Gallery Image ID
+func (id GalleryImageId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureStackHCI/galleryImages/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.GalleryImageName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Gallery Image ID
+func (id GalleryImageId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAzureStackHCI", "Microsoft.AzureStackHCI", "Microsoft.AzureStackHCI"),
+		resourceids.StaticSegment("staticGalleryImages", "galleryImages", "galleryImages"),
+		resourceids.UserSpecifiedSegment("galleryImageName", "galleryImageValue"),
+	}
+}
+
+// String returns a human-readable description of this Gallery Image ID
+func (id GalleryImageId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Gallery Image Name: %q", id.GalleryImageName),
+	}
+	return fmt.Sprintf("Gallery Image (%s)", strings.Join(components, "\n"))
+}
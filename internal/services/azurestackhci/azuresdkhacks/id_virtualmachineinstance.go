@@ -0,0 +1,121 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = VirtualMachineInstanceId{}
+
+// VirtualMachineInstanceId is a struct representing the Resource ID for a Virtual Machine Instance,
+// this is an extension resource of an Arc Machine which is always named `default`
+type VirtualMachineInstanceId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	MachineName       string
+}
+
+// NewVirtualMachineInstanceID returns a new VirtualMachineInstanceId struct
+func NewVirtualMachineInstanceID(subscriptionId string, resourceGroupName string, machineName string) VirtualMachineInstanceId {
+	return VirtualMachineInstanceId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		MachineName:       machineName,
+	}
+}
+
+// ParseVirtualMachineInstanceID parses 'input' into a VirtualMachineInstanceId
+func ParseVirtualMachineInstanceID(input string) (*VirtualMachineInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(VirtualMachineInstanceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return virtualMachineInstanceIdFromParsed(parsed)
+}
+
+// ParseVirtualMachineInstanceIDInsensitively parses 'input' case-insensitively into a VirtualMachineInstanceId
+// note: this method should only be used for API response data and not user input
+func ParseVirtualMachineInstanceIDInsensitively(input string) (*VirtualMachineInstanceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(VirtualMachineInstanceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return virtualMachineInstanceIdFromParsed(parsed)
+}
+
+func virtualMachineInstanceIdFromParsed(parsed *resourceids.ParseResult) (*VirtualMachineInstanceId, error) {
+	var ok bool
+	id := VirtualMachineInstanceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", *parsed)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", *parsed)
+	}
+
+	if id.MachineName, ok = parsed.Parsed["machineName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "machineName", *parsed)
+	}
+
+	return &id, nil
+}
+
+// ValidateVirtualMachineInstanceID checks that 'input' can be parsed as a Virtual Machine Instance ID
+func ValidateVirtualMachineInstanceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseVirtualMachineInstanceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Virtual Machine Instance ID
+func (id VirtualMachineInstanceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/providers/Microsoft.AzureStackHCI/virtualMachineInstances/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.MachineName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Virtual Machine Instance ID
+func (id VirtualMachineInstanceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHybridCompute", "Microsoft.HybridCompute", "Microsoft.HybridCompute"),
+		resourceids.StaticSegment("staticMachines", "machines", "machines"),
+		resourceids.UserSpecifiedSegment("machineName", "machineValue"),
+		resourceids.StaticSegment("staticProviders2", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAzureStackHCI", "Microsoft.AzureStackHCI", "Microsoft.AzureStackHCI"),
+		resourceids.StaticSegment("staticVirtualMachineInstances", "virtualMachineInstances", "virtualMachineInstances"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	}
+}
+
+// String returns a human-readable description of this Virtual Machine Instance ID
+func (id VirtualMachineInstanceId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Machine Name: %q", id.MachineName),
+	}
+	return fmt.Sprintf("Virtual Machine Instance (%s)", strings.Join(components, "\n"))
+}
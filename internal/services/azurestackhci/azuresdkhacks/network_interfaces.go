@@ -0,0 +1,186 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NetworkInterface struct {
+	ExtendedLocation *ExtendedLocation           `json:"extendedLocation,omitempty"`
+	Id               *string                     `json:"id,omitempty"`
+	Location         string                      `json:"location"`
+	Name             *string                     `json:"name,omitempty"`
+	Properties       *NetworkInterfaceProperties `json:"properties,omitempty"`
+	Tags             *map[string]string          `json:"tags,omitempty"`
+	Type             *string                     `json:"type,omitempty"`
+}
+
+type NetworkInterfaceProperties struct {
+	DnsSettings       *InterfaceDNSSettings `json:"dnsSettings,omitempty"`
+	IPConfigurations  *[]IPConfiguration    `json:"ipConfigurations,omitempty"`
+	MacAddress        *string               `json:"macAddress,omitempty"`
+	ProvisioningState *string               `json:"provisioningState,omitempty"`
+}
+
+type InterfaceDNSSettings struct {
+	DnsServers *[]string `json:"dnsServers,omitempty"`
+}
+
+type IPConfiguration struct {
+	Name       *string                    `json:"name,omitempty"`
+	Properties *IPConfigurationProperties `json:"properties,omitempty"`
+}
+
+type IPConfigurationProperties struct {
+	Gateway          *string                `json:"gateway,omitempty"`
+	PrefixLength     *string                `json:"prefixLength,omitempty"`
+	PrivateIPAddress *string                `json:"privateIPAddress,omitempty"`
+	Subnet           *IPConfigurationSubnet `json:"subnet,omitempty"`
+}
+
+type IPConfigurationSubnet struct {
+	Id *string `json:"id,omitempty"`
+}
+
+type NetworkInterfaceCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type NetworkInterfaceGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NetworkInterface
+}
+
+type NetworkInterfaceDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate ...
+func (c NetworkInterfacesClient) CreateOrUpdate(ctx context.Context, id NetworkInterfaceId, input NetworkInterface) (result NetworkInterfaceCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c NetworkInterfacesClient) CreateOrUpdateThenPoll(ctx context.Context, id NetworkInterfaceId, input NetworkInterface) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c NetworkInterfacesClient) Get(ctx context.Context, id NetworkInterfaceId) (result NetworkInterfaceGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c NetworkInterfacesClient) Delete(ctx context.Context, id NetworkInterfaceId) (result NetworkInterfaceDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networkinterfaces.NetworkInterfacesClient", "Delete", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c NetworkInterfacesClient) DeleteThenPoll(ctx context.Context, id NetworkInterfaceId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c NetworkInterfacesClient) prepare(ctx context.Context, id NetworkInterfaceId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,245 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type VirtualMachineInstance struct {
+	ExtendedLocation *ExtendedLocation                 `json:"extendedLocation,omitempty"`
+	Id               *string                           `json:"id,omitempty"`
+	Name             *string                           `json:"name,omitempty"`
+	Properties       *VirtualMachineInstanceProperties `json:"properties,omitempty"`
+	Type             *string                           `json:"type,omitempty"`
+}
+
+type VirtualMachineInstanceProperties struct {
+	HardwareProfile   *VirtualMachineInstanceHardwareProfile `json:"hardwareProfile,omitempty"`
+	NetworkProfile    *VirtualMachineInstanceNetworkProfile  `json:"networkProfile,omitempty"`
+	OsProfile         *VirtualMachineInstanceOsProfile       `json:"osProfile,omitempty"`
+	ProvisioningState *string                                `json:"provisioningState,omitempty"`
+	SecurityProfile   *VirtualMachineInstanceSecurityProfile `json:"securityProfile,omitempty"`
+	Status            *VirtualMachineInstanceStatus          `json:"status,omitempty"`
+	StorageProfile    *VirtualMachineInstanceStorageProfile  `json:"storageProfile,omitempty"`
+	VMId              *string                                `json:"vmId,omitempty"`
+}
+
+type VirtualMachineInstanceHardwareProfile struct {
+	DynamicMemoryConfig *DynamicMemoryConfig `json:"dynamicMemoryConfig,omitempty"`
+	MemoryMB            *int64               `json:"memoryMB,omitempty"`
+	Processors          *int64               `json:"processors,omitempty"`
+	VMSize              *string              `json:"vmSize,omitempty"`
+}
+
+type DynamicMemoryConfig struct {
+	MaximumMemoryMB    *int64 `json:"maximumMemoryMB,omitempty"`
+	MinimumMemoryMB    *int64 `json:"minimumMemoryMB,omitempty"`
+	TargetMemoryBuffer *int64 `json:"targetMemoryBuffer,omitempty"`
+}
+
+type VirtualMachineInstanceNetworkProfile struct {
+	NetworkInterfaces *[]ResourceReference `json:"networkInterfaces,omitempty"`
+}
+
+type VirtualMachineInstanceOsProfile struct {
+	AdminPassword        *string               `json:"adminPassword,omitempty"`
+	AdminUsername        *string               `json:"adminUsername,omitempty"`
+	ComputerName         *string               `json:"computerName,omitempty"`
+	LinuxConfiguration   *LinuxConfiguration   `json:"linuxConfiguration,omitempty"`
+	WindowsConfiguration *WindowsConfiguration `json:"windowsConfiguration,omitempty"`
+}
+
+type LinuxConfiguration struct {
+	DisablePasswordAuthentication *bool             `json:"disablePasswordAuthentication,omitempty"`
+	ProvisionVMAgent              *bool             `json:"provisionVMAgent,omitempty"`
+	ProvisionVMConfigAgent        *bool             `json:"provisionVMConfigAgent,omitempty"`
+	Ssh                           *SshConfiguration `json:"ssh,omitempty"`
+}
+
+type WindowsConfiguration struct {
+	EnableAutomaticUpdates *bool             `json:"enableAutomaticUpdates,omitempty"`
+	ProvisionVMAgent       *bool             `json:"provisionVMAgent,omitempty"`
+	ProvisionVMConfigAgent *bool             `json:"provisionVMConfigAgent,omitempty"`
+	Ssh                    *SshConfiguration `json:"ssh,omitempty"`
+	TimeZone               *string           `json:"timeZone,omitempty"`
+}
+
+type SshConfiguration struct {
+	PublicKeys *[]SshPublicKey `json:"publicKeys,omitempty"`
+}
+
+type SshPublicKey struct {
+	KeyData *string `json:"keyData,omitempty"`
+	Path    *string `json:"path,omitempty"`
+}
+
+type VirtualMachineInstanceSecurityProfile struct {
+	EnableTPM    *bool         `json:"enableTPM,omitempty"`
+	SecurityType *string       `json:"securityType,omitempty"`
+	UefiSettings *UefiSettings `json:"uefiSettings,omitempty"`
+}
+
+type UefiSettings struct {
+	SecureBootEnabled *bool `json:"secureBootEnabled,omitempty"`
+}
+
+type VirtualMachineInstanceStatus struct {
+	ErrorCode    *string `json:"errorCode,omitempty"`
+	ErrorMessage *string `json:"errorMessage,omitempty"`
+	PowerState   *string `json:"powerState,omitempty"`
+}
+
+type VirtualMachineInstanceStorageProfile struct {
+	DataDisks             *[]ResourceReference  `json:"dataDisks,omitempty"`
+	ImageReference        *ResourceReference    `json:"imageReference,omitempty"`
+	OsDisk                *StorageProfileOsDisk `json:"osDisk,omitempty"`
+	VMConfigStoragePathId *string               `json:"vmConfigStoragePathId,omitempty"`
+}
+
+type StorageProfileOsDisk struct {
+	Id     *string `json:"id,omitempty"`
+	OsType *string `json:"osType,omitempty"`
+}
+
+type VirtualMachineInstanceCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type VirtualMachineInstanceGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *VirtualMachineInstance
+}
+
+type VirtualMachineInstanceDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate ...
+func (c VirtualMachineInstancesClient) CreateOrUpdate(ctx context.Context, id VirtualMachineInstanceId, input VirtualMachineInstance) (result VirtualMachineInstanceCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c VirtualMachineInstancesClient) CreateOrUpdateThenPoll(ctx context.Context, id VirtualMachineInstanceId, input VirtualMachineInstance) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c VirtualMachineInstancesClient) Get(ctx context.Context, id VirtualMachineInstanceId) (result VirtualMachineInstanceGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c VirtualMachineInstancesClient) Delete(ctx context.Context, id VirtualMachineInstanceId) (result VirtualMachineInstanceDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "virtualmachineinstances.VirtualMachineInstancesClient", "Delete", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c VirtualMachineInstancesClient) DeleteThenPoll(ctx context.Context, id VirtualMachineInstanceId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c VirtualMachineInstancesClient) prepare(ctx context.Context, id VirtualMachineInstanceId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -4,12 +4,34 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	azurestackhci_v2022_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2022-12-01"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
 )
 
-func NewClient(o *common.ClientOptions) *azurestackhci_v2022_12_01.Client {
-	client := azurestackhci_v2022_12_01.NewClientWithBaseURI(o.ResourceManagerEndpoint, func(c *autorest.Client) {
+type Client struct {
+	GalleryImagesClient           *azuresdkhacks.GalleryImagesClient
+	NetworkInterfacesClient       *azuresdkhacks.NetworkInterfacesClient
+	ResourceManager               *azurestackhci_v2022_12_01.Client
+	VirtualMachineInstancesClient *azuresdkhacks.VirtualMachineInstancesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	galleryImagesClient := azuresdkhacks.NewGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&galleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	networkInterfacesClient := azuresdkhacks.NewNetworkInterfacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkInterfacesClient.Client, o.ResourceManagerAuthorizer)
+
+	resourceManager := azurestackhci_v2022_12_01.NewClientWithBaseURI(o.ResourceManagerEndpoint, func(c *autorest.Client) {
 		c.Authorizer = o.ResourceManagerAuthorizer
 	})
 
-	return &client
+	virtualMachineInstancesClient := azuresdkhacks.NewVirtualMachineInstancesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachineInstancesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		GalleryImagesClient:           &galleryImagesClient,
+		NetworkInterfacesClient:       &networkInterfacesClient,
+		ResourceManager:               &resourceManager,
+		VirtualMachineInstancesClient: &virtualMachineInstancesClient,
+	}
 }
@@ -71,7 +71,7 @@ func resourceArmStackHCICluster() *pluginsdk.Resource {
 }
 
 func resourceArmStackHCIClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.Clusters
+	client := meta.(*clients.Client).AzureStackHCI.ResourceManager.Clusters
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -113,7 +113,7 @@ func resourceArmStackHCIClusterCreate(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceArmStackHCIClusterRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.Clusters
+	client := meta.(*clients.Client).AzureStackHCI.ResourceManager.Clusters
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -153,7 +153,7 @@ func resourceArmStackHCIClusterRead(d *pluginsdk.ResourceData, meta interface{})
 }
 
 func resourceArmStackHCIClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.Clusters
+	client := meta.(*clients.Client).AzureStackHCI.ResourceManager.Clusters
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,7 +176,7 @@ func resourceArmStackHCIClusterUpdate(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceArmStackHCIClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AzureStackHCI.Clusters
+	client := meta.(*clients.Client).AzureStackHCI.ResourceManager.Clusters
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -84,7 +84,7 @@ func TestAccStackHCICluster_update(t *testing.T) {
 }
 
 func (r StackHCIClusterResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	clusterClient := client.AzureStackHCI.Clusters
+	clusterClient := client.AzureStackHCI.ResourceManager.Clusters
 	id, err := clusters.ParseClusterID(state.ID)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,361 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StackHCIGalleryImageResource struct{}
+
+type StackHCIGalleryImageModel struct {
+	Name                string                                `tfschema:"name"`
+	ResourceGroupName   string                                `tfschema:"resource_group_name"`
+	Location            string                                `tfschema:"location"`
+	CustomLocationId    string                                `tfschema:"custom_location_id"`
+	ImagePath           string                                `tfschema:"image_path"`
+	OsType              string                                `tfschema:"os_type"`
+	HyperVGeneration    string                                `tfschema:"hyperv_generation"`
+	CloudInitDataSource string                                `tfschema:"cloud_init_data_source"`
+	StoragePathId       string                                `tfschema:"storage_path_id"`
+	Identifier          []StackHCIGalleryImageIdentifierModel `tfschema:"identifier"`
+	DownloadSizeInMB    int64                                 `tfschema:"download_size_in_mb"`
+	Tags                map[string]interface{}                `tfschema:"tags"`
+}
+
+type StackHCIGalleryImageIdentifierModel struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+}
+
+var _ sdk.ResourceWithUpdate = StackHCIGalleryImageResource{}
+
+func (r StackHCIGalleryImageResource) ResourceType() string {
+	return "azurerm_stack_hci_gallery_image"
+}
+
+func (r StackHCIGalleryImageResource) ModelObject() interface{} {
+	return &StackHCIGalleryImageModel{}
+}
+
+func (r StackHCIGalleryImageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateGalleryImageID
+}
+
+func (r StackHCIGalleryImageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][\-._a-zA-Z0-9]{0,78}[_a-zA-Z0-9]$`),
+				"`name` must be between 2 and 80 characters in length, begin with a letter or number, end with a letter, number or underscore and may only contain letters, numbers, underscores, periods and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"image_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Linux",
+				"Windows",
+			}, false),
+		},
+
+		"hyperv_generation": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"V1",
+				"V2",
+			}, false),
+		},
+
+		"cloud_init_data_source": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Azure",
+				"NoCloud",
+			}, false),
+		},
+
+		"storage_path_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"identifier": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCIGalleryImageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"download_size_in_mb": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// the image is downloaded onto the cluster as a part of the creation, which can take a while
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config StackHCIGalleryImageModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := azuresdkhacks.NewGalleryImageID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.GalleryImage{
+				ExtendedLocation: &azuresdkhacks.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(azuresdkhacks.ExtendedLocationTypeCustomLocation),
+				},
+				Location: location.Normalize(config.Location),
+				Properties: &azuresdkhacks.GalleryImageProperties{
+					ImagePath:  pointer.To(config.ImagePath),
+					OsType:     config.OsType,
+					Identifier: expandStackHCIGalleryImageIdentifier(config.Identifier),
+				},
+				Tags: tags.Expand(config.Tags),
+			}
+
+			if config.HyperVGeneration != "" {
+				payload.Properties.HyperVGeneration = pointer.To(config.HyperVGeneration)
+			}
+			if config.CloudInitDataSource != "" {
+				payload.Properties.CloudInitDataSource = pointer.To(config.CloudInitDataSource)
+			}
+			if config.StoragePathId != "" {
+				payload.Properties.ContainerId = pointer.To(config.StoragePathId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+
+			id, err := azuresdkhacks.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCIGalleryImageModel{
+				Name:              id.GalleryImageName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = pointer.From(model.ExtendedLocation.Name)
+				}
+
+				if props := model.Properties; props != nil {
+					state.ImagePath = pointer.From(props.ImagePath)
+					state.OsType = props.OsType
+					state.HyperVGeneration = pointer.From(props.HyperVGeneration)
+					state.CloudInitDataSource = pointer.From(props.CloudInitDataSource)
+					state.StoragePathId = pointer.From(props.ContainerId)
+					state.Identifier = flattenStackHCIGalleryImageIdentifier(props.Identifier)
+
+					if props.Status != nil && props.Status.DownloadStatus != nil {
+						state.DownloadSizeInMB = pointer.From(props.Status.DownloadStatus.DownloadSizeInMB)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+
+			id, err := azuresdkhacks.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StackHCIGalleryImageModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+
+			id, err := azuresdkhacks.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCIGalleryImageIdentifier(input []StackHCIGalleryImageIdentifierModel) *azuresdkhacks.GalleryImageIdentifier {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &azuresdkhacks.GalleryImageIdentifier{
+		Publisher: input[0].Publisher,
+		Offer:     input[0].Offer,
+		Sku:       input[0].Sku,
+	}
+}
+
+func flattenStackHCIGalleryImageIdentifier(input *azuresdkhacks.GalleryImageIdentifier) []StackHCIGalleryImageIdentifierModel {
+	if input == nil {
+		return []StackHCIGalleryImageIdentifierModel{}
+	}
+
+	return []StackHCIGalleryImageIdentifierModel{
+		{
+			Publisher: input.Publisher,
+			Offer:     input.Offer,
+			Sku:       input.Sku,
+		},
+	}
+}
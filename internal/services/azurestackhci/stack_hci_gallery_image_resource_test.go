@@ -0,0 +1,161 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCIGalleryImageResource struct{}
+
+func TestAccStackHCIGalleryImage_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCIGalleryImage_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCIGalleryImage_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+	r := StackHCIGalleryImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StackHCIGalleryImageResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseGalleryImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.GalleryImagesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCIGalleryImageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                = "acctest-hciimg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  image_path          = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.vhd.tar.gz"
+  os_type             = "Linux"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
+
+func (r StackHCIGalleryImageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_gallery_image" "import" {
+  name                = azurerm_stack_hci_gallery_image.test.name
+  resource_group_name = azurerm_stack_hci_gallery_image.test.resource_group_name
+  location            = azurerm_stack_hci_gallery_image.test.location
+  custom_location_id  = azurerm_stack_hci_gallery_image.test.custom_location_id
+  image_path          = azurerm_stack_hci_gallery_image.test.image_path
+  os_type             = azurerm_stack_hci_gallery_image.test.os_type
+}
+`, r.basic(data))
+}
+
+func (r StackHCIGalleryImageResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                = "acctest-hciimg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  image_path          = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.vhd.tar.gz"
+  os_type             = "Linux"
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
+
+func (r StackHCIGalleryImageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-hci-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
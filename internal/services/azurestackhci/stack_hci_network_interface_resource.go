@@ -0,0 +1,351 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StackHCINetworkInterfaceResource struct{}
+
+type StackHCINetworkInterfaceModel struct {
+	Name              string                                         `tfschema:"name"`
+	ResourceGroupName string                                         `tfschema:"resource_group_name"`
+	Location          string                                         `tfschema:"location"`
+	CustomLocationId  string                                         `tfschema:"custom_location_id"`
+	IPConfiguration   []StackHCINetworkInterfaceIPConfigurationModel `tfschema:"ip_configuration"`
+	MacAddress        string                                         `tfschema:"mac_address"`
+	DnsServers        []string                                       `tfschema:"dns_servers"`
+	Tags              map[string]interface{}                         `tfschema:"tags"`
+}
+
+type StackHCINetworkInterfaceIPConfigurationModel struct {
+	SubnetId         string `tfschema:"subnet_id"`
+	PrivateIPAddress string `tfschema:"private_ip_address"`
+	Gateway          string `tfschema:"gateway"`
+	PrefixLength     string `tfschema:"prefix_length"`
+}
+
+var _ sdk.ResourceWithUpdate = StackHCINetworkInterfaceResource{}
+
+func (r StackHCINetworkInterfaceResource) ResourceType() string {
+	return "azurerm_stack_hci_network_interface"
+}
+
+func (r StackHCINetworkInterfaceResource) ModelObject() interface{} {
+	return &StackHCINetworkInterfaceModel{}
+}
+
+func (r StackHCINetworkInterfaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateNetworkInterfaceID
+}
+
+func (r StackHCINetworkInterfaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][\-._a-zA-Z0-9]{0,78}[_a-zA-Z0-9]$`),
+				"`name` must be between 2 and 80 characters in length, begin with a letter or number, end with a letter, number or underscore and may only contain letters, numbers, underscores, periods and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"ip_configuration": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Computed:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"gateway": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"prefix_length": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"mac_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsMACAddress,
+		},
+
+		"dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCINetworkInterfaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config StackHCINetworkInterfaceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := azuresdkhacks.NewNetworkInterfaceID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.NetworkInterface{
+				ExtendedLocation: &azuresdkhacks.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(azuresdkhacks.ExtendedLocationTypeCustomLocation),
+				},
+				Location: location.Normalize(config.Location),
+				Properties: &azuresdkhacks.NetworkInterfaceProperties{
+					DnsSettings: &azuresdkhacks.InterfaceDNSSettings{
+						DnsServers: pointer.To(config.DnsServers),
+					},
+					IPConfigurations: expandStackHCINetworkInterfaceIPConfiguration(config.Name, config.IPConfiguration),
+				},
+				Tags: tags.Expand(config.Tags),
+			}
+
+			if config.MacAddress != "" {
+				payload.Properties.MacAddress = pointer.To(config.MacAddress)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+
+			id, err := azuresdkhacks.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCINetworkInterfaceModel{
+				Name:              id.NetworkInterfaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = pointer.From(model.ExtendedLocation.Name)
+				}
+
+				if props := model.Properties; props != nil {
+					state.IPConfiguration = flattenStackHCINetworkInterfaceIPConfiguration(props.IPConfigurations)
+					state.MacAddress = pointer.From(props.MacAddress)
+
+					dnsServers := make([]string, 0)
+					if props.DnsSettings != nil && props.DnsSettings.DnsServers != nil {
+						dnsServers = *props.DnsSettings.DnsServers
+					}
+					state.DnsServers = dnsServers
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+
+			id, err := azuresdkhacks.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StackHCINetworkInterfaceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("dns_servers") {
+				payload.Properties.DnsSettings = &azuresdkhacks.InterfaceDNSSettings{
+					DnsServers: pointer.To(config.DnsServers),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tags.Expand(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+
+			id, err := azuresdkhacks.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCINetworkInterfaceIPConfiguration(name string, input []StackHCINetworkInterfaceIPConfigurationModel) *[]azuresdkhacks.IPConfiguration {
+	output := make([]azuresdkhacks.IPConfiguration, 0)
+	for _, v := range input {
+		ipConfiguration := azuresdkhacks.IPConfiguration{
+			Name: pointer.To(name),
+			Properties: &azuresdkhacks.IPConfigurationProperties{
+				Subnet: &azuresdkhacks.IPConfigurationSubnet{
+					Id: pointer.To(v.SubnetId),
+				},
+			},
+		}
+
+		if v.PrivateIPAddress != "" {
+			ipConfiguration.Properties.PrivateIPAddress = pointer.To(v.PrivateIPAddress)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return &output
+}
+
+func flattenStackHCINetworkInterfaceIPConfiguration(input *[]azuresdkhacks.IPConfiguration) []StackHCINetworkInterfaceIPConfigurationModel {
+	output := make([]StackHCINetworkInterfaceIPConfigurationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		props := v.Properties
+		if props == nil {
+			continue
+		}
+
+		ipConfiguration := StackHCINetworkInterfaceIPConfigurationModel{
+			PrivateIPAddress: pointer.From(props.PrivateIPAddress),
+			Gateway:          pointer.From(props.Gateway),
+			PrefixLength:     pointer.From(props.PrefixLength),
+		}
+
+		if props.Subnet != nil {
+			ipConfiguration.SubnetId = pointer.From(props.Subnet.Id)
+		}
+
+		output = append(output, ipConfiguration)
+	}
+
+	return output
+}
@@ -0,0 +1,163 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCINetworkInterfaceResource struct{}
+
+func TestAccStackHCINetworkInterface_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" || os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID and/or ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCINetworkInterface_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" || os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID and/or ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCINetworkInterface_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" || os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID and/or ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+	r := StackHCINetworkInterfaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dns_servers.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StackHCINetworkInterfaceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseNetworkInterfaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.NetworkInterfacesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCINetworkInterfaceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-hcinic-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+
+  ip_configuration {
+    subnet_id = %q
+  }
+}
+`, StackHCIGalleryImageResource{}.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID"))
+}
+
+func (r StackHCINetworkInterfaceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "import" {
+  name                = azurerm_stack_hci_network_interface.test.name
+  resource_group_name = azurerm_stack_hci_network_interface.test.resource_group_name
+  location            = azurerm_stack_hci_network_interface.test.location
+  custom_location_id  = azurerm_stack_hci_network_interface.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_network_interface.test.ip_configuration.0.subnet_id
+  }
+}
+`, r.basic(data))
+}
+
+func (r StackHCINetworkInterfaceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-hcinic-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %q
+  dns_servers         = ["10.0.0.8", "10.0.0.9"]
+
+  ip_configuration {
+    subnet_id = %q
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, StackHCIGalleryImageResource{}.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID"))
+}
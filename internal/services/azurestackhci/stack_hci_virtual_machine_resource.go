@@ -0,0 +1,764 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StackHCIVirtualMachineResource struct{}
+
+type StackHCIVirtualMachineModel struct {
+	ArcMachineId        string                                     `tfschema:"arc_machine_id"`
+	CustomLocationId    string                                     `tfschema:"custom_location_id"`
+	VmSize              string                                     `tfschema:"vm_size"`
+	ProcessorCount      int64                                      `tfschema:"processor_count"`
+	MemoryInMB          int64                                      `tfschema:"memory_in_mb"`
+	DynamicMemory       []StackHCIVirtualMachineDynamicMemoryModel `tfschema:"dynamic_memory"`
+	NetworkInterfaceIds []string                                   `tfschema:"network_interface_ids"`
+	ImageId             string                                     `tfschema:"image_id"`
+	DataDiskIds         []string                                   `tfschema:"data_disk_ids"`
+	StoragePathId       string                                     `tfschema:"storage_path_id"`
+	OsProfile           []StackHCIVirtualMachineOsProfileModel     `tfschema:"os_profile"`
+	SecureBootEnabled   bool                                       `tfschema:"secure_boot_enabled"`
+	TpmEnabled          bool                                       `tfschema:"tpm_enabled"`
+	SecurityType        string                                     `tfschema:"security_type"`
+	VmId                string                                     `tfschema:"vm_id"`
+	PowerState          string                                     `tfschema:"power_state"`
+}
+
+type StackHCIVirtualMachineDynamicMemoryModel struct {
+	MaximumMemoryInMB            int64 `tfschema:"maximum_memory_in_mb"`
+	MinimumMemoryInMB            int64 `tfschema:"minimum_memory_in_mb"`
+	TargetMemoryBufferPercentage int64 `tfschema:"target_memory_buffer_percentage"`
+}
+
+type StackHCIVirtualMachineOsProfileModel struct {
+	AdminUsername        string                                            `tfschema:"admin_username"`
+	AdminPassword        string                                            `tfschema:"admin_password"`
+	ComputerName         string                                            `tfschema:"computer_name"`
+	LinuxConfiguration   []StackHCIVirtualMachineLinuxConfigurationModel   `tfschema:"linux_configuration"`
+	WindowsConfiguration []StackHCIVirtualMachineWindowsConfigurationModel `tfschema:"windows_configuration"`
+}
+
+type StackHCIVirtualMachineLinuxConfigurationModel struct {
+	PasswordAuthenticationEnabled bool                                      `tfschema:"password_authentication_enabled"`
+	VmAgentEnabled                bool                                      `tfschema:"vm_agent_enabled"`
+	VmConfigAgentEnabled          bool                                      `tfschema:"vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVirtualMachineSshPublicKeyModel `tfschema:"ssh_public_key"`
+}
+
+type StackHCIVirtualMachineWindowsConfigurationModel struct {
+	AutomaticUpdatesEnabled bool                                      `tfschema:"automatic_updates_enabled"`
+	TimeZone                string                                    `tfschema:"time_zone"`
+	VmAgentEnabled          bool                                      `tfschema:"vm_agent_enabled"`
+	VmConfigAgentEnabled    bool                                      `tfschema:"vm_config_agent_enabled"`
+	SshPublicKey            []StackHCIVirtualMachineSshPublicKeyModel `tfschema:"ssh_public_key"`
+}
+
+type StackHCIVirtualMachineSshPublicKeyModel struct {
+	Path    string `tfschema:"path"`
+	KeyData string `tfschema:"key_data"`
+}
+
+var _ sdk.ResourceWithUpdate = StackHCIVirtualMachineResource{}
+
+func (r StackHCIVirtualMachineResource) ResourceType() string {
+	return "azurerm_stack_hci_virtual_machine"
+}
+
+func (r StackHCIVirtualMachineResource) ModelObject() interface{} {
+	return &StackHCIVirtualMachineModel{}
+}
+
+func (r StackHCIVirtualMachineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateVirtualMachineInstanceID
+}
+
+func (r StackHCIVirtualMachineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"image_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"network_interface_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azuresdkhacks.ValidateNetworkInterfaceID,
+			},
+		},
+
+		"os_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"admin_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"computer_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 15),
+					},
+
+					"linux_configuration": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"password_authentication_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"vm_agent_enabled": stackHCIVirtualMachineAgentEnabledSchema(),
+
+								"vm_config_agent_enabled": stackHCIVirtualMachineAgentEnabledSchema(),
+
+								"ssh_public_key": stackHCIVirtualMachineSshPublicKeySchema(),
+							},
+						},
+					},
+
+					"windows_configuration": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"automatic_updates_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"time_zone": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"vm_agent_enabled": stackHCIVirtualMachineAgentEnabledSchema(),
+
+								"vm_config_agent_enabled": stackHCIVirtualMachineAgentEnabledSchema(),
+
+								"ssh_public_key": stackHCIVirtualMachineSshPublicKeySchema(),
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"vm_size": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "Custom",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Custom",
+				"Default",
+				"Standard_A2_v2",
+				"Standard_A4_v2",
+				"Standard_D2s_v3",
+				"Standard_D4s_v3",
+				"Standard_D8s_v3",
+				"Standard_D16s_v3",
+				"Standard_D32s_v3",
+				"Standard_DS2_v2",
+				"Standard_DS3_v2",
+				"Standard_DS4_v2",
+				"Standard_DS5_v2",
+				"Standard_DS13_v2",
+				"Standard_K8S_v1",
+				"Standard_K8S2_v1",
+				"Standard_K8S3_v1",
+				"Standard_K8S4_v1",
+				"Standard_K8S5_v1",
+				"Standard_NK6",
+				"Standard_NK12",
+				"Standard_NV6",
+				"Standard_NV12",
+			}, false),
+		},
+
+		"processor_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"memory_in_mb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"dynamic_memory": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"maximum_memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"minimum_memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"target_memory_buffer_percentage": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(5, 2000),
+					},
+				},
+			},
+		},
+
+		"data_disk_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"storage_path_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"secure_boot_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"tpm_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"security_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"ConfidentialVM",
+				"TrustedLaunch",
+			}, false),
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"vm_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"power_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			var config StackHCIVirtualMachineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewVirtualMachineInstanceID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuresdkhacks.VirtualMachineInstance{
+				ExtendedLocation: &azuresdkhacks.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(azuresdkhacks.ExtendedLocationTypeCustomLocation),
+				},
+				Properties: &azuresdkhacks.VirtualMachineInstanceProperties{
+					HardwareProfile: expandStackHCIVirtualMachineHardwareProfile(config),
+					NetworkProfile: &azuresdkhacks.VirtualMachineInstanceNetworkProfile{
+						NetworkInterfaces: expandStackHCIVirtualMachineResourceReferences(config.NetworkInterfaceIds),
+					},
+					OsProfile: expandStackHCIVirtualMachineOsProfile(config.OsProfile),
+					SecurityProfile: &azuresdkhacks.VirtualMachineInstanceSecurityProfile{
+						EnableTPM: pointer.To(config.TpmEnabled),
+						UefiSettings: &azuresdkhacks.UefiSettings{
+							SecureBootEnabled: pointer.To(config.SecureBootEnabled),
+						},
+					},
+					StorageProfile: &azuresdkhacks.VirtualMachineInstanceStorageProfile{
+						DataDisks: expandStackHCIVirtualMachineResourceReferences(config.DataDiskIds),
+						ImageReference: &azuresdkhacks.ResourceReference{
+							Id: pointer.To(config.ImageId),
+						},
+					},
+				},
+			}
+
+			if config.SecurityType != "" {
+				payload.Properties.SecurityProfile.SecurityType = pointer.To(config.SecurityType)
+			}
+			if config.StoragePathId != "" {
+				payload.Properties.StorageProfile.VMConfigStoragePathId = pointer.To(config.StoragePathId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := azuresdkhacks.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config StackHCIVirtualMachineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := StackHCIVirtualMachineModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = pointer.From(model.ExtendedLocation.Name)
+				}
+
+				if props := model.Properties; props != nil {
+					state.VmId = pointer.From(props.VMId)
+
+					if hardware := props.HardwareProfile; hardware != nil {
+						state.VmSize = pointer.From(hardware.VMSize)
+						state.ProcessorCount = pointer.From(hardware.Processors)
+						state.MemoryInMB = pointer.From(hardware.MemoryMB)
+						state.DynamicMemory = flattenStackHCIVirtualMachineDynamicMemory(hardware.DynamicMemoryConfig)
+					}
+
+					if props.NetworkProfile != nil {
+						state.NetworkInterfaceIds = flattenStackHCIVirtualMachineResourceReferences(props.NetworkProfile.NetworkInterfaces)
+					}
+
+					// the admin password isn't returned by the API, so is taken from the config
+					state.OsProfile = flattenStackHCIVirtualMachineOsProfile(props.OsProfile, config.OsProfile)
+
+					if security := props.SecurityProfile; security != nil {
+						state.TpmEnabled = pointer.From(security.EnableTPM)
+						state.SecurityType = pointer.From(security.SecurityType)
+						if security.UefiSettings != nil {
+							state.SecureBootEnabled = pointer.From(security.UefiSettings.SecureBootEnabled)
+						}
+					}
+
+					if storage := props.StorageProfile; storage != nil {
+						state.DataDiskIds = flattenStackHCIVirtualMachineResourceReferences(storage.DataDisks)
+						state.StoragePathId = pointer.From(storage.VMConfigStoragePathId)
+						if storage.ImageReference != nil {
+							state.ImageId = pointer.From(storage.ImageReference.Id)
+						}
+					}
+
+					if props.Status != nil {
+						state.PowerState = pointer.From(props.Status.PowerState)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := azuresdkhacks.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config StackHCIVirtualMachineModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			// the admin password isn't returned by the API, so needs to be sent again
+			payload.Properties.OsProfile = expandStackHCIVirtualMachineOsProfile(config.OsProfile)
+
+			if metadata.ResourceData.HasChanges("vm_size", "processor_count", "memory_in_mb", "dynamic_memory") {
+				payload.Properties.HardwareProfile = expandStackHCIVirtualMachineHardwareProfile(config)
+			}
+
+			if metadata.ResourceData.HasChange("network_interface_ids") {
+				payload.Properties.NetworkProfile = &azuresdkhacks.VirtualMachineInstanceNetworkProfile{
+					NetworkInterfaces: expandStackHCIVirtualMachineResourceReferences(config.NetworkInterfaceIds),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("data_disk_ids") {
+				if payload.Properties.StorageProfile == nil {
+					payload.Properties.StorageProfile = &azuresdkhacks.VirtualMachineInstanceStorageProfile{}
+				}
+				payload.Properties.StorageProfile.DataDisks = expandStackHCIVirtualMachineResourceReferences(config.DataDiskIds)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := azuresdkhacks.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func stackHCIVirtualMachineAgentEnabledSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		ForceNew: true,
+		Default:  true,
+	}
+}
+
+func stackHCIVirtualMachineSshPublicKeySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"key_data": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineHardwareProfile(input StackHCIVirtualMachineModel) *azuresdkhacks.VirtualMachineInstanceHardwareProfile {
+	output := &azuresdkhacks.VirtualMachineInstanceHardwareProfile{
+		VMSize: pointer.To(input.VmSize),
+	}
+
+	if input.ProcessorCount != 0 {
+		output.Processors = pointer.To(input.ProcessorCount)
+	}
+	if input.MemoryInMB != 0 {
+		output.MemoryMB = pointer.To(input.MemoryInMB)
+	}
+
+	if len(input.DynamicMemory) > 0 {
+		output.DynamicMemoryConfig = &azuresdkhacks.DynamicMemoryConfig{
+			MaximumMemoryMB:    pointer.To(input.DynamicMemory[0].MaximumMemoryInMB),
+			MinimumMemoryMB:    pointer.To(input.DynamicMemory[0].MinimumMemoryInMB),
+			TargetMemoryBuffer: pointer.To(input.DynamicMemory[0].TargetMemoryBufferPercentage),
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineDynamicMemory(input *azuresdkhacks.DynamicMemoryConfig) []StackHCIVirtualMachineDynamicMemoryModel {
+	if input == nil || input.MaximumMemoryMB == nil {
+		return []StackHCIVirtualMachineDynamicMemoryModel{}
+	}
+
+	return []StackHCIVirtualMachineDynamicMemoryModel{
+		{
+			MaximumMemoryInMB:            pointer.From(input.MaximumMemoryMB),
+			MinimumMemoryInMB:            pointer.From(input.MinimumMemoryMB),
+			TargetMemoryBufferPercentage: pointer.From(input.TargetMemoryBuffer),
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineResourceReferences(input []string) *[]azuresdkhacks.ResourceReference {
+	output := make([]azuresdkhacks.ResourceReference, 0)
+	for _, v := range input {
+		output = append(output, azuresdkhacks.ResourceReference{
+			Id: pointer.To(v),
+		})
+	}
+	return &output
+}
+
+func flattenStackHCIVirtualMachineResourceReferences(input *[]azuresdkhacks.ResourceReference) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, pointer.From(v.Id))
+	}
+	return output
+}
+
+func expandStackHCIVirtualMachineOsProfile(input []StackHCIVirtualMachineOsProfileModel) *azuresdkhacks.VirtualMachineInstanceOsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	profile := input[0]
+	output := &azuresdkhacks.VirtualMachineInstanceOsProfile{
+		AdminUsername: pointer.To(profile.AdminUsername),
+		ComputerName:  pointer.To(profile.ComputerName),
+	}
+
+	if profile.AdminPassword != "" {
+		output.AdminPassword = pointer.To(profile.AdminPassword)
+	}
+
+	if len(profile.LinuxConfiguration) > 0 {
+		linux := profile.LinuxConfiguration[0]
+		output.LinuxConfiguration = &azuresdkhacks.LinuxConfiguration{
+			DisablePasswordAuthentication: pointer.To(!linux.PasswordAuthenticationEnabled),
+			ProvisionVMAgent:              pointer.To(linux.VmAgentEnabled),
+			ProvisionVMConfigAgent:        pointer.To(linux.VmConfigAgentEnabled),
+			Ssh:                           expandStackHCIVirtualMachineSshConfiguration(linux.SshPublicKey),
+		}
+	}
+
+	if len(profile.WindowsConfiguration) > 0 {
+		windows := profile.WindowsConfiguration[0]
+		output.WindowsConfiguration = &azuresdkhacks.WindowsConfiguration{
+			EnableAutomaticUpdates: pointer.To(windows.AutomaticUpdatesEnabled),
+			ProvisionVMAgent:       pointer.To(windows.VmAgentEnabled),
+			ProvisionVMConfigAgent: pointer.To(windows.VmConfigAgentEnabled),
+			Ssh:                    expandStackHCIVirtualMachineSshConfiguration(windows.SshPublicKey),
+		}
+		if windows.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = pointer.To(windows.TimeZone)
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineOsProfile(input *azuresdkhacks.VirtualMachineInstanceOsProfile, config []StackHCIVirtualMachineOsProfileModel) []StackHCIVirtualMachineOsProfileModel {
+	if input == nil {
+		return []StackHCIVirtualMachineOsProfileModel{}
+	}
+
+	output := StackHCIVirtualMachineOsProfileModel{
+		AdminUsername:        pointer.From(input.AdminUsername),
+		ComputerName:         pointer.From(input.ComputerName),
+		LinuxConfiguration:   []StackHCIVirtualMachineLinuxConfigurationModel{},
+		WindowsConfiguration: []StackHCIVirtualMachineWindowsConfigurationModel{},
+	}
+
+	if len(config) > 0 {
+		output.AdminPassword = config[0].AdminPassword
+	}
+
+	if linux := input.LinuxConfiguration; linux != nil {
+		output.LinuxConfiguration = []StackHCIVirtualMachineLinuxConfigurationModel{
+			{
+				PasswordAuthenticationEnabled: !pointer.From(linux.DisablePasswordAuthentication),
+				VmAgentEnabled:                pointer.From(linux.ProvisionVMAgent),
+				VmConfigAgentEnabled:          pointer.From(linux.ProvisionVMConfigAgent),
+				SshPublicKey:                  flattenStackHCIVirtualMachineSshConfiguration(linux.Ssh),
+			},
+		}
+	}
+
+	if windows := input.WindowsConfiguration; windows != nil {
+		output.WindowsConfiguration = []StackHCIVirtualMachineWindowsConfigurationModel{
+			{
+				AutomaticUpdatesEnabled: pointer.From(windows.EnableAutomaticUpdates),
+				TimeZone:                pointer.From(windows.TimeZone),
+				VmAgentEnabled:          pointer.From(windows.ProvisionVMAgent),
+				VmConfigAgentEnabled:    pointer.From(windows.ProvisionVMConfigAgent),
+				SshPublicKey:            flattenStackHCIVirtualMachineSshConfiguration(windows.Ssh),
+			},
+		}
+	}
+
+	return []StackHCIVirtualMachineOsProfileModel{output}
+}
+
+func expandStackHCIVirtualMachineSshConfiguration(input []StackHCIVirtualMachineSshPublicKeyModel) *azuresdkhacks.SshConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	publicKeys := make([]azuresdkhacks.SshPublicKey, 0)
+	for _, v := range input {
+		publicKeys = append(publicKeys, azuresdkhacks.SshPublicKey{
+			Path:    pointer.To(v.Path),
+			KeyData: pointer.To(v.KeyData),
+		})
+	}
+
+	return &azuresdkhacks.SshConfiguration{
+		PublicKeys: &publicKeys,
+	}
+}
+
+func flattenStackHCIVirtualMachineSshConfiguration(input *azuresdkhacks.SshConfiguration) []StackHCIVirtualMachineSshPublicKeyModel {
+	output := make([]StackHCIVirtualMachineSshPublicKeyModel, 0)
+	if input == nil || input.PublicKeys == nil {
+		return output
+	}
+
+	for _, v := range *input.PublicKeys {
+		output = append(output, StackHCIVirtualMachineSshPublicKeyModel{
+			Path:    pointer.From(v.Path),
+			KeyData: pointer.From(v.KeyData),
+		})
+	}
+	return output
+}
@@ -0,0 +1,162 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StackHCIVirtualMachineResource struct{}
+
+func TestAccStackHCIVirtualMachine_basic(t *testing.T) {
+	r := StackHCIVirtualMachineResource{}
+	r.preCheck(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vm_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func TestAccStackHCIVirtualMachine_update(t *testing.T) {
+	r := StackHCIVirtualMachineResource{}
+	r.preCheck(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("processor_count").HasValue("4"),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func (r StackHCIVirtualMachineResource) preCheck(t *testing.T) {
+	if os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID") == "" || os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID") == "" || os.Getenv("ARM_TEST_STACK_HCI_ARC_MACHINE_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID, ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID and/or ARM_TEST_STACK_HCI_ARC_MACHINE_ID are not specified")
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseVirtualMachineInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AzureStackHCI.VirtualMachineInstancesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StackHCIVirtualMachineResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine" "test" {
+  arc_machine_id        = %q
+  custom_location_id    = %q
+  image_id              = azurerm_stack_hci_gallery_image.test.id
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+  processor_count       = 2
+  memory_in_mb          = 8192
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctestvm"
+
+    linux_configuration {}
+  }
+}
+`, r.template(data), os.Getenv("ARM_TEST_STACK_HCI_ARC_MACHINE_ID"), os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
+
+func (r StackHCIVirtualMachineResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine" "test" {
+  arc_machine_id        = %q
+  custom_location_id    = %q
+  image_id              = azurerm_stack_hci_gallery_image.test.id
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+  processor_count       = 4
+  memory_in_mb          = 8192
+
+  dynamic_memory {
+    maximum_memory_in_mb            = 8192
+    minimum_memory_in_mb            = 512
+    target_memory_buffer_percentage = 20
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctestvm"
+
+    linux_configuration {}
+  }
+}
+`, r.template(data), os.Getenv("ARM_TEST_STACK_HCI_ARC_MACHINE_ID"), os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"))
+}
+
+func (r StackHCIVirtualMachineResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                = "acctest-hciimg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+  image_path          = "https://cloud-images.ubuntu.com/jammy/current/jammy-server-cloudimg-amd64.vhd.tar.gz"
+  os_type             = "Linux"
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-hcinic-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = %[3]q
+
+  ip_configuration {
+    subnet_id = %[4]q
+  }
+}
+`, StackHCIGalleryImageResource{}.template(data), data.RandomInteger, os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"), os.Getenv("ARM_TEST_STACK_HCI_LOGICAL_NETWORK_ID"))
+}
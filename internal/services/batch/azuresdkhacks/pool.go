@@ -0,0 +1,246 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2022-01-01/pool"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// NOTE: the Security Profile (e.g. Trusted Launch and Encryption at Host) and the Upgrade Policy of a Batch Pool are only
+// available from API Version 2023-05-01, whereas the vendored SDK uses 2022-01-01 - as such Pools are created and
+// retrieved here using the newer API Version, reusing the vendored models for everything else, until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const poolApiVersion = "2023-05-01"
+
+type SecurityTypes string
+
+const (
+	SecurityTypesTrustedLaunch SecurityTypes = "trustedLaunch"
+)
+
+type UpgradeMode string
+
+const (
+	UpgradeModeAutomatic UpgradeMode = "automatic"
+	UpgradeModeManual    UpgradeMode = "manual"
+	UpgradeModeRolling   UpgradeMode = "rolling"
+)
+
+type SecurityProfile struct {
+	EncryptionAtHost *bool          `json:"encryptionAtHost,omitempty"`
+	SecurityType     *SecurityTypes `json:"securityType,omitempty"`
+	UefiSettings     *UefiSettings  `json:"uefiSettings,omitempty"`
+}
+
+type UefiSettings struct {
+	SecureBootEnabled *bool `json:"secureBootEnabled,omitempty"`
+	VTpmEnabled       *bool `json:"vTpmEnabled,omitempty"`
+}
+
+type UpgradePolicy struct {
+	AutomaticOSUpgradePolicy *AutomaticOSUpgradePolicy `json:"automaticOSUpgradePolicy,omitempty"`
+	Mode                     UpgradeMode               `json:"mode"`
+	RollingUpgradePolicy     *RollingUpgradePolicy     `json:"rollingUpgradePolicy,omitempty"`
+}
+
+type AutomaticOSUpgradePolicy struct {
+	DisableAutomaticRollback *bool `json:"disableAutomaticRollback,omitempty"`
+	EnableAutomaticOSUpgrade *bool `json:"enableAutomaticOSUpgrade,omitempty"`
+	OsRollingUpgradeDeferral *bool `json:"osRollingUpgradeDeferral,omitempty"`
+	UseRollingUpgradePolicy  *bool `json:"useRollingUpgradePolicy,omitempty"`
+}
+
+type RollingUpgradePolicy struct {
+	EnableCrossZoneUpgrade                *bool   `json:"enableCrossZoneUpgrade,omitempty"`
+	MaxBatchInstancePercent               *int64  `json:"maxBatchInstancePercent,omitempty"`
+	MaxUnhealthyInstancePercent           *int64  `json:"maxUnhealthyInstancePercent,omitempty"`
+	MaxUnhealthyUpgradedInstancePercent   *int64  `json:"maxUnhealthyUpgradedInstancePercent,omitempty"`
+	PauseTimeBetweenBatches               *string `json:"pauseTimeBetweenBatches,omitempty"`
+	PrioritizeUnhealthyInstances          *bool   `json:"prioritizeUnhealthyInstances,omitempty"`
+	RollbackFailedInstancesOnPolicyBreach *bool   `json:"rollbackFailedInstancesOnPolicyBreach,omitempty"`
+}
+
+// Pool is the vendored Pool model with the Security Profile of the Virtual Machine Configuration and the Upgrade
+// Policy added, which are (un)marshalled into their respective locations within the `properties` of the Pool
+type Pool struct {
+	pool.Pool
+
+	SecurityProfile *SecurityProfile `json:"-"`
+	UpgradePolicy   *UpgradePolicy   `json:"-"`
+}
+
+type poolExtendedProperties struct {
+	Properties *struct {
+		DeploymentConfiguration *struct {
+			VirtualMachineConfiguration *struct {
+				SecurityProfile *SecurityProfile `json:"securityProfile,omitempty"`
+			} `json:"virtualMachineConfiguration,omitempty"`
+		} `json:"deploymentConfiguration,omitempty"`
+		UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+func (p Pool) MarshalJSON() ([]byte, error) {
+	encoded, err := json.Marshal(p.Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		return nil, err
+	}
+
+	props, ok := out["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+		out["properties"] = props
+	}
+
+	if p.UpgradePolicy != nil {
+		props["upgradePolicy"] = p.UpgradePolicy
+	}
+
+	if p.SecurityProfile != nil {
+		deploymentConfiguration, ok := props["deploymentConfiguration"].(map[string]interface{})
+		if !ok {
+			deploymentConfiguration = make(map[string]interface{})
+			props["deploymentConfiguration"] = deploymentConfiguration
+		}
+		virtualMachineConfiguration, ok := deploymentConfiguration["virtualMachineConfiguration"].(map[string]interface{})
+		if !ok {
+			virtualMachineConfiguration = make(map[string]interface{})
+			deploymentConfiguration["virtualMachineConfiguration"] = virtualMachineConfiguration
+		}
+		virtualMachineConfiguration["securityProfile"] = p.SecurityProfile
+	}
+
+	return json.Marshal(out)
+}
+
+func (p *Pool) UnmarshalJSON(input []byte) error {
+	if err := json.Unmarshal(input, &p.Pool); err != nil {
+		return err
+	}
+
+	var extended poolExtendedProperties
+	if err := json.Unmarshal(input, &extended); err != nil {
+		return err
+	}
+
+	if props := extended.Properties; props != nil {
+		p.UpgradePolicy = props.UpgradePolicy
+		if config := props.DeploymentConfiguration; config != nil && config.VirtualMachineConfiguration != nil {
+			p.SecurityProfile = config.VirtualMachineConfiguration.SecurityProfile
+		}
+	}
+
+	return nil
+}
+
+type PoolClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewPoolClientWithBaseURI(api environments.Api) (*PoolClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "pool", poolApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PoolClient: %+v", err)
+	}
+
+	return &PoolClient{
+		Client: client,
+	}, nil
+}
+
+type CreateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *Pool
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *Pool
+}
+
+// Create ...
+func (c PoolClient) Create(ctx context.Context, id pool.PoolId, input Pool) (result CreateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// Get ...
+func (c PoolClient) Get(ctx context.Context, id pool.PoolId) (result GetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
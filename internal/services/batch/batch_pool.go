@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2022-01-01/pool"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -1364,3 +1365,143 @@ func expandBatchPoolUserAccount(ref map[string]interface{}) pool.UserAccount {
 
 	return result
 }
+
+func expandBatchPoolSecurityProfile(input []interface{}) (*azuresdkhacks.SecurityProfile, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	result := azuresdkhacks.SecurityProfile{
+		EncryptionAtHost: pointer.To(raw["host_encryption_enabled"].(bool)),
+	}
+
+	secureBootEnabled := raw["secure_boot_enabled"].(bool)
+	vTpmEnabled := raw["vtpm_enabled"].(bool)
+	if v := raw["security_type"].(string); v != "" {
+		result.SecurityType = pointer.To(azuresdkhacks.SecurityTypes(v))
+		result.UefiSettings = &azuresdkhacks.UefiSettings{
+			SecureBootEnabled: pointer.To(secureBootEnabled),
+			VTpmEnabled:       pointer.To(vTpmEnabled),
+		}
+	} else if secureBootEnabled || vTpmEnabled {
+		return nil, fmt.Errorf("`security_type` must be specified when `secure_boot_enabled` or `vtpm_enabled` is set to `true`")
+	}
+
+	return &result, nil
+}
+
+func flattenBatchPoolSecurityProfile(input *azuresdkhacks.SecurityProfile) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	securityType := ""
+	if input.SecurityType != nil {
+		securityType = string(*input.SecurityType)
+	}
+
+	secureBootEnabled := false
+	vTpmEnabled := false
+	if input.UefiSettings != nil {
+		secureBootEnabled = pointer.From(input.UefiSettings.SecureBootEnabled)
+		vTpmEnabled = pointer.From(input.UefiSettings.VTpmEnabled)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"host_encryption_enabled": pointer.From(input.EncryptionAtHost),
+			"security_type":           securityType,
+			"secure_boot_enabled":     secureBootEnabled,
+			"vtpm_enabled":            vTpmEnabled,
+		},
+	}
+}
+
+func expandBatchPoolUpgradePolicy(input []interface{}) (*azuresdkhacks.UpgradePolicy, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	result := azuresdkhacks.UpgradePolicy{
+		Mode: azuresdkhacks.UpgradeMode(raw["mode"].(string)),
+	}
+
+	if v := raw["automatic_os_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		if result.Mode == azuresdkhacks.UpgradeModeManual {
+			return nil, fmt.Errorf("an `automatic_os_upgrade_policy` block cannot be specified when `mode` is set to %q", string(result.Mode))
+		}
+
+		policy := v[0].(map[string]interface{})
+		result.AutomaticOSUpgradePolicy = &azuresdkhacks.AutomaticOSUpgradePolicy{
+			DisableAutomaticRollback: pointer.To(policy["disable_automatic_rollback"].(bool)),
+			EnableAutomaticOSUpgrade: pointer.To(policy["enable_automatic_os_upgrade"].(bool)),
+			OsRollingUpgradeDeferral: pointer.To(policy["os_rolling_upgrade_deferral"].(bool)),
+			UseRollingUpgradePolicy:  pointer.To(policy["use_rolling_upgrade_policy"].(bool)),
+		}
+	}
+
+	if v := raw["rolling_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		policy := v[0].(map[string]interface{})
+		rollingUpgradePolicy := azuresdkhacks.RollingUpgradePolicy{
+			EnableCrossZoneUpgrade:                pointer.To(policy["cross_zone_upgrades_enabled"].(bool)),
+			PrioritizeUnhealthyInstances:          pointer.To(policy["prioritize_unhealthy_instances_enabled"].(bool)),
+			RollbackFailedInstancesOnPolicyBreach: pointer.To(policy["rollback_failed_instances_on_policy_breach_enabled"].(bool)),
+		}
+		if v := policy["max_batch_instance_percent"].(int); v != 0 {
+			rollingUpgradePolicy.MaxBatchInstancePercent = pointer.To(int64(v))
+		}
+		if v := policy["max_unhealthy_instance_percent"].(int); v != 0 {
+			rollingUpgradePolicy.MaxUnhealthyInstancePercent = pointer.To(int64(v))
+		}
+		if v := policy["max_unhealthy_upgraded_instance_percent"].(int); v != 0 {
+			rollingUpgradePolicy.MaxUnhealthyUpgradedInstancePercent = pointer.To(int64(v))
+		}
+		if v := policy["pause_time_between_batches"].(string); v != "" {
+			rollingUpgradePolicy.PauseTimeBetweenBatches = pointer.To(v)
+		}
+		result.RollingUpgradePolicy = &rollingUpgradePolicy
+	} else if result.Mode == azuresdkhacks.UpgradeModeRolling {
+		return nil, fmt.Errorf("a `rolling_upgrade_policy` block must be specified when `mode` is set to %q", string(result.Mode))
+	}
+
+	return &result, nil
+}
+
+func flattenBatchPoolUpgradePolicy(input *azuresdkhacks.UpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	automaticOSUpgradePolicy := make([]interface{}, 0)
+	if policy := input.AutomaticOSUpgradePolicy; policy != nil {
+		automaticOSUpgradePolicy = append(automaticOSUpgradePolicy, map[string]interface{}{
+			"disable_automatic_rollback":  pointer.From(policy.DisableAutomaticRollback),
+			"enable_automatic_os_upgrade": pointer.From(policy.EnableAutomaticOSUpgrade),
+			"os_rolling_upgrade_deferral": pointer.From(policy.OsRollingUpgradeDeferral),
+			"use_rolling_upgrade_policy":  pointer.From(policy.UseRollingUpgradePolicy),
+		})
+	}
+
+	rollingUpgradePolicy := make([]interface{}, 0)
+	if policy := input.RollingUpgradePolicy; policy != nil {
+		rollingUpgradePolicy = append(rollingUpgradePolicy, map[string]interface{}{
+			"cross_zone_upgrades_enabled":                        pointer.From(policy.EnableCrossZoneUpgrade),
+			"max_batch_instance_percent":                         int(pointer.From(policy.MaxBatchInstancePercent)),
+			"max_unhealthy_instance_percent":                     int(pointer.From(policy.MaxUnhealthyInstancePercent)),
+			"max_unhealthy_upgraded_instance_percent":            int(pointer.From(policy.MaxUnhealthyUpgradedInstancePercent)),
+			"pause_time_between_batches":                         pointer.From(policy.PauseTimeBetweenBatches),
+			"prioritize_unhealthy_instances_enabled":             pointer.From(policy.PrioritizeUnhealthyInstances),
+			"rollback_failed_instances_on_policy_breach_enabled": pointer.From(policy.RollbackFailedInstancesOnPolicyBreach),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                        string(input.Mode),
+			"automatic_os_upgrade_policy": automaticOSUpgradePolicy,
+			"rolling_upgrade_policy":      rollingUpgradePolicy,
+		},
+	}
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -700,6 +702,40 @@ func resourceBatchPool() *pluginsdk.Resource {
 						string(pool.DiffDiskPlacementCacheDisk),
 					}, false),
 			},
+			"security_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"host_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"security_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.SecurityTypesTrustedLaunch),
+							}, false),
+						},
+						"secure_boot_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"vtpm_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"inter_node_communication": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -791,6 +827,106 @@ func resourceBatchPool() *pluginsdk.Resource {
 					},
 				},
 			},
+			"upgrade_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.UpgradeModeAutomatic),
+								string(azuresdkhacks.UpgradeModeManual),
+								string(azuresdkhacks.UpgradeModeRolling),
+							}, false),
+						},
+						"automatic_os_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"disable_automatic_rollback": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"enable_automatic_os_upgrade": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"use_rolling_upgrade_policy": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"os_rolling_upgrade_deferral": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"rolling_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"cross_zone_upgrades_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_batch_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"max_unhealthy_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(5, 100),
+									},
+									"max_unhealthy_upgraded_instance_percent": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"pause_time_between_batches": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+									"prioritize_unhealthy_instances_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"rollback_failed_instances_on_policy_breach_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"windows": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -912,8 +1048,26 @@ func resourceBatchPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("expanding `network_configuration`: %+v", err)
 	}
 
-	_, err = client.Create(ctx, id, parameters, pool.CreateOperationOptions{})
+	securityProfile, err := expandBatchPoolSecurityProfile(d.Get("security_profile").([]interface{}))
 	if err != nil {
+		return fmt.Errorf("expanding `security_profile`: %+v", err)
+	}
+	if securityProfile != nil && parameters.Properties.DeploymentConfiguration == nil {
+		return fmt.Errorf("a `security_profile` block can only be specified when the pool uses a virtual machine configuration")
+	}
+
+	upgradePolicy, err := expandBatchPoolUpgradePolicy(d.Get("upgrade_policy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `upgrade_policy`: %+v", err)
+	}
+
+	// the Security Profile and Upgrade Policy are only available in a newer API version than the Pool Client uses
+	extendedParameters := azuresdkhacks.Pool{
+		Pool:            parameters,
+		SecurityProfile: securityProfile,
+		UpgradePolicy:   upgradePolicy,
+	}
+	if _, err = meta.(*clients.Client).Batch.ExtendedPoolClient.Create(ctx, id, extendedParameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -1058,7 +1212,7 @@ func resourceBatchPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 }
 
 func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Batch.PoolClient
+	client := meta.(*clients.Client).Batch.ExtendedPoolClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -1241,6 +1395,14 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				return fmt.Errorf("setting `network_configuration`: %v", err)
 			}
 		}
+
+		if err := d.Set("security_profile", flattenBatchPoolSecurityProfile(model.SecurityProfile)); err != nil {
+			return fmt.Errorf("setting `security_profile`: %v", err)
+		}
+
+		if err := d.Set("upgrade_policy", flattenBatchPoolUpgradePolicy(model.UpgradePolicy)); err != nil {
+			return fmt.Errorf("setting `upgrade_policy`: %v", err)
+		}
 	}
 
 	return nil
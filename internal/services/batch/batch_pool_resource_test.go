@@ -643,6 +643,41 @@ func TestAccBatchPool_diskSettings(t *testing.T) {
 	})
 }
 
+func TestAccBatchPool_securityProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
+	r := BatchPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.securityProfile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_profile.0.host_encryption_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("security_profile.0.security_type").HasValue("trustedLaunch"),
+				check.That(data.ResourceName).Key("security_profile.0.secure_boot_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("security_profile.0.vtpm_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("stop_pending_resize_operation"),
+	})
+}
+
+func TestAccBatchPool_upgradePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
+	r := BatchPoolResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.upgradePolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("upgrade_policy.0.mode").HasValue("rolling"),
+				check.That(data.ResourceName).Key("upgrade_policy.0.automatic_os_upgrade_policy.0.enable_automatic_os_upgrade").HasValue("true"),
+				check.That(data.ResourceName).Key("upgrade_policy.0.rolling_upgrade_policy.0.max_batch_instance_percent").HasValue("20"),
+			),
+		},
+		data.ImportStep("stop_pending_resize_operation"),
+	})
+}
+
 func TestAccBatchPool_extensions(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
 	r := BatchPoolResource{}
@@ -2300,6 +2335,88 @@ resource "azurerm_batch_pool" "test" {
 `, template, data.RandomString, data.RandomString)
 }
 
+func (BatchPoolResource) securityProfile(data acceptance.TestData) string {
+	template := BatchPoolResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  node_agent_sku_id   = "batch.node.ubuntu 22.04"
+  vm_size             = "Standard_D2s_v3"
+  security_profile {
+    host_encryption_enabled = false
+    security_type           = "trustedLaunch"
+    secure_boot_enabled     = true
+    vtpm_enabled            = true
+  }
+  fixed_scale {
+    target_dedicated_nodes = 0
+  }
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts-gen2"
+    version   = "latest"
+  }
+}
+`, template, data.RandomString, data.RandomString)
+}
+
+func (BatchPoolResource) upgradePolicy(data acceptance.TestData) string {
+	template := BatchPoolResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  node_agent_sku_id   = "batch.node.windows amd64"
+  vm_size             = "Standard_D2s_v3"
+  upgrade_policy {
+    mode = "rolling"
+    automatic_os_upgrade_policy {
+      disable_automatic_rollback  = true
+      enable_automatic_os_upgrade = true
+      use_rolling_upgrade_policy  = true
+      os_rolling_upgrade_deferral = true
+    }
+    rolling_upgrade_policy {
+      cross_zone_upgrades_enabled             = true
+      max_batch_instance_percent              = 20
+      max_unhealthy_instance_percent          = 20
+      max_unhealthy_upgraded_instance_percent = 20
+      pause_time_between_batches              = "PT0S"
+      prioritize_unhealthy_instances_enabled  = false
+    }
+  }
+  windows {
+    enable_automatic_updates = false
+  }
+  fixed_scale {
+    target_dedicated_nodes = 0
+  }
+  storage_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2019-datacenter-smalldisk"
+    version   = "latest"
+  }
+}
+`, template, data.RandomString, data.RandomString)
+}
+
 func (BatchPoolResource) interNodeCommunicationWithTaskSchedulingPolicy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
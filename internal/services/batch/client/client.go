@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2022-01-01/certificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2022-01-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/azuresdkhacks"
 	batchDataplane "github.com/tombuildsstuff/kermit/sdk/batch/2022-01.15.0/batch"
 )
 
@@ -19,6 +20,8 @@ type Client struct {
 	CertificateClient *certificate.CertificateClient
 	PoolClient        *pool.PoolClient
 
+	ExtendedPoolClient *azuresdkhacks.PoolClient
+
 	BatchManagementAuthorizer autorest.Authorizer
 }
 
@@ -47,11 +50,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(poolClient.Client, o.Authorizers.ResourceManager)
 
+	extendedPoolClient, err := azuresdkhacks.NewPoolClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Extended Pool client: %+v", err)
+	}
+	o.Configure(extendedPoolClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		AccountClient:             accountClient,
 		ApplicationClient:         applicationClient,
 		CertificateClient:         certificateClient,
 		PoolClient:                poolClient,
+		ExtendedPoolClient:        extendedPoolClient,
 		BatchManagementAuthorizer: o.BatchManagementAuthorizer,
 	}, nil
 }
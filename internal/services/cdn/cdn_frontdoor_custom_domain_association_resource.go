@@ -1,10 +1,12 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
@@ -52,6 +54,12 @@ func resourceCdnFrontDoorCustomDomainAssociation() *pluginsdk.Resource {
 					ValidateFunc: validate.FrontDoorRouteID,
 				},
 			},
+
+			"await_validation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -85,6 +93,28 @@ func resourceCdnFrontDoorCustomDomainAssociationCreate(d *pluginsdk.ResourceData
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	// the DNS TXT record containing the validation token is typically created in the same configuration as the
+	// association, so optionally wait for Front Door to pick it up and approve the custom domain
+	if d.Get("await_validation").(bool) {
+		timeout, _ := ctx.Deadline()
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending: []string{
+				string(cdn.DomainValidationStateUnknown),
+				string(cdn.DomainValidationStateSubmitting),
+				string(cdn.DomainValidationStatePending),
+				string(cdn.DomainValidationStatePendingRevalidation),
+				string(cdn.DomainValidationStateRefreshingValidationToken),
+			},
+			Target:     []string{string(cdn.DomainValidationStateApproved)},
+			Refresh:    cdnFrontDoorCustomDomainValidationStateRefreshFunc(ctx, client, *cdId),
+			MinTimeout: 30 * time.Second,
+			Timeout:    time.Until(timeout),
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for the domain validation of %s to be approved: %+v", cdId, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	d.Set("cdn_frontdoor_custom_domain_id", cdId.ID())
 	d.Set("cdn_frontdoor_route_ids", routes)
@@ -235,3 +265,19 @@ func validateRoutes(d *pluginsdk.ResourceData, meta interface{}, id *parse.Front
 
 	return result, nil
 }
+
+func cdnFrontDoorCustomDomainValidationStateRefreshFunc(ctx context.Context, client *cdn.AFDCustomDomainsClient, id parse.FrontDoorCustomDomainId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ProfileName, id.CustomDomainName)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling for %s: %+v", id, err)
+		}
+
+		state := string(cdn.DomainValidationStateUnknown)
+		if props := resp.AFDDomainProperties; props != nil && props.DomainValidationState != "" {
+			state = string(props.DomainValidationState)
+		}
+
+		return resp, state, nil
+	}
+}
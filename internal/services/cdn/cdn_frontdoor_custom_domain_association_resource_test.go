@@ -72,6 +72,21 @@ func TestAccCdnFrontDoorCustomDomainAssociation_removeAssociations(t *testing.T)
 	})
 }
 
+func TestAccCdnFrontDoorCustomDomainAssociation_awaitValidation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_custom_domain_association", "test")
+	r := CdnFrontDoorCustomDomainAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.awaitValidation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_cdn_frontdoor_custom_domain.contoso").Key("domain_validation_state").HasValue("Approved"),
+			),
+		},
+	})
+}
+
 func (r CdnFrontDoorCustomDomainAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FrontDoorCustomDomainAssociationID(state.ID)
 	if err != nil {
@@ -102,6 +117,32 @@ resource "azurerm_cdn_frontdoor_custom_domain_association" "test" {
 `, template)
 }
 
+func (r CdnFrontDoorCustomDomainAssociationResource) awaitValidation(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_txt_record" "contoso" {
+  name                = trimsuffix(azurerm_cdn_frontdoor_custom_domain.contoso.validation_dns_record_name, ".${azurerm_dns_zone.test.name}")
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 3600
+
+  record {
+    value = azurerm_cdn_frontdoor_custom_domain.contoso.validation_token
+  }
+}
+
+resource "azurerm_cdn_frontdoor_custom_domain_association" "test" {
+  cdn_frontdoor_custom_domain_id = azurerm_cdn_frontdoor_custom_domain.contoso.id
+  cdn_frontdoor_route_ids        = [azurerm_cdn_frontdoor_route.contoso.id]
+  await_validation               = true
+
+  depends_on = [azurerm_dns_txt_record.contoso]
+}
+`, template)
+}
+
 func (r CdnFrontDoorCustomDomainAssociationResource) update(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
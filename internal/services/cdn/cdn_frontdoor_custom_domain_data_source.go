@@ -85,6 +85,16 @@ func dataSourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"domain_validation_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"validation_dns_record_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -135,6 +145,9 @@ func dataSourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("expiration_date", validationProps.ExpirationDate)
 			d.Set("validation_token", validationProps.ValidationToken)
 		}
+
+		d.Set("domain_validation_state", string(props.DomainValidationState))
+		d.Set("validation_dns_record_name", cdnFrontDoorCustomDomainValidationRecordName(props.HostName))
 	}
 
 	return nil
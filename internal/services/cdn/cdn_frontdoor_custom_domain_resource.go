@@ -114,6 +114,16 @@ func resourceCdnFrontDoorCustomDomain() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"domain_validation_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"validation_dns_record_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 
@@ -225,6 +235,9 @@ func resourceCdnFrontDoorCustomDomainRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("expiration_date", validationProps.ExpirationDate)
 			d.Set("validation_token", validationProps.ValidationToken)
 		}
+
+		d.Set("domain_validation_state", string(props.DomainValidationState))
+		d.Set("validation_dns_record_name", cdnFrontDoorCustomDomainValidationRecordName(props.HostName))
 	}
 
 	return nil
@@ -319,6 +332,16 @@ func resourceCdnFrontDoorCustomDomainDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
+// cdnFrontDoorCustomDomainValidationRecordName returns the name of the DNS TXT record which Front Door checks for the
+// `validation_token` when validating ownership of the Custom Domain
+func cdnFrontDoorCustomDomainValidationRecordName(hostName *string) string {
+	if hostName == nil || *hostName == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("_dnsauth.%s", *hostName)
+}
+
 func expandTlsParameters(input []interface{}) (*cdn.AFDDomainHTTPSParameters, error) {
 	// NOTE: With the Frontdoor service, they do not treat an empty object like an empty object
 	// if it is not nil they assume it is fully defined and then end up throwing errors when they
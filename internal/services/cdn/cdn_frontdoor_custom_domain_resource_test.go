@@ -3,6 +3,7 @@ package cdn_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -25,6 +26,9 @@ func TestAccCdnFrontDoorCustomDomain_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("validation_token").IsNotEmpty(),
+				check.That(data.ResourceName).Key("domain_validation_state").IsNotEmpty(),
+				check.That(data.ResourceName).Key("validation_dns_record_name").MatchesRegex(regexp.MustCompile(`^_dnsauth\.`)),
 			),
 		},
 		data.ImportStep(),
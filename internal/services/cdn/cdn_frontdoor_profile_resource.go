@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	cdnClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -47,6 +48,25 @@ func resourceCdnFrontDoorProfile() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			"log_scrubbing_rule": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"match_variable": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdnClient.ScrubbingRuleEntryMatchVariableQueryStringArgNames),
+								string(cdnClient.ScrubbingRuleEntryMatchVariableRequestIPAddress),
+								string(cdnClient.ScrubbingRuleEntryMatchVariableRequestUri),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"response_timeout_seconds": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -112,6 +132,13 @@ func resourceCdnFrontDoorProfileCreate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
 	}
 
+	if rules := d.Get("log_scrubbing_rule").(*pluginsdk.Set).List(); len(rules) > 0 {
+		logScrubbingClient := meta.(*clients.Client).Cdn.FrontDoorProfileLogScrubbingClient
+		if err := logScrubbingClient.UpdateThenPoll(ctx, id, expandCdnFrontDoorProfileLogScrubbing(rules)); err != nil {
+			return fmt.Errorf("updating the log scrubbing rules for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceCdnFrontDoorProfileRead(d, meta)
 }
@@ -152,6 +179,20 @@ func resourceCdnFrontDoorProfileRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("sku_name", skuName)
 
+	// Log Scrubbing isn't available in the API Version used by the Front Door Profiles Client
+	logScrubbingResp, err := meta.(*clients.Client).Cdn.FrontDoorProfileLogScrubbingClient.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving the log scrubbing rules for %s: %+v", id, err)
+	}
+
+	var logScrubbing *cdnClient.ProfileLogScrubbing
+	if model := logScrubbingResp.Model; model != nil && model.Properties != nil {
+		logScrubbing = model.Properties.LogScrubbing
+	}
+	if err := d.Set("log_scrubbing_rule", flattenCdnFrontDoorProfileLogScrubbing(logScrubbing)); err != nil {
+		return fmt.Errorf("setting `log_scrubbing_rule`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -183,6 +224,13 @@ func resourceCdnFrontDoorProfileUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
 	}
 
+	if d.HasChange("log_scrubbing_rule") {
+		logScrubbingClient := meta.(*clients.Client).Cdn.FrontDoorProfileLogScrubbingClient
+		if err := logScrubbingClient.UpdateThenPoll(ctx, *id, expandCdnFrontDoorProfileLogScrubbing(d.Get("log_scrubbing_rule").(*pluginsdk.Set).List())); err != nil {
+			return fmt.Errorf("updating the log scrubbing rules for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceCdnFrontDoorProfileRead(d, meta)
 }
 
@@ -207,3 +255,51 @@ func resourceCdnFrontDoorProfileDelete(d *pluginsdk.ResourceData, meta interface
 
 	return nil
 }
+
+func expandCdnFrontDoorProfileLogScrubbing(input []interface{}) cdnClient.ProfileLogScrubbingModel {
+	// removing all of the rules disables Log Scrubbing on the Front Door Profile
+	state := cdnClient.ProfileLogScrubbingStateDisabled
+	if len(input) > 0 {
+		state = cdnClient.ProfileLogScrubbingStateEnabled
+	}
+
+	rules := make([]cdnClient.ProfileScrubbingRules, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		ruleState := cdnClient.ScrubbingRuleEntryStateEnabled
+
+		rules = append(rules, cdnClient.ProfileScrubbingRules{
+			MatchVariable:         cdnClient.ScrubbingRuleEntryMatchVariable(v["match_variable"].(string)),
+			SelectorMatchOperator: cdnClient.ScrubbingRuleEntryMatchOperatorEqualsAny,
+			State:                 &ruleState,
+		})
+	}
+
+	return cdnClient.ProfileLogScrubbingModel{
+		Properties: &cdnClient.ProfileLogScrubbingProperties{
+			LogScrubbing: &cdnClient.ProfileLogScrubbing{
+				ScrubbingRules: &rules,
+				State:          &state,
+			},
+		},
+	}
+}
+
+func flattenCdnFrontDoorProfileLogScrubbing(input *cdnClient.ProfileLogScrubbing) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.ScrubbingRules == nil || input.State == nil || *input.State != cdnClient.ProfileLogScrubbingStateEnabled {
+		return results
+	}
+
+	for _, rule := range *input.ScrubbingRules {
+		if rule.State != nil && *rule.State != cdnClient.ScrubbingRuleEntryStateEnabled {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"match_variable": string(rule.MatchVariable),
+		})
+	}
+
+	return results
+}
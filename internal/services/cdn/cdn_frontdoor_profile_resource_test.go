@@ -66,6 +66,7 @@ func TestAccCdnFrontDoorProfile_update(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("response_timeout_seconds").HasValue("240"),
+				check.That(data.ResourceName).Key("log_scrubbing_rule.#").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
@@ -74,6 +75,15 @@ func TestAccCdnFrontDoorProfile_update(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("response_timeout_seconds").HasValue("120"),
+				check.That(data.ResourceName).Key("log_scrubbing_rule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log_scrubbing_rule.#").HasValue("2"),
 			),
 		},
 		data.ImportStep(),
@@ -142,6 +152,14 @@ resource "azurerm_cdn_frontdoor_profile" "test" {
   response_timeout_seconds = 240
   sku_name                 = "Premium_AzureFrontDoor"
 
+  log_scrubbing_rule {
+    match_variable = "QueryStringArgNames"
+  }
+
+  log_scrubbing_rule {
+    match_variable = "RequestIPAddress"
+  }
+
   tags = {
     ENV = "Test"
   }
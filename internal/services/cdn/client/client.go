@@ -1,6 +1,8 @@
 package client
 
 import (
+	"fmt"
+
 	cdnSdk "github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2020-09-01/cdn"          // nolint: staticcheck
 	cdnFrontDoorSdk "github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2021-06-01/cdn" // nolint: staticcheck
 	"github.com/Azure/azure-sdk-for-go/services/frontdoor/mgmt/2020-11-01/frontdoor"     // nolint: staticcheck
@@ -16,6 +18,7 @@ type Client struct {
 	FrontDoorRoutesClient                 *cdnFrontDoorSdk.RoutesClient
 	FrontDoorRulesClient                  *cdnFrontDoorSdk.RulesClient
 	FrontDoorProfileClient                *cdnFrontDoorSdk.ProfilesClient
+	FrontDoorProfileLogScrubbingClient    *FrontDoorProfileLogScrubbingClient
 	FrontDoorSecretsClient                *cdnFrontDoorSdk.SecretsClient
 	FrontDoorRuleSetsClient               *cdnFrontDoorSdk.RuleSetsClient
 	FrontDoorLegacyFirewallPoliciesClient *frontdoor.PoliciesClient
@@ -24,7 +27,7 @@ type Client struct {
 	ProfilesClient                        *cdnSdk.ProfilesClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	frontDoorEndpointsClient := cdnFrontDoorSdk.NewAFDEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&frontDoorEndpointsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -52,6 +55,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	frontDoorProfilesClient := cdnFrontDoorSdk.NewProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&frontDoorProfilesClient.Client, o.ResourceManagerAuthorizer)
 
+	frontDoorProfileLogScrubbingClient, err := NewFrontDoorProfileLogScrubbingClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building front door profile log scrubbing client: %+v", err)
+	}
+	o.Configure(frontDoorProfileLogScrubbingClient.Client, o.Authorizers.ResourceManager)
+
 	frontDoorPolicySecretsClient := cdnFrontDoorSdk.NewSecretsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&frontDoorPolicySecretsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -76,11 +85,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		FrontDoorRoutesClient:                 &frontDoorRoutesClient,
 		FrontDoorRulesClient:                  &frontDoorRulesClient,
 		FrontDoorProfileClient:                &frontDoorProfilesClient,
+		FrontDoorProfileLogScrubbingClient:    frontDoorProfileLogScrubbingClient,
 		FrontDoorSecretsClient:                &frontDoorPolicySecretsClient,
 		FrontDoorRuleSetsClient:               &frontDoorRuleSetsClient,
 		FrontDoorLegacyFirewallPoliciesClient: &frontDoorLegacyFirewallPoliciesClient,
 		CustomDomainsClient:                   &customDomainsClient,
 		EndpointsClient:                       &endpointsClient,
 		ProfilesClient:                        &profilesClient,
-	}
+	}, nil
 }
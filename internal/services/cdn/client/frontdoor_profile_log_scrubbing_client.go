@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+)
+
+const frontDoorProfileLogScrubbingApiVersion = "2024-02-01"
+
+// FrontDoorProfileLogScrubbingClient is used to manage the Log Scrubbing Rules for a Front Door Profile, since the
+// API Version used by the Front Door Profiles Client doesn't support Log Scrubbing
+type FrontDoorProfileLogScrubbingClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewFrontDoorProfileLogScrubbingClientWithBaseURI(api environments.Api) (*FrontDoorProfileLogScrubbingClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "profiles", frontDoorProfileLogScrubbingApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating FrontDoorProfileLogScrubbingClient: %+v", err)
+	}
+
+	return &FrontDoorProfileLogScrubbingClient{
+		Client: client,
+	}, nil
+}
+
+type ProfileLogScrubbingState string
+
+const (
+	ProfileLogScrubbingStateDisabled ProfileLogScrubbingState = "Disabled"
+	ProfileLogScrubbingStateEnabled  ProfileLogScrubbingState = "Enabled"
+)
+
+type ScrubbingRuleEntryMatchVariable string
+
+const (
+	ScrubbingRuleEntryMatchVariableQueryStringArgNames ScrubbingRuleEntryMatchVariable = "QueryStringArgNames"
+	ScrubbingRuleEntryMatchVariableRequestIPAddress    ScrubbingRuleEntryMatchVariable = "RequestIPAddress"
+	ScrubbingRuleEntryMatchVariableRequestUri          ScrubbingRuleEntryMatchVariable = "RequestUri"
+)
+
+type ScrubbingRuleEntryMatchOperator string
+
+const (
+	ScrubbingRuleEntryMatchOperatorEqualsAny ScrubbingRuleEntryMatchOperator = "EqualsAny"
+)
+
+type ScrubbingRuleEntryState string
+
+const (
+	ScrubbingRuleEntryStateDisabled ScrubbingRuleEntryState = "Disabled"
+	ScrubbingRuleEntryStateEnabled  ScrubbingRuleEntryState = "Enabled"
+)
+
+type ProfileLogScrubbingModel struct {
+	Properties *ProfileLogScrubbingProperties `json:"properties,omitempty"`
+}
+
+type ProfileLogScrubbingProperties struct {
+	LogScrubbing *ProfileLogScrubbing `json:"logScrubbing,omitempty"`
+}
+
+type ProfileLogScrubbing struct {
+	ScrubbingRules *[]ProfileScrubbingRules  `json:"scrubbingRules,omitempty"`
+	State          *ProfileLogScrubbingState `json:"state,omitempty"`
+}
+
+type ProfileScrubbingRules struct {
+	MatchVariable         ScrubbingRuleEntryMatchVariable `json:"matchVariable"`
+	Selector              *string                         `json:"selector,omitempty"`
+	SelectorMatchOperator ScrubbingRuleEntryMatchOperator `json:"selectorMatchOperator"`
+	State                 *ScrubbingRuleEntryState        `json:"state,omitempty"`
+}
+
+type ProfileLogScrubbingGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ProfileLogScrubbingModel
+}
+
+// Get retrieves the Log Scrubbing configuration for the specified Front Door Profile
+func (c FrontDoorProfileLogScrubbingClient) Get(ctx context.Context, id parse.FrontDoorProfileId) (result ProfileLogScrubbingGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateThenPoll patches the Log Scrubbing configuration of the specified Front Door Profile, then polls until the
+// Front Door Profile has been updated
+func (c FrontDoorProfileLogScrubbingClient) UpdateThenPoll(ctx context.Context, id parse.FrontDoorProfileId, input ProfileLogScrubbingModel) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPatch,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Update: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Update: %+v", err)
+	}
+
+	return nil
+}
@@ -335,6 +335,28 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	// before creating check to see if the resource exists in the soft delete state
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	deletedAccountId := cognitiveservicesaccounts.NewDeletedAccountID(id.SubscriptionId, location, id.ResourceGroupName, id.AccountName)
+	softDeleted, err := client.DeletedAccountsGet(ctx, deletedAccountId)
+	if err != nil {
+		// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+		if !response.WasNotFound(softDeleted.HttpResponse) && !response.WasForbidden(softDeleted.HttpResponse) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted %s: %+v", id, err)
+		}
+	}
+
+	// if so, does the user want us to recover it?
+	recoverSoftDeleted := false
+	if !response.WasNotFound(softDeleted.HttpResponse) && !response.WasForbidden(softDeleted.HttpResponse) {
+		if !meta.(*clients.Client).Features.CognitiveAccount.RecoverSoftDeleted {
+			// this exists but the users opted out, so they must import it out-of-band
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedCognitiveAccountErrorFmt(id.AccountName, location))
+		}
+
+		recoverSoftDeleted = true
+	}
+
 	sku, err := expandAccountSkuName(d.Get("sku_name").(string))
 	if err != nil {
 		return fmt.Errorf("expanding sku_name for %s: %v", id, err)
@@ -369,7 +391,7 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	props := cognitiveservicesaccounts.Account{
 		Kind:     utils.String(kind),
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Location: utils.String(location),
 		Sku:      sku,
 		Properties: &cognitiveservicesaccounts.AccountProperties{
 			ApiProperties:                 apiProps,
@@ -392,6 +414,11 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 	props.Identity = identity
 
+	if recoverSoftDeleted {
+		log.Printf("[DEBUG] Recovering Soft-Deleted %s", id)
+		props.Properties.Restore = utils.Bool(true)
+	}
+
 	if _, err := client.AccountsCreate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -665,6 +692,23 @@ func expandAccountSkuName(skuName string) (*cognitiveservicesaccounts.Sku, error
 	}, nil
 }
 
+func optedOutOfRecoveringSoftDeletedCognitiveAccountErrorFmt(name, location string) string {
+	message := `
+An existing soft-deleted Cognitive Account exists with the Name %q in the location %q, however
+automatically recovering this Cognitive Account has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted Cognitive Account when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`
+	return fmt.Sprintf(message, name, location)
+}
+
 func cognitiveAccountStateRefreshFunc(ctx context.Context, client *cognitiveservicesaccounts.CognitiveServicesAccountsClient, id cognitiveservicesaccounts.AccountId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.AccountsGet(ctx, id)
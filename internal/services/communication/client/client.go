@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -12,6 +13,8 @@ type Client struct {
 	ServiceClient *communicationservices.CommunicationServicesClient
 
 	EmailServicesClient *emailservices.EmailServicesClient
+
+	DomainClient *domains.DomainsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -27,8 +30,15 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(emailServicesClient.Client, o.Authorizers.ResourceManager)
 
+	domainClient, err := domains.NewDomainsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Domain client: %+v", err)
+	}
+	o.Configure(domainClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ServiceClient:       servicesClient,
 		EmailServicesClient: emailServicesClient,
+		DomainClient:        domainClient,
 	}, nil
 }
@@ -0,0 +1,476 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = EmailCommunicationServiceDomainResource{}
+
+type EmailCommunicationServiceDomainResource struct{}
+
+type EmailCommunicationServiceDomainModel struct {
+	Name                          string                      `tfschema:"name"`
+	EmailServiceId                string                      `tfschema:"email_service_id"`
+	DomainManagement              string                      `tfschema:"domain_management"`
+	UserEngagementTrackingEnabled bool                        `tfschema:"user_engagement_tracking_enabled"`
+	AwaitVerification             bool                        `tfschema:"await_verification"`
+	Tags                          map[string]string           `tfschema:"tags"`
+	FromSenderDomain              string                      `tfschema:"from_sender_domain"`
+	MailFromSenderDomain          string                      `tfschema:"mail_from_sender_domain"`
+	VerificationRecords           []DomainVerificationRecords `tfschema:"verification_records"`
+}
+
+type DomainVerificationRecords struct {
+	Domain []DomainDnsRecord `tfschema:"domain"`
+	SPF    []DomainDnsRecord `tfschema:"spf"`
+	DKIM   []DomainDnsRecord `tfschema:"dkim"`
+	DKIM2  []DomainDnsRecord `tfschema:"dkim2"`
+	DMARC  []DomainDnsRecord `tfschema:"dmarc"`
+}
+
+type DomainDnsRecord struct {
+	Name   string `tfschema:"name"`
+	Type   string `tfschema:"type"`
+	Value  string `tfschema:"value"`
+	TTL    int64  `tfschema:"ttl"`
+	Status string `tfschema:"status"`
+}
+
+// emailDomainVerificationTypes are the verification types required for a Domain to be able to send email, these are
+// verified in this order since the SPF and DKIM records can only be verified once the Domain itself has been verified
+var emailDomainVerificationTypes = []domains.VerificationType{
+	domains.VerificationTypeDomain,
+	domains.VerificationTypeSPF,
+	domains.VerificationTypeDKIM,
+	domains.VerificationTypeDKIMTwo,
+}
+
+func (EmailCommunicationServiceDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"email_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: emailservices.ValidateEmailServiceID,
+		},
+
+		"domain_management": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(domains.PossibleValuesForDomainManagement(), false),
+		},
+
+		"user_engagement_tracking_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"await_verification": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (EmailCommunicationServiceDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	dnsRecordSchema := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"value": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ttl": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"from_sender_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mail_from_sender_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"verification_records": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"domain": dnsRecordSchema(),
+
+					"spf": dnsRecordSchema(),
+
+					"dkim": dnsRecordSchema(),
+
+					"dkim2": dnsRecordSchema(),
+
+					"dmarc": dnsRecordSchema(),
+				},
+			},
+		},
+	}
+}
+
+func (EmailCommunicationServiceDomainResource) ModelObject() interface{} {
+	return &EmailCommunicationServiceDomainModel{}
+}
+
+func (EmailCommunicationServiceDomainResource) ResourceType() string {
+	return "azurerm_email_communication_service_domain"
+}
+
+func (EmailCommunicationServiceDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return domains.ValidateDomainID
+}
+
+func (r EmailCommunicationServiceDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainClient
+
+			var model EmailCommunicationServiceDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			emailServiceId, err := emailservices.ParseEmailServiceID(model.EmailServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := domains.NewDomainID(emailServiceId.SubscriptionId, emailServiceId.ResourceGroupName, emailServiceId.EmailServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			userEngagementTracking := domains.UserEngagementTrackingDisabled
+			if model.UserEngagementTrackingEnabled {
+				userEngagementTracking = domains.UserEngagementTrackingEnabled
+			}
+
+			param := domains.DomainResource{
+				// The location is always `global` from the Azure Portal
+				Location: location.Normalize("global"),
+				Properties: &domains.DomainProperties{
+					DomainManagement:       domains.DomainManagement(model.DomainManagement),
+					UserEngagementTracking: pointer.To(userEngagementTracking),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model.AwaitVerification {
+				if err := awaitEmailCommunicationServiceDomainVerification(ctx, client, id); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EmailCommunicationServiceDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("user_engagement_tracking_enabled", "tags") {
+				userEngagementTracking := domains.UserEngagementTrackingDisabled
+				if model.UserEngagementTrackingEnabled {
+					userEngagementTracking = domains.UserEngagementTrackingEnabled
+				}
+
+				param := domains.UpdateDomainRequestParameters{
+					Properties: &domains.UpdateDomainProperties{
+						UserEngagementTracking: pointer.To(userEngagementTracking),
+					},
+					Tags: pointer.To(model.Tags),
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, param); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
+			}
+
+			if model.AwaitVerification && metadata.ResourceData.HasChange("await_verification") {
+				if err := awaitEmailCommunicationServiceDomainVerification(ctx, client, *id); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (EmailCommunicationServiceDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := EmailCommunicationServiceDomainModel{
+				Name:           id.DomainName,
+				EmailServiceId: emailservices.NewEmailServiceID(id.SubscriptionId, id.ResourceGroupName, id.EmailServiceName).ID(),
+			}
+
+			// `await_verification` isn't returned by the API, so we retrieve it from the config/state
+			state.AwaitVerification = metadata.ResourceData.Get("await_verification").(bool)
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DomainManagement = string(props.DomainManagement)
+					state.UserEngagementTrackingEnabled = pointer.From(props.UserEngagementTracking) == domains.UserEngagementTrackingEnabled
+					state.FromSenderDomain = pointer.From(props.FromSenderDomain)
+					state.MailFromSenderDomain = pointer.From(props.MailFromSenderDomain)
+					state.VerificationRecords = flattenEmailCommunicationServiceDomainVerificationRecords(props.VerificationRecords, props.VerificationStates)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (EmailCommunicationServiceDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// awaitEmailCommunicationServiceDomainVerification initiates the verification of each of the DNS records required to
+// send email which isn't yet verified, then waits for it to be verified - which requires the DNS records exposed in
+// `verification_records` to have been created beforehand.
+func awaitEmailCommunicationServiceDomainVerification(ctx context.Context, client *domains.DomainsClient, id domains.DomainId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	for _, verificationType := range emailDomainVerificationTypes {
+		status, err := emailCommunicationServiceDomainVerificationStatus(ctx, client, id, verificationType)
+		if err != nil {
+			return err
+		}
+		if status == string(domains.VerificationStatusVerified) {
+			continue
+		}
+
+		if status != string(domains.VerificationStatusVerificationRequested) && status != string(domains.VerificationStatusVerificationInProgress) {
+			param := domains.VerificationParameter{
+				VerificationType: verificationType,
+			}
+			if err := client.InitiateVerificationThenPoll(ctx, id, param); err != nil {
+				return fmt.Errorf("initiating the %s verification for %s: %+v", verificationType, id, err)
+			}
+		}
+
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending: []string{
+				string(domains.VerificationStatusNotStarted),
+				string(domains.VerificationStatusVerificationRequested),
+				string(domains.VerificationStatusVerificationInProgress),
+			},
+			Target: []string{
+				string(domains.VerificationStatusVerified),
+			},
+			Refresh: func() (interface{}, string, error) {
+				status, err := emailCommunicationServiceDomainVerificationStatus(ctx, client, id, verificationType)
+				if err != nil {
+					return nil, "", err
+				}
+				return status, status, nil
+			},
+			MinTimeout:   15 * time.Second,
+			PollInterval: 15 * time.Second,
+			Timeout:      time.Until(deadline),
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for the %s verification for %s: %+v", verificationType, id, err)
+		}
+	}
+
+	return nil
+}
+
+func emailCommunicationServiceDomainVerificationStatus(ctx context.Context, client *domains.DomainsClient, id domains.DomainId, verificationType domains.VerificationType) (string, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var states *domains.DomainPropertiesVerificationStates
+	if model := resp.Model; model != nil && model.Properties != nil {
+		states = model.Properties.VerificationStates
+	}
+	if states == nil {
+		return string(domains.VerificationStatusNotStarted), nil
+	}
+
+	var record *domains.VerificationStatusRecord
+	switch verificationType {
+	case domains.VerificationTypeDomain:
+		record = states.Domain
+	case domains.VerificationTypeSPF:
+		record = states.SPF
+	case domains.VerificationTypeDKIM:
+		record = states.DKIM
+	case domains.VerificationTypeDKIMTwo:
+		record = states.DKIM2
+	case domains.VerificationTypeDMARC:
+		record = states.DMARC
+	}
+	if record == nil || record.Status == nil {
+		return string(domains.VerificationStatusNotStarted), nil
+	}
+
+	if *record.Status == domains.VerificationStatusVerificationFailed {
+		return "", fmt.Errorf("the %s verification for %s failed with the error code %q - check that the DNS record within `verification_records` has been created", verificationType, id, pointer.From(record.ErrorCode))
+	}
+
+	return string(*record.Status), nil
+}
+
+func flattenEmailCommunicationServiceDomainVerificationRecords(records *domains.DomainPropertiesVerificationRecords, states *domains.DomainPropertiesVerificationStates) []DomainVerificationRecords {
+	if records == nil {
+		return []DomainVerificationRecords{}
+	}
+
+	if states == nil {
+		states = &domains.DomainPropertiesVerificationStates{}
+	}
+
+	return []DomainVerificationRecords{
+		{
+			Domain: flattenEmailCommunicationServiceDomainDnsRecord(records.Domain, states.Domain),
+			SPF:    flattenEmailCommunicationServiceDomainDnsRecord(records.SPF, states.SPF),
+			DKIM:   flattenEmailCommunicationServiceDomainDnsRecord(records.DKIM, states.DKIM),
+			DKIM2:  flattenEmailCommunicationServiceDomainDnsRecord(records.DKIM2, states.DKIM2),
+			DMARC:  flattenEmailCommunicationServiceDomainDnsRecord(records.DMARC, states.DMARC),
+		},
+	}
+}
+
+func flattenEmailCommunicationServiceDomainDnsRecord(record *domains.DnsRecord, state *domains.VerificationStatusRecord) []DomainDnsRecord {
+	if record == nil {
+		return []DomainDnsRecord{}
+	}
+
+	output := DomainDnsRecord{
+		Name:  pointer.From(record.Name),
+		Type:  pointer.From(record.Type),
+		Value: pointer.From(record.Value),
+		TTL:   pointer.From(record.Ttl),
+	}
+	if state != nil {
+		output.Status = string(pointer.From(state.Status))
+	}
+
+	return []DomainDnsRecord{output}
+}
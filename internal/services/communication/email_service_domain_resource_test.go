@@ -0,0 +1,188 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailServiceDomainTestResource struct{}
+
+func TestAccEmailServiceDomain_azureManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailServiceDomainTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("from_sender_domain").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailServiceDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailServiceDomainTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEmailServiceDomain_customerManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailServiceDomainTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customerManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("verification_records.0.domain.0.value").Exists(),
+				check.That(data.ResourceName).Key("verification_records.0.spf.0.value").Exists(),
+				check.That(data.ResourceName).Key("verification_records.0.dkim.0.value").Exists(),
+				check.That(data.ResourceName).Key("verification_records.0.dkim2.0.value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailServiceDomain_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailServiceDomainTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.azureManagedUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.azureManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailServiceDomainTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := domains.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.DomainClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EmailServiceDomainTestResource) azureManaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+}
+`, r.template(data))
+}
+
+func (r EmailServiceDomainTestResource) azureManagedUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+
+  user_engagement_tracking_enabled = true
+  await_verification               = true
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (r EmailServiceDomainTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "import" {
+  name              = azurerm_email_communication_service_domain.test.name
+  email_service_id  = azurerm_email_communication_service_domain.test.email_service_id
+  domain_management = azurerm_email_communication_service_domain.test.domain_management
+}
+`, r.azureManaged(data))
+}
+
+func (r EmailServiceDomainTestResource) customerManaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "acctest-%d.example.com"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "CustomerManaged"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EmailServiceDomainTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-communicationservice-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_email_communication_service" "test" {
+  name                = "acctest-CommunicationService-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
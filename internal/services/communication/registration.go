@@ -31,6 +31,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		EmailCommunicationServiceResource{},
+		EmailCommunicationServiceDomainResource{},
 		CommunicationServiceResource{},
 	}
 }
@@ -0,0 +1,151 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/disks"
+)
+
+// NOTE: `performancePlus` isn't available in the 2022-03-02 API used by the vendored SDK, as such Managed Disks
+// using Performance Plus are created and retrieved here against the 2022-07-02 API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const disksApiVersion = "2022-07-02"
+
+type DisksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDisksClientWithBaseURI(endpoint string) DisksClient {
+	return DisksClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/disks/%s", disksApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type Disk struct {
+	ExtendedLocation  *edgezones.Model   `json:"extendedLocation,omitempty"`
+	Id                *string            `json:"id,omitempty"`
+	Location          string             `json:"location"`
+	ManagedBy         *string            `json:"managedBy,omitempty"`
+	ManagedByExtended *[]string          `json:"managedByExtended,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	Properties        *DiskProperties    `json:"properties,omitempty"`
+	Sku               *disks.DiskSku     `json:"sku,omitempty"`
+	Tags              *map[string]string `json:"tags,omitempty"`
+	Type              *string            `json:"type,omitempty"`
+	Zones             *zones.Schema      `json:"zones,omitempty"`
+}
+
+// DiskProperties extends the Disk Properties from the SDK, the `creationData` field defined here takes precedence
+// over the one in the embedded struct
+type DiskProperties struct {
+	disks.DiskProperties
+	CreationData CreationData `json:"creationData"`
+}
+
+type CreationData struct {
+	disks.CreationData
+	PerformancePlus *bool `json:"performancePlus,omitempty"`
+}
+
+type DiskCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type DiskGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *Disk
+}
+
+// CreateOrUpdate ...
+func (c DisksClient) CreateOrUpdate(ctx context.Context, id disks.DiskId, input Disk) (result DiskCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "disks.DisksClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "disks.DisksClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "disks.DisksClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c DisksClient) CreateOrUpdateThenPoll(ctx context.Context, id disks.DiskId, input Disk) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c DisksClient) Get(ctx context.Context, id disks.DiskId) (result DiskGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "disks.DisksClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "disks.DisksClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "disks.DisksClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c DisksClient) prepare(ctx context.Context, id disks.DiskId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": disksApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
 )
 
@@ -30,6 +31,7 @@ type Client struct {
 	DedicatedHostsClient             *dedicatedhosts.DedicatedHostsClient
 	DedicatedHostGroupsClient        *dedicatedhostgroups.DedicatedHostGroupsClient
 	DisksClient                      *disks.DisksClient
+	DisksWorkaroundClient            *azuresdkhacks.DisksClient
 	DiskAccessClient                 *diskaccesses.DiskAccessesClient
 	DiskEncryptionSetsClient         *diskencryptionsets.DiskEncryptionSetsClient
 	GalleriesClient                  *galleries.GalleriesClient
@@ -73,6 +75,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	disksClient := disks.NewDisksClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&disksClient.Client, o.ResourceManagerAuthorizer)
 
+	disksWorkaroundClient := azuresdkhacks.NewDisksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&disksWorkaroundClient.Client, o.ResourceManagerAuthorizer)
+
 	diskAccessClient := diskaccesses.NewDiskAccessesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&diskAccessClient.Client, o.ResourceManagerAuthorizer)
 
@@ -149,6 +154,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DedicatedHostsClient:             &dedicatedHostsClient,
 		DedicatedHostGroupsClient:        &dedicatedHostGroupsClient,
 		DisksClient:                      &disksClient,
+		DisksWorkaroundClient:            &disksWorkaroundClient,
 		DiskAccessClient:                 &diskAccessClient,
 		DiskEncryptionSetsClient:         &diskEncryptionSetsClient,
 		GalleriesClient:                  &galleriesClient,
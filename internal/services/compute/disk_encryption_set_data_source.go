@@ -40,6 +40,11 @@ func dataSourceDiskEncryptionSet() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"key_rotation_last_timestamp": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -71,6 +76,12 @@ func dataSourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{}
 
 	if props := model.Properties; props != nil {
 		d.Set("auto_key_rotation_enabled", props.RotationToLatestKeyVersionEnabled)
+
+		keyRotationLastTimestamp := ""
+		if props.LastKeyRotationTimestamp != nil {
+			keyRotationLastTimestamp = *props.LastKeyRotationTimestamp
+		}
+		d.Set("key_rotation_last_timestamp", keyRotationLastTimestamp)
 	}
 
 	return tags.FlattenAndSet(d, model.Tags)
@@ -90,6 +90,11 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 			"identity": commonschema.SystemAssignedUserAssignedIdentityRequired(),
 
 			"tags": commonschema.Tags(),
+
+			"key_rotation_last_timestamp": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -219,6 +224,12 @@ func resourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{})
 			federatedClientId = *props.FederatedClientId
 		}
 		d.Set("federated_client_id", federatedClientId)
+
+		keyRotationLastTimestamp := ""
+		if props.LastKeyRotationTimestamp != nil {
+			keyRotationLastTimestamp = *props.LastKeyRotationTimestamp
+		}
+		d.Set("key_rotation_last_timestamp", keyRotationLastTimestamp)
 	}
 
 	flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
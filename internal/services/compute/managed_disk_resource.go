@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -44,10 +45,10 @@ func resourceManagedDisk() *pluginsdk.Resource {
 			0: migration.ManagedDiskV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := disks.ParseDiskID(id)
 			return err
-		}),
+		}, importManagedDisk),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -285,6 +286,12 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"performance_plus_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"zone": commonschema.ZoneSingleOptionalForceNew(),
 
 			"tags": commonschema.Tags(),
@@ -298,6 +305,32 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				}
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if !diff.Get("performance_plus_enabled").(bool) {
+					return nil
+				}
+
+				if diff.NewValueKnown("storage_account_type") {
+					switch diff.Get("storage_account_type").(string) {
+					case string(disks.DiskStorageAccountTypesPremiumLRS):
+					case string(disks.DiskStorageAccountTypesPremiumZRS):
+					case string(disks.DiskStorageAccountTypesStandardSSDLRS):
+					case string(disks.DiskStorageAccountTypesStandardSSDZRS):
+					case string(disks.DiskStorageAccountTypesStandardLRS):
+					default:
+						return fmt.Errorf("`performance_plus_enabled` can only be set to true when `storage_account_type` is set to `Premium_LRS`, `Premium_ZRS`, `StandardSSD_LRS`, `StandardSSD_ZRS` or `Standard_LRS`")
+					}
+				}
+
+				// Performance Plus is only available for Disks larger than 512GB
+				if diff.NewValueKnown("disk_size_gb") {
+					if diskSizeGB := diff.Get("disk_size_gb").(int); diskSizeGB != 0 && diskSizeGB <= 512 {
+						return fmt.Errorf("`performance_plus_enabled` can only be set to true when `disk_size_gb` is larger than 512GB")
+					}
+				}
+
+				return nil
+			}),
 		),
 	}
 }
@@ -570,9 +603,20 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err := client.CreateOrUpdateThenPoll(ctx, id, createDisk)
-	if err != nil {
-		return fmt.Errorf("creating/updating Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if d.Get("performance_plus_enabled").(bool) {
+		if diskSizeGB == 0 {
+			return fmt.Errorf("`disk_size_gb` must be specified when `performance_plus_enabled` is set to true")
+		}
+
+		// Performance Plus can only be enabled when the Disk is created
+		if err := meta.(*clients.Client).Compute.DisksWorkaroundClient.CreateOrUpdateThenPoll(ctx, id, expandManagedDiskWithPerformancePlus(createDisk)); err != nil {
+			return fmt.Errorf("creating/updating Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	} else {
+		err := client.CreateOrUpdateThenPoll(ctx, id, createDisk)
+		if err != nil {
+			return fmt.Errorf("creating/updating Managed Disk %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
 	}
 
 	read, err := client.Get(ctx, id)
@@ -626,6 +670,13 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("max_shares") {
+		// the number of shares can only be changed when the Disk is detached from all Virtual Machines, or when those
+		// are deallocated - which we can only do when the Disk is attached to a single Virtual Machine
+		if attachedTo := pointer.From(disk.Model.ManagedByExtended); len(attachedTo) > 1 {
+			return fmt.Errorf("`max_shares` can only be changed when %s is detached from all Virtual Machines, or when all Virtual Machines it's attached to are deallocated - it's currently attached to: %s", *id, strings.Join(attachedTo, ", "))
+		}
+		shouldShutDown = true
+
 		diskUpdate.Properties.MaxShares = utils.Int64(int64(maxShares))
 		var skuName disks.DiskStorageAccountTypes
 		for _, v := range disks.PossibleValuesForDiskStorageAccountTypes() {
@@ -1090,8 +1141,20 @@ func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error
 				onDemandBurstingEnabled = *props.BurstingEnabled
 			}
 			d.Set("on_demand_bursting_enabled", onDemandBurstingEnabled)
+
 		}
 
+		// `performancePlus` isn't exposed in the version of the API used by the SDK, so this is only looked up via the
+		// Workaround Client when it's been enabled (or detected during import) to avoid a second request for every disk
+		performancePlusEnabled := false
+		if d.Get("performance_plus_enabled").(bool) {
+			performancePlusEnabled, err = managedDiskPerformancePlusEnabled(ctx, meta.(*clients.Client).Compute.DisksWorkaroundClient, *id)
+			if err != nil {
+				return err
+			}
+		}
+		d.Set("performance_plus_enabled", performancePlusEnabled)
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
@@ -1100,6 +1163,57 @@ func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error
 	return nil
 }
 
+func importManagedDisk(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, err := disks.ParseDiskID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	performancePlusEnabled, err := managedDiskPerformancePlusEnabled(ctx, meta.(*clients.Client).Compute.DisksWorkaroundClient, *id)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+	d.Set("performance_plus_enabled", performancePlusEnabled)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func managedDiskPerformancePlusEnabled(ctx context.Context, client *azuresdkhacks.DisksClient, id disks.DiskId) (bool, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("retrieving Performance Plus for %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.CreationData.PerformancePlus != nil {
+		return *model.Properties.CreationData.PerformancePlus, nil
+	}
+
+	return false, nil
+}
+
+func expandManagedDiskWithPerformancePlus(input disks.Disk) azuresdkhacks.Disk {
+	output := azuresdkhacks.Disk{
+		ExtendedLocation: input.ExtendedLocation,
+		Location:         input.Location,
+		Name:             input.Name,
+		Sku:              input.Sku,
+		Tags:             input.Tags,
+		Zones:            input.Zones,
+	}
+
+	if input.Properties != nil {
+		output.Properties = &azuresdkhacks.DiskProperties{
+			DiskProperties: *input.Properties,
+			CreationData: azuresdkhacks.CreationData{
+				CreationData:    input.Properties.CreationData,
+				PerformancePlus: utils.Bool(true),
+			},
+		}
+	}
+
+	return output
+}
+
 func resourceManagedDiskDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.DisksClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -644,6 +644,22 @@ func TestAccManagedDisk_update_withOnDemandBurstingEnabled(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_create_withPerformancePlusEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.create_withPerformancePlusEnabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("performance_plus_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccManagedDisk_create_withHyperVGeneration(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -2301,6 +2317,27 @@ resource "azurerm_managed_disk" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ManagedDiskResource) create_withPerformancePlusEnabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+resource "azurerm_managed_disk" "test" {
+  name                     = "acctestd-%d"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  storage_account_type     = "Premium_LRS"
+  create_option            = "Empty"
+  disk_size_gb             = "1024"
+  performance_plus_enabled = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ManagedDiskResource) update_withOnDemandBurstingEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,132 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/certificates"
+)
+
+// ContainerAppsCertificatesClient supports Certificates sourced from a Key Vault, which requires the
+// `certificateKeyVaultProperties` field that isn't present in the 2022-03-01 API.
+// The remaining operations (Delete/Update) continue to use the SDK client.
+type ContainerAppsCertificatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContainerAppsCertificatesClientWithBaseURI(endpoint string) ContainerAppsCertificatesClient {
+	return ContainerAppsCertificatesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/certificates/%s", defaultApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type Certificate struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *CertificateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type CertificateProperties struct {
+	CertificateKeyVaultProperties *CertificateKeyVaultProperties `json:"certificateKeyVaultProperties,omitempty"`
+	ExpirationDate                *string                        `json:"expirationDate,omitempty"`
+	IssueDate                     *string                        `json:"issueDate,omitempty"`
+	Issuer                        *string                        `json:"issuer,omitempty"`
+	Password                      *string                        `json:"password,omitempty"`
+	ProvisioningState             *string                        `json:"provisioningState,omitempty"`
+	PublicKeyHash                 *string                        `json:"publicKeyHash,omitempty"`
+	SubjectName                   *string                        `json:"subjectName,omitempty"`
+	Thumbprint                    *string                        `json:"thumbprint,omitempty"`
+	Valid                         *bool                          `json:"valid,omitempty"`
+	Value                         *string                        `json:"value,omitempty"`
+}
+
+type CertificateKeyVaultProperties struct {
+	Identity    *string `json:"identity,omitempty"`
+	KeyVaultUrl *string `json:"keyVaultUrl,omitempty"`
+}
+
+type CertificateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *Certificate
+}
+
+// CreateOrUpdate ...
+func (c ContainerAppsCertificatesClient) CreateOrUpdate(ctx context.Context, id certificates.CertificateId, input Certificate) (result CertificateOperationResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "certificates.ContainerAppsCertificatesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "certificates.ContainerAppsCertificatesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "certificates.ContainerAppsCertificatesClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Get ...
+func (c ContainerAppsCertificatesClient) Get(ctx context.Context, id certificates.CertificateId) (result CertificateOperationResponse, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": defaultApiVersion,
+		}))
+
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "certificates.ContainerAppsCertificatesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "certificates.ContainerAppsCertificatesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "certificates.ContainerAppsCertificatesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
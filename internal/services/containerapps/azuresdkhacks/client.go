@@ -0,0 +1,32 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: Session Pools and Key Vault references for Certificates aren't available in the 2022-03-01 API
+// used by the vendored SDK, as such these clients are implemented here against the 2025-01-01 API until
+// the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2025-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("hashicorp/go-azure-sdk/containerappssessionpools/%s", defaultApiVersion)
+}
+
+type ContainerAppsSessionPoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContainerAppsSessionPoolsClientWithBaseURI(endpoint string) ContainerAppsSessionPoolsClient {
+	return ContainerAppsSessionPoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
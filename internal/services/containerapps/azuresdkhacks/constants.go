@@ -0,0 +1,88 @@
+package azuresdkhacks
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ContainerType string
+
+const (
+	ContainerTypeCustomContainer ContainerType = "CustomContainer"
+	ContainerTypePythonLTS       ContainerType = "PythonLTS"
+)
+
+func PossibleValuesForContainerType() []string {
+	return []string{
+		string(ContainerTypeCustomContainer),
+		string(ContainerTypePythonLTS),
+	}
+}
+
+type IdentitySettingsLifeCycle string
+
+const (
+	IdentitySettingsLifeCycleAll  IdentitySettingsLifeCycle = "All"
+	IdentitySettingsLifeCycleInit IdentitySettingsLifeCycle = "Init"
+	IdentitySettingsLifeCycleMain IdentitySettingsLifeCycle = "Main"
+	IdentitySettingsLifeCycleNone IdentitySettingsLifeCycle = "None"
+)
+
+func PossibleValuesForIdentitySettingsLifeCycle() []string {
+	return []string{
+		string(IdentitySettingsLifeCycleAll),
+		string(IdentitySettingsLifeCycleInit),
+		string(IdentitySettingsLifeCycleMain),
+		string(IdentitySettingsLifeCycleNone),
+	}
+}
+
+type LifecycleType string
+
+const (
+	LifecycleTypeOnContainerExit LifecycleType = "OnContainerExit"
+	LifecycleTypeTimed           LifecycleType = "Timed"
+)
+
+func PossibleValuesForLifecycleType() []string {
+	return []string{
+		string(LifecycleTypeOnContainerExit),
+		string(LifecycleTypeTimed),
+	}
+}
+
+type PoolManagementType string
+
+const (
+	PoolManagementTypeDynamic PoolManagementType = "Dynamic"
+	PoolManagementTypeManual  PoolManagementType = "Manual"
+)
+
+func PossibleValuesForPoolManagementType() []string {
+	return []string{
+		string(PoolManagementTypeDynamic),
+		string(PoolManagementTypeManual),
+	}
+}
+
+type SessionNetworkStatus string
+
+const (
+	SessionNetworkStatusEgressDisabled SessionNetworkStatus = "EgressDisabled"
+	SessionNetworkStatusEgressEnabled  SessionNetworkStatus = "EgressEnabled"
+)
+
+func PossibleValuesForSessionNetworkStatus() []string {
+	return []string{
+		string(SessionNetworkStatusEgressDisabled),
+		string(SessionNetworkStatusEgressEnabled),
+	}
+}
+
+type SessionPoolProvisioningState string
+
+const (
+	SessionPoolProvisioningStateCanceled   SessionPoolProvisioningState = "Canceled"
+	SessionPoolProvisioningStateDeleting   SessionPoolProvisioningState = "Deleting"
+	SessionPoolProvisioningStateFailed     SessionPoolProvisioningState = "Failed"
+	SessionPoolProvisioningStateInProgress SessionPoolProvisioningState = "InProgress"
+	SessionPoolProvisioningStateSucceeded  SessionPoolProvisioningState = "Succeeded"
+)
@@ -0,0 +1,99 @@
+package azuresdkhacks
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type SessionPool struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *SessionPoolProperties                   `json:"properties,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type SessionPoolProperties struct {
+	ContainerType               *ContainerType                `json:"containerType,omitempty"`
+	CustomContainerTemplate     *CustomContainerTemplate      `json:"customContainerTemplate,omitempty"`
+	DynamicPoolConfiguration    *DynamicPoolConfiguration     `json:"dynamicPoolConfiguration,omitempty"`
+	EnvironmentId               *string                       `json:"environmentId,omitempty"`
+	ManagedIdentitySettings     *[]ManagedIdentitySetting     `json:"managedIdentitySettings,omitempty"`
+	NodeCount                   *int64                        `json:"nodeCount,omitempty"`
+	PoolManagementEndpoint      *string                       `json:"poolManagementEndpoint,omitempty"`
+	PoolManagementType          *PoolManagementType           `json:"poolManagementType,omitempty"`
+	ProvisioningState           *SessionPoolProvisioningState `json:"provisioningState,omitempty"`
+	ScaleConfiguration          *ScaleConfiguration           `json:"scaleConfiguration,omitempty"`
+	Secrets                     *[]SessionPoolSecret          `json:"secrets,omitempty"`
+	SessionNetworkConfiguration *SessionNetworkConfiguration  `json:"sessionNetworkConfiguration,omitempty"`
+}
+
+type CustomContainerTemplate struct {
+	Containers          *[]SessionContainer         `json:"containers,omitempty"`
+	Ingress             *SessionIngress             `json:"ingress,omitempty"`
+	RegistryCredentials *SessionRegistryCredentials `json:"registryCredentials,omitempty"`
+}
+
+type SessionContainer struct {
+	Args      *[]string                  `json:"args,omitempty"`
+	Command   *[]string                  `json:"command,omitempty"`
+	Env       *[]EnvironmentVar          `json:"env,omitempty"`
+	Image     *string                    `json:"image,omitempty"`
+	Name      *string                    `json:"name,omitempty"`
+	Resources *SessionContainerResources `json:"resources,omitempty"`
+}
+
+type EnvironmentVar struct {
+	Name      *string `json:"name,omitempty"`
+	SecretRef *string `json:"secretRef,omitempty"`
+	Value     *string `json:"value,omitempty"`
+}
+
+type SessionContainerResources struct {
+	Cpu    *float64 `json:"cpu,omitempty"`
+	Memory *string  `json:"memory,omitempty"`
+}
+
+type SessionIngress struct {
+	TargetPort *int64 `json:"targetPort,omitempty"`
+}
+
+type SessionRegistryCredentials struct {
+	Identity          *string `json:"identity,omitempty"`
+	PasswordSecretRef *string `json:"passwordSecretRef,omitempty"`
+	Server            *string `json:"server,omitempty"`
+	Username          *string `json:"username,omitempty"`
+}
+
+type DynamicPoolConfiguration struct {
+	LifecycleConfiguration *LifecycleConfiguration `json:"lifecycleConfiguration,omitempty"`
+}
+
+type LifecycleConfiguration struct {
+	CooldownPeriodInSeconds *int64         `json:"cooldownPeriodInSeconds,omitempty"`
+	LifecycleType           *LifecycleType `json:"lifecycleType,omitempty"`
+	MaxAlivePeriodInSeconds *int64         `json:"maxAlivePeriodInSeconds,omitempty"`
+}
+
+type ManagedIdentitySetting struct {
+	Identity  string                     `json:"identity"`
+	Lifecycle *IdentitySettingsLifeCycle `json:"lifecycle,omitempty"`
+}
+
+type ScaleConfiguration struct {
+	MaxConcurrentSessions *int64 `json:"maxConcurrentSessions,omitempty"`
+	ReadySessionInstances *int64 `json:"readySessionInstances,omitempty"`
+}
+
+type SessionPoolSecret struct {
+	Name  *string `json:"name,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type SessionNetworkConfiguration struct {
+	Status *SessionNetworkStatus `json:"status,omitempty"`
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
 )
 
 type Client struct {
@@ -15,7 +16,9 @@ type Client struct {
 	ContainerAppClient         *containerapps.ContainerAppsClient
 	ContainerAppRevisionClient *containerappsrevisions.ContainerAppsRevisionsClient
 	DaprComponentsClient       *daprcomponents.DaprComponentsClient
+	KeyVaultCertificatesClient *azuresdkhacks.ContainerAppsCertificatesClient
 	ManagedEnvironmentClient   *managedenvironments.ManagedEnvironmentsClient
+	SessionPoolsClient         *azuresdkhacks.ContainerAppsSessionPoolsClient
 	StorageClient              *managedenvironmentsstorages.ManagedEnvironmentsStoragesClient
 }
 
@@ -38,12 +41,20 @@ func NewClient(o *common.ClientOptions) *Client {
 	daprComponentClient := daprcomponents.NewDaprComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&daprComponentClient.Client, o.ResourceManagerAuthorizer)
 
+	keyVaultCertificatesClient := azuresdkhacks.NewContainerAppsCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&keyVaultCertificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	sessionPoolsClient := azuresdkhacks.NewContainerAppsSessionPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sessionPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		CertificatesClient:         &certificatesClient,
 		ContainerAppClient:         &containerAppsClient,
 		ContainerAppRevisionClient: &containerAppsRevisionsClient,
 		DaprComponentsClient:       &daprComponentClient,
+		KeyVaultCertificatesClient: &keyVaultCertificatesClient,
 		ManagedEnvironmentClient:   &managedEnvironmentClient,
+		SessionPoolsClient:         &sessionPoolsClient,
 		StorageClient:              &managedEnvironmentStoragesClient,
 	}
 }
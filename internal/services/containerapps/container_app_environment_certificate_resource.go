@@ -7,12 +7,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -28,6 +31,8 @@ type ContainerAppCertificateModel struct {
 	CertificatePassword string `tfschema:"certificate_password"`
 	CertificateBlob     string `tfschema:"certificate_blob_base64"`
 
+	CertificateKeyVault []CertificateKeyVaultModel `tfschema:"certificate_key_vault"`
+
 	// Read Only
 	SubjectName    string `tfschema:"subject_name"`
 	Issuer         string `tfschema:"issuer"`
@@ -36,6 +41,11 @@ type ContainerAppCertificateModel struct {
 	Thumbprint     string `tfschema:"thumbprint"`
 }
 
+type CertificateKeyVaultModel struct {
+	Identity         string `tfschema:"identity"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+}
+
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
 
 func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
@@ -70,18 +80,52 @@ func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*plug
 
 		"certificate_blob_base64": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			ForceNew:     true,
 			ValidateFunc: validation.StringIsBase64,
+			ExactlyOneOf: []string{"certificate_blob_base64", "certificate_key_vault"},
 			Description:  "The Certificate Private Key as a base64 encoded PFX or PEM.",
 		},
 
 		"certificate_password": {
-			Type:        pluginsdk.TypeString,
-			Required:    true,
-			ForceNew:    true,
-			Sensitive:   true,
-			Description: "The password for the Certificate.",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"certificate_key_vault"},
+			Description:   "The password for the Certificate.",
+		},
+
+		"certificate_key_vault": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"certificate_blob_base64", "certificate_key_vault"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						Description:  "The ID of the Key Vault Secret containing the Certificate. A versionless ID allows the Certificate to be rotated automatically.",
+					},
+
+					"identity": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  "System",
+						ValidateFunc: validation.Any(
+							validation.StringInSlice([]string{"System"}, false),
+							commonids.ValidateUserAssignedIdentityID,
+						),
+						Description: "The Managed Identity used to access the Key Vault, either `System` or the ID of a User Assigned Identity assigned to the Container App Environment.",
+					},
+				},
+			},
+			Description: "The Key Vault Secret containing the Certificate, accessed using a Managed Identity of the Container App Environment.",
 		},
 
 		"tags": commonschema.Tags(),
@@ -126,7 +170,7 @@ func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.ContainerApps.CertificatesClient
+			client := metadata.Client.ContainerApps.KeyVaultCertificatesClient
 			environmentsClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
 
 			var cert ContainerAppCertificateModel
@@ -146,14 +190,21 @@ func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
 			}
 
-			model := certificates.Certificate{
-				Location: env.Model.Location,
-				Name:     pointer.To(id.CertificateName),
-				Properties: &certificates.CertificateProperties{
-					Password: pointer.To(cert.CertificatePassword),
-					Value:    pointer.To(cert.CertificateBlob),
-				},
-				Tags: tags.Expand(cert.Tags),
+			model := azuresdkhacks.Certificate{
+				Location:   env.Model.Location,
+				Name:       pointer.To(id.CertificateName),
+				Properties: &azuresdkhacks.CertificateProperties{},
+				Tags:       tags.Expand(cert.Tags),
+			}
+
+			if len(cert.CertificateKeyVault) > 0 {
+				model.Properties.CertificateKeyVaultProperties = &azuresdkhacks.CertificateKeyVaultProperties{
+					Identity:    pointer.To(cert.CertificateKeyVault[0].Identity),
+					KeyVaultUrl: pointer.To(cert.CertificateKeyVault[0].KeyVaultSecretId),
+				}
+			} else {
+				model.Properties.Password = pointer.To(cert.CertificatePassword)
+				model.Properties.Value = pointer.To(cert.CertificateBlob)
 			}
 
 			if _, err := client.CreateOrUpdate(ctx, id, model); err != nil {
@@ -171,7 +222,7 @@ func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.ContainerApps.CertificatesClient
+			client := metadata.Client.ContainerApps.KeyVaultCertificatesClient
 
 			id, err := certificates.ParseCertificateID(metadata.ResourceData.Id())
 			if err != nil {
@@ -208,6 +259,15 @@ func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
 					state.IssueDate = pointer.From(props.IssueDate)
 					state.ExpirationDate = pointer.From(props.ExpirationDate)
 					state.Thumbprint = pointer.From(props.Thumbprint)
+
+					if kv := props.CertificateKeyVaultProperties; kv != nil {
+						state.CertificateKeyVault = []CertificateKeyVaultModel{
+							{
+								Identity:         pointer.From(kv.Identity),
+								KeyVaultSecretId: pointer.From(kv.KeyVaultUrl),
+							},
+						}
+					}
 				}
 			}
 
@@ -37,6 +37,8 @@ type ContainerAppModel struct {
 	Dapr         []helpers.Dapr              `tfschema:"dapr"`
 	Template     []helpers.ContainerTemplate `tfschema:"template"`
 
+	TrafficWeightManagedExternally bool `tfschema:"traffic_weight_managed_externally"`
+
 	Identity []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 
 	Tags map[string]interface{} `tfschema:"tags"`
@@ -96,6 +98,13 @@ func (r ContainerAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"ingress": helpers.ContainerAppIngressSchema(),
 
+		"traffic_weight_managed_externally": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should the traffic weights for the ingress be managed outside of this resource, for example by the `azurerm_container_app_traffic_weight` resource or a deployment pipeline?",
+		},
+
 		"registry": helpers.ContainerAppRegistrySchema(),
 
 		"secret": helpers.SecretsSchema(),
@@ -261,6 +270,11 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 							state.RevisionMode = string(pointer.From(config.ActiveRevisionsMode))
 						}
 						state.Ingress = helpers.FlattenContainerAppIngress(config.Ingress, id.ContainerAppName)
+						// when the traffic weights are managed elsewhere they're intentionally omitted to avoid a perpetual diff
+						state.TrafficWeightManagedExternally = metadata.ResourceData.Get("traffic_weight_managed_externally").(bool)
+						if state.TrafficWeightManagedExternally && len(state.Ingress) > 0 {
+							state.Ingress[0].TrafficWeights = nil
+						}
 						state.Registries = helpers.FlattenContainerAppRegistries(config.Registries)
 						state.Dapr = helpers.FlattenContainerAppDapr(config.Dapr)
 					}
@@ -348,7 +362,15 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("ingress") {
+				var existingTraffic *[]containerapps.TrafficWeight
+				if model.Properties.Configuration.Ingress != nil {
+					existingTraffic = model.Properties.Configuration.Ingress.Traffic
+				}
+
 				model.Properties.Configuration.Ingress = helpers.ExpandContainerAppIngress(state.Ingress, id.ContainerAppName)
+				if state.TrafficWeightManagedExternally && model.Properties.Configuration.Ingress != nil {
+					model.Properties.Configuration.Ingress.Traffic = existingTraffic
+				}
 			}
 
 			if metadata.ResourceData.HasChange("registry") {
@@ -406,6 +428,19 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff != nil {
+				if _, ok := metadata.ResourceDiff.GetOk("ingress"); ok {
+					trafficWeights := metadata.ResourceDiff.Get("ingress.0.traffic_weight").([]interface{})
+					managedExternally := metadata.ResourceDiff.Get("traffic_weight_managed_externally").(bool)
+					if managedExternally && len(trafficWeights) > 0 {
+						return fmt.Errorf("`ingress.0.traffic_weight` cannot be specified when `traffic_weight_managed_externally` is enabled")
+					}
+					if !managedExternally && len(trafficWeights) == 0 {
+						return fmt.Errorf("`ingress.0.traffic_weight` must be specified unless `traffic_weight_managed_externally` is enabled")
+					}
+				}
+			}
+
 			if metadata.ResourceDiff != nil && metadata.ResourceDiff.HasChange("secret") {
 				stateSecretsRaw, configSecretsRaw := metadata.ResourceDiff.GetChange("secret")
 				stateSecrets := stateSecretsRaw.(*schema.Set).List()
@@ -0,0 +1,744 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+type ContainerAppSessionPoolModel struct {
+	Name                 string `tfschema:"name"`
+	ResourceGroup        string `tfschema:"resource_group_name"`
+	ManagedEnvironmentId string `tfschema:"container_app_environment_id"`
+	Location             string `tfschema:"location"`
+
+	ContainerType           string                                   `tfschema:"container_type"`
+	PoolManagementType      string                                   `tfschema:"pool_management_type"`
+	MaxConcurrentSessions   int64                                    `tfschema:"max_concurrent_sessions"`
+	ReadySessionInstances   int64                                    `tfschema:"ready_session_instances"`
+	LifecycleType           string                                   `tfschema:"lifecycle_type"`
+	CooldownPeriodInSeconds int64                                    `tfschema:"cooldown_period_in_seconds"`
+	MaxAlivePeriodInSeconds int64                                    `tfschema:"max_alive_period_in_seconds"`
+	NetworkStatus           string                                   `tfschema:"network_status"`
+	CustomContainer         []SessionPoolCustomContainerModel        `tfschema:"custom_container"`
+	Secrets                 []helpers.Secret                         `tfschema:"secret"`
+	ManagedIdentitySettings []SessionPoolManagedIdentitySettingModel `tfschema:"managed_identity_setting"`
+
+	Identity []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+
+	Tags map[string]interface{} `tfschema:"tags"`
+
+	NodeCount              int64  `tfschema:"node_count"`
+	PoolManagementEndpoint string `tfschema:"pool_management_endpoint"`
+}
+
+type SessionPoolCustomContainerModel struct {
+	Name       string                    `tfschema:"name"`
+	Image      string                    `tfschema:"image"`
+	Cpu        float64                   `tfschema:"cpu"`
+	Memory     string                    `tfschema:"memory"`
+	Command    []string                  `tfschema:"command"`
+	Args       []string                  `tfschema:"args"`
+	Env        []helpers.ContainerEnvVar `tfschema:"env"`
+	TargetPort int64                     `tfschema:"target_port"`
+	Registry   []helpers.Registry        `tfschema:"registry"`
+}
+
+type SessionPoolManagedIdentitySettingModel struct {
+	Identity  string `tfschema:"identity"`
+	Lifecycle string `tfschema:"lifecycle"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppSessionPoolResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = ContainerAppSessionPoolResource{}
+
+func (r ContainerAppSessionPoolResource) ModelObject() interface{} {
+	return &ContainerAppSessionPoolModel{}
+}
+
+func (r ContainerAppSessionPoolResource) ResourceType() string {
+	return "azurerm_container_app_session_pool"
+}
+
+func (r ContainerAppSessionPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateSessionPoolID
+}
+
+func (r ContainerAppSessionPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name for this Container App Session Pool.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to host this Session Pool.",
+		},
+
+		"container_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.ContainerTypePythonLTS),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForContainerType(), false),
+			Description:  "The type of container used for the sessions in this Session Pool. Possible values are `CustomContainer` and `PythonLTS`.",
+		},
+
+		"pool_management_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(azuresdkhacks.PoolManagementTypeDynamic),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForPoolManagementType(), false),
+			Description:  "How the sessions in this Session Pool are managed. Possible values are `Dynamic` and `Manual`.",
+		},
+
+		"max_concurrent_sessions": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of sessions which can run concurrently in this Session Pool.",
+		},
+
+		"ready_session_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The number of sessions which are kept ready and waiting for allocation in this Session Pool.",
+		},
+
+		"lifecycle_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(azuresdkhacks.LifecycleTypeTimed),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForLifecycleType(), false),
+			Description:  "The lifecycle of the sessions in this Session Pool. Possible values are `OnContainerExit` and `Timed`.",
+		},
+
+		"cooldown_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(300, 3600),
+			Description:  "The number of seconds a session can be idle before it's terminated, when `lifecycle_type` is `Timed`.",
+		},
+
+		"max_alive_period_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of seconds a session can run for, when `lifecycle_type` is `OnContainerExit`.",
+		},
+
+		"network_status": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(azuresdkhacks.SessionNetworkStatusEgressDisabled),
+			ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForSessionNetworkStatus(), false),
+			Description:  "Whether the sessions in this Session Pool have outbound network access. Possible values are `EgressDisabled` and `EgressEnabled`.",
+		},
+
+		"custom_container": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ContainerAppContainerName,
+						Description:  "The name of the container.",
+					},
+
+					"image": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The image to use to create the container.",
+					},
+
+					"cpu": {
+						Type:         pluginsdk.TypeFloat,
+						Required:     true,
+						ValidateFunc: validate.ContainerCpu,
+						Description:  "The amount of vCPU to allocate to the container.",
+					},
+
+					"memory": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The amount of memory to allocate to the container, e.g. `0.5Gi`.",
+					},
+
+					"command": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						Description: "A command to pass to the container to override the default.",
+					},
+
+					"args": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						Description: "A list of args to pass to the container.",
+					},
+
+					"env": helpers.ContainerEnvVarSchema(),
+
+					"target_port": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+						Description:  "The port the container listens on for session requests.",
+					},
+
+					"registry": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"server": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									Description:  "The hostname for the Container Registry.",
+								},
+
+								"username": {
+									Type:        pluginsdk.TypeString,
+									Optional:    true,
+									Description: "The username to use for this Container Registry.",
+								},
+
+								"password_secret_name": {
+									Type:        pluginsdk.TypeString,
+									Optional:    true,
+									Description: "The name of the Secret Reference containing the password value for this user on the Container Registry.",
+								},
+
+								"identity": {
+									Type:        pluginsdk.TypeString,
+									Optional:    true,
+									Description: "ID of the System or User Managed Identity used to pull images from the Container Registry",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"secret": helpers.SecretsSchema(),
+
+		"managed_identity_setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"identity": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The Managed Identity to configure, either `system` or the ID of a User Assigned Identity.",
+					},
+
+					"lifecycle": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(azuresdkhacks.IdentitySettingsLifeCycleMain),
+						ValidateFunc: validation.StringInSlice(azuresdkhacks.PossibleValuesForIdentitySettingsLifeCycle(), false),
+						Description:  "When the Managed Identity is available to the sessions. Possible values are `All`, `Init`, `Main` and `None`.",
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"node_count": {
+			Type:        pluginsdk.TypeInt,
+			Computed:    true,
+			Description: "The number of nodes this Session Pool is using.",
+		},
+
+		"pool_management_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint used to manage the sessions in this Session Pool.",
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+			environmentClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var pool ContainerAppSessionPoolModel
+			if err := metadata.Decode(&pool); err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewSessionPoolID(subscriptionId, pool.ResourceGroup, pool.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			envId, err := managedenvironments.ParseManagedEnvironmentID(pool.ManagedEnvironmentId)
+			if err != nil {
+				return fmt.Errorf("parsing Container App Environment ID for %s: %+v", id, err)
+			}
+
+			env, err := environmentClient.Get(ctx, *envId)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+			if env.Model == nil {
+				return fmt.Errorf("reading %s for %s: model was nil", *envId, id)
+			}
+
+			sessionPool := azuresdkhacks.SessionPool{
+				Location:   location.Normalize(env.Model.Location),
+				Properties: expandContainerAppSessionPoolProperties(pool),
+				Tags:       tags.Expand(pool.Tags),
+			}
+
+			ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(pool.Identity)
+			if err != nil {
+				return err
+			}
+			sessionPool.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*ident))
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, sessionPool); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := azuresdkhacks.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			// the values of the secrets aren't returned by the API, so are taken from the existing state
+			var config ContainerAppSessionPoolModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			state := ContainerAppSessionPoolModel{
+				Name:          id.SessionPoolName,
+				ResourceGroup: id.ResourceGroupName,
+				Secrets:       config.Secrets,
+			}
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if model.Identity != nil {
+					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
+					if err != nil {
+						return err
+					}
+					state.Identity = pointer.From(ident)
+				}
+
+				if props := model.Properties; props != nil {
+					envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.EnvironmentId))
+					if err != nil {
+						return err
+					}
+					state.ManagedEnvironmentId = envId.ID()
+
+					if props.ContainerType != nil {
+						state.ContainerType = string(*props.ContainerType)
+					}
+					if props.PoolManagementType != nil {
+						state.PoolManagementType = string(*props.PoolManagementType)
+					}
+					if scale := props.ScaleConfiguration; scale != nil {
+						state.MaxConcurrentSessions = pointer.From(scale.MaxConcurrentSessions)
+						state.ReadySessionInstances = pointer.From(scale.ReadySessionInstances)
+					}
+					if dynamic := props.DynamicPoolConfiguration; dynamic != nil && dynamic.LifecycleConfiguration != nil {
+						lifecycle := dynamic.LifecycleConfiguration
+						if lifecycle.LifecycleType != nil {
+							state.LifecycleType = string(*lifecycle.LifecycleType)
+						}
+						state.CooldownPeriodInSeconds = pointer.From(lifecycle.CooldownPeriodInSeconds)
+						state.MaxAlivePeriodInSeconds = pointer.From(lifecycle.MaxAlivePeriodInSeconds)
+					}
+					if network := props.SessionNetworkConfiguration; network != nil && network.Status != nil {
+						state.NetworkStatus = string(*network.Status)
+					}
+
+					state.CustomContainer = flattenContainerAppSessionPoolCustomContainer(props.CustomContainerTemplate)
+					state.ManagedIdentitySettings = flattenContainerAppSessionPoolManagedIdentitySettings(props.ManagedIdentitySettings)
+					state.NodeCount = pointer.From(props.NodeCount)
+					state.PoolManagementEndpoint = pointer.From(props.PoolManagementEndpoint)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := azuresdkhacks.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var pool ContainerAppSessionPoolModel
+			if err := metadata.Decode(&pool); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("reading %s: model was nil", *id)
+			}
+
+			// the secret values aren't returned by the API, so the complete set of properties is sent from the config
+			model := existing.Model
+			model.Properties = expandContainerAppSessionPoolProperties(pool)
+
+			if metadata.ResourceData.HasChange("identity") {
+				ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(pool.Identity)
+				if err != nil {
+					return err
+				}
+				model.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*ident))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				model.Tags = tags.Expand(pool.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.SessionPoolsClient
+
+			id, err := azuresdkhacks.ParseSessionPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppSessionPoolResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			customContainers := rd.Get("custom_container").([]interface{})
+			if rd.Get("container_type").(string) == string(azuresdkhacks.ContainerTypeCustomContainer) {
+				if len(customContainers) == 0 {
+					return fmt.Errorf("a `custom_container` block must be specified when `container_type` is `CustomContainer`")
+				}
+			} else if len(customContainers) > 0 {
+				return fmt.Errorf("a `custom_container` block can only be specified when `container_type` is `CustomContainer`")
+			}
+
+			switch rd.Get("lifecycle_type").(string) {
+			case string(azuresdkhacks.LifecycleTypeTimed):
+				if v, ok := rd.GetOk("max_alive_period_in_seconds"); ok && rd.HasChange("max_alive_period_in_seconds") && v.(int) != 0 {
+					return fmt.Errorf("`max_alive_period_in_seconds` can only be specified when `lifecycle_type` is `OnContainerExit`")
+				}
+			case string(azuresdkhacks.LifecycleTypeOnContainerExit):
+				if v, ok := rd.GetOk("cooldown_period_in_seconds"); ok && rd.HasChange("cooldown_period_in_seconds") && v.(int) != 0 {
+					return fmt.Errorf("`cooldown_period_in_seconds` can only be specified when `lifecycle_type` is `Timed`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppSessionPoolProperties(input ContainerAppSessionPoolModel) *azuresdkhacks.SessionPoolProperties {
+	props := &azuresdkhacks.SessionPoolProperties{
+		ContainerType:      pointer.To(azuresdkhacks.ContainerType(input.ContainerType)),
+		EnvironmentId:      pointer.To(input.ManagedEnvironmentId),
+		PoolManagementType: pointer.To(azuresdkhacks.PoolManagementType(input.PoolManagementType)),
+		ScaleConfiguration: &azuresdkhacks.ScaleConfiguration{
+			MaxConcurrentSessions: pointer.To(input.MaxConcurrentSessions),
+		},
+		SessionNetworkConfiguration: &azuresdkhacks.SessionNetworkConfiguration{
+			Status: pointer.To(azuresdkhacks.SessionNetworkStatus(input.NetworkStatus)),
+		},
+		CustomContainerTemplate: expandContainerAppSessionPoolCustomContainer(input.CustomContainer),
+		ManagedIdentitySettings: expandContainerAppSessionPoolManagedIdentitySettings(input.ManagedIdentitySettings),
+	}
+
+	if input.ReadySessionInstances != 0 {
+		props.ScaleConfiguration.ReadySessionInstances = pointer.To(input.ReadySessionInstances)
+	}
+
+	lifecycle := &azuresdkhacks.LifecycleConfiguration{
+		LifecycleType: pointer.To(azuresdkhacks.LifecycleType(input.LifecycleType)),
+	}
+	if input.CooldownPeriodInSeconds != 0 && input.LifecycleType == string(azuresdkhacks.LifecycleTypeTimed) {
+		lifecycle.CooldownPeriodInSeconds = pointer.To(input.CooldownPeriodInSeconds)
+	}
+	if input.MaxAlivePeriodInSeconds != 0 && input.LifecycleType == string(azuresdkhacks.LifecycleTypeOnContainerExit) {
+		lifecycle.MaxAlivePeriodInSeconds = pointer.To(input.MaxAlivePeriodInSeconds)
+	}
+	props.DynamicPoolConfiguration = &azuresdkhacks.DynamicPoolConfiguration{
+		LifecycleConfiguration: lifecycle,
+	}
+
+	secrets := make([]azuresdkhacks.SessionPoolSecret, 0)
+	for _, v := range input.Secrets {
+		secrets = append(secrets, azuresdkhacks.SessionPoolSecret{
+			Name:  pointer.To(v.Name),
+			Value: pointer.To(v.Value),
+		})
+	}
+	props.Secrets = &secrets
+
+	return props
+}
+
+func expandContainerAppSessionPoolCustomContainer(input []SessionPoolCustomContainerModel) *azuresdkhacks.CustomContainerTemplate {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+
+	env := make([]azuresdkhacks.EnvironmentVar, 0)
+	for _, e := range v.Env {
+		envVar := azuresdkhacks.EnvironmentVar{
+			Name: pointer.To(e.Name),
+		}
+		if e.SecretReference != "" {
+			envVar.SecretRef = pointer.To(e.SecretReference)
+		} else {
+			envVar.Value = pointer.To(e.Value)
+		}
+		env = append(env, envVar)
+	}
+
+	container := azuresdkhacks.SessionContainer{
+		Name:  pointer.To(v.Name),
+		Image: pointer.To(v.Image),
+		Env:   &env,
+		Resources: &azuresdkhacks.SessionContainerResources{
+			Cpu:    pointer.To(v.Cpu),
+			Memory: pointer.To(v.Memory),
+		},
+	}
+	if len(v.Command) > 0 {
+		container.Command = pointer.To(v.Command)
+	}
+	if len(v.Args) > 0 {
+		container.Args = pointer.To(v.Args)
+	}
+
+	output := &azuresdkhacks.CustomContainerTemplate{
+		Containers: &[]azuresdkhacks.SessionContainer{container},
+		Ingress: &azuresdkhacks.SessionIngress{
+			TargetPort: pointer.To(v.TargetPort),
+		},
+	}
+
+	if len(v.Registry) > 0 {
+		registry := v.Registry[0]
+		output.RegistryCredentials = &azuresdkhacks.SessionRegistryCredentials{
+			Server: pointer.To(registry.Server),
+		}
+		if registry.Identity != "" {
+			output.RegistryCredentials.Identity = pointer.To(registry.Identity)
+		}
+		if registry.UserName != "" {
+			output.RegistryCredentials.Username = pointer.To(registry.UserName)
+		}
+		if registry.PasswordSecretRef != "" {
+			output.RegistryCredentials.PasswordSecretRef = pointer.To(registry.PasswordSecretRef)
+		}
+	}
+
+	return output
+}
+
+func flattenContainerAppSessionPoolCustomContainer(input *azuresdkhacks.CustomContainerTemplate) []SessionPoolCustomContainerModel {
+	if input == nil || input.Containers == nil || len(*input.Containers) == 0 {
+		return []SessionPoolCustomContainerModel{}
+	}
+
+	container := (*input.Containers)[0]
+	output := SessionPoolCustomContainerModel{
+		Name:    pointer.From(container.Name),
+		Image:   pointer.From(container.Image),
+		Command: pointer.From(container.Command),
+		Args:    pointer.From(container.Args),
+	}
+
+	if resources := container.Resources; resources != nil {
+		output.Cpu = pointer.From(resources.Cpu)
+		output.Memory = pointer.From(resources.Memory)
+	}
+
+	if container.Env != nil {
+		for _, e := range *container.Env {
+			output.Env = append(output.Env, helpers.ContainerEnvVar{
+				Name:            pointer.From(e.Name),
+				Value:           pointer.From(e.Value),
+				SecretReference: pointer.From(e.SecretRef),
+			})
+		}
+	}
+
+	if input.Ingress != nil {
+		output.TargetPort = pointer.From(input.Ingress.TargetPort)
+	}
+
+	if registry := input.RegistryCredentials; registry != nil {
+		output.Registry = []helpers.Registry{
+			{
+				Server:            pointer.From(registry.Server),
+				UserName:          pointer.From(registry.Username),
+				PasswordSecretRef: pointer.From(registry.PasswordSecretRef),
+				Identity:          pointer.From(registry.Identity),
+			},
+		}
+	}
+
+	return []SessionPoolCustomContainerModel{output}
+}
+
+func expandContainerAppSessionPoolManagedIdentitySettings(input []SessionPoolManagedIdentitySettingModel) *[]azuresdkhacks.ManagedIdentitySetting {
+	output := make([]azuresdkhacks.ManagedIdentitySetting, 0)
+	for _, v := range input {
+		output = append(output, azuresdkhacks.ManagedIdentitySetting{
+			Identity:  v.Identity,
+			Lifecycle: pointer.To(azuresdkhacks.IdentitySettingsLifeCycle(v.Lifecycle)),
+		})
+	}
+
+	return &output
+}
+
+func flattenContainerAppSessionPoolManagedIdentitySettings(input *[]azuresdkhacks.ManagedIdentitySetting) []SessionPoolManagedIdentitySettingModel {
+	output := make([]SessionPoolManagedIdentitySettingModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		setting := SessionPoolManagedIdentitySettingModel{
+			Identity: v.Identity,
+		}
+		if v.Lifecycle != nil {
+			setting.Lifecycle = string(*v.Lifecycle)
+		}
+		output = append(output, setting)
+	}
+
+	return output
+}
@@ -0,0 +1,240 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppSessionPoolResource struct{}
+
+func TestAccContainerAppSessionPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool_management_endpoint").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppSessionPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppSessionPool_customContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("secret"),
+	})
+}
+
+func TestAccContainerAppSessionPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_session_pool", "test")
+	r := ContainerAppSessionPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppSessionPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseSessionPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.SessionPoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r ContainerAppSessionPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-csp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  max_concurrent_sessions      = 5
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-csp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  max_concurrent_sessions      = 10
+  ready_session_instances      = 2
+  cooldown_period_in_seconds   = 600
+  network_status               = "EgressEnabled"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  managed_identity_setting {
+    identity  = azurerm_user_assigned_identity.test.id
+    lifecycle = "Init"
+  }
+
+  tags = {
+    environment = "accTest"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) customContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_container_app_session_pool" "test" {
+  name                         = "acctest-csp%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  container_type               = "CustomContainer"
+  max_concurrent_sessions      = 5
+  ready_session_instances      = 1
+
+  secret {
+    name  = "greeting"
+    value = "hello"
+  }
+
+  custom_container {
+    name        = "session"
+    image       = "mcr.microsoft.com/k8se/quickstart:latest"
+    cpu         = 0.25
+    memory      = "0.5Gi"
+    target_port = 80
+
+    env {
+      name        = "GREETING"
+      secret_name = "greeting"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerAppSessionPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_session_pool" "import" {
+  name                         = azurerm_container_app_session_pool.test.name
+  resource_group_name          = azurerm_container_app_session_pool.test.resource_group_name
+  container_app_environment_id = azurerm_container_app_session_pool.test.container_app_environment_id
+  max_concurrent_sessions      = azurerm_container_app_session_pool.test.max_concurrent_sessions
+}
+`, r.basic(data))
+}
+
+func (r ContainerAppSessionPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-CAE-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_container_app_environment" "test" {
+  name                       = "accTest-CAEnv%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
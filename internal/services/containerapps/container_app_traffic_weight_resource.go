@@ -0,0 +1,208 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppTrafficWeightResource struct{}
+
+type ContainerAppTrafficWeightModel struct {
+	ContainerAppId string                  `tfschema:"container_app_id"`
+	TrafficWeights []helpers.TrafficWeight `tfschema:"traffic_weight"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppTrafficWeightResource{}
+
+func (r ContainerAppTrafficWeightResource) ModelObject() interface{} {
+	return &ContainerAppTrafficWeightModel{}
+}
+
+func (r ContainerAppTrafficWeightResource) ResourceType() string {
+	return "azurerm_container_app_traffic_weight"
+}
+
+func (r ContainerAppTrafficWeightResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return containerapps.ValidateContainerAppID
+}
+
+func (r ContainerAppTrafficWeightResource) Arguments() map[string]*pluginsdk.Schema {
+	trafficWeight := helpers.ContainerAppIngressTrafficWeight()
+	trafficWeight.Optional = false
+	trafficWeight.Required = true
+	trafficWeight.MinItems = 1
+
+	return map[string]*pluginsdk.Schema{
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateContainerAppID,
+			Description:  "The ID of the Container App whose ingress traffic should be split between revisions.",
+		},
+
+		"traffic_weight": trafficWeight,
+	}
+}
+
+func (r ContainerAppTrafficWeightResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppTrafficWeightResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ContainerAppTrafficWeightModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			id, err := containerapps.ParseContainerAppID(config.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if err := updateContainerAppTraffic(ctx, metadata, *id, helpers.ExpandContainerAppIngressTraffic(config.TrafficWeights, id.ContainerAppName)); err != nil {
+				return fmt.Errorf("configuring traffic weights for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppTrafficWeightResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			state := ContainerAppTrafficWeightModel{
+				ContainerAppId: id.ID(),
+			}
+
+			if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil {
+				ingress := model.Properties.Configuration.Ingress
+				if ingress == nil {
+					return metadata.MarkAsGone(id)
+				}
+				state.TrafficWeights = helpers.FlattenContainerAppIngressTraffic(ingress.Traffic, id.ContainerAppName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppTrafficWeightResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerAppTrafficWeightModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			if err := updateContainerAppTraffic(ctx, metadata, *id, helpers.ExpandContainerAppIngressTraffic(config.TrafficWeights, id.ContainerAppName)); err != nil {
+				return fmt.Errorf("updating traffic weights for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppTrafficWeightResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := containerapps.ParseContainerAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			// traffic can't be removed from an ingress, so all of it is sent back to the latest revision
+			traffic := []containerapps.TrafficWeight{
+				{
+					LatestRevision: pointer.To(true),
+					Weight:         pointer.To(int64(100)),
+				},
+			}
+			if err := updateContainerAppTraffic(ctx, metadata, *id, &traffic); err != nil {
+				return fmt.Errorf("resetting traffic weights for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func updateContainerAppTraffic(ctx context.Context, metadata sdk.ResourceMetaData, id containerapps.ContainerAppId, traffic *[]containerapps.TrafficWeight) error {
+	client := metadata.Client.ContainerApps.ContainerAppClient
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	model := existing.Model
+	if model == nil || model.Properties == nil || model.Properties.Configuration == nil {
+		return fmt.Errorf("retrieving %s: `properties.configuration` was nil", id)
+	}
+	if model.Properties.Configuration.Ingress == nil {
+		return fmt.Errorf("%s has no ingress configured", id)
+	}
+
+	// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+	secretsResp, err := client.ListSecrets(ctx, id)
+	if err != nil || secretsResp.Model == nil {
+		if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+			return fmt.Errorf("retrieving secrets for %s: %+v", id, err)
+		}
+	}
+	model.Properties.Configuration.Secrets = helpers.UnpackContainerSecretsCollection(secretsResp.Model)
+
+	model.Properties.Configuration.Ingress.Traffic = traffic
+
+	return client.CreateOrUpdateThenPoll(ctx, id, *model)
+}
@@ -0,0 +1,144 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2022-03-01/containerapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppTrafficWeightResource struct{}
+
+func TestAccContainerAppTrafficWeight_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_traffic_weight", "test")
+	r := ContainerAppTrafficWeightResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppTrafficWeight_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_traffic_weight", "test")
+	r := ContainerAppTrafficWeightResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("traffic_weight.0.label").HasValue("stable"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppTrafficWeightResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := containerapps.ParseContainerAppID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.ContainerAppClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Configuration != nil && model.Properties.Configuration.Ingress != nil {
+		return pointer.To(model.Properties.Configuration.Ingress.Traffic != nil), nil
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ContainerAppTrafficWeightResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_traffic_weight" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  traffic_weight {
+    latest_revision = true
+    percentage      = 100
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerAppTrafficWeightResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_traffic_weight" "test" {
+  container_app_id = azurerm_container_app.test.id
+
+  traffic_weight {
+    label           = "stable"
+    revision_suffix = "%s"
+    percentage      = 100
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r ContainerAppTrafficWeightResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app" "test" {
+  name                              = "acctest-capp-%[2]d"
+  resource_group_name               = azurerm_resource_group.test.name
+  container_app_environment_id      = azurerm_container_app_environment.test.id
+  revision_mode                     = "Multiple"
+  traffic_weight_managed_externally = true
+
+  template {
+    container {
+      name   = "acctest-cont-%[2]d"
+      image  = "jackofallops/azure-containerapps-python-acctest:v0.0.1"
+      cpu    = 0.25
+      memory = "0.5Gi"
+    }
+
+    revision_suffix = "%[3]s"
+  }
+
+  ingress {
+    external_enabled = true
+    target_port      = 5000
+  }
+}
+`, ContainerAppResource{}.template(data), data.RandomInteger, data.RandomString)
+}
@@ -259,7 +259,7 @@ func ExpandContainerAppIngress(input []Ingress, appName string) *containerapps.I
 		External:      pointer.To(ingress.IsExternal),
 		Fqdn:          pointer.To(ingress.FQDN),
 		TargetPort:    pointer.To(int64(ingress.TargetPort)),
-		Traffic:       expandContainerAppIngressTraffic(ingress.TrafficWeights, appName),
+		Traffic:       ExpandContainerAppIngressTraffic(ingress.TrafficWeights, appName),
 	}
 	transport := containerapps.IngressTransportMethod(ingress.Transport)
 	result.Transport = &transport
@@ -279,7 +279,7 @@ func FlattenContainerAppIngress(input *containerapps.Ingress, appName string) []
 		IsExternal:     pointer.From(ingress.External),
 		FQDN:           pointer.From(ingress.Fqdn),
 		TargetPort:     int(pointer.From(ingress.TargetPort)),
-		TrafficWeights: flattenContainerAppIngressTraffic(ingress.Traffic, appName),
+		TrafficWeights: FlattenContainerAppIngressTraffic(ingress.Traffic, appName),
 	}
 
 	if ingress.Transport != nil {
@@ -408,7 +408,7 @@ type TrafficWeight struct {
 func ContainerAppIngressTrafficWeight() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
-		Required: true,
+		Optional: true,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
 				"label": {
@@ -477,7 +477,7 @@ func ContainerAppIngressTrafficWeightComputed() *pluginsdk.Schema {
 	}
 }
 
-func expandContainerAppIngressTraffic(input []TrafficWeight, appName string) *[]containerapps.TrafficWeight {
+func ExpandContainerAppIngressTraffic(input []TrafficWeight, appName string) *[]containerapps.TrafficWeight {
 	if len(input) == 0 {
 		return nil
 	}
@@ -504,7 +504,7 @@ func expandContainerAppIngressTraffic(input []TrafficWeight, appName string) *[]
 	return &result
 }
 
-func flattenContainerAppIngressTraffic(input *[]containerapps.TrafficWeight, appName string) []TrafficWeight {
+func FlattenContainerAppIngressTraffic(input *[]containerapps.TrafficWeight, appName string) []TrafficWeight {
 	if input == nil {
 		return []TrafficWeight{}
 	}
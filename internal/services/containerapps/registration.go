@@ -33,5 +33,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerAppEnvironmentResource{},
 		ContainerAppEnvironmentStorageResource{},
 		ContainerAppResource{},
+		ContainerAppSessionPoolResource{},
+		ContainerAppTrafficWeightResource{},
 	}
 }
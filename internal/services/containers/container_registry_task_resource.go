@@ -212,11 +212,11 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 					"context_path": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validate.ContainerRegistryTaskContextPath,
 					},
 					"context_access_token": {
 						Type:         pluginsdk.TypeString,
-						Required:     true,
+						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
@@ -280,7 +280,7 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 					"context_path": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validate.ContainerRegistryTaskContextPath,
 					},
 					"context_access_token": {
 						Type:         pluginsdk.TypeString,
@@ -328,7 +328,7 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 					"context_path": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validate.ContainerRegistryTaskContextPath,
 					},
 					"context_access_token": {
 						Type:         pluginsdk.TypeString,
@@ -493,7 +493,7 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 					"schedule": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validate.ContainerRegistryTaskTimerSchedule,
 					},
 					"enabled": {
 						Type:     pluginsdk.TypeBool,
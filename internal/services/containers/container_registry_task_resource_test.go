@@ -152,6 +152,21 @@ func TestAccContainerRegistryTask_encodedTaskStep(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistryTask_encodedTaskStepSourceless(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encodedTaskStepSourceless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerRegistryTask_dockerStepBaseImageTrigger(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
 
@@ -617,6 +632,33 @@ EOF
 `, template, data.RandomInteger, r.githubRepo.url, r.githubRepo.token)
 }
 
+func (r ContainerRegistryTaskResource) encodedTaskStepSourceless(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  platform {
+    os = "Linux"
+  }
+  encoded_step {
+    task_content = <<EOF
+version: v1.1.0
+steps:
+  - cmd: mcr.microsoft.com/hello-world
+EOF
+    context_path = "/dev/null"
+  }
+  timer_trigger {
+    name     = "nightly"
+    schedule = "0 21 * * 1-5"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r ContainerRegistryTaskResource) encodedTaskStepUpdate(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -30,6 +30,8 @@ type ContainerRegistryTokenPasswordModel struct {
 	TokenId   string                           `tfschema:"container_registry_token_id"`
 	Password1 []ContainerRegistryTokenPassword `tfschema:"password1"`
 	Password2 []ContainerRegistryTokenPassword `tfschema:"password2"`
+
+	RotationTriggers map[string]interface{} `tfschema:"rotation_triggers"`
 }
 
 type ContainerRegistryTokenPassword struct {
@@ -93,6 +95,16 @@ func (r ContainerRegistryTokenPasswordResource) Arguments() map[string]*pluginsd
 				},
 			},
 		},
+
+		// changing any of these values regenerates the passwords, which allows them to be rotated on a schedule (e.g. using `time_rotating`)
+		"rotation_triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
 	}
 }
 
@@ -200,7 +212,8 @@ func (r ContainerRegistryTokenPasswordResource) Read() sdk.ResourceFunc {
 			}
 
 			model := ContainerRegistryTokenPasswordModel{
-				TokenId: tokens.NewTokenID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TokenName).ID(),
+				TokenId:          tokens.NewTokenID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TokenName).ID(),
+				RotationTriggers: state.RotationTriggers,
 			}
 			for _, pwd := range pwds {
 				name := string(*pwd.Name)
@@ -101,6 +101,28 @@ func TestAccContainerRegistryTokenPassword_updateExpiryReflectNewValue(t *testin
 	})
 }
 
+func TestAccContainerRegistryTokenPassword_rotationTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_token_password", "test")
+	r := ContainerRegistryTokenPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationTriggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password1.0.value", "rotation_triggers"),
+		{
+			Config: r.rotationTriggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password1.0.value", "rotation_triggers"),
+	})
+}
+
 func TestAccContainerRegistryTokenPassword_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_token_password", "test")
 	r := ContainerRegistryTokenPasswordResource{Expiry: time.Now().Add(time.Hour)}
@@ -183,6 +205,22 @@ resource "azurerm_resource_group" "consumer" {
 `, template, expiry, data.RandomInteger, data.Locations.Primary, tagName)
 }
 
+func (r ContainerRegistryTokenPasswordResource) rotationTriggers(data acceptance.TestData, rotation string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_token_password" "test" {
+  container_registry_token_id = azurerm_container_registry_token.test.id
+  password1 {}
+
+  rotation_triggers = {
+    rotation = %q
+  }
+}
+`, template, rotation)
+}
+
 func (r ContainerRegistryTokenPasswordResource) complete(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
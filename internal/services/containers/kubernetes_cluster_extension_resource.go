@@ -29,6 +29,10 @@ type KubernetesClusterExtensionModel struct {
 	TargetNamespace                string            `tfschema:"target_namespace"`
 	Version                        string            `tfschema:"version"`
 	CurrentVersion                 string            `tfschema:"current_version"`
+
+	AzureMLSetting []KubernetesClusterExtensionAzureMLModel `tfschema:"azure_ml_setting"`
+	DaprSetting    []KubernetesClusterExtensionDaprModel    `tfschema:"dapr_setting"`
+	FluxSetting    []KubernetesClusterExtensionFluxModel    `tfschema:"flux_setting"`
 }
 
 type PlanModel struct {
@@ -41,7 +45,10 @@ type PlanModel struct {
 
 type KubernetesClusterExtensionResource struct{}
 
-var _ sdk.ResourceWithUpdate = KubernetesClusterExtensionResource{}
+var (
+	_ sdk.ResourceWithUpdate        = KubernetesClusterExtensionResource{}
+	_ sdk.ResourceWithCustomizeDiff = KubernetesClusterExtensionResource{}
+)
 
 func (r KubernetesClusterExtensionResource) ResourceType() string {
 	return "azurerm_kubernetes_cluster_extension"
@@ -100,6 +107,12 @@ func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Sc
 			},
 		},
 
+		"azure_ml_setting": kubernetesClusterExtensionAzureMLSettingSchema(),
+
+		"dapr_setting": kubernetesClusterExtensionDaprSettingSchema(),
+
+		"flux_setting": kubernetesClusterExtensionFluxSettingSchema(),
+
 		"plan": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -225,12 +238,14 @@ func (r KubernetesClusterExtensionResource) Create() sdk.ResourceFunc {
 				autoUpgradeMinorVersion = true
 			}
 
+			configurationSettings, configurationProtectedSettings := expandKubernetesClusterExtensionTypedSettings(model)
+
 			properties := &extensions.Extension{
 				Plan: expandPlanModel(model.Plan),
 				Properties: &extensions.ExtensionProperties{
 					AutoUpgradeMinorVersion:        &autoUpgradeMinorVersion,
-					ConfigurationProtectedSettings: &model.ConfigurationProtectedSettings,
-					ConfigurationSettings:          &model.ConfigurationSettings,
+					ConfigurationProtectedSettings: &configurationProtectedSettings,
+					ConfigurationSettings:          &configurationSettings,
 				},
 			}
 
@@ -292,12 +307,14 @@ func (r KubernetesClusterExtensionResource) Update() sdk.ResourceFunc {
 				Properties: &extensions.PatchExtensionProperties{},
 			}
 
-			if metadata.ResourceData.HasChange("configuration_protected_settings") {
-				properties.Properties.ConfigurationProtectedSettings = &model.ConfigurationProtectedSettings
+			configurationSettings, configurationProtectedSettings := expandKubernetesClusterExtensionTypedSettings(model)
+
+			if metadata.ResourceData.HasChanges("configuration_protected_settings", "azure_ml_setting") {
+				properties.Properties.ConfigurationProtectedSettings = &configurationProtectedSettings
 			}
 
-			if metadata.ResourceData.HasChange("configuration_settings") {
-				properties.Properties.ConfigurationSettings = &model.ConfigurationSettings
+			if metadata.ResourceData.HasChanges("configuration_settings", "azure_ml_setting", "dapr_setting", "flux_setting") {
+				properties.Properties.ConfigurationSettings = &configurationSettings
 			}
 
 			if err := client.UpdateThenPoll(ctx, *id, *properties); err != nil {
@@ -346,7 +363,7 @@ func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
 					}
 
 					state.ConfigurationProtectedSettings = originalModel.ConfigurationProtectedSettings
-					state.ConfigurationSettings = pointer.From(properties.ConfigurationSettings)
+					flattenKubernetesClusterExtensionTypedSettings(pointer.From(properties.ConfigurationSettings), originalModel, &state)
 					state.CurrentVersion = pointer.From(properties.CurrentVersion)
 					state.ExtensionType = pointer.From(properties.ExtensionType)
 					state.Plan = flattenPlanModel(model.Plan)
@@ -371,6 +388,15 @@ func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r KubernetesClusterExtensionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return validateKubernetesClusterExtensionTypedSettings(metadata.ResourceDiff)
+		},
+	}
+}
+
 func (r KubernetesClusterExtensionResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -79,6 +79,27 @@ func TestAccKubernetesClusterExtension_update(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterExtension_fluxSetting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_extension", "test")
+	r := KubernetesClusterExtensionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fluxSetting(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("configuration_settings", "flux_setting"),
+		{
+			Config: r.fluxSetting(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("configuration_settings", "flux_setting"),
+	})
+}
+
 func TestAccKubernetesClusterExtension_plan(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_extension", "test")
 	r := KubernetesClusterExtensionResource{}
@@ -234,3 +255,22 @@ resource "azurerm_kubernetes_cluster_extension" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r KubernetesClusterExtensionResource) fluxSetting(data acceptance.TestData, imageAutomationEnabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_extension" "test" {
+  name              = "acctest-kce-%d"
+  cluster_id        = azurerm_kubernetes_cluster.test.id
+  extension_type    = "microsoft.flux"
+  release_namespace = "flux-system"
+
+  flux_setting {
+    multi_tenancy_enforced              = false
+    image_automation_controller_enabled = %[3]t
+    image_reflector_controller_enabled  = %[3]t
+  }
+}
+`, r.template(data), data.RandomInteger, imageAutomationEnabled)
+}
@@ -0,0 +1,416 @@
+package containers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the typed settings blocks below are mapped onto the well-known configuration settings of the first-party
+// extensions, any other settings can continue to be specified via `configuration_settings`
+
+const (
+	kubernetesClusterExtensionTypeAzureML = "Microsoft.AzureML.Kubernetes"
+	kubernetesClusterExtensionTypeDapr    = "Microsoft.Dapr"
+	kubernetesClusterExtensionTypeFlux    = "microsoft.flux"
+)
+
+type KubernetesClusterExtensionFluxModel struct {
+	MultiTenancyEnforced             bool `tfschema:"multi_tenancy_enforced"`
+	HelmControllerEnabled            bool `tfschema:"helm_controller_enabled"`
+	ImageAutomationControllerEnabled bool `tfschema:"image_automation_controller_enabled"`
+	ImageReflectorControllerEnabled  bool `tfschema:"image_reflector_controller_enabled"`
+	NotificationControllerEnabled    bool `tfschema:"notification_controller_enabled"`
+}
+
+type KubernetesClusterExtensionDaprModel struct {
+	HighAvailabilityEnabled      bool  `tfschema:"high_availability_enabled"`
+	HighAvailabilityReplicaCount int64 `tfschema:"high_availability_replica_count"`
+	MtlsEnabled                  bool  `tfschema:"mtls_enabled"`
+	SkipExistingDaprCheck        bool  `tfschema:"skip_existing_dapr_check"`
+}
+
+type KubernetesClusterExtensionAzureMLModel struct {
+	ClusterPurpose                         string `tfschema:"cluster_purpose"`
+	TrainingEnabled                        bool   `tfschema:"training_enabled"`
+	InferenceEnabled                       bool   `tfschema:"inference_enabled"`
+	InferenceRouterServiceType             string `tfschema:"inference_router_service_type"`
+	InferenceRouterHighAvailabilityEnabled bool   `tfschema:"inference_router_high_availability_enabled"`
+	InternalLoadBalancerProvider           string `tfschema:"internal_load_balancer_provider"`
+	AllowInsecureConnections               bool   `tfschema:"allow_insecure_connections"`
+	SslCname                               string `tfschema:"ssl_cname"`
+	SslCertificatePem                      string `tfschema:"ssl_certificate_pem"`
+	SslKeyPem                              string `tfschema:"ssl_key_pem"`
+}
+
+const (
+	fluxSettingMultiTenancyEnforced             = "multiTenancy.enforce"
+	fluxSettingHelmControllerEnabled            = "helm-controller.enabled"
+	fluxSettingImageAutomationControllerEnabled = "image-automation-controller.enabled"
+	fluxSettingImageReflectorControllerEnabled  = "image-reflector-controller.enabled"
+	fluxSettingNotificationControllerEnabled    = "notification-controller.enabled"
+
+	daprSettingHighAvailabilityEnabled      = "global.ha.enabled"
+	daprSettingHighAvailabilityReplicaCount = "global.ha.replicaCount"
+	daprSettingMtlsEnabled                  = "global.mtls.enabled"
+	daprSettingSkipExistingDaprCheck        = "skipExistingDaprCheck"
+
+	azureMLSettingClusterPurpose                         = "clusterPurpose"
+	azureMLSettingTrainingEnabled                        = "enableTraining"
+	azureMLSettingInferenceEnabled                       = "enableInference"
+	azureMLSettingInferenceRouterServiceType             = "inferenceRouterServiceType"
+	azureMLSettingInferenceRouterHighAvailabilityEnabled = "inferenceRouterHA"
+	azureMLSettingInternalLoadBalancerProvider           = "internalLoadBalancerProvider"
+	azureMLSettingAllowInsecureConnections               = "allowInsecureConnections"
+	azureMLSettingSslCname                               = "sslCname"
+	azureMLProtectedSettingSslCertificatePem             = "sslCertPemFile"
+	azureMLProtectedSettingSslKeyPem                     = "sslKeyPemFile"
+)
+
+// kubernetesClusterExtensionTypedSettingKeys returns the configuration settings managed by each of the typed blocks
+func kubernetesClusterExtensionTypedSettingKeys() map[string][]string {
+	return map[string][]string{
+		"flux_setting": {
+			fluxSettingMultiTenancyEnforced,
+			fluxSettingHelmControllerEnabled,
+			fluxSettingImageAutomationControllerEnabled,
+			fluxSettingImageReflectorControllerEnabled,
+			fluxSettingNotificationControllerEnabled,
+		},
+		"dapr_setting": {
+			daprSettingHighAvailabilityEnabled,
+			daprSettingHighAvailabilityReplicaCount,
+			daprSettingMtlsEnabled,
+			daprSettingSkipExistingDaprCheck,
+		},
+		"azure_ml_setting": {
+			azureMLSettingClusterPurpose,
+			azureMLSettingTrainingEnabled,
+			azureMLSettingInferenceEnabled,
+			azureMLSettingInferenceRouterServiceType,
+			azureMLSettingInferenceRouterHighAvailabilityEnabled,
+			azureMLSettingInternalLoadBalancerProvider,
+			azureMLSettingAllowInsecureConnections,
+			azureMLSettingSslCname,
+			azureMLProtectedSettingSslCertificatePem,
+			azureMLProtectedSettingSslKeyPem,
+		},
+	}
+}
+
+func kubernetesClusterExtensionTypedSettingExtensionTypes() map[string]string {
+	return map[string]string{
+		"flux_setting":     kubernetesClusterExtensionTypeFlux,
+		"dapr_setting":     kubernetesClusterExtensionTypeDapr,
+		"azure_ml_setting": kubernetesClusterExtensionTypeAzureML,
+	}
+}
+
+func kubernetesClusterExtensionFluxSettingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"dapr_setting", "azure_ml_setting"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"multi_tenancy_enforced": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"helm_controller_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"image_automation_controller_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"image_reflector_controller_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"notification_controller_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func kubernetesClusterExtensionDaprSettingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"flux_setting", "azure_ml_setting"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"high_availability_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"high_availability_replica_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntBetween(1, 10),
+				},
+
+				"mtls_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"skip_existing_dapr_check": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func kubernetesClusterExtensionAzureMLSettingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"flux_setting", "dapr_setting"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cluster_purpose": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "DevTest",
+					ValidateFunc: validation.StringInSlice([]string{"DevTest", "FastProd"}, false),
+				},
+
+				"training_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"inference_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"inference_router_service_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"LoadBalancer", "NodePort", "ClusterIP"}, false),
+				},
+
+				"inference_router_high_availability_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"internal_load_balancer_provider": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"azure"}, false),
+				},
+
+				"allow_insecure_connections": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"ssl_cname": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"ssl_certificate_pem": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					RequiredWith: []string{"azure_ml_setting.0.ssl_key_pem"},
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"ssl_key_pem": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					RequiredWith: []string{"azure_ml_setting.0.ssl_certificate_pem"},
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+// validateKubernetesClusterExtensionTypedSettings ensures the typed settings blocks are only used with the
+// matching extension type, and that the settings they manage aren't also specified in the free-form maps
+func validateKubernetesClusterExtensionTypedSettings(diff *pluginsdk.ResourceDiff) error {
+	extensionType := diff.Get("extension_type").(string)
+	settings := diff.Get("configuration_settings").(map[string]interface{})
+	protectedSettings := diff.Get("configuration_protected_settings").(map[string]interface{})
+
+	for block, keys := range kubernetesClusterExtensionTypedSettingKeys() {
+		if v, ok := diff.GetOk(block); !ok || len(v.([]interface{})) == 0 {
+			continue
+		}
+
+		if expected := kubernetesClusterExtensionTypedSettingExtensionTypes()[block]; !strings.EqualFold(extensionType, expected) {
+			return fmt.Errorf("`%s` can only be specified when `extension_type` is `%s`", block, expected)
+		}
+
+		for _, key := range keys {
+			if _, ok := settings[key]; ok {
+				return fmt.Errorf("the setting %q is managed by `%s` and cannot also be specified in `configuration_settings`", key, block)
+			}
+			if _, ok := protectedSettings[key]; ok {
+				return fmt.Errorf("the setting %q is managed by `%s` and cannot also be specified in `configuration_protected_settings`", key, block)
+			}
+		}
+	}
+
+	return nil
+}
+
+// expandKubernetesClusterExtensionTypedSettings merges the typed settings blocks into the free-form configuration settings
+func expandKubernetesClusterExtensionTypedSettings(model KubernetesClusterExtensionModel) (settings map[string]string, protectedSettings map[string]string) {
+	settings = make(map[string]string)
+	for k, v := range model.ConfigurationSettings {
+		settings[k] = v
+	}
+	protectedSettings = make(map[string]string)
+	for k, v := range model.ConfigurationProtectedSettings {
+		protectedSettings[k] = v
+	}
+
+	if len(model.FluxSetting) > 0 {
+		flux := model.FluxSetting[0]
+		settings[fluxSettingMultiTenancyEnforced] = strconv.FormatBool(flux.MultiTenancyEnforced)
+		settings[fluxSettingHelmControllerEnabled] = strconv.FormatBool(flux.HelmControllerEnabled)
+		settings[fluxSettingImageAutomationControllerEnabled] = strconv.FormatBool(flux.ImageAutomationControllerEnabled)
+		settings[fluxSettingImageReflectorControllerEnabled] = strconv.FormatBool(flux.ImageReflectorControllerEnabled)
+		settings[fluxSettingNotificationControllerEnabled] = strconv.FormatBool(flux.NotificationControllerEnabled)
+	}
+
+	if len(model.DaprSetting) > 0 {
+		dapr := model.DaprSetting[0]
+		settings[daprSettingHighAvailabilityEnabled] = strconv.FormatBool(dapr.HighAvailabilityEnabled)
+		settings[daprSettingHighAvailabilityReplicaCount] = strconv.FormatInt(dapr.HighAvailabilityReplicaCount, 10)
+		settings[daprSettingMtlsEnabled] = strconv.FormatBool(dapr.MtlsEnabled)
+		settings[daprSettingSkipExistingDaprCheck] = strconv.FormatBool(dapr.SkipExistingDaprCheck)
+	}
+
+	if len(model.AzureMLSetting) > 0 {
+		azureML := model.AzureMLSetting[0]
+		settings[azureMLSettingClusterPurpose] = azureML.ClusterPurpose
+		settings[azureMLSettingTrainingEnabled] = strconv.FormatBool(azureML.TrainingEnabled)
+		settings[azureMLSettingInferenceEnabled] = strconv.FormatBool(azureML.InferenceEnabled)
+		settings[azureMLSettingInferenceRouterHighAvailabilityEnabled] = strconv.FormatBool(azureML.InferenceRouterHighAvailabilityEnabled)
+		settings[azureMLSettingAllowInsecureConnections] = strconv.FormatBool(azureML.AllowInsecureConnections)
+		if azureML.InferenceRouterServiceType != "" {
+			settings[azureMLSettingInferenceRouterServiceType] = azureML.InferenceRouterServiceType
+		}
+		if azureML.InternalLoadBalancerProvider != "" {
+			settings[azureMLSettingInternalLoadBalancerProvider] = azureML.InternalLoadBalancerProvider
+		}
+		if azureML.SslCname != "" {
+			settings[azureMLSettingSslCname] = azureML.SslCname
+		}
+		if azureML.SslCertificatePem != "" {
+			protectedSettings[azureMLProtectedSettingSslCertificatePem] = azureML.SslCertificatePem
+		}
+		if azureML.SslKeyPem != "" {
+			protectedSettings[azureMLProtectedSettingSslKeyPem] = azureML.SslKeyPem
+		}
+	}
+
+	return settings, protectedSettings
+}
+
+// flattenKubernetesClusterExtensionTypedSettings populates the typed settings blocks which are present in the
+// configuration from the configuration settings, and removes the settings they manage from the free-form map
+func flattenKubernetesClusterExtensionTypedSettings(input map[string]string, config KubernetesClusterExtensionModel, state *KubernetesClusterExtensionModel) {
+	settings := make(map[string]string)
+	for k, v := range input {
+		settings[k] = v
+	}
+
+	parseBool := func(key string) bool {
+		return strings.EqualFold(settings[key], "true")
+	}
+
+	if len(config.FluxSetting) > 0 {
+		state.FluxSetting = []KubernetesClusterExtensionFluxModel{
+			{
+				MultiTenancyEnforced:             parseBool(fluxSettingMultiTenancyEnforced),
+				HelmControllerEnabled:            parseBool(fluxSettingHelmControllerEnabled),
+				ImageAutomationControllerEnabled: parseBool(fluxSettingImageAutomationControllerEnabled),
+				ImageReflectorControllerEnabled:  parseBool(fluxSettingImageReflectorControllerEnabled),
+				NotificationControllerEnabled:    parseBool(fluxSettingNotificationControllerEnabled),
+			},
+		}
+		for _, key := range kubernetesClusterExtensionTypedSettingKeys()["flux_setting"] {
+			delete(settings, key)
+		}
+	}
+
+	if len(config.DaprSetting) > 0 {
+		replicaCount, _ := strconv.ParseInt(settings[daprSettingHighAvailabilityReplicaCount], 10, 64)
+		state.DaprSetting = []KubernetesClusterExtensionDaprModel{
+			{
+				HighAvailabilityEnabled:      parseBool(daprSettingHighAvailabilityEnabled),
+				HighAvailabilityReplicaCount: replicaCount,
+				MtlsEnabled:                  parseBool(daprSettingMtlsEnabled),
+				SkipExistingDaprCheck:        parseBool(daprSettingSkipExistingDaprCheck),
+			},
+		}
+		for _, key := range kubernetesClusterExtensionTypedSettingKeys()["dapr_setting"] {
+			delete(settings, key)
+		}
+	}
+
+	if len(config.AzureMLSetting) > 0 {
+		// the protected settings aren't returned by the API, so are taken from the config
+		state.AzureMLSetting = []KubernetesClusterExtensionAzureMLModel{
+			{
+				ClusterPurpose:                         settings[azureMLSettingClusterPurpose],
+				TrainingEnabled:                        parseBool(azureMLSettingTrainingEnabled),
+				InferenceEnabled:                       parseBool(azureMLSettingInferenceEnabled),
+				InferenceRouterServiceType:             settings[azureMLSettingInferenceRouterServiceType],
+				InferenceRouterHighAvailabilityEnabled: parseBool(azureMLSettingInferenceRouterHighAvailabilityEnabled),
+				InternalLoadBalancerProvider:           settings[azureMLSettingInternalLoadBalancerProvider],
+				AllowInsecureConnections:               parseBool(azureMLSettingAllowInsecureConnections),
+				SslCname:                               settings[azureMLSettingSslCname],
+				SslCertificatePem:                      config.AzureMLSetting[0].SslCertificatePem,
+				SslKeyPem:                              config.AzureMLSetting[0].SslKeyPem,
+			},
+		}
+		for _, key := range kubernetesClusterExtensionTypedSettingKeys()["azure_ml_setting"] {
+			delete(settings, key)
+		}
+	}
+
+	state.ConfigurationSettings = settings
+}
@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ContainerRegistryTaskContextPath validates the source context of a Container Registry Task, which can be a
+// Git repository, a tarball in a Storage Account (e.g. using a SAS URL), an OCI artifact or `/dev/null` for
+// tasks which don't require a source context.
+func ContainerRegistryTaskContextPath(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if value == "/dev/null" {
+		return
+	}
+
+	if !regexp.MustCompile(`^(https?|oci)://[^\s]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a Git repository or Storage URL starting with `https://`, an OCI artifact starting with `oci://`, or `/dev/null`: %q", k, value))
+	}
+
+	return
+}
+
+// ContainerRegistryTaskTimerSchedule validates that the schedule of a timer trigger is a cron expression with
+// five fields: minute, hour, day of month, month and day of week.
+func ContainerRegistryTaskTimerSchedule(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) != 5 {
+		errors = append(errors, fmt.Errorf("%q must be a cron expression containing 5 fields (minute, hour, day of month, month and day of week), got %q", k, value))
+		return
+	}
+
+	bounds := []struct {
+		name string
+		min  int
+		max  int
+	}{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day of month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12},
+		{name: "day of week", min: 0, max: 6},
+	}
+
+	for i, field := range fields {
+		if err := validateCronField(field, bounds[i].min, bounds[i].max); err != nil {
+			errors = append(errors, fmt.Errorf("%q has an invalid %s field %q: %+v", k, bounds[i].name, field, err))
+		}
+	}
+
+	return
+}
+
+func validateCronField(field string, min, max int) error {
+	for _, item := range strings.Split(field, ",") {
+		rangePart := item
+		if idx := strings.Index(item, "/"); idx != -1 {
+			step, err := strconv.Atoi(item[idx+1:])
+			if err != nil || step < 1 {
+				return fmt.Errorf("step must be a positive number")
+			}
+			rangePart = item[:idx]
+		}
+
+		if rangePart == "*" {
+			continue
+		}
+
+		values := strings.Split(rangePart, "-")
+		if len(values) > 2 {
+			return fmt.Errorf("range must be in the format `start-end`")
+		}
+
+		parsed := make([]int, 0)
+		for _, v := range values {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("%q is not a number", v)
+			}
+			if i < min || i > max {
+				return fmt.Errorf("%d must be between %d and %d", i, min, max)
+			}
+			parsed = append(parsed, i)
+		}
+
+		if len(parsed) == 2 && parsed[0] > parsed[1] {
+			return fmt.Errorf("range start %d must not be greater than range end %d", parsed[0], parsed[1])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryTaskContextPath(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "/dev/null",
+			ErrCount: 0,
+		},
+		{
+			Value:    "https://github.com/Azure-Samples/acr-build-helloworld-node.git#main",
+			ErrCount: 0,
+		},
+		{
+			Value:    "https://example.blob.core.windows.net/context/source.tar.gz?sv=2021-06-08&sig=abc",
+			ErrCount: 0,
+		},
+		{
+			Value:    "oci://example.azurecr.io/context:v1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ftp://example.com/context",
+			ErrCount: 1,
+		},
+		{
+			Value:    "https://github.com/some repo",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryTaskContextPath(tc.Value, "context_path")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected ContainerRegistryTaskContextPath to return %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestContainerRegistryTaskTimerSchedule(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "* * * * *",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0 12 * * Mon-Fri",
+			ErrCount: 1,
+		},
+		{
+			Value:    "*/15 0-6,18-23 1 1,6 0-6",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0 21 * * *",
+			ErrCount: 0,
+		},
+		{
+			Value:    "60 * * * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "* 24 * * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "* * 0 * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "* * * 13 *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "*/0 * * * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "30-10 * * * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0 0 * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0 0 * * * *",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryTaskTimerSchedule(tc.Value, "schedule")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected ContainerRegistryTaskTimerSchedule to return %d errors for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
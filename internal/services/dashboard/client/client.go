@@ -8,6 +8,7 @@ import (
 )
 
 type Client struct {
+	GrafanaPluginsClient  *GrafanaPluginsClient
 	GrafanaResourceClient *grafanaresource.GrafanaResourceClient
 }
 
@@ -18,7 +19,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(grafanaResourceClient.Client, o.Authorizers.ResourceManager)
 
+	grafanaPluginsClient, err := NewGrafanaPluginsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building GrafanaPlugins client: %+v", err)
+	}
+	o.Configure(grafanaPluginsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
+		GrafanaPluginsClient:  grafanaPluginsClient,
 		GrafanaResourceClient: grafanaResourceClient,
 	}, nil
 }
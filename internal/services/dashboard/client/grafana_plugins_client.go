@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2022-08-01/grafanaresource"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// the Grafana Plugins are only exposed in newer API Versions than the one used for the Grafana resource,
+// as such these are managed using a separate client until the Grafana resource is upgraded
+const grafanaPluginsApiVersion = "2023-10-01-preview"
+
+type GrafanaPluginsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewGrafanaPluginsClientWithBaseURI(api environments.Api) (*GrafanaPluginsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "grafanaplugins", grafanaPluginsApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GrafanaPluginsClient: %+v", err)
+	}
+
+	return &GrafanaPluginsClient{
+		Client: client,
+	}, nil
+}
+
+type GrafanaPlugin struct {
+	PluginId *string `json:"pluginId,omitempty"`
+}
+
+type grafanaPluginsProperties struct {
+	GrafanaPlugins *map[string]GrafanaPlugin `json:"grafanaPlugins,omitempty"`
+}
+
+type grafanaPluginsModel struct {
+	Properties *grafanaPluginsProperties `json:"properties,omitempty"`
+}
+
+type GrafanaPluginsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *map[string]GrafanaPlugin
+}
+
+// Get returns the Plugins installed on the specified Grafana instance, keyed by the Plugin ID
+func (c GrafanaPluginsClient) Get(ctx context.Context, id grafanaresource.GrafanaId) (result GrafanaPluginsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model grafanaPluginsModel
+	if err = resp.Unmarshal(&model); err != nil {
+		return
+	}
+
+	plugins := make(map[string]GrafanaPlugin)
+	if model.Properties != nil && model.Properties.GrafanaPlugins != nil {
+		plugins = *model.Properties.GrafanaPlugins
+	}
+	result.Model = &plugins
+
+	return
+}
+
+// UpdateThenPoll replaces the Plugins installed on the specified Grafana instance, installing any Plugins which
+// aren't installed and uninstalling any Plugins which aren't specified, then polls until this has completed
+func (c GrafanaPluginsClient) UpdateThenPoll(ctx context.Context, id grafanaresource.GrafanaId, plugins map[string]GrafanaPlugin) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPatch,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	input := grafanaPluginsModel{
+		Properties: &grafanaPluginsProperties{
+			GrafanaPlugins: &plugins,
+		},
+	}
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Update: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Update: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2022-08-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	dashboardClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DashboardGrafanaPluginModel struct {
+	GrafanaId string `tfschema:"grafana_id"`
+	PluginId  string `tfschema:"plugin_id"`
+}
+
+type DashboardGrafanaPluginResource struct{}
+
+var _ sdk.Resource = DashboardGrafanaPluginResource{}
+
+func (r DashboardGrafanaPluginResource) ResourceType() string {
+	return "azurerm_dashboard_grafana_plugin"
+}
+
+func (r DashboardGrafanaPluginResource) ModelObject() interface{} {
+	return &DashboardGrafanaPluginModel{}
+}
+
+func (r DashboardGrafanaPluginResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.GrafanaPluginID
+}
+
+func (r DashboardGrafanaPluginResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"grafana_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: grafanaresource.ValidateGrafanaID,
+		},
+
+		"plugin_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotEmpty,
+				validation.StringDoesNotContainAny("/"),
+			),
+		},
+	}
+}
+
+func (r DashboardGrafanaPluginResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DashboardGrafanaPluginResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaPluginsClient
+
+			var model DashboardGrafanaPluginModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			grafanaId, err := grafanaresource.ParseGrafanaID(model.GrafanaId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewGrafanaPluginID(grafanaId.SubscriptionId, grafanaId.ResourceGroupName, grafanaId.GrafanaName, model.PluginId)
+
+			locks.ByID(grafanaId.ID())
+			defer locks.UnlockByID(grafanaId.ID())
+
+			existing, err := client.Get(ctx, *grafanaId)
+			if err != nil {
+				return fmt.Errorf("retrieving the Plugins for %s: %+v", *grafanaId, err)
+			}
+
+			plugins := make(map[string]dashboardClient.GrafanaPlugin)
+			if existing.Model != nil {
+				plugins = *existing.Model
+			}
+			if _, ok := findGrafanaPlugin(plugins, id.PluginName); ok {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			plugins[id.PluginName] = dashboardClient.GrafanaPlugin{}
+			if err := client.UpdateThenPoll(ctx, *grafanaId, plugins); err != nil {
+				return fmt.Errorf("installing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DashboardGrafanaPluginResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaPluginsClient
+
+			id, err := parse.GrafanaPluginID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroup, id.GrafanaName)
+
+			resp, err := client.Get(ctx, grafanaId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving the Plugins for %s: %+v", grafanaId, err)
+			}
+
+			if resp.Model == nil {
+				return fmt.Errorf("retrieving the Plugins for %s: model was nil", grafanaId)
+			}
+
+			pluginId, ok := findGrafanaPlugin(*resp.Model, id.PluginName)
+			if !ok {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := DashboardGrafanaPluginModel{
+				GrafanaId: grafanaId.ID(),
+				PluginId:  pluginId,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DashboardGrafanaPluginResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dashboard.GrafanaPluginsClient
+
+			id, err := parse.GrafanaPluginID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroup, id.GrafanaName)
+
+			locks.ByID(grafanaId.ID())
+			defer locks.UnlockByID(grafanaId.ID())
+
+			existing, err := client.Get(ctx, grafanaId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+
+				return fmt.Errorf("retrieving the Plugins for %s: %+v", grafanaId, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving the Plugins for %s: model was nil", grafanaId)
+			}
+
+			plugins := *existing.Model
+			pluginId, ok := findGrafanaPlugin(plugins, id.PluginName)
+			if !ok {
+				return nil
+			}
+
+			delete(plugins, pluginId)
+			if err := client.UpdateThenPoll(ctx, grafanaId, plugins); err != nil {
+				return fmt.Errorf("uninstalling %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findGrafanaPlugin returns the key of the specified Plugin within the installed Plugins, since the casing
+// returned by the API can differ from the casing which was specified
+func findGrafanaPlugin(plugins map[string]dashboardClient.GrafanaPlugin, pluginId string) (string, bool) {
+	for key, plugin := range plugins {
+		if strings.EqualFold(key, pluginId) || strings.EqualFold(pointer.From(plugin.PluginId), pluginId) {
+			return key, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,148 @@
+package dashboard_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2022-08-01/grafanaresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dashboard/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DashboardGrafanaPluginResource struct{}
+
+func TestAccDashboardGrafanaPlugin_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_plugin", "test")
+	r := DashboardGrafanaPluginResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDashboardGrafanaPlugin_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_plugin", "test")
+	r := DashboardGrafanaPluginResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDashboardGrafanaPlugin_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dashboard_grafana_plugin", "test")
+	r := DashboardGrafanaPluginResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_dashboard_grafana_plugin.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing one of the Plugins should leave the other installed
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DashboardGrafanaPluginResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GrafanaPluginID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	grafanaId := grafanaresource.NewGrafanaID(id.SubscriptionId, id.ResourceGroup, id.GrafanaName)
+	resp, err := clients.Dashboard.GrafanaPluginsClient.Get(ctx, grafanaId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving the Plugins for %s: %+v", grafanaId, err)
+	}
+
+	if resp.Model != nil {
+		for key := range *resp.Model {
+			if strings.EqualFold(key, id.PluginName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r DashboardGrafanaPluginResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest-rg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dashboard_grafana" "test" {
+  name                = "a-dg-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DashboardGrafanaPluginResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_plugin" "test" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  plugin_id  = "grafana-clock-panel"
+}
+`, r.template(data))
+}
+
+func (r DashboardGrafanaPluginResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_plugin" "import" {
+  grafana_id = azurerm_dashboard_grafana_plugin.test.grafana_id
+  plugin_id  = azurerm_dashboard_grafana_plugin.test.plugin_id
+}
+`, r.basic(data))
+}
+
+func (r DashboardGrafanaPluginResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dashboard_grafana_plugin" "second" {
+  grafana_id = azurerm_dashboard_grafana.test.id
+  plugin_id  = "grafana-polystat-panel"
+}
+`, r.basic(data))
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type GrafanaPluginId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	GrafanaName    string
+	PluginName     string
+}
+
+func NewGrafanaPluginID(subscriptionId, resourceGroup, grafanaName, pluginName string) GrafanaPluginId {
+	return GrafanaPluginId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		GrafanaName:    grafanaName,
+		PluginName:     pluginName,
+	}
+}
+
+func (id GrafanaPluginId) String() string {
+	segments := []string{
+		fmt.Sprintf("Plugin Name %q", id.PluginName),
+		fmt.Sprintf("Grafana Name %q", id.GrafanaName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Grafana Plugin", segmentsStr)
+}
+
+func (id GrafanaPluginId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Dashboard/grafana/%s/plugins/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GrafanaName, id.PluginName)
+}
+
+// GrafanaPluginID parses a GrafanaPlugin ID into an GrafanaPluginId struct
+func GrafanaPluginID(input string) (*GrafanaPluginId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an GrafanaPlugin ID: %+v", input, err)
+	}
+
+	resourceId := GrafanaPluginId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.GrafanaName, err = id.PopSegment("grafana"); err != nil {
+		return nil, err
+	}
+	if resourceId.PluginName, err = id.PopSegment("plugins"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
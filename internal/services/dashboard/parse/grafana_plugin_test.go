@@ -0,0 +1,128 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = GrafanaPluginId{}
+
+func TestGrafanaPluginIDFormatter(t *testing.T) {
+	actual := NewGrafanaPluginID("12345678-1234-9876-4563-123456789012", "group1", "grafana1", "plugin1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/grafana1/plugins/plugin1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestGrafanaPluginID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *GrafanaPluginId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing GrafanaName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/",
+			Error: true,
+		},
+
+		{
+			// missing value for GrafanaName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/",
+			Error: true,
+		},
+
+		{
+			// missing PluginName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/grafana1/",
+			Error: true,
+		},
+
+		{
+			// missing value for PluginName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/grafana1/plugins/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/grafana1/plugins/plugin1",
+			Expected: &GrafanaPluginId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+				GrafanaName:    "grafana1",
+				PluginName:     "plugin1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.DASHBOARD/GRAFANA/GRAFANA1/PLUGINS/PLUGIN1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := GrafanaPluginID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.GrafanaName != v.Expected.GrafanaName {
+			t.Fatalf("Expected %q but got %q for GrafanaName", v.Expected.GrafanaName, actual.GrafanaName)
+		}
+		if actual.PluginName != v.Expected.PluginName {
+			t.Fatalf("Expected %q but got %q for PluginName", v.Expected.PluginName, actual.PluginName)
+		}
+	}
+}
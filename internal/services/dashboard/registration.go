@@ -47,5 +47,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DashboardGrafanaResource{},
+		DashboardGrafanaPluginResource{},
 	}
 }
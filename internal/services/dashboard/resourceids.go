@@ -0,0 +1,3 @@
+package dashboard
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=GrafanaPlugin -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Dashboard/grafana/grafana1/plugins/plugin1
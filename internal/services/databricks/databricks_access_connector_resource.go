@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -26,6 +27,8 @@ type AccessConnectorResourceModel struct {
 	ResourceGroup string            `tfschema:"resource_group_name"`
 	Location      string            `tfschema:"location"`
 	Tags          map[string]string `tfschema:"tags"`
+
+	UserAssignedIdentityPrincipalIds map[string]string `tfschema:"user_assigned_identity_principal_ids"`
 }
 
 func (r AccessConnectorResource) Arguments() map[string]*pluginsdk.Schema {
@@ -41,14 +44,22 @@ func (r AccessConnectorResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"resource_group_name": commonschema.ResourceGroupName(),
 
-		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
 		"tags": commonschema.Tags(),
 	}
 }
 
 func (r AccessConnectorResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"user_assigned_identity_principal_ids": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
 }
 
 func (r AccessConnectorResource) ModelObject() interface{} {
@@ -127,8 +138,6 @@ func (r AccessConnectorResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("identity") {
-				// TODO: Switch this to 'identity.ExpandSystemOrSingleUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))'
-				// once SDK Helpers PR #164 has been merged and integrated into the provider...
 				identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
 				if err != nil {
 					return fmt.Errorf("expanding `identity`: %+v", err)
@@ -189,6 +198,8 @@ func (r AccessConnectorResource) Read() sdk.ResourceFunc {
 					if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
 						return fmt.Errorf("setting `identity`: %+v", err)
 					}
+
+					state.UserAssignedIdentityPrincipalIds = flattenAccessConnectorUserAssignedIdentityPrincipalIds(model.Identity)
 				}
 			}
 			return metadata.Encode(&state)
@@ -216,3 +227,25 @@ func (r AccessConnectorResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// flattenAccessConnectorUserAssignedIdentityPrincipalIds returns a map of each User Assigned Identity ID to its Principal ID,
+// which is needed to grant each identity access to the storage used by Unity Catalog
+func flattenAccessConnectorUserAssignedIdentityPrincipalIds(input *identity.LegacySystemAndUserAssignedMap) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range input.IdentityIds {
+		identityId := k
+		if parsed, err := commonids.ParseUserAssignedIdentityIDInsensitively(k); err == nil {
+			identityId = parsed.ID()
+		}
+
+		if v.PrincipalId != nil {
+			output[identityId] = *v.PrincipalId
+		}
+	}
+
+	return output
+}
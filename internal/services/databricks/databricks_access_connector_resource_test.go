@@ -61,6 +61,21 @@ func TestAccDatabricksAccessConnector_identityUserAssigned(t *testing.T) {
 	})
 }
 
+func TestAccDatabricksAccessConnector_identityMultipleUserAssigned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_access_connector", "test")
+	r := DatabricksAccessConnectorResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityMultipleUserAssigned(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_assigned_identity_principal_ids.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDatabricksAccessConnector_identityComplete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_databricks_access_connector", "test")
 	r := DatabricksAccessConnectorResource{}
@@ -182,6 +197,42 @@ resource "azurerm_databricks_access_connector" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r DatabricksAccessConnectorResource) identityMultipleUserAssigned(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestDBUAI-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_user_assigned_identity" "other" {
+  name                = "acctestDBUAI2-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_databricks_access_connector" "test" {
+  name                = "acctestDBAC%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  identity {
+    type = "SystemAssigned, UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+      azurerm_user_assigned_identity.other.id,
+    ]
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r DatabricksAccessConnectorResource) basic(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,155 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: this workaround client exists since the Data Factory SDK contains the models for Credentials
+// but is missing the `CredentialOperationsClient` used to manage them
+
+type CredentialsClient struct {
+	datafactory.BaseClient
+}
+
+type CredentialResourceModel struct {
+	autorest.Response `json:"-"`
+	Value             *datafactory.CredentialResource
+}
+
+func NewCredentialsClientWithBaseURI(baseURI string, subscriptionID string) CredentialsClient {
+	return CredentialsClient{datafactory.NewWithBaseURI(baseURI, subscriptionID)}
+}
+
+func (client CredentialsClient) Get(ctx context.Context, resourceGroupName string, factoryName string, credentialName string) (result CredentialResourceModel, err error) {
+	req, err := client.GetPreparer(ctx, resourceGroupName, factoryName, credentialName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetPreparer prepares the Get request.
+func (client CredentialsClient) GetPreparer(ctx context.Context, resourceGroupName string, factoryName string, credentialName string) (*http.Request, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(credentialPath, client.pathParameters(resourceGroupName, factoryName, credentialName)),
+		autorest.WithQueryParameters(queryParameters()))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetResponder handles the response to the Get request. The method always closes the http.Response Body.
+func (client CredentialsClient) GetResponder(resp *http.Response) (result CredentialResourceModel, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Value),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+func (client CredentialsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, factoryName string, credentialName string, credential datafactory.CredentialResource) (result CredentialResourceModel, err error) {
+	req, err := client.CreateOrUpdatePreparer(ctx, resourceGroupName, factoryName, credentialName, credential)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "CreateOrUpdate", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "CreateOrUpdate", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdatePreparer prepares the CreateOrUpdate request.
+func (client CredentialsClient) CreateOrUpdatePreparer(ctx context.Context, resourceGroupName string, factoryName string, credentialName string, credential datafactory.CredentialResource) (*http.Request, error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(credentialPath, client.pathParameters(resourceGroupName, factoryName, credentialName)),
+		autorest.WithJSON(credential),
+		autorest.WithQueryParameters(queryParameters()))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client CredentialsClient) Delete(ctx context.Context, resourceGroupName string, factoryName string, credentialName string) (result autorest.Response, err error) {
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters(credentialPath, client.pathParameters(resourceGroupName, factoryName, credentialName)),
+		autorest.WithQueryParameters(queryParameters()))
+	req, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datafactory.CredentialsClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return
+}
+
+const apiVersion = "2018-06-01"
+
+const credentialPath = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.DataFactory/factories/{factoryName}/credentials/{credentialName}"
+
+func (client CredentialsClient) pathParameters(resourceGroupName string, factoryName string, credentialName string) map[string]interface{} {
+	return map[string]interface{}{
+		"credentialName":    autorest.Encode("path", credentialName),
+		"factoryName":       autorest.Encode("path", factoryName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+}
+
+func queryParameters() map[string]interface{} {
+	return map[string]interface{}{
+		"api-version": apiVersion,
+	}
+}
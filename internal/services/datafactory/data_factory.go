@@ -1,6 +1,7 @@
 package datafactory
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -8,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -32,6 +35,31 @@ func expandDataFactoryLinkedServiceIntegrationRuntime(integrationRuntimeName str
 	}
 }
 
+// expandDataFactoryLinkedServiceCredential returns a reference to the named Credential, ensuring that it
+// exists within the Data Factory since otherwise the Linked Service fails to authenticate at runtime
+func expandDataFactoryLinkedServiceCredential(ctx context.Context, client *azuresdkhacks.CredentialsClient, dataFactoryId parse.DataFactoryId, credentialName string) (*datafactory.CredentialReference, error) {
+	credentialId := parse.NewCredentialID(dataFactoryId.SubscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, credentialName)
+	resp, err := client.Get(ctx, credentialId.ResourceGroup, credentialId.FactoryName, credentialId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("the Credential %q referenced by `credential_name` was not found in %s", credentialName, dataFactoryId)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", credentialId, err)
+	}
+
+	return &datafactory.CredentialReference{
+		Type:          utils.String("CredentialReference"),
+		ReferenceName: utils.String(credentialName),
+	}, nil
+}
+
+func flattenDataFactoryLinkedServiceCredential(input *datafactory.CredentialReference) string {
+	if input == nil || input.ReferenceName == nil {
+		return ""
+	}
+	return *input.ReferenceName
+}
+
 // Because the password isn't returned from the api in the connection string, we'll check all
 // but the password string and return true if they match.
 func azureRmDataFactoryLinkedServiceConnectionStringDiff(_, old string, new string, _ *pluginsdk.ResourceData) bool {
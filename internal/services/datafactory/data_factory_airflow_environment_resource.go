@@ -0,0 +1,389 @@
+package datafactory
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Airflow Integration Runtime type isn't available in the Data Factory SDK, so the
+// typeProperties are sent and parsed using the types below
+const dataFactoryIntegrationRuntimeTypeAirflow datafactory.TypeBasicIntegrationRuntime = "Airflow"
+
+type dataFactoryAirflowTypeProperties struct {
+	ComputeProperties *dataFactoryAirflowComputeProperties `json:"computeProperties,omitempty"`
+	AirflowProperties *dataFactoryAirflowProperties        `json:"airflowProperties,omitempty"`
+}
+
+type dataFactoryAirflowComputeProperties struct {
+	Location    *string `json:"location,omitempty"`
+	ComputeSize *string `json:"computeSize,omitempty"`
+	ExtraNodes  *int    `json:"extraNodes,omitempty"`
+}
+
+type dataFactoryAirflowProperties struct {
+	AirflowVersion       *string            `json:"airflowVersion,omitempty"`
+	AirflowRequirements  *[]string          `json:"airflowRequirements,omitempty"`
+	EnvironmentVariables *map[string]string `json:"environmentVariables,omitempty"`
+	EnableAADIntegration *bool              `json:"enableAADIntegration,omitempty"`
+	UserName             *string            `json:"userName,omitempty"`
+	Password             *string            `json:"password,omitempty"`
+}
+
+func resourceDataFactoryAirflowEnvironment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataFactoryAirflowEnvironmentCreateUpdate,
+		Read:   resourceDataFactoryAirflowEnvironmentRead,
+		Update: resourceDataFactoryAirflowEnvironmentCreateUpdate,
+		Delete: resourceDataFactoryAirflowEnvironmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IntegrationRuntimeID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid name for Airflow Environment: minimum 3 characters, must start and end with a number or a letter, may only consist of letters, numbers and dashes and no consecutive dashes.`,
+				),
+			},
+
+			"data_factory_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataFactoryID,
+			},
+
+			"location": commonschema.Location(),
+
+			"airflow_version": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"node_size": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Small",
+					"Large",
+				}, false),
+			},
+
+			"additional_node_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"requirements": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"aad_integration_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"basic_authentication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"username": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataFactoryAirflowEnvironmentCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	subscriptionId := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient.SubscriptionID
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	dataFactoryId, err := parse.DataFactoryID(d.Get("data_factory_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewIntegrationRuntimeID(subscriptionId, dataFactoryId.ResourceGroup, dataFactoryId.FactoryName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_data_factory_airflow_environment", id.ID())
+		}
+	}
+
+	typeProperties, err := expandDataFactoryAirflowTypeProperties(d)
+	if err != nil {
+		return err
+	}
+
+	description := d.Get("description").(string)
+	airflowIntegrationRuntime := datafactory.IntegrationRuntime{
+		Description: &description,
+		AdditionalProperties: map[string]interface{}{
+			"type":           dataFactoryIntegrationRuntimeTypeAirflow,
+			"typeProperties": typeProperties,
+		},
+	}
+
+	integrationRuntime := datafactory.IntegrationRuntimeResource{
+		Name:       &id.Name,
+		Properties: airflowIntegrationRuntime,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, integrationRuntime, ""); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceDataFactoryAirflowEnvironmentRead(d, meta)
+}
+
+func resourceDataFactoryAirflowEnvironmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	dataFactoryId := parse.NewDataFactoryID(id.SubscriptionId, id.ResourceGroup, id.FactoryName)
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("data_factory_id", dataFactoryId.ID())
+
+	integrationRuntime, ok := resp.Properties.AsIntegrationRuntime()
+	if !ok || integrationRuntime.Type != dataFactoryIntegrationRuntimeTypeAirflow {
+		return fmt.Errorf("converting %s to an Airflow Environment", *id)
+	}
+
+	d.Set("description", integrationRuntime.Description)
+
+	typeProperties, err := flattenDataFactoryAirflowTypeProperties(integrationRuntime.AdditionalProperties["typeProperties"])
+	if err != nil {
+		return fmt.Errorf("parsing `typeProperties` for %s: %+v", *id, err)
+	}
+
+	if computeProps := typeProperties.ComputeProperties; computeProps != nil {
+		d.Set("location", location.NormalizeNilable(computeProps.Location))
+		d.Set("node_size", computeProps.ComputeSize)
+
+		additionalNodeCount := 0
+		if computeProps.ExtraNodes != nil {
+			additionalNodeCount = *computeProps.ExtraNodes
+		}
+		d.Set("additional_node_count", additionalNodeCount)
+	}
+
+	if airflowProps := typeProperties.AirflowProperties; airflowProps != nil {
+		d.Set("airflow_version", airflowProps.AirflowVersion)
+
+		requirements := make([]interface{}, 0)
+		if airflowProps.AirflowRequirements != nil {
+			for _, v := range *airflowProps.AirflowRequirements {
+				requirements = append(requirements, v)
+			}
+		}
+		if err := d.Set("requirements", requirements); err != nil {
+			return fmt.Errorf("setting `requirements`: %+v", err)
+		}
+
+		environmentVariables := make(map[string]interface{})
+		if airflowProps.EnvironmentVariables != nil {
+			for k, v := range *airflowProps.EnvironmentVariables {
+				environmentVariables[k] = v
+			}
+		}
+		if err := d.Set("environment_variables", environmentVariables); err != nil {
+			return fmt.Errorf("setting `environment_variables`: %+v", err)
+		}
+
+		aadIntegrationEnabled := true
+		if airflowProps.EnableAADIntegration != nil {
+			aadIntegrationEnabled = *airflowProps.EnableAADIntegration
+		}
+		d.Set("aad_integration_enabled", aadIntegrationEnabled)
+
+		// the password isn't returned by the API, so this is retrieved from the config
+		basicAuthentication := make([]interface{}, 0)
+		if airflowProps.UserName != nil && *airflowProps.UserName != "" {
+			password := ""
+			if v, ok := d.GetOk("basic_authentication.0.password"); ok {
+				password = v.(string)
+			}
+			basicAuthentication = append(basicAuthentication, map[string]interface{}{
+				"username": *airflowProps.UserName,
+				"password": password,
+			})
+		}
+		if err := d.Set("basic_authentication", basicAuthentication); err != nil {
+			return fmt.Errorf("setting `basic_authentication`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataFactoryAirflowEnvironmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IntegrationRuntimeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Delete(ctx, id.ResourceGroup, id.FactoryName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(response) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandDataFactoryAirflowTypeProperties(d *pluginsdk.ResourceData) (*dataFactoryAirflowTypeProperties, error) {
+	requirements := make([]string, 0)
+	for _, v := range d.Get("requirements").([]interface{}) {
+		requirements = append(requirements, v.(string))
+	}
+
+	environmentVariables := make(map[string]string)
+	for k, v := range d.Get("environment_variables").(map[string]interface{}) {
+		environmentVariables[k] = v.(string)
+	}
+
+	aadIntegrationEnabled := d.Get("aad_integration_enabled").(bool)
+	airflowProperties := &dataFactoryAirflowProperties{
+		AirflowVersion:       utils.String(d.Get("airflow_version").(string)),
+		AirflowRequirements:  &requirements,
+		EnvironmentVariables: &environmentVariables,
+		EnableAADIntegration: utils.Bool(aadIntegrationEnabled),
+	}
+
+	basicAuthentication := d.Get("basic_authentication").([]interface{})
+	if len(basicAuthentication) > 0 && basicAuthentication[0] != nil {
+		if aadIntegrationEnabled {
+			return nil, fmt.Errorf("`basic_authentication` cannot be specified when `aad_integration_enabled` is `true`")
+		}
+		raw := basicAuthentication[0].(map[string]interface{})
+		airflowProperties.UserName = utils.String(raw["username"].(string))
+		airflowProperties.Password = utils.String(raw["password"].(string))
+	} else if !aadIntegrationEnabled {
+		return nil, fmt.Errorf("`basic_authentication` must be specified when `aad_integration_enabled` is `false`")
+	}
+
+	return &dataFactoryAirflowTypeProperties{
+		ComputeProperties: &dataFactoryAirflowComputeProperties{
+			Location:    utils.String(location.Normalize(d.Get("location").(string))),
+			ComputeSize: utils.String(d.Get("node_size").(string)),
+			ExtraNodes:  utils.Int(d.Get("additional_node_count").(int)),
+		},
+		AirflowProperties: airflowProperties,
+	}, nil
+}
+
+func flattenDataFactoryAirflowTypeProperties(input interface{}) (*dataFactoryAirflowTypeProperties, error) {
+	result := dataFactoryAirflowTypeProperties{}
+	if input == nil {
+		return &result, nil
+	}
+
+	// the typeProperties are returned as a generic map, so round-trip these through JSON to parse them
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
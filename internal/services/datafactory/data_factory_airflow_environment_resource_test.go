@@ -0,0 +1,181 @@
+package datafactory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AirflowEnvironmentResource struct{}
+
+func TestAccDataFactoryAirflowEnvironment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_airflow_environment", "test")
+	r := AirflowEnvironmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryAirflowEnvironment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_airflow_environment", "test")
+	r := AirflowEnvironmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("basic_authentication.0.password"),
+	})
+}
+
+func TestAccDataFactoryAirflowEnvironment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_airflow_environment", "test")
+	r := AirflowEnvironmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("basic_authentication.0.password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryAirflowEnvironment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_airflow_environment", "test")
+	r := AirflowEnvironmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t AirflowEnvironmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IntegrationRuntimeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (AirflowEnvironmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_airflow_environment" "test" {
+  name            = "acctestafe%d"
+  data_factory_id = azurerm_data_factory.test.id
+  location        = azurerm_resource_group.test.location
+  airflow_version = "2.6.3"
+  node_size       = "Small"
+}
+`, AirflowEnvironmentResource{}.template(data), data.RandomInteger)
+}
+
+func (AirflowEnvironmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_airflow_environment" "test" {
+  name                    = "acctestafe%d"
+  data_factory_id         = azurerm_data_factory.test.id
+  location                = azurerm_resource_group.test.location
+  description             = "acctest"
+  airflow_version         = "2.6.3"
+  node_size               = "Large"
+  additional_node_count   = 1
+  aad_integration_enabled = false
+
+  requirements = [
+    "apache-airflow-providers-microsoft-azure",
+  ]
+
+  environment_variables = {
+    ENV = "Test"
+  }
+
+  basic_authentication {
+    username = "acctestuser"
+    password = "P@ssw0rd1234!"
+  }
+}
+`, AirflowEnvironmentResource{}.template(data), data.RandomInteger)
+}
+
+func (AirflowEnvironmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_factory_airflow_environment" "import" {
+  name            = azurerm_data_factory_airflow_environment.test.name
+  data_factory_id = azurerm_data_factory_airflow_environment.test.data_factory_id
+  location        = azurerm_data_factory_airflow_environment.test.location
+  airflow_version = azurerm_data_factory_airflow_environment.test.airflow_version
+  node_size       = azurerm_data_factory_airflow_environment.test.node_size
+}
+`, AirflowEnvironmentResource{}.basic(data))
+}
+
+func (AirflowEnvironmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfafe%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -154,6 +154,15 @@ func resourceDataFactoryLinkedServiceAzureBlobStorage() *pluginsdk.Resource {
 				},
 			},
 
+			"credential_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.LinkedServiceDatasetName,
+				ConflictsWith: []string{
+					"service_principal_id",
+				},
+			},
+
 			"service_endpoint": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -265,7 +274,17 @@ func resourceDataFactoryLinkedServiceBlobStorageCreateUpdate(d *pluginsdk.Resour
 		if v, ok := d.GetOk("service_endpoint"); ok {
 			blobStorageProperties.ServiceEndpoint = utils.String(v.(string))
 		}
+		if v, ok := d.GetOk("credential_name"); ok {
+			credential, err := expandDataFactoryLinkedServiceCredential(ctx, meta.(*clients.Client).DataFactory.CredentialsClient, *dataFactoryId, v.(string))
+			if err != nil {
+				return err
+			}
+			blobStorageProperties.Credential = credential
+		}
 	} else {
+		if _, ok := d.GetOk("credential_name"); ok {
+			return fmt.Errorf("`credential_name` can only be specified when `use_managed_identity` is `true`")
+		}
 		if v, ok := d.GetOk("service_endpoint"); ok {
 			blobStorageProperties.ServiceEndpoint = utils.String(v.(string))
 		}
@@ -374,6 +393,7 @@ func resourceDataFactoryLinkedServiceBlobStorageRead(d *pluginsdk.ResourceData,
 
 	if properties := blobStorage.AzureBlobStorageLinkedServiceTypeProperties; properties != nil {
 		d.Set("storage_kind", properties.AccountKind)
+		d.Set("credential_name", flattenDataFactoryLinkedServiceCredential(properties.Credential))
 		if sasToken := properties.SasToken; sasToken != nil {
 			if keyVaultPassword, ok := sasToken.AsAzureKeyVaultSecretReference(); ok {
 				if err := d.Set("key_vault_sas_token", flattenAzureKeyVaultSecretReference(keyVaultPassword)); err != nil {
@@ -46,6 +46,22 @@ func TestAccDataFactoryLinkedServiceAzureBlobStorage_managed_id(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryLinkedServiceAzureBlobStorage_credential(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_azure_blob_storage", "test")
+	r := LinkedServiceAzureBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.credential(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_name").HasValue(fmt.Sprintf("acctestdfc%d", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("service_endpoint"),
+	})
+}
+
 func TestAccDataFactoryLinkedServiceAzureBlobStorage_sas_uri(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_azure_blob_storage", "test")
 	r := LinkedServiceAzureBlobStorageResource{}
@@ -259,6 +275,34 @@ resource "azurerm_data_factory_linked_service_azure_blob_storage" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (LinkedServiceAzureBlobStorageResource) credential(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accsa%[2]d"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_data_factory_linked_service_azure_blob_storage" "test" {
+  name                 = "acctestBlobStorage%[2]d"
+  data_factory_id      = azurerm_data_factory.test.id
+  use_managed_identity = true
+  service_endpoint     = azurerm_storage_account.test.primary_blob_endpoint
+  credential_name      = azurerm_data_factory_credential_user_managed_identity.test.name
+}
+`, CredentialUserManagedIdentityResource{}.basic(data), data.RandomInteger)
+}
+
 func (LinkedServiceAzureBlobStorageResource) managed_id(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
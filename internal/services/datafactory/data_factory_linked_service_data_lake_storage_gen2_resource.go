@@ -63,6 +63,13 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
 			},
 
+			"credential_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validate.LinkedServiceDatasetName,
+				ConflictsWith: []string{"service_principal_key", "service_principal_id", "storage_account_key", "tenant"},
+			},
+
 			"service_principal_id": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
@@ -168,6 +175,16 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate(d *pluginsd
 		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
 			URL: utils.String(d.Get("url").(string)),
 		}
+
+		if v, ok := d.GetOk("credential_name"); ok {
+			credential, err := expandDataFactoryLinkedServiceCredential(ctx, meta.(*clients.Client).DataFactory.CredentialsClient, *dataFactoryId, v.(string))
+			if err != nil {
+				return err
+			}
+			datalakeStorageGen2Properties.Credential = credential
+		}
+	} else if _, ok := d.GetOk("credential_name"); ok {
+		return fmt.Errorf("`credential_name` can only be specified when `use_managed_identity` is `true`")
 	} else if v, ok := d.GetOk("storage_account_key"); ok {
 		datalakeStorageGen2Properties = &datafactory.AzureBlobFSLinkedServiceTypeProperties{
 			URL: utils.String(d.Get("url").(string)),
@@ -270,6 +287,10 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2Read(d *pluginsdk.Resour
 		d.Set("url", dataLakeStorageGen2.URL)
 	}
 
+	if props := dataLakeStorageGen2.AzureBlobFSLinkedServiceTypeProperties; props != nil {
+		d.Set("credential_name", flattenDataFactoryLinkedServiceCredential(props.Credential))
+	}
+
 	d.Set("additional_properties", dataLakeStorageGen2.AdditionalProperties)
 
 	if dataLakeStorageGen2.Description != nil {
@@ -50,6 +50,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_data_factory_dataset_snowflake":                     resourceDataFactoryDatasetSnowflake(),
 		"azurerm_data_factory_dataset_sql_server_table":              resourceDataFactoryDatasetSQLServerTable(),
 		"azurerm_data_factory_custom_dataset":                        resourceDataFactoryCustomDataset(),
+		"azurerm_data_factory_credential_service_principal":          resourceDataFactoryCredentialServicePrincipal(),
+		"azurerm_data_factory_credential_user_managed_identity":      resourceDataFactoryCredentialUserManagedIdentity(),
+		"azurerm_data_factory_airflow_environment":                   resourceDataFactoryAirflowEnvironment(),
 		"azurerm_data_factory_integration_runtime_managed":           resourceDataFactoryIntegrationRuntimeManaged(),
 		"azurerm_data_factory_integration_runtime_azure":             resourceDataFactoryIntegrationRuntimeAzure(),
 		"azurerm_data_factory_integration_runtime_azure_ssis":        resourceDataFactoryIntegrationRuntimeAzureSsis(),
@@ -0,0 +1,134 @@
+package digitaltwins
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2020-12-01/digitaltwinsinstance"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2020-12-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceDigitalTwinsEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDigitalTwinsEndpointRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DigitalTwinsInstanceName,
+			},
+
+			"digital_twins_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: digitaltwinsinstance.ValidateDigitalTwinsInstanceID,
+			},
+
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"endpoint_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"endpoint_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"entity_path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDigitalTwinsEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	digitalTwinsId, err := endpoints.ParseDigitalTwinsInstanceID(d.Get("digital_twins_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := endpoints.NewEndpointID(digitalTwinsId.SubscriptionId, digitalTwinsId.ResourceGroupName, digitalTwinsId.DigitalTwinsInstanceName, d.Get("name").(string))
+	resp, err := client.DigitalTwinsEndpointGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("%s does not exist", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.EndpointName)
+	d.Set("digital_twins_id", digitaltwinsinstance.NewDigitalTwinsInstanceID(id.SubscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName).ID())
+
+	authenticationType := ""
+	endpointType := ""
+	endpointUri := ""
+	entityPath := ""
+	provisioningState := ""
+	if model := resp.Model; model != nil {
+		switch props := model.Properties.(type) {
+		case endpoints.EventGrid:
+			endpointType = "EventGrid"
+			if props.AuthenticationType != nil {
+				authenticationType = string(*props.AuthenticationType)
+			}
+			endpointUri = utils.NormalizeNilableString(props.TopicEndpoint)
+			if props.ProvisioningState != nil {
+				provisioningState = string(*props.ProvisioningState)
+			}
+		case endpoints.EventHub:
+			endpointType = "EventHub"
+			if props.AuthenticationType != nil {
+				authenticationType = string(*props.AuthenticationType)
+			}
+			endpointUri = utils.NormalizeNilableString(props.EndpointUri)
+			entityPath = utils.NormalizeNilableString(props.EntityPath)
+			if props.ProvisioningState != nil {
+				provisioningState = string(*props.ProvisioningState)
+			}
+		case endpoints.ServiceBus:
+			endpointType = "ServiceBus"
+			if props.AuthenticationType != nil {
+				authenticationType = string(*props.AuthenticationType)
+			}
+			endpointUri = utils.NormalizeNilableString(props.EndpointUri)
+			entityPath = utils.NormalizeNilableString(props.EntityPath)
+			if props.ProvisioningState != nil {
+				provisioningState = string(*props.ProvisioningState)
+			}
+		}
+	}
+	d.Set("authentication_type", authenticationType)
+	d.Set("endpoint_type", endpointType)
+	d.Set("endpoint_uri", endpointUri)
+	d.Set("entity_path", entityPath)
+	d.Set("provisioning_state", provisioningState)
+
+	return nil
+}
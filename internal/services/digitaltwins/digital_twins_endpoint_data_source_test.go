@@ -0,0 +1,38 @@
+package digitaltwins_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DigitalTwinsEndpointDataSource struct{}
+
+func TestAccDigitalTwinsEndpointDataSource_eventHub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_digital_twins_endpoint", "test")
+	r := DigitalTwinsEndpointDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.eventHub(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("endpoint_type").HasValue("EventHub"),
+				check.That(data.ResourceName).Key("authentication_type").HasValue("KeyBased"),
+				check.That(data.ResourceName).Key("provisioning_state").Exists(),
+			),
+		},
+	})
+}
+
+func (DigitalTwinsEndpointDataSource) eventHub(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_digital_twins_endpoint" "test" {
+  name             = azurerm_digital_twins_endpoint_eventhub.test.name
+  digital_twins_id = azurerm_digital_twins_endpoint_eventhub.test.digital_twins_id
+}
+`, DigitalTwinsEndpointEventHubResource{}.basic(data))
+}
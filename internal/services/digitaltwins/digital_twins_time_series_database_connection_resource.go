@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2022-10-31/timeseriesdatabaseconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2022-07-07/clusters"
@@ -29,6 +31,7 @@ type TimeSeriesDatabaseConnectionModel struct {
 	KustoClusterUri              string `tfschema:"kusto_cluster_uri"`
 	KustoDatabaseName            string `tfschema:"kusto_database_name"`
 	KustoTableName               string `tfschema:"kusto_table_name"`
+	UserAssignedIdentityId       string `tfschema:"user_assigned_identity_id"`
 }
 
 type TimeSeriesDatabaseConnectionResource struct{}
@@ -106,6 +109,13 @@ func (m TimeSeriesDatabaseConnectionResource) Arguments() map[string]*pluginsdk.
 			ForceNew:     true,
 			ValidateFunc: kustoValidate.EntityName,
 		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
 	}
 }
 
@@ -168,6 +178,13 @@ func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 				properties.EventHubConsumerGroup = utils.String(model.EventhubConsumerGroupName)
 			}
 
+			if model.UserAssignedIdentityId != "" {
+				properties.Identity = &timeseriesdatabaseconnections.ManagedIdentityReference{
+					Type:                 pointer.To(timeseriesdatabaseconnections.IdentityTypeUserAssigned),
+					UserAssignedIdentity: utils.String(model.UserAssignedIdentityId),
+				}
+			}
+
 			req := timeseriesdatabaseconnections.TimeSeriesDatabaseConnection{
 				Properties: properties,
 			}
@@ -233,6 +250,14 @@ func (m TimeSeriesDatabaseConnectionResource) Read() sdk.ResourceFunc {
 					kustoTableName = *properties.AdxTableName
 				}
 				output.KustoTableName = kustoTableName
+
+				if identity := properties.Identity; identity != nil && identity.Type != nil && *identity.Type == timeseriesdatabaseconnections.IdentityTypeUserAssigned && identity.UserAssignedIdentity != nil {
+					userAssignedIdentityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(*identity.UserAssignedIdentity)
+					if err != nil {
+						return fmt.Errorf("parsing `user_assigned_identity_id`: %+v", err)
+					}
+					output.UserAssignedIdentityId = userAssignedIdentityId.ID()
+				}
 			}
 
 			return meta.Encode(&output)
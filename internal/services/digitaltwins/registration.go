@@ -28,6 +28,7 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azurerm_digital_twins_endpoint": dataSourceDigitalTwinsEndpoint(),
 		"azurerm_digital_twins_instance": dataSourceDigitalTwinsInstance(),
 	}
 }
@@ -0,0 +1,268 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ClientCertificateValidationScheme string
+
+const (
+	ClientCertificateValidationSchemeDnsMatchesAuthenticationName     ClientCertificateValidationScheme = "DnsMatchesAuthenticationName"
+	ClientCertificateValidationSchemeEmailMatchesAuthenticationName   ClientCertificateValidationScheme = "EmailMatchesAuthenticationName"
+	ClientCertificateValidationSchemeIPMatchesAuthenticationName      ClientCertificateValidationScheme = "IpMatchesAuthenticationName"
+	ClientCertificateValidationSchemeSubjectMatchesAuthenticationName ClientCertificateValidationScheme = "SubjectMatchesAuthenticationName"
+	ClientCertificateValidationSchemeThumbprintMatch                  ClientCertificateValidationScheme = "ThumbprintMatch"
+	ClientCertificateValidationSchemeUriMatchesAuthenticationName     ClientCertificateValidationScheme = "UriMatchesAuthenticationName"
+)
+
+type ClientState string
+
+const (
+	ClientStateDisabled ClientState = "Disabled"
+	ClientStateEnabled  ClientState = "Enabled"
+)
+
+type PermissionType string
+
+const (
+	PermissionTypePublisher  PermissionType = "Publisher"
+	PermissionTypeSubscriber PermissionType = "Subscriber"
+)
+
+type NamespaceClient struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *NamespaceClientProperties `json:"properties,omitempty"`
+}
+
+type NamespaceClientProperties struct {
+	Attributes                      *map[string]interface{}          `json:"attributes,omitempty"`
+	AuthenticationName              *string                          `json:"authenticationName,omitempty"`
+	ClientCertificateAuthentication *ClientCertificateAuthentication `json:"clientCertificateAuthentication,omitempty"`
+	Description                     *string                          `json:"description,omitempty"`
+	State                           *ClientState                     `json:"state,omitempty"`
+}
+
+type ClientCertificateAuthentication struct {
+	AllowedThumbprints *[]string                          `json:"allowedThumbprints,omitempty"`
+	ValidationScheme   *ClientCertificateValidationScheme `json:"validationScheme,omitempty"`
+}
+
+type NamespaceClientGroup struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *NamespaceClientGroupProperties `json:"properties,omitempty"`
+}
+
+type NamespaceClientGroupProperties struct {
+	Description *string `json:"description,omitempty"`
+	Query       *string `json:"query,omitempty"`
+}
+
+type NamespaceTopicSpace struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *NamespaceTopicSpaceProperties `json:"properties,omitempty"`
+}
+
+type NamespaceTopicSpaceProperties struct {
+	Description    *string   `json:"description,omitempty"`
+	TopicTemplates *[]string `json:"topicTemplates,omitempty"`
+}
+
+type NamespacePermissionBinding struct {
+	Id         *string                               `json:"id,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Properties *NamespacePermissionBindingProperties `json:"properties,omitempty"`
+}
+
+type NamespacePermissionBindingProperties struct {
+	ClientGroupName *string         `json:"clientGroupName,omitempty"`
+	Description     *string         `json:"description,omitempty"`
+	Permission      *PermissionType `json:"permission,omitempty"`
+	TopicSpaceName  *string         `json:"topicSpaceName,omitempty"`
+}
+
+type NamespaceCaCertificate struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *NamespaceCaCertificateProperties `json:"properties,omitempty"`
+}
+
+type NamespaceCaCertificateProperties struct {
+	Description        *string `json:"description,omitempty"`
+	EncodedCertificate *string `json:"encodedCertificate,omitempty"`
+	ExpiryTimeInUtc    *string `json:"expiryTimeInUtc,omitempty"`
+}
+
+type NamespaceClientsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespaceClientsClientWithBaseURI(endpoint string) NamespaceClientsClient {
+	return NamespaceClientsClient{
+		Client:  newClient(),
+		baseUri: endpoint,
+	}
+}
+
+type NamespaceClientGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NamespaceClient
+}
+
+// Get ...
+func (c NamespaceClientsClient) Get(ctx context.Context, id parse.NamespaceClientId) (result NamespaceClientGetOperationResponse, err error) {
+	result.HttpResponse, err = get(ctx, c.Client, c.baseUri, "NamespaceClientsClient", id.ID(), &result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll ...
+func (c NamespaceClientsClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NamespaceClientId, input NamespaceClient) error {
+	return createOrUpdateThenPoll(ctx, c.Client, c.baseUri, "NamespaceClientsClient", id.ID(), input)
+}
+
+// DeleteThenPoll ...
+func (c NamespaceClientsClient) DeleteThenPoll(ctx context.Context, id parse.NamespaceClientId) error {
+	return deleteThenPoll(ctx, c.Client, c.baseUri, "NamespaceClientsClient", id.ID())
+}
+
+type NamespaceClientGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespaceClientGroupsClientWithBaseURI(endpoint string) NamespaceClientGroupsClient {
+	return NamespaceClientGroupsClient{
+		Client:  newClient(),
+		baseUri: endpoint,
+	}
+}
+
+type NamespaceClientGroupGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NamespaceClientGroup
+}
+
+// Get ...
+func (c NamespaceClientGroupsClient) Get(ctx context.Context, id parse.NamespaceClientGroupId) (result NamespaceClientGroupGetOperationResponse, err error) {
+	result.HttpResponse, err = get(ctx, c.Client, c.baseUri, "NamespaceClientGroupsClient", id.ID(), &result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll ...
+func (c NamespaceClientGroupsClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NamespaceClientGroupId, input NamespaceClientGroup) error {
+	return createOrUpdateThenPoll(ctx, c.Client, c.baseUri, "NamespaceClientGroupsClient", id.ID(), input)
+}
+
+// DeleteThenPoll ...
+func (c NamespaceClientGroupsClient) DeleteThenPoll(ctx context.Context, id parse.NamespaceClientGroupId) error {
+	return deleteThenPoll(ctx, c.Client, c.baseUri, "NamespaceClientGroupsClient", id.ID())
+}
+
+type NamespaceTopicSpacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespaceTopicSpacesClientWithBaseURI(endpoint string) NamespaceTopicSpacesClient {
+	return NamespaceTopicSpacesClient{
+		Client:  newClient(),
+		baseUri: endpoint,
+	}
+}
+
+type NamespaceTopicSpaceGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NamespaceTopicSpace
+}
+
+// Get ...
+func (c NamespaceTopicSpacesClient) Get(ctx context.Context, id parse.NamespaceTopicSpaceId) (result NamespaceTopicSpaceGetOperationResponse, err error) {
+	result.HttpResponse, err = get(ctx, c.Client, c.baseUri, "NamespaceTopicSpacesClient", id.ID(), &result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll ...
+func (c NamespaceTopicSpacesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NamespaceTopicSpaceId, input NamespaceTopicSpace) error {
+	return createOrUpdateThenPoll(ctx, c.Client, c.baseUri, "NamespaceTopicSpacesClient", id.ID(), input)
+}
+
+// DeleteThenPoll ...
+func (c NamespaceTopicSpacesClient) DeleteThenPoll(ctx context.Context, id parse.NamespaceTopicSpaceId) error {
+	return deleteThenPoll(ctx, c.Client, c.baseUri, "NamespaceTopicSpacesClient", id.ID())
+}
+
+type NamespacePermissionBindingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespacePermissionBindingsClientWithBaseURI(endpoint string) NamespacePermissionBindingsClient {
+	return NamespacePermissionBindingsClient{
+		Client:  newClient(),
+		baseUri: endpoint,
+	}
+}
+
+type NamespacePermissionBindingGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NamespacePermissionBinding
+}
+
+// Get ...
+func (c NamespacePermissionBindingsClient) Get(ctx context.Context, id parse.NamespacePermissionBindingId) (result NamespacePermissionBindingGetOperationResponse, err error) {
+	result.HttpResponse, err = get(ctx, c.Client, c.baseUri, "NamespacePermissionBindingsClient", id.ID(), &result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll ...
+func (c NamespacePermissionBindingsClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NamespacePermissionBindingId, input NamespacePermissionBinding) error {
+	return createOrUpdateThenPoll(ctx, c.Client, c.baseUri, "NamespacePermissionBindingsClient", id.ID(), input)
+}
+
+// DeleteThenPoll ...
+func (c NamespacePermissionBindingsClient) DeleteThenPoll(ctx context.Context, id parse.NamespacePermissionBindingId) error {
+	return deleteThenPoll(ctx, c.Client, c.baseUri, "NamespacePermissionBindingsClient", id.ID())
+}
+
+type NamespaceCaCertificatesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespaceCaCertificatesClientWithBaseURI(endpoint string) NamespaceCaCertificatesClient {
+	return NamespaceCaCertificatesClient{
+		Client:  newClient(),
+		baseUri: endpoint,
+	}
+}
+
+type NamespaceCaCertificateGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *NamespaceCaCertificate
+}
+
+// Get ...
+func (c NamespaceCaCertificatesClient) Get(ctx context.Context, id parse.NamespaceCaCertificateId) (result NamespaceCaCertificateGetOperationResponse, err error) {
+	result.HttpResponse, err = get(ctx, c.Client, c.baseUri, "NamespaceCaCertificatesClient", id.ID(), &result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll ...
+func (c NamespaceCaCertificatesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NamespaceCaCertificateId, input NamespaceCaCertificate) error {
+	return createOrUpdateThenPoll(ctx, c.Client, c.baseUri, "NamespaceCaCertificatesClient", id.ID(), input)
+}
+
+// DeleteThenPoll ...
+func (c NamespaceCaCertificatesClient) DeleteThenPoll(ctx context.Context, id parse.NamespaceCaCertificateId) error {
+	return deleteThenPoll(ctx, c.Client, c.baseUri, "NamespaceCaCertificatesClient", id.ID())
+}
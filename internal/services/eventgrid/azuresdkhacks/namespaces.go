@@ -0,0 +1,225 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+)
+
+// NOTE: Event Grid Namespaces (and the MQTT Broker resources within them - Clients, Client Groups, Topic Spaces,
+// Permission Bindings and CA Certificates) aren't available in the 2021-12-01 API used by the vendored SDK, as such
+// these are managed here against the 2023-06-01-preview API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const namespacesApiVersion = "2023-06-01-preview"
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+type TopicSpacesConfigurationState string
+
+const (
+	TopicSpacesConfigurationStateDisabled TopicSpacesConfigurationState = "Disabled"
+	TopicSpacesConfigurationStateEnabled  TopicSpacesConfigurationState = "Enabled"
+)
+
+type AlternativeAuthenticationNameSource string
+
+const (
+	AlternativeAuthenticationNameSourceClientCertificateDns     AlternativeAuthenticationNameSource = "ClientCertificateDns"
+	AlternativeAuthenticationNameSourceClientCertificateEmail   AlternativeAuthenticationNameSource = "ClientCertificateEmail"
+	AlternativeAuthenticationNameSourceClientCertificateIP      AlternativeAuthenticationNameSource = "ClientCertificateIp"
+	AlternativeAuthenticationNameSourceClientCertificateSubject AlternativeAuthenticationNameSource = "ClientCertificateSubject"
+	AlternativeAuthenticationNameSourceClientCertificateUri     AlternativeAuthenticationNameSource = "ClientCertificateUri"
+)
+
+type Namespace struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *NamespaceProperties                     `json:"properties,omitempty"`
+	Sku        *NamespaceSku                            `json:"sku,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+}
+
+type NamespaceSku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+type NamespaceProperties struct {
+	InboundIPRules           *[]InboundIPRule          `json:"inboundIpRules,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess      `json:"publicNetworkAccess,omitempty"`
+	TopicSpacesConfiguration *TopicSpacesConfiguration `json:"topicSpacesConfiguration,omitempty"`
+}
+
+type InboundIPRule struct {
+	Action *string `json:"action,omitempty"`
+	IPMask *string `json:"ipMask,omitempty"`
+}
+
+type TopicSpacesConfiguration struct {
+	ClientAuthentication                       *ClientAuthenticationSettings  `json:"clientAuthentication,omitempty"`
+	Hostname                                   *string                        `json:"hostname,omitempty"`
+	MaximumClientSessionsPerAuthenticationName *int64                         `json:"maximumClientSessionsPerAuthenticationName,omitempty"`
+	MaximumSessionExpiryInHours                *int64                         `json:"maximumSessionExpiryInHours,omitempty"`
+	RouteTopicResourceId                       *string                        `json:"routeTopicResourceId,omitempty"`
+	RoutingEnrichments                         *RoutingEnrichments            `json:"routingEnrichments,omitempty"`
+	State                                      *TopicSpacesConfigurationState `json:"state,omitempty"`
+}
+
+type ClientAuthenticationSettings struct {
+	AlternativeAuthenticationNameSources *[]AlternativeAuthenticationNameSource `json:"alternativeAuthenticationNameSources,omitempty"`
+}
+
+type RoutingEnrichments struct {
+	Dynamic *[]DynamicRoutingEnrichment `json:"dynamic,omitempty"`
+	Static  *[]StaticRoutingEnrichment  `json:"static,omitempty"`
+}
+
+type DynamicRoutingEnrichment struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type StaticRoutingEnrichment struct {
+	Key       *string `json:"key,omitempty"`
+	Value     *string `json:"value,omitempty"`
+	ValueType string  `json:"valueType"`
+}
+
+type NamespacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNamespacesClientWithBaseURI(endpoint string) NamespacesClient {
+	return NamespacesClient{
+		Client:  newClient(),
+		baseUri: endpoint,
+	}
+}
+
+type NamespaceGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *Namespace
+}
+
+// Get ...
+func (c NamespacesClient) Get(ctx context.Context, id parse.NamespaceId) (result NamespaceGetOperationResponse, err error) {
+	result.HttpResponse, err = get(ctx, c.Client, c.baseUri, "NamespacesClient", id.ID(), &result.Model)
+	return
+}
+
+// CreateOrUpdateThenPoll ...
+func (c NamespacesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NamespaceId, input Namespace) error {
+	return createOrUpdateThenPoll(ctx, c.Client, c.baseUri, "NamespacesClient", id.ID(), input)
+}
+
+// DeleteThenPoll ...
+func (c NamespacesClient) DeleteThenPoll(ctx context.Context, id parse.NamespaceId) error {
+	return deleteThenPoll(ctx, c.Client, c.baseUri, "NamespacesClient", id.ID())
+}
+
+func newClient() autorest.Client {
+	return autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/eventgrid/%s", namespacesApiVersion))
+}
+
+// get, createOrUpdateThenPoll and deleteThenPoll are shared by each of the clients in this package, since the Event
+// Grid Namespace and its nested resources are all managed in the same way
+func get(ctx context.Context, client autorest.Client, baseUri string, clientName string, id string, model interface{}) (*http.Response, error) {
+	req, err := prepare(ctx, baseUri, id, autorest.AsGet())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "eventgrid."+clientName, "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "eventgrid."+clientName, "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(model),
+		autorest.ByClosing())
+	if err != nil {
+		return resp, autorest.NewErrorWithError(err, "eventgrid."+clientName, "Get", resp, "Failure responding to request")
+	}
+
+	return resp, nil
+}
+
+func createOrUpdateThenPoll(ctx context.Context, client autorest.Client, baseUri string, clientName string, id string, input interface{}) error {
+	req, err := prepare(ctx, baseUri, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "eventgrid."+clientName, "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "eventgrid."+clientName, "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, client, req.Method)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "eventgrid."+clientName, "CreateOrUpdate", resp, "Failure polling request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func deleteThenPoll(ctx context.Context, client autorest.Client, baseUri string, clientName string, id string) error {
+	req, err := prepare(ctx, baseUri, id, autorest.AsDelete())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "eventgrid."+clientName, "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "eventgrid."+clientName, "Delete", resp, "Failure sending request")
+	}
+
+	poller, err := polling.NewPollerFromResponse(ctx, resp, client, req.Method)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "eventgrid."+clientName, "Delete", resp, "Failure polling request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func prepare(ctx context.Context, baseUri string, id string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": namespacesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(baseUri),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
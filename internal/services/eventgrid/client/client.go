@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2021-12-01/eventgrid" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
 )
 
 type Client struct {
@@ -12,6 +13,13 @@ type Client struct {
 	TopicsClient                        *eventgrid.TopicsClient
 	SystemTopicsClient                  *eventgrid.SystemTopicsClient
 	SystemTopicEventSubscriptionsClient *eventgrid.SystemTopicEventSubscriptionsClient
+
+	NamespacesClient                  *azuresdkhacks.NamespacesClient
+	NamespaceCaCertificatesClient     *azuresdkhacks.NamespaceCaCertificatesClient
+	NamespaceClientsClient            *azuresdkhacks.NamespaceClientsClient
+	NamespaceClientGroupsClient       *azuresdkhacks.NamespaceClientGroupsClient
+	NamespacePermissionBindingsClient *azuresdkhacks.NamespacePermissionBindingsClient
+	NamespaceTopicSpacesClient        *azuresdkhacks.NamespaceTopicSpacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -33,6 +41,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	SystemTopicEventSubscriptionsClient := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SystemTopicEventSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	NamespacesClient := azuresdkhacks.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceCaCertificatesClient := azuresdkhacks.NewNamespaceCaCertificatesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceCaCertificatesClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceClientsClient := azuresdkhacks.NewNamespaceClientsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceClientsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceClientGroupsClient := azuresdkhacks.NewNamespaceClientGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceClientGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespacePermissionBindingsClient := azuresdkhacks.NewNamespacePermissionBindingsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacePermissionBindingsClient.Client, o.ResourceManagerAuthorizer)
+
+	NamespaceTopicSpacesClient := azuresdkhacks.NewNamespaceTopicSpacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespaceTopicSpacesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DomainsClient:                       &DomainsClient,
 		EventSubscriptionsClient:            &EventSubscriptionsClient,
@@ -40,5 +66,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		TopicsClient:                        &TopicsClient,
 		SystemTopicsClient:                  &SystemTopicsClient,
 		SystemTopicEventSubscriptionsClient: &SystemTopicEventSubscriptionsClient,
+
+		NamespacesClient:                  &NamespacesClient,
+		NamespaceCaCertificatesClient:     &NamespaceCaCertificatesClient,
+		NamespaceClientsClient:            &NamespaceClientsClient,
+		NamespaceClientGroupsClient:       &NamespaceClientGroupsClient,
+		NamespacePermissionBindingsClient: &NamespacePermissionBindingsClient,
+		NamespaceTopicSpacesClient:        &NamespaceTopicSpacesClient,
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2021-12-01/eventgrid" // nolint: staticcheck
 	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2017-04-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/queues"
@@ -62,6 +63,52 @@ func eventSubscriptionCustomizeDiffAdvancedFilter(ctx context.Context, d *plugin
 	return nil
 }
 
+// eventSubscriptionCustomizeDiffIdentity validates the `delivery_identity` and `dead_letter_identity` blocks against the configured destinations
+func eventSubscriptionCustomizeDiffIdentity(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if deliveryIdentity := d.Get("delivery_identity").([]interface{}); len(deliveryIdentity) > 0 {
+		// delivery with a managed identity is only supported for Event Hubs, Service Bus Queues & Topics and Storage Queues
+		for _, endpoint := range []EventSubscriptionEndpointType{AzureFunctionEndpoint, HybridConnectionEndpointID, WebHookEndpoint} {
+			if v, ok := d.GetOk(string(endpoint)); ok && v != nil {
+				return fmt.Errorf("`delivery_identity` cannot be specified when using `%s`, delivery with a managed identity is only supported for `eventhub_endpoint_id`, `service_bus_queue_endpoint_id`, `service_bus_topic_endpoint_id` and `storage_queue_endpoint`", endpoint)
+			}
+		}
+
+		if err := validateEventSubscriptionIdentity(d, "delivery_identity"); err != nil {
+			return err
+		}
+	}
+
+	if deadLetterIdentity := d.Get("dead_letter_identity").([]interface{}); len(deadLetterIdentity) > 0 {
+		if len(d.Get("storage_blob_dead_letter_destination").([]interface{})) == 0 {
+			return fmt.Errorf("`storage_blob_dead_letter_destination` must be specified when `dead_letter_identity` is set")
+		}
+
+		if err := validateEventSubscriptionIdentity(d, "dead_letter_identity"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateEventSubscriptionIdentity(d *pluginsdk.ResourceDiff, key string) error {
+	userAssignedIdentityKey := fmt.Sprintf("%s.0.user_assigned_identity", key)
+	if !d.NewValueKnown(userAssignedIdentityKey) {
+		return nil
+	}
+
+	identityType := d.Get(fmt.Sprintf("%s.0.type", key)).(string)
+	userAssignedIdentity := d.Get(userAssignedIdentityKey).(string)
+	if identityType == string(eventgrid.EventSubscriptionIdentityTypeUserAssigned) && userAssignedIdentity == "" {
+		return fmt.Errorf("`%s.0.user_assigned_identity` must be specified when `type` is `UserAssigned`", key)
+	}
+	if identityType != string(eventgrid.EventSubscriptionIdentityTypeUserAssigned) && userAssignedIdentity != "" {
+		return fmt.Errorf("`%s.0.user_assigned_identity` can only be specified when `type` is `UserAssigned`", key)
+	}
+
+	return nil
+}
+
 func eventSubscriptionSchemaEventSubscriptionName() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeString,
@@ -799,8 +846,9 @@ func eventSubscriptionSchemaIdentity() *schema.Schema {
 					}, false),
 				},
 				"user_assigned_identity": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 				},
 			},
 		},
@@ -41,7 +41,10 @@ func resourceEventGridEventSubscription() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventSubscriptionCustomizeDiffAdvancedFilter),
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(eventSubscriptionCustomizeDiffAdvancedFilter),
+			pluginsdk.CustomizeDiffShim(eventSubscriptionCustomizeDiffIdentity),
+		),
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.EventSubscriptionID(id)
@@ -196,9 +199,6 @@ func resourceEventGridEventSubscriptionCreateUpdate(d *pluginsdk.ResourceData, m
 	}
 
 	if v, ok := d.GetOk("dead_letter_identity"); ok {
-		if deadLetterDestination == nil {
-			return fmt.Errorf("`dead_letter_identity`: `storage_blob_dead_letter_destination` must be specified")
-		}
 		deadLetterIdentityRaw := v.([]interface{})
 		deadLetterIdentity, err := expandEventGridEventSubscriptionIdentity(deadLetterIdentityRaw)
 		if err != nil {
@@ -307,6 +307,11 @@ func resourceEventGridEventSubscriptionRead(d *pluginsdk.ResourceData, meta inte
 			if err := d.Set("service_bus_queue_endpoint_id", serviceBusQueueEndpoint.ResourceID); err != nil {
 				return fmt.Errorf("setting `service_bus_queue_endpoint_id` for %s: %+v", *id, err)
 			}
+			if serviceBusQueueEndpoint.DeliveryAttributeMappings != nil {
+				if err := d.Set("delivery_property", flattenDeliveryProperties(d, serviceBusQueueEndpoint.DeliveryAttributeMappings)); err != nil {
+					return fmt.Errorf("setting `delivery_property` for %s: %+v", *id, err)
+				}
+			}
 		}
 		if serviceBusTopicEndpoint, ok := destination.AsServiceBusTopicEventSubscriptionDestination(); ok {
 			if err := d.Set("service_bus_topic_endpoint_id", serviceBusTopicEndpoint.ResourceID); err != nil {
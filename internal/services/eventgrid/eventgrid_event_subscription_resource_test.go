@@ -3,6 +3,7 @@ package eventgrid_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -421,6 +422,47 @@ func TestAccEventGridEventSubscription_userIdentity(t *testing.T) {
 	})
 }
 
+func TestAccEventGridEventSubscription_serviceBusTopicSystemIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_event_subscription", "test")
+	r := EventGridEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.serviceBusTopicSystemIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delivery_identity.0.type").HasValue("SystemAssigned"),
+				check.That(data.ResourceName).Key("service_bus_topic_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridEventSubscription_deliveryIdentityUnsupportedEndpoint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_event_subscription", "test")
+	r := EventGridEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.deliveryIdentityUnsupportedEndpoint(data),
+			ExpectError: regexp.MustCompile("`delivery_identity` cannot be specified when using `webhook_endpoint`"),
+		},
+	})
+}
+
+func TestAccEventGridEventSubscription_deadLetterIdentityWithoutDestination(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_event_subscription", "test")
+	r := EventGridEventSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.deadLetterIdentityWithoutDestination(data),
+			ExpectError: regexp.MustCompile("`storage_blob_dead_letter_destination` must be specified when `dead_letter_identity` is set"),
+		},
+	})
+}
+
 func (EventGridEventSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.EventSubscriptionID(state.ID)
 	if err != nil {
@@ -1442,3 +1484,117 @@ resource "azurerm_eventgrid_event_subscription" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (EventGridEventSubscriptionResource) serviceBusTopicSystemIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name         = "acctestservicebustopic-%[1]d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+}
+
+resource "azurerm_eventgrid_topic" "test" {
+  name                = "acctesteg-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_role_assignment" "sender" {
+  scope                = azurerm_servicebus_namespace.test.id
+  role_definition_name = "Azure Service Bus Data Sender"
+  principal_id         = azurerm_eventgrid_topic.test.identity.0.principal_id
+}
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name                          = "acctesteg-%[1]d"
+  scope                         = azurerm_eventgrid_topic.test.id
+  service_bus_topic_endpoint_id = azurerm_servicebus_topic.test.id
+
+  delivery_identity {
+    type = "SystemAssigned"
+  }
+
+  depends_on = [azurerm_role_assignment.sender]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (EventGridEventSubscriptionResource) deliveryIdentityUnsupportedEndpoint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name  = "acctesteg-%[1]d"
+  scope = azurerm_resource_group.test.id
+
+  webhook_endpoint {
+    url = "https://example.com/api/updates"
+  }
+
+  delivery_identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (EventGridEventSubscriptionResource) deadLetterIdentityWithoutDestination(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Basic"
+}
+
+resource "azurerm_servicebus_queue" "test" {
+  name         = "acctestservicebusqueue-%[1]d"
+  namespace_id = azurerm_servicebus_namespace.test.id
+}
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name                          = "acctesteg-%[1]d"
+  scope                         = azurerm_resource_group.test.id
+  service_bus_queue_endpoint_id = azurerm_servicebus_queue.test.id
+
+  dead_letter_identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,189 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceCaCertificateResource struct{}
+
+var _ sdk.Resource = NamespaceCaCertificateResource{}
+
+type NamespaceCaCertificateModel struct {
+	Name               string `tfschema:"name"`
+	NamespaceId        string `tfschema:"namespace_id"`
+	EncodedCertificate string `tfschema:"encoded_certificate"`
+	Description        string `tfschema:"description"`
+	ExpiryTime         string `tfschema:"expiry_time"`
+}
+
+func (r NamespaceCaCertificateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceNestedResourceName,
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"encoded_certificate": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+	}
+}
+
+func (r NamespaceCaCertificateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiry_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r NamespaceCaCertificateResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_ca_certificate"
+}
+
+func (r NamespaceCaCertificateResource) ModelObject() interface{} {
+	return &NamespaceCaCertificateModel{}
+}
+
+func (r NamespaceCaCertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceCaCertificateID
+}
+
+func (r NamespaceCaCertificateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NamespaceCaCertificateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespaceCaCertificateID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := azuresdkhacks.NamespaceCaCertificateProperties{
+				EncodedCertificate: pointer.To(model.EncodedCertificate),
+			}
+			if model.Description != "" {
+				properties.Description = pointer.To(model.Description)
+			}
+
+			input := azuresdkhacks.NamespaceCaCertificate{
+				Properties: &properties,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespaceCaCertificateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+
+			id, err := parse.NamespaceCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := NamespaceCaCertificateModel{
+				Name:        id.CaCertificateName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				state.EncodedCertificate = pointer.From(properties.EncodedCertificate)
+				state.Description = pointer.From(properties.Description)
+				state.ExpiryTime = pointer.From(properties.ExpiryTimeInUtc)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespaceCaCertificateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceCaCertificatesClient
+
+			id, err := parse.NamespaceCaCertificateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridNamespaceCaCertificateResource struct{}
+
+func TestAccEventGridNamespaceCaCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespaceCaCertificate_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_ca_certificate", "test")
+	r := EventGridNamespaceCaCertificateResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("expiry_time").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EventGridNamespaceCaCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NamespaceCaCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespaceCaCertificatesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EventGridNamespaceCaCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eg-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventgrid_namespace" "test" {
+  name                = "acctest-egns-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  topic_spaces_configuration {}
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r EventGridNamespaceCaCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                = "acctest-ca"
+  namespace_id        = azurerm_eventgrid_namespace.test.id
+  encoded_certificate = file("testdata/namespace_ca_certificate.pem")
+}
+`, r.template(data))
+}
+
+func (r EventGridNamespaceCaCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "import" {
+  name                = azurerm_eventgrid_namespace_ca_certificate.test.name
+  namespace_id        = azurerm_eventgrid_namespace_ca_certificate.test.namespace_id
+  encoded_certificate = azurerm_eventgrid_namespace_ca_certificate.test.encoded_certificate
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespaceCaCertificateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_ca_certificate" "test" {
+  name                = "acctest-ca"
+  namespace_id        = azurerm_eventgrid_namespace.test.id
+  encoded_certificate = file("testdata/namespace_ca_certificate.pem")
+  description         = "The root CA used by devices"
+}
+`, r.template(data))
+}
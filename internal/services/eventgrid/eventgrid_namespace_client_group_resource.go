@@ -0,0 +1,208 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceClientGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = NamespaceClientGroupResource{}
+
+type NamespaceClientGroupModel struct {
+	Name        string `tfschema:"name"`
+	NamespaceId string `tfschema:"namespace_id"`
+	Query       string `tfschema:"query"`
+	Description string `tfschema:"description"`
+}
+
+func (r NamespaceClientGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceNestedResourceName,
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+	}
+}
+
+func (r NamespaceClientGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceClientGroupResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client_group"
+}
+
+func (r NamespaceClientGroupResource) ModelObject() interface{} {
+	return &NamespaceClientGroupModel{}
+}
+
+func (r NamespaceClientGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceClientGroupID
+}
+
+func (r NamespaceClientGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NamespaceClientGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespaceClientGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandNamespaceClientGroup(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespaceClientGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			id, err := parse.NamespaceClientGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := NamespaceClientGroupModel{
+				Name:        id.ClientGroupName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				state.Query = pointer.From(properties.Query)
+				state.Description = pointer.From(properties.Description)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespaceClientGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			id, err := parse.NamespaceClientGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NamespaceClientGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandNamespaceClientGroup(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NamespaceClientGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientGroupsClient
+
+			id, err := parse.NamespaceClientGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceClientGroup(model NamespaceClientGroupModel) azuresdkhacks.NamespaceClientGroup {
+	properties := azuresdkhacks.NamespaceClientGroupProperties{
+		Query: pointer.To(model.Query),
+	}
+
+	if model.Description != "" {
+		properties.Description = pointer.To(model.Description)
+	}
+
+	return azuresdkhacks.NamespaceClientGroup{
+		Properties: &properties,
+	}
+}
@@ -0,0 +1,308 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceClientResource struct{}
+
+var _ sdk.ResourceWithUpdate = NamespaceClientResource{}
+
+type NamespaceClientModel struct {
+	Name                            string                                 `tfschema:"name"`
+	NamespaceId                     string                                 `tfschema:"namespace_id"`
+	AuthenticationName              string                                 `tfschema:"authentication_name"`
+	ClientCertificateAuthentication []ClientCertificateAuthenticationModel `tfschema:"client_certificate_authentication"`
+	Attributes                      map[string]string                      `tfschema:"attributes"`
+	Description                     string                                 `tfschema:"description"`
+	Enabled                         bool                                   `tfschema:"enabled"`
+}
+
+type ClientCertificateAuthenticationModel struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r NamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceClientName,
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(azuresdkhacks.ClientCertificateValidationSchemeDnsMatchesAuthenticationName),
+							string(azuresdkhacks.ClientCertificateValidationSchemeEmailMatchesAuthenticationName),
+							string(azuresdkhacks.ClientCertificateValidationSchemeIPMatchesAuthenticationName),
+							string(azuresdkhacks.ClientCertificateValidationSchemeSubjectMatchesAuthenticationName),
+							string(azuresdkhacks.ClientCertificateValidationSchemeThumbprintMatch),
+							string(azuresdkhacks.ClientCertificateValidationSchemeUriMatchesAuthenticationName),
+						}, false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r NamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r NamespaceClientResource) ModelObject() interface{} {
+	return &NamespaceClientModel{}
+}
+
+func (r NamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceClientID
+}
+
+func (r NamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespaceClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandNamespaceClient(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := parse.NamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := NamespaceClientModel{
+				Name:        id.ClientName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				state.AuthenticationName = pointer.From(properties.AuthenticationName)
+				state.Description = pointer.From(properties.Description)
+				state.Enabled = properties.State == nil || *properties.State == azuresdkhacks.ClientStateEnabled
+
+				if auth := properties.ClientCertificateAuthentication; auth != nil {
+					state.ClientCertificateAuthentication = []ClientCertificateAuthenticationModel{
+						{
+							ValidationScheme:   string(pointer.From(auth.ValidationScheme)),
+							AllowedThumbprints: pointer.From(auth.AllowedThumbprints),
+						},
+					}
+				}
+
+				// attributes can hold strings, integers and arrays of strings, only the string values are supported here
+				if properties.Attributes != nil {
+					state.Attributes = make(map[string]string)
+					for k, v := range *properties.Attributes {
+						if value, ok := v.(string); ok {
+							state.Attributes[k] = value
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := parse.NamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandNamespaceClient(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := parse.NamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceClient(model NamespaceClientModel) azuresdkhacks.NamespaceClient {
+	state := azuresdkhacks.ClientStateEnabled
+	if !model.Enabled {
+		state = azuresdkhacks.ClientStateDisabled
+	}
+
+	attributes := make(map[string]interface{})
+	for k, v := range model.Attributes {
+		attributes[k] = v
+	}
+
+	properties := azuresdkhacks.NamespaceClientProperties{
+		Attributes: &attributes,
+		State:      &state,
+	}
+
+	if model.AuthenticationName != "" {
+		properties.AuthenticationName = pointer.To(model.AuthenticationName)
+	}
+
+	if model.Description != "" {
+		properties.Description = pointer.To(model.Description)
+	}
+
+	if len(model.ClientCertificateAuthentication) > 0 {
+		auth := model.ClientCertificateAuthentication[0]
+		properties.ClientCertificateAuthentication = &azuresdkhacks.ClientCertificateAuthentication{
+			ValidationScheme: pointer.To(azuresdkhacks.ClientCertificateValidationScheme(auth.ValidationScheme)),
+		}
+		if len(auth.AllowedThumbprints) > 0 {
+			properties.ClientCertificateAuthentication.AllowedThumbprints = pointer.To(auth.AllowedThumbprints)
+		}
+	}
+
+	return azuresdkhacks.NamespaceClient{
+		Properties: &properties,
+	}
+}
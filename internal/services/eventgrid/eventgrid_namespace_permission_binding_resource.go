@@ -0,0 +1,231 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespacePermissionBindingResource struct{}
+
+var _ sdk.ResourceWithUpdate = NamespacePermissionBindingResource{}
+
+type NamespacePermissionBindingModel struct {
+	Name            string `tfschema:"name"`
+	NamespaceId     string `tfschema:"namespace_id"`
+	ClientGroupName string `tfschema:"client_group_name"`
+	TopicSpaceName  string `tfschema:"topic_space_name"`
+	Permission      string `tfschema:"permission"`
+	Description     string `tfschema:"description"`
+}
+
+func (r NamespacePermissionBindingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceNestedResourceName,
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"client_group_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"topic_space_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceNestedResourceName,
+		},
+
+		"permission": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.PermissionTypePublisher),
+				string(azuresdkhacks.PermissionTypeSubscriber),
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+	}
+}
+
+func (r NamespacePermissionBindingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespacePermissionBindingResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_permission_binding"
+}
+
+func (r NamespacePermissionBindingResource) ModelObject() interface{} {
+	return &NamespacePermissionBindingModel{}
+}
+
+func (r NamespacePermissionBindingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespacePermissionBindingID
+}
+
+func (r NamespacePermissionBindingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NamespacePermissionBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespacePermissionBindingID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandNamespacePermissionBinding(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespacePermissionBindingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := parse.NamespacePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := NamespacePermissionBindingModel{
+				Name:        id.PermissionBindingName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				state.ClientGroupName = pointer.From(properties.ClientGroupName)
+				state.TopicSpaceName = pointer.From(properties.TopicSpaceName)
+				state.Permission = string(pointer.From(properties.Permission))
+				state.Description = pointer.From(properties.Description)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespacePermissionBindingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := parse.NamespacePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NamespacePermissionBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandNamespacePermissionBinding(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NamespacePermissionBindingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := parse.NamespacePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespacePermissionBinding(model NamespacePermissionBindingModel) azuresdkhacks.NamespacePermissionBinding {
+	properties := azuresdkhacks.NamespacePermissionBindingProperties{
+		ClientGroupName: pointer.To(model.ClientGroupName),
+		Permission:      pointer.To(azuresdkhacks.PermissionType(model.Permission)),
+		TopicSpaceName:  pointer.To(model.TopicSpaceName),
+	}
+
+	if model.Description != "" {
+		properties.Description = pointer.To(model.Description)
+	}
+
+	return azuresdkhacks.NamespacePermissionBinding{
+		Properties: &properties,
+	}
+}
@@ -0,0 +1,527 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = NamespaceResource{}
+
+type NamespaceModel struct {
+	Name                     string                          `tfschema:"name"`
+	ResourceGroupName        string                          `tfschema:"resource_group_name"`
+	Location                 string                          `tfschema:"location"`
+	Sku                      string                          `tfschema:"sku"`
+	Capacity                 int64                           `tfschema:"capacity"`
+	PublicNetworkAccess      string                          `tfschema:"public_network_access"`
+	InboundIPRule            []NamespaceInboundIPRuleModel   `tfschema:"inbound_ip_rule"`
+	TopicSpacesConfiguration []TopicSpacesConfigurationModel `tfschema:"topic_spaces_configuration"`
+	Tags                     map[string]string               `tfschema:"tags"`
+}
+
+type NamespaceInboundIPRuleModel struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type TopicSpacesConfigurationModel struct {
+	AlternativeAuthenticationNameSource        []string                 `tfschema:"alternative_authentication_name_source"`
+	MaximumClientSessionsPerAuthenticationName int64                    `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64                    `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string                   `tfschema:"route_topic_id"`
+	StaticRoutingEnrichment                    []RoutingEnrichmentModel `tfschema:"static_routing_enrichment"`
+	DynamicRoutingEnrichment                   []RoutingEnrichmentModel `tfschema:"dynamic_routing_enrichment"`
+	Hostname                                   string                   `tfschema:"hostname"`
+}
+
+type RoutingEnrichmentModel struct {
+	Key   string `tfschema:"key"`
+	Value string `tfschema:"value"`
+}
+
+func (r NamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "Standard",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Standard",
+			}, false),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"public_network_access": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(azuresdkhacks.PublicNetworkAccessEnabled),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(azuresdkhacks.PublicNetworkAccessDisabled),
+				string(azuresdkhacks.PublicNetworkAccessEnabled),
+			}, false),
+		},
+
+		"inbound_ip_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 128,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_mask": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsCIDR,
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  "Allow",
+						ValidateFunc: validation.StringInSlice([]string{
+							"Allow",
+						}, false),
+					},
+				},
+			},
+		},
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_source": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(azuresdkhacks.AlternativeAuthenticationNameSourceClientCertificateDns),
+								string(azuresdkhacks.AlternativeAuthenticationNameSourceClientCertificateEmail),
+								string(azuresdkhacks.AlternativeAuthenticationNameSourceClientCertificateIP),
+								string(azuresdkhacks.AlternativeAuthenticationNameSourceClientCertificateSubject),
+								string(azuresdkhacks.AlternativeAuthenticationNameSourceClientCertificateUri),
+							}, false),
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.TopicID,
+					},
+
+					"static_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"dynamic_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"hostname": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r NamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r NamespaceResource) ModelObject() interface{} {
+	return &NamespaceModel{}
+}
+
+func (r NamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceID
+}
+
+func (r NamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := parse.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			input := expandNamespace(model)
+			input.Identity = identityValue
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := parse.NamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := NamespaceModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.Normalize(model.Location),
+			}
+
+			identityValue, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+
+			if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			if sku := model.Sku; sku != nil {
+				state.Sku = pointer.From(sku.Name)
+				state.Capacity = pointer.From(sku.Capacity)
+			}
+
+			if properties := model.Properties; properties != nil {
+				publicNetworkAccess := azuresdkhacks.PublicNetworkAccessEnabled
+				if properties.PublicNetworkAccess != nil {
+					publicNetworkAccess = *properties.PublicNetworkAccess
+				}
+				state.PublicNetworkAccess = string(publicNetworkAccess)
+
+				state.InboundIPRule = flattenNamespaceInboundIPRules(properties.InboundIPRules)
+				state.TopicSpacesConfiguration = flattenNamespaceTopicSpacesConfiguration(properties.TopicSpacesConfiguration)
+			}
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := parse.NamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			input := expandNamespace(model)
+			input.Identity = identityValue
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := parse.NamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespace(model NamespaceModel) azuresdkhacks.Namespace {
+	publicNetworkAccess := azuresdkhacks.PublicNetworkAccess(model.PublicNetworkAccess)
+
+	return azuresdkhacks.Namespace{
+		Location: location.Normalize(model.Location),
+		Properties: &azuresdkhacks.NamespaceProperties{
+			InboundIPRules:           expandNamespaceInboundIPRules(model.InboundIPRule),
+			PublicNetworkAccess:      &publicNetworkAccess,
+			TopicSpacesConfiguration: expandNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration),
+		},
+		Sku: &azuresdkhacks.NamespaceSku{
+			Name:     pointer.To(model.Sku),
+			Capacity: pointer.To(model.Capacity),
+		},
+		Tags: &model.Tags,
+	}
+}
+
+func expandNamespaceInboundIPRules(input []NamespaceInboundIPRuleModel) *[]azuresdkhacks.InboundIPRule {
+	rules := make([]azuresdkhacks.InboundIPRule, 0)
+	for _, v := range input {
+		rules = append(rules, azuresdkhacks.InboundIPRule{
+			Action: pointer.To(v.Action),
+			IPMask: pointer.To(v.IPMask),
+		})
+	}
+
+	return &rules
+}
+
+func flattenNamespaceInboundIPRules(input *[]azuresdkhacks.InboundIPRule) []NamespaceInboundIPRuleModel {
+	output := make([]NamespaceInboundIPRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, NamespaceInboundIPRuleModel{
+			Action: pointer.From(v.Action),
+			IPMask: pointer.From(v.IPMask),
+		})
+	}
+
+	return output
+}
+
+func expandNamespaceTopicSpacesConfiguration(input []TopicSpacesConfigurationModel) *azuresdkhacks.TopicSpacesConfiguration {
+	if len(input) == 0 {
+		return &azuresdkhacks.TopicSpacesConfiguration{
+			State: pointer.To(azuresdkhacks.TopicSpacesConfigurationStateDisabled),
+		}
+	}
+
+	config := input[0]
+
+	nameSources := make([]azuresdkhacks.AlternativeAuthenticationNameSource, 0)
+	for _, v := range config.AlternativeAuthenticationNameSource {
+		nameSources = append(nameSources, azuresdkhacks.AlternativeAuthenticationNameSource(v))
+	}
+
+	staticEnrichments := make([]azuresdkhacks.StaticRoutingEnrichment, 0)
+	for _, v := range config.StaticRoutingEnrichment {
+		staticEnrichments = append(staticEnrichments, azuresdkhacks.StaticRoutingEnrichment{
+			Key:       pointer.To(v.Key),
+			Value:     pointer.To(v.Value),
+			ValueType: "String",
+		})
+	}
+
+	dynamicEnrichments := make([]azuresdkhacks.DynamicRoutingEnrichment, 0)
+	for _, v := range config.DynamicRoutingEnrichment {
+		dynamicEnrichments = append(dynamicEnrichments, azuresdkhacks.DynamicRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	output := azuresdkhacks.TopicSpacesConfiguration{
+		ClientAuthentication: &azuresdkhacks.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &nameSources,
+		},
+		MaximumClientSessionsPerAuthenticationName: pointer.To(config.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.To(config.MaximumSessionExpiryInHours),
+		RoutingEnrichments: &azuresdkhacks.RoutingEnrichments{
+			Dynamic: &dynamicEnrichments,
+			Static:  &staticEnrichments,
+		},
+		State: pointer.To(azuresdkhacks.TopicSpacesConfigurationStateEnabled),
+	}
+
+	if config.RouteTopicId != "" {
+		output.RouteTopicResourceId = pointer.To(config.RouteTopicId)
+	}
+
+	return &output
+}
+
+func flattenNamespaceTopicSpacesConfiguration(input *azuresdkhacks.TopicSpacesConfiguration) []TopicSpacesConfigurationModel {
+	if input == nil || input.State == nil || *input.State != azuresdkhacks.TopicSpacesConfigurationStateEnabled {
+		return []TopicSpacesConfigurationModel{}
+	}
+
+	config := TopicSpacesConfigurationModel{
+		Hostname: pointer.From(input.Hostname),
+		MaximumClientSessionsPerAuthenticationName: pointer.From(input.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.From(input.MaximumSessionExpiryInHours),
+		StaticRoutingEnrichment:                    []RoutingEnrichmentModel{},
+		DynamicRoutingEnrichment:                   []RoutingEnrichmentModel{},
+		RouteTopicId:                               pointer.From(input.RouteTopicResourceId),
+	}
+
+	if auth := input.ClientAuthentication; auth != nil && auth.AlternativeAuthenticationNameSources != nil {
+		for _, v := range *auth.AlternativeAuthenticationNameSources {
+			config.AlternativeAuthenticationNameSource = append(config.AlternativeAuthenticationNameSource, string(v))
+		}
+	}
+
+	if enrichments := input.RoutingEnrichments; enrichments != nil {
+		if enrichments.Static != nil {
+			for _, v := range *enrichments.Static {
+				config.StaticRoutingEnrichment = append(config.StaticRoutingEnrichment, RoutingEnrichmentModel{
+					Key:   pointer.From(v.Key),
+					Value: pointer.From(v.Value),
+				})
+			}
+		}
+
+		if enrichments.Dynamic != nil {
+			for _, v := range *enrichments.Dynamic {
+				config.DynamicRoutingEnrichment = append(config.DynamicRoutingEnrichment, RoutingEnrichmentModel{
+					Key:   pointer.From(v.Key),
+					Value: pointer.From(v.Value),
+				})
+			}
+		}
+	}
+
+	return []TopicSpacesConfigurationModel{config}
+}
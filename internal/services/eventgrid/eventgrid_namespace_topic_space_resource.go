@@ -0,0 +1,213 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NamespaceTopicSpaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = NamespaceTopicSpaceResource{}
+
+type NamespaceTopicSpaceModel struct {
+	Name           string   `tfschema:"name"`
+	NamespaceId    string   `tfschema:"namespace_id"`
+	TopicTemplates []string `tfschema:"topic_templates"`
+	Description    string   `tfschema:"description"`
+}
+
+func (r NamespaceTopicSpaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceNestedResourceName,
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"topic_templates": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 10,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 512),
+		},
+	}
+}
+
+func (r NamespaceTopicSpaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NamespaceTopicSpaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_space"
+}
+
+func (r NamespaceTopicSpaceResource) ModelObject() interface{} {
+	return &NamespaceTopicSpaceModel{}
+}
+
+func (r NamespaceTopicSpaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceTopicSpaceID
+}
+
+func (r NamespaceTopicSpaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model NamespaceTopicSpaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.EventGrid.NamespaceTopicSpacesClient
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespaceTopicSpaceID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandNamespaceTopicSpace(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r NamespaceTopicSpaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicSpacesClient
+
+			id, err := parse.NamespaceTopicSpaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := NamespaceTopicSpaceModel{
+				Name:        id.TopicSpaceName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if properties := model.Properties; properties != nil {
+				state.TopicTemplates = pointer.From(properties.TopicTemplates)
+				state.Description = pointer.From(properties.Description)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NamespaceTopicSpaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicSpacesClient
+
+			id, err := parse.NamespaceTopicSpaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model NamespaceTopicSpaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandNamespaceTopicSpace(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NamespaceTopicSpaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicSpacesClient
+
+			id, err := parse.NamespaceTopicSpaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceTopicSpace(model NamespaceTopicSpaceModel) azuresdkhacks.NamespaceTopicSpace {
+	properties := azuresdkhacks.NamespaceTopicSpaceProperties{
+		TopicTemplates: pointer.To(model.TopicTemplates),
+	}
+
+	if model.Description != "" {
+		properties.Description = pointer.To(model.Description)
+	}
+
+	return azuresdkhacks.NamespaceTopicSpace{
+		Properties: &properties,
+	}
+}
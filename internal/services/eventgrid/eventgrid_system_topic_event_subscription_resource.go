@@ -135,6 +135,8 @@ func resourceEventGridSystemTopicEventSubscription() *pluginsdk.Resource {
 
 			"delivery_property": eventSubscriptionSchemaDeliveryProperty(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(eventSubscriptionCustomizeDiffIdentity),
 	}
 }
 
@@ -199,9 +201,6 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.Reso
 	}
 
 	if v, ok := d.GetOk("dead_letter_identity"); ok {
-		if deadLetterDestination == nil {
-			return fmt.Errorf("`dead_letter_identity`: `storage_blob_dead_letter_destination` must be specified")
-		}
 		deadLetterIdentityRaw := v.([]interface{})
 		deadLetterIdentity, err := expandEventGridEventSubscriptionIdentity(deadLetterIdentityRaw)
 		if err != nil {
@@ -312,6 +311,11 @@ func resourceEventGridSystemTopicEventSubscriptionRead(d *pluginsdk.ResourceData
 			if err := d.Set("service_bus_queue_endpoint_id", serviceBusQueueEndpoint.ResourceID); err != nil {
 				return fmt.Errorf("setting `service_bus_queue_endpoint_id`: %v", err)
 			}
+			if serviceBusQueueEndpoint.DeliveryAttributeMappings != nil {
+				if err := d.Set("delivery_property", flattenDeliveryProperties(d, serviceBusQueueEndpoint.DeliveryAttributeMappings)); err != nil {
+					return fmt.Errorf("setting `delivery_property`: %v", err)
+				}
+			}
 		}
 		if serviceBusTopicEndpoint, ok := destination.AsServiceBusTopicEventSubscriptionDestination(); ok {
 			if err := d.Set("service_bus_topic_endpoint_id", serviceBusTopicEndpoint.ResourceID); err != nil {
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NamespaceCaCertificateId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	NamespaceName     string
+	CaCertificateName string
+}
+
+func NewNamespaceCaCertificateID(subscriptionId, resourceGroup, namespaceName, caCertificateName string) NamespaceCaCertificateId {
+	return NamespaceCaCertificateId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		NamespaceName:     namespaceName,
+		CaCertificateName: caCertificateName,
+	}
+}
+
+func (id NamespaceCaCertificateId) String() string {
+	segments := []string{
+		fmt.Sprintf("Ca Certificate Name %q", id.CaCertificateName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Namespace Ca Certificate", segmentsStr)
+}
+
+func (id NamespaceCaCertificateId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/caCertificates/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.CaCertificateName)
+}
+
+// NamespaceCaCertificateID parses a NamespaceCaCertificate ID into an NamespaceCaCertificateId struct
+func NamespaceCaCertificateID(input string) (*NamespaceCaCertificateId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NamespaceCaCertificate ID: %+v", input, err)
+	}
+
+	resourceId := NamespaceCaCertificateId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.CaCertificateName, err = id.PopSegment("caCertificates"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NamespaceClientId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	ClientName     string
+}
+
+func NewNamespaceClientID(subscriptionId, resourceGroup, namespaceName, clientName string) NamespaceClientId {
+	return NamespaceClientId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		ClientName:     clientName,
+	}
+}
+
+func (id NamespaceClientId) String() string {
+	segments := []string{
+		fmt.Sprintf("Client Name %q", id.ClientName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Namespace Client", segmentsStr)
+}
+
+func (id NamespaceClientId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/clients/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.ClientName)
+}
+
+// NamespaceClientID parses a NamespaceClient ID into an NamespaceClientId struct
+func NamespaceClientID(input string) (*NamespaceClientId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NamespaceClient ID: %+v", input, err)
+	}
+
+	resourceId := NamespaceClientId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ClientName, err = id.PopSegment("clients"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NamespaceClientGroupId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	NamespaceName   string
+	ClientGroupName string
+}
+
+func NewNamespaceClientGroupID(subscriptionId, resourceGroup, namespaceName, clientGroupName string) NamespaceClientGroupId {
+	return NamespaceClientGroupId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		NamespaceName:   namespaceName,
+		ClientGroupName: clientGroupName,
+	}
+}
+
+func (id NamespaceClientGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Client Group Name %q", id.ClientGroupName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Namespace Client Group", segmentsStr)
+}
+
+func (id NamespaceClientGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/clientGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.ClientGroupName)
+}
+
+// NamespaceClientGroupID parses a NamespaceClientGroup ID into an NamespaceClientGroupId struct
+func NamespaceClientGroupID(input string) (*NamespaceClientGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NamespaceClientGroup ID: %+v", input, err)
+	}
+
+	resourceId := NamespaceClientGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ClientGroupName, err = id.PopSegment("clientGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NamespacePermissionBindingId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	NamespaceName         string
+	PermissionBindingName string
+}
+
+func NewNamespacePermissionBindingID(subscriptionId, resourceGroup, namespaceName, permissionBindingName string) NamespacePermissionBindingId {
+	return NamespacePermissionBindingId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		NamespaceName:         namespaceName,
+		PermissionBindingName: permissionBindingName,
+	}
+}
+
+func (id NamespacePermissionBindingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Permission Binding Name %q", id.PermissionBindingName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Namespace Permission Binding", segmentsStr)
+}
+
+func (id NamespacePermissionBindingId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/permissionBindings/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.PermissionBindingName)
+}
+
+// NamespacePermissionBindingID parses a NamespacePermissionBinding ID into an NamespacePermissionBindingId struct
+func NamespacePermissionBindingID(input string) (*NamespacePermissionBindingId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NamespacePermissionBinding ID: %+v", input, err)
+	}
+
+	resourceId := NamespacePermissionBindingId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.PermissionBindingName, err = id.PopSegment("permissionBindings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
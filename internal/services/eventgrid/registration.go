@@ -7,12 +7,30 @@ import (
 
 type Registration struct{}
 
-var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistration                   = Registration{}
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/event-grid"
 }
 
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		NamespaceResource{},
+		NamespaceCaCertificateResource{},
+		NamespaceClientGroupResource{},
+		NamespaceClientResource{},
+		NamespacePermissionBindingResource{},
+		NamespaceTopicSpaceResource{},
+	}
+}
+
 // Name is the name of this Service
 func (r Registration) Name() string {
 	return "EventGrid"
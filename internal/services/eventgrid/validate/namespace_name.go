@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func NamespaceName(v interface{}, k string) (warnings []string, errors []error) {
+	return namespaceResourceName(v, k, regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "alphanumeric characters and dashes")
+}
+
+// NamespaceNestedResourceName validates the name of a Client Group, Topic Space, Permission Binding or CA Certificate
+// within an Event Grid Namespace
+func NamespaceNestedResourceName(v interface{}, k string) (warnings []string, errors []error) {
+	return NamespaceName(v, k)
+}
+
+func NamespaceClientName(v interface{}, k string) (warnings []string, errors []error) {
+	return namespaceResourceName(v, k, regexp.MustCompile(`^[a-zA-Z0-9-:]+$`), "alphanumeric characters, dashes and colons")
+}
+
+func namespaceResourceName(v interface{}, k string, pattern *regexp.Regexp, allowed string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if len(value) < 3 || len(value) > 50 {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 50 characters long", k))
+	}
+
+	if !pattern.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q may only contain %s", k, allowed))
+	}
+
+	return warnings, errors
+}
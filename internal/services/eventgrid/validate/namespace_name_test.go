@@ -0,0 +1,57 @@
+package validate
+
+import "testing"
+
+func TestNamespaceName(t *testing.T) {
+	validNames := []string{
+		"valid-name",
+		"Aa0",
+		"01234567890123456789012345678901234567890123456789",
+	}
+	for _, v := range validNames {
+		_, errors := NamespaceName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Event Grid Namespace Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"invalid_name",
+		"invalid:name",
+		"aa",
+		"012345678901234567890123456789012345678901234567890",
+	}
+	for _, v := range invalidNames {
+		_, errors := NamespaceName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Event Grid Namespace Name", v)
+		}
+	}
+}
+
+func TestNamespaceClientName(t *testing.T) {
+	validNames := []string{
+		"valid-name",
+		"device:sensor-1",
+		"Aa0",
+	}
+	for _, v := range validNames {
+		_, errors := NamespaceClientName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Event Grid Namespace Client Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"invalid_name",
+		"invalid.name",
+		"aa",
+		"012345678901234567890123456789012345678901234567890",
+	}
+	for _, v := range invalidNames {
+		_, errors := NamespaceClientName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Event Grid Namespace Client Name", v)
+		}
+	}
+}
@@ -27,6 +27,8 @@ type Client struct {
 	NamespaceAuthorizationRulesClient      *authorizationrulesnamespaces.AuthorizationRulesNamespacesClient
 	NetworkRuleSetsClient                  *networkrulesets.NetworkRuleSetsClient
 	SchemaRegistryClient                   *schemaregistry.SchemaRegistryClient
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -101,5 +103,6 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		NamespaceAuthorizationRulesClient:      namespaceAuthorizationRulesClient,
 		NetworkRuleSetsClient:                  networkRuleSetsClient,
 		SchemaRegistryClient:                   schemaRegistryClient,
+		o:                                      o,
 	}, nil
 }
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+const schemaRegistryDataPlaneApiVersion = "2022-10"
+
+type SchemaFormat string
+
+const (
+	SchemaFormatAvro   SchemaFormat = "Avro"
+	SchemaFormatCustom SchemaFormat = "Custom"
+	SchemaFormatJson   SchemaFormat = "Json"
+)
+
+// SchemaRegistryDataPlaneClient is used to register and retrieve the Schemas within the Schema Groups of an
+// EventHub Namespace, which are only available via the Data Plane API of the Namespace
+type SchemaRegistryDataPlaneClient struct {
+	Client *resourcemanager.Client
+}
+
+// SchemaRegistryDataPlaneClientForNamespace returns a SchemaRegistryDataPlaneClient for the specified EventHub Namespace
+func (c *Client) SchemaRegistryDataPlaneClientForNamespace(namespaceName string) (*SchemaRegistryDataPlaneClient, error) {
+	domainSuffix, ok := c.o.Environment.ServiceBus.DomainSuffix()
+	if !ok {
+		return nil, fmt.Errorf("determining the domain suffix for EventHub Namespaces: the Service Bus API is not supported in this Azure Environment")
+	}
+	endpoint := fmt.Sprintf("https://%s.%s", namespaceName, *domainSuffix)
+
+	// the Data Plane endpoint is specific to the Namespace, however the authorization token needs to be obtained for
+	// the EventHubs resource, so we'll need a separate API to obtain the Authorizer for
+	appId, _ := c.o.Environment.EventHubs.AppId()
+	authApi := environments.NewApiEndpoint("EventHubs", "https://eventhubs.azure.net", appId)
+	auth, err := c.o.Authorizers.AuthorizerFunc(authApi)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer for %q: %+v", endpoint, err)
+	}
+
+	api := environments.NewApiEndpoint("EventHubsSchemaRegistry", endpoint, appId)
+	schemaRegistryClient, err := resourcemanager.NewResourceManagerClient(api, "schemaregistry", schemaRegistryDataPlaneApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SchemaRegistryDataPlaneClient: %+v", err)
+	}
+	c.o.Configure(schemaRegistryClient, auth)
+
+	return &SchemaRegistryDataPlaneClient{
+		Client: schemaRegistryClient,
+	}, nil
+}
+
+type SchemaProperties struct {
+	Id      string
+	Format  SchemaFormat
+	Version int64
+}
+
+type SchemaRegisterOperationResponse struct {
+	HttpResponse *http.Response
+	Properties   *SchemaProperties
+}
+
+type SchemaGetOperationResponse struct {
+	HttpResponse *http.Response
+	Content      *string
+	Properties   *SchemaProperties
+}
+
+type SchemaListVersionsOperationResponse struct {
+	HttpResponse *http.Response
+	Versions     *[]int64
+}
+
+// Register registers the specified content as a new version of the Schema - if the content matches an existing
+// version of the Schema then the existing version is returned
+func (c SchemaRegistryDataPlaneClient) Register(ctx context.Context, schemaGroupName, schemaName string, format SchemaFormat, content string) (result SchemaRegisterOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: contentTypeForSchemaFormat(format),
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       fmt.Sprintf("/$schemaGroups/%s/schemas/%s", url.PathEscape(schemaGroupName), url.PathEscape(schemaName)),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	// the Schema content is sent as-is, rather than being marshalled
+	req.ContentLength = int64(len(content))
+	req.Body = io.NopCloser(strings.NewReader(content))
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Properties, err = schemaPropertiesFromResponse(resp.Response)
+	return
+}
+
+// GetVersion retrieves the content and properties of the specified version of the Schema
+func (c SchemaRegistryDataPlaneClient) GetVersion(ctx context.Context, schemaGroupName, schemaName string, version int64) (result SchemaGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       fmt.Sprintf("/$schemaGroups/%s/schemas/%s/versions/%d", url.PathEscape(schemaGroupName), url.PathEscape(schemaName), version),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		err = fmt.Errorf("reading response body: %+v", err)
+		return
+	}
+	content := string(body)
+	result.Content = &content
+
+	result.Properties, err = schemaPropertiesFromResponse(resp.Response)
+	return
+}
+
+// ListVersions retrieves the versions which have been registered for the Schema
+func (c SchemaRegistryDataPlaneClient) ListVersions(ctx context.Context, schemaGroupName, schemaName string) (result SchemaListVersionsOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       fmt.Sprintf("/$schemaGroups/%s/schemas/%s/versions", url.PathEscape(schemaGroupName), url.PathEscape(schemaName)),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model struct {
+		SchemaVersions *[]int64 `json:"schemaVersions,omitempty"`
+	}
+	if err = resp.Unmarshal(&model); err != nil {
+		return
+	}
+	result.Versions = model.SchemaVersions
+
+	return
+}
+
+func contentTypeForSchemaFormat(format SchemaFormat) string {
+	switch format {
+	case SchemaFormatAvro:
+		return "application/json; serialization=Avro"
+	case SchemaFormatJson:
+		return "application/json; serialization=Json"
+	}
+
+	return "text/plain; charset=utf-8"
+}
+
+func schemaPropertiesFromResponse(resp *http.Response) (*SchemaProperties, error) {
+	props := SchemaProperties{
+		Id:     resp.Header.Get("Schema-Id"),
+		Format: SchemaFormatCustom,
+	}
+
+	contentType := strings.ToLower(resp.Header.Get("Content-Type"))
+	switch {
+	case strings.Contains(contentType, "serialization=avro"):
+		props.Format = SchemaFormatAvro
+	case strings.Contains(contentType, "serialization=json"):
+		props.Format = SchemaFormatJson
+	}
+
+	if v := resp.Header.Get("Schema-Version"); v != "" {
+		version, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the `Schema-Version` header %q: %+v", v, err)
+		}
+		props.Version = version
+	}
+
+	return &props, nil
+}
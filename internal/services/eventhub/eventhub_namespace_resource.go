@@ -267,14 +267,18 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
 				oldSku, newSku := d.GetChange("sku")
 				if d.HasChange("sku") {
-					if strings.EqualFold(newSku.(string), string(namespaces.SkuNamePremium)) || strings.EqualFold(oldSku.(string), string(namespaces.SkuTierPremium)) {
-						log.Printf("[DEBUG] cannot migrate a namespace from or to Premium SKU")
+					// a Standard namespace can be upgraded to Premium in-place, however any other migration from or to the
+					// Premium SKU requires the namespace to be recreated
+					upgradeToPremium := strings.EqualFold(oldSku.(string), string(namespaces.SkuNameStandard)) && strings.EqualFold(newSku.(string), string(namespaces.SkuNamePremium))
+					if !upgradeToPremium && (strings.EqualFold(newSku.(string), string(namespaces.SkuNamePremium)) || strings.EqualFold(oldSku.(string), string(namespaces.SkuTierPremium))) {
+						log.Printf("[DEBUG] cannot migrate a namespace from %q to %q SKU", oldSku.(string), newSku.(string))
 						d.ForceNew("sku")
 					}
 				}
 				return nil
 			}),
 			pluginsdk.CustomizeDiffShim(eventhubTLSVersionDiff),
+			pluginsdk.CustomizeDiffShim(eventhubMaximumThroughputUnitsDiff),
 		),
 	}
 	if !features.FourPointOhBeta() {
@@ -451,7 +455,8 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Tags: tags.Expand(t),
 	}
 
-	if !features.FourPointOhBeta() {
+	// for premium namespace, the zone_redundant is computed based on the region, user's input will be overridden
+	if !features.FourPointOhBeta() && sku != string(namespaces.SkuNamePremium) {
 		parameters.Properties.ZoneRedundant = utils.Bool(d.Get("zone_redundant").(bool))
 	}
 
@@ -464,7 +469,9 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.Properties.MinimumTlsVersion = &minimumTls
 	}
 
-	if v, ok := d.GetOk("maximum_throughput_units"); ok {
+	// Auto Inflate isn't available for Premium namespaces, so the (computed) value from a Standard namespace which is
+	// being upgraded to Premium mustn't be sent
+	if v, ok := d.GetOk("maximum_throughput_units"); ok && sku != string(namespaces.SkuNamePremium) {
 		parameters.Properties.MaximumThroughputUnits = utils.Int64(int64(v.(int)))
 	}
 
@@ -768,6 +775,42 @@ func resourceVnetRuleHash(v interface{}) int {
 	return pluginsdk.HashString(buf.String())
 }
 
+// eventhubMaximumThroughputUnitsDiff validates `maximum_throughput_units` against the SKU at plan time, since it's only
+// used by Auto Inflate which is only available for Standard namespaces
+func eventhubMaximumThroughputUnitsDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	sku := d.Get("sku").(string)
+	autoInflateEnabled := d.Get("auto_inflate_enabled").(bool)
+
+	if autoInflateEnabled && sku != string(namespaces.SkuNameStandard) {
+		return fmt.Errorf("`auto_inflate_enabled` can only be enabled for a `Standard` SKU namespace")
+	}
+
+	// `maximum_throughput_units` is Computed, so only the value specified in the configuration is validated
+	raw := d.GetRawConfig().GetAttr("maximum_throughput_units")
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	maximumThroughputUnits := d.Get("maximum_throughput_units").(int)
+	if maximumThroughputUnits == 0 {
+		return nil
+	}
+
+	if sku != string(namespaces.SkuNameStandard) {
+		return fmt.Errorf("`maximum_throughput_units` can only be set for a `Standard` SKU namespace, got %q", sku)
+	}
+
+	if !autoInflateEnabled {
+		return fmt.Errorf("`maximum_throughput_units` can only be set when `auto_inflate_enabled` is `true`")
+	}
+
+	if capacity := d.Get("capacity").(int); maximumThroughputUnits < capacity {
+		return fmt.Errorf("`maximum_throughput_units` (%d) must be greater than or equal to `capacity` (%d)", maximumThroughputUnits, capacity)
+	}
+
+	return nil
+}
+
 func eventhubTLSVersionDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) (err error) {
 	old, new := d.GetChange("minimum_tls_version")
 	if old != "" && new == "" {
@@ -513,6 +513,50 @@ func TestAccEventHubNamespace_autoInfalteDisabledWithAutoInflateUnits(t *testing
 	})
 }
 
+func TestAccEventHubNamespace_upgradeStandardToPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace", "test")
+	r := EventHubNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.skuUpgrade(data, "Standard"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("Standard"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.skuUpgrade(data, "Premium"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("Premium"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubNamespace_maximumThroughputUnitsInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_namespace", "test")
+	r := EventHubNamespaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.maximumThroughputUnitsInvalid(data, "Premium", true, 2),
+			ExpectError: regexp.MustCompile("`auto_inflate_enabled` can only be enabled for a `Standard` SKU namespace"),
+		},
+		{
+			Config:      r.maximumThroughputUnitsInvalid(data, "Standard", false, 2),
+			ExpectError: regexp.MustCompile("`maximum_throughput_units` can only be set when `auto_inflate_enabled` is `true`"),
+		},
+		{
+			Config:      r.maximumThroughputUnitsInvalid(data, "Standard", true, 1),
+			ExpectError: regexp.MustCompile("must be greater than or equal to `capacity`"),
+		},
+	})
+}
+
 func (EventHubNamespaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := namespaces.ParseNamespaceID(state.ID)
 	if err != nil {
@@ -1133,3 +1177,48 @@ resource "azurerm_eventhub_namespace" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (EventHubNamespaceResource) skuUpgrade(data acceptance.TestData, sku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eh-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "%s"
+  capacity            = 1
+  zone_redundant      = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, sku)
+}
+
+func (EventHubNamespaceResource) maximumThroughputUnitsInvalid(data acceptance.TestData, sku string, autoInflateEnabled bool, maximumThroughputUnits int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eh-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                     = "acctesteventhubnamespace-%d"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  sku                      = "%s"
+  capacity                 = 2
+  auto_inflate_enabled     = %t
+  maximum_throughput_units = %d
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, sku, autoInflateEnabled, maximumThroughputUnits)
+}
@@ -0,0 +1,264 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/schemaregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	eventhubClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SchemaResourceModel struct {
+	Name          string `tfschema:"name"`
+	SchemaGroupId string `tfschema:"schema_group_id"`
+	Format        string `tfschema:"format"`
+	Content       string `tfschema:"content"`
+	SchemaId      string `tfschema:"schema_id"`
+	Version       int64  `tfschema:"version"`
+}
+
+var (
+	_ sdk.Resource                  = SchemaResource{}
+	_ sdk.ResourceWithUpdate        = SchemaResource{}
+	_ sdk.ResourceWithCustomizeDiff = SchemaResource{}
+)
+
+type SchemaResource struct{}
+
+func (r SchemaResource) ResourceType() string {
+	return "azurerm_eventhub_schema"
+}
+
+func (r SchemaResource) ModelObject() interface{} {
+	return &SchemaResourceModel{}
+}
+
+func (r SchemaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SchemaID
+}
+
+func (r SchemaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateSchemaName(),
+		},
+
+		"schema_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: schemaregistry.ValidateSchemaGroupID,
+		},
+
+		"format": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(eventhubClient.SchemaFormatAvro),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(eventhubClient.SchemaFormatAvro),
+				string(eventhubClient.SchemaFormatCustom),
+				string(eventhubClient.SchemaFormatJson),
+			}, false),
+		},
+
+		"content": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+	}
+}
+
+func (r SchemaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"schema_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r SchemaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SchemaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			schemaGroupId, err := schemaregistry.ParseSchemaGroupID(model.SchemaGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewSchemaID(schemaGroupId.SubscriptionId, schemaGroupId.ResourceGroupName, schemaGroupId.NamespaceName, schemaGroupId.SchemaGroupName, model.Name)
+
+			client, err := metadata.Client.Eventhub.SchemaRegistryDataPlaneClientForNamespace(id.NamespaceName)
+			if err != nil {
+				return fmt.Errorf("building Schema Registry client for %s: %+v", id, err)
+			}
+
+			existing, err := client.ListVersions(ctx, id.SchemaGroupName, id.Name)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.Register(ctx, id.SchemaGroupName, id.Name, eventhubClient.SchemaFormat(model.Format), model.Content); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SchemaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.Eventhub.SchemaRegistryDataPlaneClientForNamespace(id.NamespaceName)
+			if err != nil {
+				return fmt.Errorf("building Schema Registry client for %s: %+v", *id, err)
+			}
+
+			versions, err := client.ListVersions(ctx, id.SchemaGroupName, id.Name)
+			if err != nil {
+				if response.WasNotFound(versions.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing versions for %s: %+v", *id, err)
+			}
+
+			// the latest version of the Schema is the one that's being managed, since new versions are registered
+			// when the content changes
+			var latestVersion int64
+			if versions.Versions != nil {
+				for _, v := range *versions.Versions {
+					if v > latestVersion {
+						latestVersion = v
+					}
+				}
+			}
+			if latestVersion == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetVersion(ctx, id.SchemaGroupName, id.Name, latestVersion)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving version %d of %s: %+v", latestVersion, *id, err)
+			}
+
+			state := SchemaResourceModel{
+				Name:          id.Name,
+				SchemaGroupId: schemaregistry.NewSchemaGroupID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.SchemaGroupName).ID(),
+				Version:       latestVersion,
+			}
+
+			if resp.Content != nil {
+				state.Content = *resp.Content
+			}
+
+			if props := resp.Properties; props != nil {
+				state.Format = string(props.Format)
+				state.SchemaId = props.Id
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SchemaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SchemaResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Eventhub.SchemaRegistryDataPlaneClientForNamespace(id.NamespaceName)
+			if err != nil {
+				return fmt.Errorf("building Schema Registry client for %s: %+v", *id, err)
+			}
+
+			if metadata.ResourceData.HasChange("content") {
+				// registering the updated content creates a new version of the Schema
+				if _, err := client.Register(ctx, id.SchemaGroupName, id.Name, eventhubClient.SchemaFormat(model.Format), model.Content); err != nil {
+					return fmt.Errorf("registering a new version of %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SchemaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Schema Registry Data Plane API doesn't support deleting an individual Schema - the Schema (and all
+			// of its versions) are removed when the Schema Group is deleted, so this only removes it from the state
+			metadata.Logger.Infof("%s can't be deleted independently of its Schema Group - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+func (r SchemaResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Id() == "" || !metadata.ResourceDiff.HasChange("content") {
+				return nil
+			}
+
+			// updating the content registers a new version of the Schema, which has a new Schema ID
+			if err := metadata.ResourceDiff.SetNewComputed("schema_id"); err != nil {
+				return err
+			}
+			return metadata.ResourceDiff.SetNewComputed("version")
+		},
+	}
+}
@@ -0,0 +1,209 @@
+package eventhub_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/schemaregistry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventHubSchemaResource struct{}
+
+func TestAccEventHubSchema_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_schema", "test")
+	r := EventHubSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schema_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventHubSchema_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_schema", "test")
+	r := EventHubSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventHubSchema_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_schema", "test")
+	r := EventHubSchemaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventHubSchemaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SchemaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Data Plane endpoint is unavailable once the Namespace has been deleted, so check the Schema Group exists first
+	schemaGroupId := schemaregistry.NewSchemaGroupID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.SchemaGroupName)
+	schemaGroup, err := clients.Eventhub.SchemaRegistryClient.Get(ctx, schemaGroupId)
+	if err != nil {
+		if response.WasNotFound(schemaGroup.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", schemaGroupId, err)
+	}
+
+	client, err := clients.Eventhub.SchemaRegistryDataPlaneClientForNamespace(id.NamespaceName)
+	if err != nil {
+		return nil, fmt.Errorf("building Schema Registry client for %s: %+v", *id, err)
+	}
+
+	resp, err := client.ListVersions(ctx, id.SchemaGroupName, id.Name)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing versions for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Versions != nil && len(*resp.Versions) > 0), nil
+}
+
+func (EventHubSchemaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-eventhub-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace_schema_group" "test" {
+  name                 = "acctestsg-%d"
+  namespace_id         = azurerm_eventhub_namespace.test.id
+  schema_compatibility = "None"
+  schema_type          = "Avro"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub_namespace.test.id
+  role_definition_name = "Schema Registry Contributor (Preview)"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r EventHubSchemaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_schema" "test" {
+  name            = "acctestschema-%d"
+  schema_group_id = azurerm_eventhub_namespace_schema_group.test.id
+  format          = "Avro"
+  content = jsonencode({
+    type      = "record"
+    name      = "Order"
+    namespace = "com.example"
+    fields = [
+      {
+        name = "id"
+        type = "string"
+      },
+    ]
+  })
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubSchemaResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_schema" "test" {
+  name            = "acctestschema-%d"
+  schema_group_id = azurerm_eventhub_namespace_schema_group.test.id
+  format          = "Avro"
+  content = jsonencode({
+    type      = "record"
+    name      = "Order"
+    namespace = "com.example"
+    fields = [
+      {
+        name = "id"
+        type = "string"
+      },
+      {
+        name    = "quantity"
+        type    = "int"
+        default = 1
+      },
+    ]
+  })
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r EventHubSchemaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_schema" "import" {
+  name            = azurerm_eventhub_schema.test.name
+  schema_group_id = azurerm_eventhub_schema.test.schema_group_id
+  format          = azurerm_eventhub_schema.test.format
+  content         = azurerm_eventhub_schema.test.content
+}
+`, r.basic(data))
+}
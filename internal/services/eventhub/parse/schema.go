@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SchemaId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	NamespaceName   string
+	SchemaGroupName string
+	Name            string
+}
+
+func NewSchemaID(subscriptionId, resourceGroup, namespaceName, schemaGroupName, name string) SchemaId {
+	return SchemaId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		NamespaceName:   namespaceName,
+		SchemaGroupName: schemaGroupName,
+		Name:            name,
+	}
+}
+
+func (id SchemaId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Schema Group Name %q", id.SchemaGroupName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Schema", segmentsStr)
+}
+
+func (id SchemaId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/schemaGroups/%s/schemas/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.SchemaGroupName, id.Name)
+}
+
+// SchemaID parses a Schema ID into an SchemaId struct
+func SchemaID(input string) (*SchemaId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an Schema ID: %+v", input, err)
+	}
+
+	resourceId := SchemaId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.SchemaGroupName, err = id.PopSegment("schemaGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("schemas"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
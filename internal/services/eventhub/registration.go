@@ -60,5 +60,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ConsumerGroupResource{},
+		SchemaResource{},
 	}
 }
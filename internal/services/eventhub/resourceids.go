@@ -0,0 +1,3 @@
+package eventhub
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Schema -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/schemaGroups/schemaGroup1/schemas/schema1
@@ -42,3 +42,10 @@ func ValidateSchemaGroupName() pluginsdk.SchemaValidateFunc {
 		"The schema group name can contain only letters, numbers, periods (.), hyphens (-),and underscores (_), up to 256 characters, and it must begin and end with a letter or number.",
 	)
 }
+
+func ValidateSchemaName() pluginsdk.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile("^[a-zA-Z0-9]([-._a-zA-Z0-9]{0,254}[a-zA-Z0-9])?$"),
+		"The schema name can contain only letters, numbers, periods (.), hyphens (-), and underscores (_), up to 256 characters, and it must begin and end with a letter or number.",
+	)
+}
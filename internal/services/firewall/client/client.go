@@ -1,6 +1,8 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
@@ -8,22 +10,30 @@ import (
 type Client struct {
 	AzureFirewallsClient          *network.AzureFirewallsClient
 	FirewallPolicyClient          *network.FirewallPoliciesClient
+	FirewallPolicyIdpsClient      *FirewallPolicyIdpsClient
 	FirewallPolicyRuleGroupClient *network.FirewallPolicyRuleCollectionGroupsClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	firewallsClient := network.NewAzureFirewallsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&firewallsClient.Client, o.ResourceManagerAuthorizer)
 
 	policyClient := network.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyClient.Client, o.ResourceManagerAuthorizer)
 
+	policyIdpsClient, err := NewFirewallPolicyIdpsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building firewall policy idps client: %+v", err)
+	}
+	o.Configure(policyIdpsClient.Client, o.Authorizers.ResourceManager)
+
 	policyRuleGroupClient := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyRuleGroupClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AzureFirewallsClient:          &firewallsClient,
 		FirewallPolicyClient:          &policyClient,
+		FirewallPolicyIdpsClient:      policyIdpsClient,
 		FirewallPolicyRuleGroupClient: &policyRuleGroupClient,
-	}
+	}, nil
 }
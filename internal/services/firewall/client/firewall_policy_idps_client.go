@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+)
+
+const firewallPolicyIdpsApiVersion = "2024-05-01"
+
+// FirewallPolicyIdpsClient is used to manage Firewall Policies which specify an Intrusion Detection Profile, since
+// the API Version used by the Firewall Policies Client doesn't support selecting the signature profile
+type FirewallPolicyIdpsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewFirewallPolicyIdpsClientWithBaseURI(api environments.Api) (*FirewallPolicyIdpsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "firewallpolicies", firewallPolicyIdpsApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating FirewallPolicyIdpsClient: %+v", err)
+	}
+
+	return &FirewallPolicyIdpsClient{
+		Client: client,
+	}, nil
+}
+
+type FirewallPolicyIdps struct {
+	Properties *FirewallPolicyIdpsProperties `json:"properties,omitempty"`
+}
+
+type FirewallPolicyIdpsProperties struct {
+	IntrusionDetection *FirewallPolicyIdpsIntrusionDetection `json:"intrusionDetection,omitempty"`
+}
+
+type FirewallPolicyIdpsIntrusionDetection struct {
+	Profile *string `json:"profile,omitempty"`
+}
+
+type FirewallPolicyIdpsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *FirewallPolicyIdps
+}
+
+// Get retrieves the Intrusion Detection Profile for the specified Firewall Policy
+func (c FirewallPolicyIdpsClient) Get(ctx context.Context, id parse.FirewallPolicyId) (result FirewallPolicyIdpsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the specified Firewall Policy using the payload as-is, then polls until
+// it's been provisioned - the payload is untyped so that the Firewall Policy can be built using the existing models
+func (c FirewallPolicyIdpsClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.FirewallPolicyId, input map[string]interface{}) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -124,12 +126,25 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	locks.ByName(id.Name, AzureFirewallPolicyResourceName)
 	defer locks.UnlockByName(id.Name, AzureFirewallPolicyResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, props)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creating/updating %s: %+v", id, err)
+	if profile := firewallPolicyIntrusionDetectionProfile(d.Get("intrusion_detection").([]interface{})); profile != "" {
+		// the API Version used by the Firewall Policies Client doesn't support the Intrusion Detection Profile, so the
+		// Firewall Policy is built as usual and then sent with the profile using the newer API Version
+		payload, err := expandFirewallPolicyWithIntrusionDetectionProfile(props, profile)
+		if err != nil {
+			return err
+		}
+
+		if err := meta.(*clients.Client).Firewall.FirewallPolicyIdpsClient.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, props)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creating/updating %s: %+v", id, err)
+		}
 	}
 
 	d.SetId(id.ID())
@@ -183,7 +198,18 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "dns": %+v`, err)
 		}
 
-		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(resp.IntrusionDetection)); err != nil {
+		intrusionDetectionProfile := ""
+		if prop.IntrusionDetection != nil {
+			idpsResp, err := meta.(*clients.Client).Firewall.FirewallPolicyIdpsClient.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving Intrusion Detection Profile for %s: %+v", *id, err)
+			}
+			if model := idpsResp.Model; model != nil && model.Properties != nil && model.Properties.IntrusionDetection != nil && model.Properties.IntrusionDetection.Profile != nil {
+				intrusionDetectionProfile = *model.Properties.IntrusionDetection.Profile
+			}
+		}
+
+		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(resp.IntrusionDetection, intrusionDetectionProfile)); err != nil {
 			return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
 		}
 
@@ -345,6 +371,40 @@ func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.Firewa
 	}
 }
 
+func firewallPolicyIntrusionDetectionProfile(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+
+	return input[0].(map[string]interface{})["profile"].(string)
+}
+
+func expandFirewallPolicyWithIntrusionDetectionProfile(policy network.FirewallPolicy, profile string) (map[string]interface{}, error) {
+	raw, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling Firewall Policy: %+v", err)
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshalling Firewall Policy: %+v", err)
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	intrusionDetection, ok := properties["intrusionDetection"].(map[string]interface{})
+	if !ok {
+		intrusionDetection = make(map[string]interface{})
+	}
+	intrusionDetection["profile"] = profile
+	properties["intrusionDetection"] = intrusionDetection
+	payload["properties"] = properties
+
+	return payload, nil
+}
+
 func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -481,7 +541,7 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		}}
 }
 
-func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection) []interface{} {
+func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection, profile string) []interface{} {
 	if input == nil {
 		return []interface{}{}
 	}
@@ -493,6 +553,7 @@ func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrus
 		return []interface{}{
 			map[string]interface{}{
 				"mode":                string(input.Mode),
+				"profile":             profile,
 				"signature_overrides": signatureOverrides,
 				"traffic_bypass":      trafficBypass,
 			},
@@ -569,6 +630,7 @@ func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrus
 	return []interface{}{
 		map[string]interface{}{
 			"mode":                string(input.Mode),
+			"profile":             profile,
 			"signature_overrides": signatureOverrides,
 			"traffic_bypass":      trafficBypass,
 			"private_ranges":      privateRanges,
@@ -790,6 +852,16 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 						}, false),
 						Optional: true,
 					},
+					"profile": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Computed: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Basic",
+							"Standard",
+							"Advanced",
+						}, false),
+					},
 					"signature_overrides": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -895,8 +967,9 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"key_vault_secret_id": {
-						Type:     pluginsdk.TypeString,
-						Required: true,
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 					},
 					"name": {
 						Type:     pluginsdk.TypeString,
@@ -190,6 +190,30 @@ func TestAccFirewallPolicy_insights(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_intrusionDetectionProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.intrusionDetectionProfile(data, "Standard"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("intrusion_detection.0.profile").HasValue("Standard"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.intrusionDetectionProfile(data, "Advanced"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("intrusion_detection.0.profile").HasValue("Advanced"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (FirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FirewallPolicyID(state.ID)
 	if err != nil {
@@ -337,6 +361,34 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) intrusionDetectionProfile(data acceptance.TestData, profile string) string {
+	r := FirewallPolicyResource{}
+	template := r.templatePremium(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+  intrusion_detection {
+    mode    = "Deny"
+    profile = %q
+  }
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+  tls_certificate {
+    key_vault_secret_id = azurerm_key_vault_certificate.test.versionless_secret_id
+    name                = azurerm_key_vault_certificate.test.name
+  }
+}
+`, template, data.RandomInteger, profile)
+}
+
 func (FirewallPolicyResource) requiresImport(data acceptance.TestData) string {
 	r := FirewallPolicyResource{}
 	template := r.basic(data)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -404,13 +405,18 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 									},
 									"translated_port": {
 										Type:         pluginsdk.TypeInt,
-										Required:     true,
+										Optional:     true,
 										ValidateFunc: validation.IsPortNumber,
 									},
+									"translated_port_range": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.PortOrPortRangeWithin(1, 65535),
+									},
 									"translated_fqdn": {
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
+										ValidateFunc: validate.FirewallPolicyNatRuleTranslatedFqdn(),
 									},
 								},
 							},
@@ -680,6 +686,28 @@ func expandFirewallPolicyRuleNat(input []interface{}) (*[]network.BasicFirewallP
 		if condition["translated_address"].(string) == "" && condition["translated_fqdn"].(string) == "" {
 			return nil, fmt.Errorf("should specify either `translated_address` or `translated_fqdn` in rule %s", condition["name"].(string))
 		}
+
+		// Exactly one of `translated_port` and `translated_port_range` should be set.
+		translatedPort := ""
+		if v := condition["translated_port"].(int); v != 0 {
+			translatedPort = strconv.Itoa(v)
+		}
+		if v := condition["translated_port_range"].(string); v != "" {
+			if translatedPort != "" {
+				return nil, fmt.Errorf("can't specify both `translated_port` and `translated_port_range` in rule %s", condition["name"].(string))
+			}
+
+			// a port range is translated port-for-port, so the destination has to be a range of the same size
+			destinationPorts := condition["destination_ports"].([]interface{})
+			if len(destinationPorts) == 0 || destinationPorts[0] == nil || firewallPolicyNatRulePortRangeSize(destinationPorts[0].(string)) != firewallPolicyNatRulePortRangeSize(v) {
+				return nil, fmt.Errorf("`translated_port_range` must contain the same number of ports as `destination_ports` in rule %s", condition["name"].(string))
+			}
+			translatedPort = v
+		}
+		if translatedPort == "" {
+			return nil, fmt.Errorf("should specify either `translated_port` or `translated_port_range` in rule %s", condition["name"].(string))
+		}
+
 		output := &network.NatRule{
 			Name:                 utils.String(condition["name"].(string)),
 			RuleType:             network.RuleTypeNatRule,
@@ -688,7 +716,7 @@ func expandFirewallPolicyRuleNat(input []interface{}) (*[]network.BasicFirewallP
 			SourceIPGroups:       utils.ExpandStringSlice(condition["source_ip_groups"].([]interface{})),
 			DestinationAddresses: &destinationAddresses,
 			DestinationPorts:     utils.ExpandStringSlice(condition["destination_ports"].([]interface{})),
-			TranslatedPort:       utils.String(strconv.Itoa(condition["translated_port"].(int))),
+			TranslatedPort:       utils.String(translatedPort),
 		}
 		if condition["translated_address"].(string) != "" {
 			output.TranslatedAddress = utils.String(condition["translated_address"].(string))
@@ -921,12 +949,17 @@ func flattenFirewallPolicyRuleNat(input *[]network.BasicFirewallPolicyRule) ([]i
 		}
 
 		translatedPort := 0
+		translatedPortRange := ""
 		if rule.TranslatedPort != nil {
-			port, err := strconv.Atoi(*rule.TranslatedPort)
-			if err != nil {
-				return nil, fmt.Errorf(`The "translatedPort" property is not a valid integer (%s)`, *rule.TranslatedPort)
+			if strings.Contains(*rule.TranslatedPort, "-") {
+				translatedPortRange = *rule.TranslatedPort
+			} else {
+				port, err := strconv.Atoi(*rule.TranslatedPort)
+				if err != nil {
+					return nil, fmt.Errorf(`The "translatedPort" property is not a valid integer (%s)`, *rule.TranslatedPort)
+				}
+				translatedPort = port
 			}
-			translatedPort = port
 		}
 
 		translatedAddress := ""
@@ -940,16 +973,36 @@ func flattenFirewallPolicyRuleNat(input *[]network.BasicFirewallPolicyRule) ([]i
 		}
 
 		output = append(output, map[string]interface{}{
-			"name":                name,
-			"protocols":           protocols,
-			"source_addresses":    utils.FlattenStringSlice(rule.SourceAddresses),
-			"source_ip_groups":    utils.FlattenStringSlice(rule.SourceIPGroups),
-			"destination_address": destinationAddr,
-			"destination_ports":   utils.FlattenStringSlice(rule.DestinationPorts),
-			"translated_address":  translatedAddress,
-			"translated_port":     translatedPort,
-			"translated_fqdn":     translatedFQDN,
+			"name":                  name,
+			"protocols":             protocols,
+			"source_addresses":      utils.FlattenStringSlice(rule.SourceAddresses),
+			"source_ip_groups":      utils.FlattenStringSlice(rule.SourceIPGroups),
+			"destination_address":   destinationAddr,
+			"destination_ports":     utils.FlattenStringSlice(rule.DestinationPorts),
+			"translated_address":    translatedAddress,
+			"translated_port":       translatedPort,
+			"translated_port_range": translatedPortRange,
+			"translated_fqdn":       translatedFQDN,
 		})
 	}
 	return output, nil
 }
+
+// firewallPolicyNatRulePortRangeSize returns the number of ports covered by a port (e.g. `80`) or port range (e.g. `8080-8090`)
+func firewallPolicyNatRulePortRangeSize(input string) int {
+	start, end, found := strings.Cut(input, "-")
+	if !found {
+		return 1
+	}
+
+	startPort, err := strconv.Atoi(start)
+	if err != nil {
+		return 0
+	}
+	endPort, err := strconv.Atoi(end)
+	if err != nil {
+		return 0
+	}
+
+	return endPort - startPort + 1
+}
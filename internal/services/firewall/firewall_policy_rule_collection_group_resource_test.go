@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -133,6 +134,35 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_natRulePortRange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.natRulePortRange(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("nat_rule_collection.0.rule.0.translated_port_range").HasValue("8080-8090"),
+				check.That(data.ResourceName).Key("nat_rule_collection.0.rule.1.translated_fqdn").HasValue("time.microsoft.com"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroup_natRulePortRangeMismatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.natRulePortRangeMismatch(data),
+			ExpectError: regexp.MustCompile("`translated_port_range` must contain the same number of ports as `destination_ports`"),
+		},
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.FirewallPolicyRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -871,6 +901,87 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (FirewallPolicyRuleCollectionGroupResource) natRulePortRange(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  nat_rule_collection {
+    name     = "nat_rule_collection1"
+    priority = 300
+    action   = "Dnat"
+    rule {
+      name                  = "nat_rule_collection1_rule1"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.1", "10.0.0.2"]
+      destination_address   = "192.168.1.1"
+      destination_ports     = ["80-90"]
+      translated_address    = "192.168.0.1"
+      translated_port_range = "8080-8090"
+    }
+    rule {
+      name                = "nat_rule_collection1_rule2"
+      protocols           = ["TCP", "UDP"]
+      source_addresses    = ["10.0.0.1", "10.0.0.2"]
+      destination_address = "192.168.1.1"
+      destination_ports   = ["443"]
+      translated_fqdn     = "time.microsoft.com"
+      translated_port     = "8443"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (FirewallPolicyRuleCollectionGroupResource) natRulePortRangeMismatch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  nat_rule_collection {
+    name     = "nat_rule_collection1"
+    priority = 300
+    action   = "Dnat"
+    rule {
+      name                  = "nat_rule_collection1_rule1"
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_address   = "192.168.1.1"
+      destination_ports     = ["80-90"]
+      translated_address    = "192.168.0.1"
+      translated_port_range = "8080-8085"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyRuleCollectionGroupResource{}.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,12 @@
+package validate
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func FirewallPolicyNatRuleTranslatedFqdn() func(i interface{}, k string) (warnings []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`),
+		"The translated FQDN must be a valid fully qualified domain name, made up of labels of up to 63 letters, numbers or hyphens which neither begin nor end with a hyphen.")
+}
@@ -0,0 +1,40 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFirewallPolicyNatRuleTranslatedFqdn(t *testing.T) {
+	validFqdns := []string{
+		"a",
+		"contoso.com",
+		"app-1.internal.contoso.com",
+		"10-0-0-1.example.net",
+		strings.Repeat("w", 63) + ".com",
+	}
+	for _, v := range validFqdns {
+		_, errors := FirewallPolicyNatRuleTranslatedFqdn()(v, "translated_fqdn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Translated FQDN: %q", v, errors)
+		}
+	}
+
+	invalidFqdns := []string{
+		"",
+		".contoso.com",
+		"contoso.com.",
+		"-contoso.com",
+		"contoso-.com",
+		"con_toso.com",
+		"*.contoso.com",
+		"contoso..com",
+		strings.Repeat("w", 64) + ".com",
+	}
+	for _, v := range invalidFqdns {
+		_, errors := FirewallPolicyNatRuleTranslatedFqdn()(v, "translated_fqdn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Translated FQDN", v)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVault "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -1125,8 +1126,9 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"timezone": {
-								Type:     pluginsdk.TypeString,
-								Required: true,
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: computeValidate.VirtualMachineTimeZone(),
 							},
 							"schedule": {
 								Type:     pluginsdk.TypeList,
@@ -3,14 +3,20 @@ package hpccache
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-01-01/caches"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-01-01/storagetargets"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
+// hpcCacheMinimumSubnetAddressCount is the number of IP addresses the HPC Cache needs in its dedicated subnet
+const hpcCacheMinimumSubnetAddressCount = 64
+
 func CacheGetAccessPolicyByName(policies []caches.NfsAccessPolicy, name string) *caches.NfsAccessPolicy {
 	for _, policy := range policies {
 		if policy.Name == name {
@@ -86,3 +92,66 @@ func resourceHPCCacheRefresh(ctx context.Context, client *caches.CachesClient, i
 		return resp, string(*resp.Model.Properties.ProvisioningState), nil
 	}
 }
+
+// validateHPCCacheSubnetSize checks that the Subnet is large enough to host an HPC Cache, since the API only
+// surfaces this as a failure some time into the provisioning of the Cache.
+func validateHPCCacheSubnetSize(ctx context.Context, client *network.SubnetsClient, subnetId string) error {
+	id, err := networkParse.SubnetID(subnetId)
+	if err != nil {
+		return err
+	}
+
+	subnet, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if subnet.SubnetPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	prefixes := make([]string, 0)
+	if v := subnet.SubnetPropertiesFormat.AddressPrefix; v != nil {
+		prefixes = append(prefixes, *v)
+	}
+	if v := subnet.SubnetPropertiesFormat.AddressPrefixes; v != nil {
+		prefixes = append(prefixes, *v...)
+	}
+
+	count, err := subnetAddressCount(prefixes)
+	if err != nil {
+		return fmt.Errorf("determining the size of %s: %+v", *id, err)
+	}
+
+	if count < hpcCacheMinimumSubnetAddressCount {
+		return fmt.Errorf("the HPC Cache requires a dedicated Subnet with at least %d IP addresses (a `/26` or larger) but %s only has %d", hpcCacheMinimumSubnetAddressCount, *id, count)
+	}
+
+	return nil
+}
+
+// subnetAddressCount returns the number of IPv4 addresses across the given address prefixes, de-duplicated by prefix.
+func subnetAddressCount(prefixes []string) (int, error) {
+	seen := make(map[string]struct{})
+	count := 0
+	for _, prefix := range prefixes {
+		_, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return 0, fmt.Errorf("parsing address prefix %q: %+v", prefix, err)
+		}
+
+		// IPv6 prefixes don't count towards the addresses available to the Cache
+		if ipNet.IP.To4() == nil {
+			continue
+		}
+
+		if _, ok := seen[ipNet.String()]; ok {
+			continue
+		}
+		seen[ipNet.String()] = struct{}{}
+
+		ones, bits := ipNet.Mask.Size()
+		count += 1 << (bits - ones)
+	}
+
+	return count, nil
+}
@@ -77,6 +77,10 @@ func resourceHPCCacheCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{})
 		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_hpc_cache", id.ID())
 		}
+
+		if err := validateHPCCacheSubnetSize(ctx, meta.(*clients.Client).Network.SubnetsClient, d.Get("subnet_id").(string)); err != nil {
+			return err
+		}
 	}
 
 	location := d.Get("location").(string)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -54,6 +55,18 @@ func TestAccHPCCache_basic(t *testing.T) {
 	})
 }
 
+func TestAccHPCCache_subnetTooSmall(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_hpc_cache", "test")
+	r := HPCCacheResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.subnetTooSmall(data),
+			ExpectError: regexp.MustCompile("the HPC Cache requires a dedicated Subnet with at least 64 IP addresses"),
+		},
+	})
+}
+
 func TestAccHPCCache_mtu(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_hpc_cache", "test")
 	r := HPCCacheResource{}
@@ -413,6 +426,28 @@ resource "azurerm_hpc_cache" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r HPCCacheResource) subnetTooSmall(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "small" {
+  name                 = "acctestsub-small-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.3.0/27"]
+}
+
+resource "azurerm_hpc_cache" "test" {
+  name                = "acctest-HPCC-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cache_size_in_gb    = 3072
+  subnet_id           = azurerm_subnet.small.id
+  sku_name            = "Standard_2G"
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r HPCCacheResource) updateTags(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
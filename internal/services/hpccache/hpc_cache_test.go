@@ -0,0 +1,56 @@
+package hpccache
+
+import "testing"
+
+func TestSubnetAddressCount(t *testing.T) {
+	testCases := []struct {
+		Prefixes []string
+		Expected int
+		Error    bool
+	}{
+		{
+			Prefixes: []string{},
+			Expected: 0,
+		},
+		{
+			Prefixes: []string{"10.0.1.0/24"},
+			Expected: 256,
+		},
+		{
+			Prefixes: []string{"10.0.1.0/27"},
+			Expected: 32,
+		},
+		{
+			Prefixes: []string{"10.0.1.0/27", "10.0.2.0/27"},
+			Expected: 64,
+		},
+		{
+			Prefixes: []string{"10.0.1.0/26", "10.0.1.0/26"},
+			Expected: 64,
+		},
+		{
+			Prefixes: []string{"10.0.1.0/26", "ace:cab:deca::/64"},
+			Expected: 64,
+		},
+		{
+			Prefixes: []string{"not-a-prefix"},
+			Error:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		actual, err := subnetAddressCount(tc.Prefixes)
+		if err != nil {
+			if !tc.Error {
+				t.Fatalf("unexpected error for %+v: %+v", tc.Prefixes, err)
+			}
+			continue
+		}
+		if tc.Error {
+			t.Fatalf("expected an error for %+v but didn't get one", tc.Prefixes)
+		}
+		if actual != tc.Expected {
+			t.Fatalf("expected %d for %+v but got %d", tc.Expected, tc.Prefixes, actual)
+		}
+	}
+}
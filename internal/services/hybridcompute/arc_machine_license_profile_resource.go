@@ -0,0 +1,377 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	arcMachineLicenseSubscriptionStatusDisabled = "Disabled"
+	arcMachineLicenseSubscriptionStatusEnabled  = "Enabled"
+)
+
+type ArcMachineLicenseProfileResource struct{}
+
+type ArcMachineLicenseProfileModel struct {
+	ArcMachineId                     string                                 `tfschema:"arc_machine_id"`
+	Location                         string                                 `tfschema:"location"`
+	EsuLicenseId                     string                                 `tfschema:"esu_license_id"`
+	Product                          []ArcMachineLicenseProfileProductModel `tfschema:"product"`
+	SoftwareAssuranceCustomerEnabled bool                                   `tfschema:"software_assurance_customer_enabled"`
+	EsuEligibility                   string                                 `tfschema:"esu_eligibility"`
+	EsuKeyState                      string                                 `tfschema:"esu_key_state"`
+	ServerType                       string                                 `tfschema:"server_type"`
+	Tags                             map[string]interface{}                 `tfschema:"tags"`
+}
+
+type ArcMachineLicenseProfileProductModel struct {
+	Type                string                                        `tfschema:"type"`
+	SubscriptionEnabled bool                                          `tfschema:"subscription_enabled"`
+	Features            []ArcMachineLicenseProfileProductFeatureModel `tfschema:"feature"`
+}
+
+type ArcMachineLicenseProfileProductFeatureModel struct {
+	Name                string `tfschema:"name"`
+	SubscriptionEnabled bool   `tfschema:"subscription_enabled"`
+}
+
+var _ sdk.ResourceWithUpdate = ArcMachineLicenseProfileResource{}
+
+func (r ArcMachineLicenseProfileResource) ResourceType() string {
+	return "azurerm_arc_machine_license_profile"
+}
+
+func (r ArcMachineLicenseProfileResource) ModelObject() interface{} {
+	return &ArcMachineLicenseProfileModel{}
+}
+
+func (r ArcMachineLicenseProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateMachineLicenseProfileID
+}
+
+func (r ArcMachineLicenseProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"esu_license_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+			AtLeastOneOf: []string{"esu_license_id", "product", "software_assurance_customer_enabled"},
+		},
+
+		"product": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"esu_license_id", "product", "software_assurance_customer_enabled"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"WindowsServer",
+							"WindowsIoTEnterprise",
+						}, false),
+					},
+
+					"subscription_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"feature": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"subscription_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"software_assurance_customer_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			AtLeastOneOf: []string{"esu_license_id", "product", "software_assurance_customer_enabled"},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"esu_eligibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_key_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+			machinesClient := metadata.Client.HybridCompute.MachinesClient
+
+			var config ArcMachineLicenseProfileModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewMachineLicenseProfileID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			machine, err := machinesClient.Get(ctx, *machineId, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *machineId, err)
+			}
+			if machine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *machineId)
+			}
+
+			payload := azuresdkhacks.LicenseProfile{
+				Location:   location.Normalize(machine.Model.Location),
+				Properties: expandArcMachineLicenseProfileProperties(config),
+				Tags:       tags.Expand(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := azuresdkhacks.ParseMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineLicenseProfileModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if esu := props.EsuProfile; esu != nil {
+						state.EsuLicenseId = pointer.From(esu.AssignedLicense)
+						state.EsuEligibility = pointer.From(esu.EsuEligibility)
+						state.EsuKeyState = pointer.From(esu.EsuKeyState)
+						state.ServerType = pointer.From(esu.ServerType)
+					}
+
+					state.Product = flattenArcMachineLicenseProfileProduct(props.ProductProfile)
+
+					if sa := props.SoftwareAssurance; sa != nil {
+						state.SoftwareAssuranceCustomerEnabled = pointer.From(sa.SoftwareAssuranceCustomer)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := azuresdkhacks.ParseMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ArcMachineLicenseProfileModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := azuresdkhacks.LicenseProfile{
+				Location:   existing.Model.Location,
+				Properties: expandArcMachineLicenseProfileProperties(config),
+				Tags:       tags.Expand(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := azuresdkhacks.ParseMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineLicenseProfileProperties(input ArcMachineLicenseProfileModel) *azuresdkhacks.LicenseProfileProperties {
+	output := &azuresdkhacks.LicenseProfileProperties{
+		SoftwareAssurance: &azuresdkhacks.LicenseProfileSoftwareAssuranceProperties{
+			SoftwareAssuranceCustomer: pointer.To(input.SoftwareAssuranceCustomerEnabled),
+		},
+	}
+
+	if input.EsuLicenseId != "" {
+		output.EsuProfile = &azuresdkhacks.LicenseProfileEsuProperties{
+			AssignedLicense: pointer.To(input.EsuLicenseId),
+		}
+	}
+
+	if len(input.Product) > 0 {
+		product := input.Product[0]
+
+		features := make([]azuresdkhacks.ProductFeature, 0)
+		for _, v := range product.Features {
+			features = append(features, azuresdkhacks.ProductFeature{
+				Name:               pointer.To(v.Name),
+				SubscriptionStatus: pointer.To(expandArcMachineLicenseSubscriptionStatus(v.SubscriptionEnabled)),
+			})
+		}
+
+		output.ProductProfile = &azuresdkhacks.LicenseProfileProductProfileProperties{
+			ProductType:        pointer.To(product.Type),
+			SubscriptionStatus: pointer.To(expandArcMachineLicenseSubscriptionStatus(product.SubscriptionEnabled)),
+			ProductFeatures:    &features,
+		}
+	}
+
+	return output
+}
+
+func expandArcMachineLicenseSubscriptionStatus(enabled bool) string {
+	if enabled {
+		return arcMachineLicenseSubscriptionStatusEnabled
+	}
+	return arcMachineLicenseSubscriptionStatusDisabled
+}
+
+func flattenArcMachineLicenseProfileProduct(input *azuresdkhacks.LicenseProfileProductProfileProperties) []ArcMachineLicenseProfileProductModel {
+	if input == nil || input.ProductType == nil {
+		return []ArcMachineLicenseProfileProductModel{}
+	}
+
+	features := make([]ArcMachineLicenseProfileProductFeatureModel, 0)
+	if input.ProductFeatures != nil {
+		for _, v := range *input.ProductFeatures {
+			features = append(features, ArcMachineLicenseProfileProductFeatureModel{
+				Name: pointer.From(v.Name),
+				// the status transitions through `Enabling` before settling on `Enabled`
+				SubscriptionEnabled: pointer.From(v.SubscriptionStatus) != arcMachineLicenseSubscriptionStatusDisabled,
+			})
+		}
+	}
+
+	return []ArcMachineLicenseProfileProductModel{
+		{
+			Type:                pointer.From(input.ProductType),
+			SubscriptionEnabled: pointer.From(input.SubscriptionStatus) != arcMachineLicenseSubscriptionStatusDisabled,
+			Features:            features,
+		},
+	}
+}
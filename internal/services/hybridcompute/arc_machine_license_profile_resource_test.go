@@ -0,0 +1,137 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseProfileResource struct{}
+
+func TestAccArcMachineLicenseProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, clientSecret, randomUUID, password)
+		}),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("software_assurance_customer_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachineLicenseProfileResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseMachineLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.HybridCompute.LicenseProfilesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcMachineLicenseProfileResource) basic(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id                      = data.azurerm_hybrid_compute_machine.test.id
+  software_assurance_customer_enabled = true
+}
+`, ArcMachineRunCommandResource{}.template(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineLicenseProfileResource) requiresImport(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "import" {
+  arc_machine_id                      = azurerm_arc_machine_license_profile.test.arc_machine_id
+  software_assurance_customer_enabled = azurerm_arc_machine_license_profile.test.software_assurance_customer_enabled
+}
+`, r.basic(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineLicenseProfileResource) update(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id                      = data.azurerm_hybrid_compute_machine.test.id
+  software_assurance_customer_enabled = false
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, ArcMachineRunCommandResource{}.template(data, secret, randomUUID, password))
+}
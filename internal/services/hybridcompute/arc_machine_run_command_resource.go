@@ -0,0 +1,578 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcMachineRunCommandResource struct{}
+
+type ArcMachineRunCommandModel struct {
+	Name                      string                                  `tfschema:"name"`
+	ArcMachineId              string                                  `tfschema:"arc_machine_id"`
+	Location                  string                                  `tfschema:"location"`
+	Source                    []ArcMachineRunCommandSourceModel       `tfschema:"source"`
+	Parameters                []ArcMachineRunCommandParameterModel    `tfschema:"parameter"`
+	ProtectedParameters       []ArcMachineRunCommandParameterModel    `tfschema:"protected_parameter"`
+	RunAsUser                 string                                  `tfschema:"run_as_user"`
+	RunAsPassword             string                                  `tfschema:"run_as_password"`
+	AsyncExecutionEnabled     bool                                    `tfschema:"async_execution_enabled"`
+	TimeoutInSeconds          int64                                   `tfschema:"timeout_in_seconds"`
+	OutputBlobUri             string                                  `tfschema:"output_blob_uri"`
+	OutputBlobManagedIdentity []ArcMachineRunCommandIdentityModel     `tfschema:"output_blob_managed_identity"`
+	ErrorBlobUri              string                                  `tfschema:"error_blob_uri"`
+	ErrorBlobManagedIdentity  []ArcMachineRunCommandIdentityModel     `tfschema:"error_blob_managed_identity"`
+	InstanceView              []ArcMachineRunCommandInstanceViewModel `tfschema:"instance_view"`
+	Tags                      map[string]interface{}                  `tfschema:"tags"`
+}
+
+type ArcMachineRunCommandSourceModel struct {
+	CommandId                string                              `tfschema:"command_id"`
+	Script                   string                              `tfschema:"script"`
+	ScriptUri                string                              `tfschema:"script_uri"`
+	ScriptUriManagedIdentity []ArcMachineRunCommandIdentityModel `tfschema:"script_uri_managed_identity"`
+}
+
+type ArcMachineRunCommandParameterModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ArcMachineRunCommandIdentityModel struct {
+	ClientId string `tfschema:"client_id"`
+	ObjectId string `tfschema:"object_id"`
+}
+
+type ArcMachineRunCommandInstanceViewModel struct {
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExitCode         int64  `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	Error            string `tfschema:"error"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+var _ sdk.ResourceWithUpdate = ArcMachineRunCommandResource{}
+
+func (r ArcMachineRunCommandResource) ResourceType() string {
+	return "azurerm_arc_machine_run_command"
+}
+
+func (r ArcMachineRunCommandResource) ModelObject() interface{} {
+	return &ArcMachineRunCommandModel{}
+}
+
+func (r ArcMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateMachineRunCommandID
+}
+
+func (r ArcMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,79}$`),
+				"`name` must be between 1 and 80 characters in length, begin with a letter or number and may only contain letters, numbers, underscores, periods and hyphens",
+			),
+		},
+
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+					},
+
+					"script_uri_managed_identity": arcMachineRunCommandIdentitySchema(),
+				},
+			},
+		},
+
+		"parameter": arcMachineRunCommandParameterSchema(false),
+
+		"protected_parameter": arcMachineRunCommandParameterSchema(true),
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"run_as_user"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"async_execution_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 86400),
+		},
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"output_blob_managed_identity": arcMachineRunCommandIdentitySchema(),
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"error_blob_managed_identity": arcMachineRunCommandIdentitySchema(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+			machinesClient := metadata.Client.HybridCompute.MachinesClient
+
+			var config ArcMachineRunCommandModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(config.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewMachineRunCommandID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			machine, err := machinesClient.Get(ctx, *machineId, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *machineId, err)
+			}
+			if machine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *machineId)
+			}
+
+			payload := azuresdkhacks.MachineRunCommand{
+				Location:   location.Normalize(machine.Model.Location),
+				Properties: expandArcMachineRunCommandProperties(config),
+				Tags:       tags.Expand(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			id, err := azuresdkhacks.ParseMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config ArcMachineRunCommandModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ArcMachineRunCommandModel{
+				Name:         id.RunCommandName,
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.Source = flattenArcMachineRunCommandSource(props.Source)
+					state.Parameters = flattenArcMachineRunCommandParameters(props.Parameters)
+					state.RunAsUser = pointer.From(props.RunAsUser)
+					state.AsyncExecutionEnabled = pointer.From(props.AsyncExecution)
+					state.TimeoutInSeconds = pointer.From(props.TimeoutInSeconds)
+					state.OutputBlobManagedIdentity = flattenArcMachineRunCommandIdentity(props.OutputBlobManagedIdentity)
+					state.ErrorBlobManagedIdentity = flattenArcMachineRunCommandIdentity(props.ErrorBlobManagedIdentity)
+					state.InstanceView = flattenArcMachineRunCommandInstanceView(props.InstanceView)
+				}
+			}
+
+			// the sensitive values aren't returned by the API, so are taken from the config
+			state.ProtectedParameters = config.ProtectedParameters
+			state.RunAsPassword = config.RunAsPassword
+			state.OutputBlobUri = config.OutputBlobUri
+			state.ErrorBlobUri = config.ErrorBlobUri
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			id, err := azuresdkhacks.ParseMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ArcMachineRunCommandModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the sensitive values aren't returned by the API, so the whole payload is rebuilt from the config
+			payload := azuresdkhacks.MachineRunCommand{
+				Location:   existing.Model.Location,
+				Properties: expandArcMachineRunCommandProperties(config),
+				Tags:       tags.Expand(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			id, err := azuresdkhacks.ParseMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func arcMachineRunCommandParameterSchema(sensitive bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeList,
+		Optional:  true,
+		Sensitive: sensitive,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    sensitive,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func arcMachineRunCommandIdentitySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"object_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func expandArcMachineRunCommandProperties(input ArcMachineRunCommandModel) *azuresdkhacks.MachineRunCommandProperties {
+	output := &azuresdkhacks.MachineRunCommandProperties{
+		AsyncExecution:            pointer.To(input.AsyncExecutionEnabled),
+		ErrorBlobManagedIdentity:  expandArcMachineRunCommandIdentity(input.ErrorBlobManagedIdentity),
+		OutputBlobManagedIdentity: expandArcMachineRunCommandIdentity(input.OutputBlobManagedIdentity),
+		Parameters:                expandArcMachineRunCommandParameters(input.Parameters),
+		ProtectedParameters:       expandArcMachineRunCommandParameters(input.ProtectedParameters),
+	}
+
+	if len(input.Source) > 0 {
+		source := input.Source[0]
+		output.Source = &azuresdkhacks.MachineRunCommandScriptSource{
+			ScriptUriManagedIdentity: expandArcMachineRunCommandIdentity(source.ScriptUriManagedIdentity),
+		}
+		if source.CommandId != "" {
+			output.Source.CommandId = pointer.To(source.CommandId)
+		}
+		if source.Script != "" {
+			output.Source.Script = pointer.To(source.Script)
+		}
+		if source.ScriptUri != "" {
+			output.Source.ScriptUri = pointer.To(source.ScriptUri)
+		}
+	}
+
+	if input.RunAsUser != "" {
+		output.RunAsUser = pointer.To(input.RunAsUser)
+	}
+	if input.RunAsPassword != "" {
+		output.RunAsPassword = pointer.To(input.RunAsPassword)
+	}
+	if input.TimeoutInSeconds != 0 {
+		output.TimeoutInSeconds = pointer.To(input.TimeoutInSeconds)
+	}
+	if input.OutputBlobUri != "" {
+		output.OutputBlobUri = pointer.To(input.OutputBlobUri)
+	}
+	if input.ErrorBlobUri != "" {
+		output.ErrorBlobUri = pointer.To(input.ErrorBlobUri)
+	}
+
+	return output
+}
+
+func expandArcMachineRunCommandParameters(input []ArcMachineRunCommandParameterModel) *[]azuresdkhacks.RunCommandInputParameter {
+	output := make([]azuresdkhacks.RunCommandInputParameter, 0)
+	for _, v := range input {
+		output = append(output, azuresdkhacks.RunCommandInputParameter{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+	return &output
+}
+
+func expandArcMachineRunCommandIdentity(input []ArcMachineRunCommandIdentityModel) *azuresdkhacks.RunCommandManagedIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := &azuresdkhacks.RunCommandManagedIdentity{}
+	if input[0].ClientId != "" {
+		output.ClientId = pointer.To(input[0].ClientId)
+	}
+	if input[0].ObjectId != "" {
+		output.ObjectId = pointer.To(input[0].ObjectId)
+	}
+	return output
+}
+
+func flattenArcMachineRunCommandSource(input *azuresdkhacks.MachineRunCommandScriptSource) []ArcMachineRunCommandSourceModel {
+	if input == nil {
+		return []ArcMachineRunCommandSourceModel{}
+	}
+
+	return []ArcMachineRunCommandSourceModel{
+		{
+			CommandId:                pointer.From(input.CommandId),
+			Script:                   pointer.From(input.Script),
+			ScriptUri:                pointer.From(input.ScriptUri),
+			ScriptUriManagedIdentity: flattenArcMachineRunCommandIdentity(input.ScriptUriManagedIdentity),
+		},
+	}
+}
+
+func flattenArcMachineRunCommandParameters(input *[]azuresdkhacks.RunCommandInputParameter) []ArcMachineRunCommandParameterModel {
+	output := make([]ArcMachineRunCommandParameterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, ArcMachineRunCommandParameterModel{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+	return output
+}
+
+func flattenArcMachineRunCommandIdentity(input *azuresdkhacks.RunCommandManagedIdentity) []ArcMachineRunCommandIdentityModel {
+	if input == nil || (input.ClientId == nil && input.ObjectId == nil) {
+		return []ArcMachineRunCommandIdentityModel{}
+	}
+
+	return []ArcMachineRunCommandIdentityModel{
+		{
+			ClientId: pointer.From(input.ClientId),
+			ObjectId: pointer.From(input.ObjectId),
+		},
+	}
+}
+
+func flattenArcMachineRunCommandInstanceView(input *azuresdkhacks.MachineRunCommandInstanceView) []ArcMachineRunCommandInstanceViewModel {
+	if input == nil {
+		return []ArcMachineRunCommandInstanceViewModel{}
+	}
+
+	return []ArcMachineRunCommandInstanceViewModel{
+		{
+			ExecutionState:   pointer.From(input.ExecutionState),
+			ExecutionMessage: pointer.From(input.ExecutionMessage),
+			ExitCode:         pointer.From(input.ExitCode),
+			Output:           pointer.From(input.Output),
+			Error:            pointer.From(input.Error),
+			StartTime:        pointer.From(input.StartTime),
+			EndTime:          pointer.From(input.EndTime),
+		},
+	}
+}
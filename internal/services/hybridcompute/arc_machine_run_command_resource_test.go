@@ -0,0 +1,181 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineRunCommandResource struct{}
+
+func TestAccArcMachineRunCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+	r := ArcMachineRunCommandResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineRunCommand_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+	r := ArcMachineRunCommandResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, clientSecret, randomUUID, password)
+		}),
+	})
+}
+
+func TestAccArcMachineRunCommand_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+	r := ArcMachineRunCommandResource{}
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	randomUUID, _ := uuid.GenerateUUID()
+	password := generateRandomPassword(10)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_parameter"),
+		{
+			Config: r.basic(data, clientSecret, randomUUID, password),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachineRunCommandResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := azuresdkhacks.ParseMachineRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.HybridCompute.MachineRunCommandsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcMachineRunCommandResource) template(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_hybrid_compute_machine" "test" {
+  name                = azurerm_linux_virtual_machine.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  depends_on = [
+    azurerm_linux_virtual_machine.test
+  ]
+}
+`, HybridComputeMachineDataSource{}.template(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineRunCommandResource) basic(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_run_command" "test" {
+  name           = "acctest-rc-%d"
+  arc_machine_id = data.azurerm_hybrid_compute_machine.test.id
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, r.template(data, secret, randomUUID, password), data.RandomInteger)
+}
+
+func (r ArcMachineRunCommandResource) requiresImport(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_run_command" "import" {
+  name           = azurerm_arc_machine_run_command.test.name
+  arc_machine_id = azurerm_arc_machine_run_command.test.arc_machine_id
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, r.basic(data, secret, randomUUID, password))
+}
+
+func (r ArcMachineRunCommandResource) complete(data acceptance.TestData, secret string, randomUUID string, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_run_command" "test" {
+  name               = "acctest-rc-%d"
+  arc_machine_id     = data.azurerm_hybrid_compute_machine.test.id
+  timeout_in_seconds = 600
+
+  source {
+    script = "echo $GREETING $SECRET_GREETING"
+  }
+
+  parameter {
+    name  = "GREETING"
+    value = "hello"
+  }
+
+  protected_parameter {
+    name  = "SECRET_GREETING"
+    value = "world"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data, secret, randomUUID, password), data.RandomInteger)
+}
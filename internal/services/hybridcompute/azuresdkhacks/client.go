@@ -0,0 +1,40 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// NOTE: Run Commands and License Profiles for Arc Machines aren't available in the 2022-11-10 API used by
+// the vendored SDK, as such these clients are implemented here against the 2024-07-10 API until the SDK
+// is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2024-07-10"
+
+type MachineRunCommandsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMachineRunCommandsClientWithBaseURI(endpoint string) MachineRunCommandsClient {
+	return MachineRunCommandsClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/machineruncommands/%s", defaultApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type LicenseProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLicenseProfilesClientWithBaseURI(endpoint string) LicenseProfilesClient {
+	return LicenseProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/licenseprofiles/%s", defaultApiVersion)),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,119 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = MachineLicenseProfileId{}
+
+// MachineLicenseProfileId is a struct representing the Resource ID for a Machine License Profile,
+// a Machine can only have a single License Profile which is always named `default`
+type MachineLicenseProfileId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	MachineName       string
+}
+
+// NewMachineLicenseProfileID returns a new MachineLicenseProfileId struct
+func NewMachineLicenseProfileID(subscriptionId string, resourceGroupName string, machineName string) MachineLicenseProfileId {
+	return MachineLicenseProfileId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		MachineName:       machineName,
+	}
+}
+
+// ParseMachineLicenseProfileID parses 'input' into a MachineLicenseProfileId
+func ParseMachineLicenseProfileID(input string) (*MachineLicenseProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(MachineLicenseProfileId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return machineLicenseProfileIdFromParsed(parsed)
+}
+
+// ParseMachineLicenseProfileIDInsensitively parses 'input' case-insensitively into a MachineLicenseProfileId
+// note: this method should only be used for API response data and not user input
+func ParseMachineLicenseProfileIDInsensitively(input string) (*MachineLicenseProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(MachineLicenseProfileId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return machineLicenseProfileIdFromParsed(parsed)
+}
+
+func machineLicenseProfileIdFromParsed(parsed *resourceids.ParseResult) (*MachineLicenseProfileId, error) {
+	var ok bool
+	id := MachineLicenseProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", *parsed)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", *parsed)
+	}
+
+	if id.MachineName, ok = parsed.Parsed["machineName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "machineName", *parsed)
+	}
+
+	return &id, nil
+}
+
+// ValidateMachineLicenseProfileID checks that 'input' can be parsed as a Machine License Profile ID
+func ValidateMachineLicenseProfileID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseMachineLicenseProfileID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Machine License Profile ID
+func (id MachineLicenseProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/licenseProfiles/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.MachineName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Machine License Profile ID
+func (id MachineLicenseProfileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHybridCompute", "Microsoft.HybridCompute", "Microsoft.HybridCompute"),
+		resourceids.StaticSegment("staticMachines", "machines", "machines"),
+		resourceids.UserSpecifiedSegment("machineName", "machineValue"),
+		resourceids.StaticSegment("staticLicenseProfiles", "licenseProfiles", "licenseProfiles"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	}
+}
+
+// String returns a human-readable description of this Machine License Profile ID
+func (id MachineLicenseProfileId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Machine Name: %q", id.MachineName),
+	}
+	return fmt.Sprintf("Machine License Profile (%s)", strings.Join(components, "\n"))
+}
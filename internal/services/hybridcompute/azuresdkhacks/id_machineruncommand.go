@@ -0,0 +1,125 @@
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+var _ resourceids.ResourceId = MachineRunCommandId{}
+
+// MachineRunCommandId is a struct representing the Resource ID for a Machine Run Command
+type MachineRunCommandId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	MachineName       string
+	RunCommandName    string
+}
+
+// NewMachineRunCommandID returns a new MachineRunCommandId struct
+func NewMachineRunCommandID(subscriptionId string, resourceGroupName string, machineName string, runCommandName string) MachineRunCommandId {
+	return MachineRunCommandId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		MachineName:       machineName,
+		RunCommandName:    runCommandName,
+	}
+}
+
+// ParseMachineRunCommandID parses 'input' into a MachineRunCommandId
+func ParseMachineRunCommandID(input string) (*MachineRunCommandId, error) {
+	parser := resourceids.NewParserFromResourceIdType(MachineRunCommandId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return machineRunCommandIdFromParsed(parsed)
+}
+
+// ParseMachineRunCommandIDInsensitively parses 'input' case-insensitively into a MachineRunCommandId
+// note: this method should only be used for API response data and not user input
+func ParseMachineRunCommandIDInsensitively(input string) (*MachineRunCommandId, error) {
+	parser := resourceids.NewParserFromResourceIdType(MachineRunCommandId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	return machineRunCommandIdFromParsed(parsed)
+}
+
+func machineRunCommandIdFromParsed(parsed *resourceids.ParseResult) (*MachineRunCommandId, error) {
+	var ok bool
+	id := MachineRunCommandId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", *parsed)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", *parsed)
+	}
+
+	if id.MachineName, ok = parsed.Parsed["machineName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "machineName", *parsed)
+	}
+
+	if id.RunCommandName, ok = parsed.Parsed["runCommandName"]; !ok {
+		return nil, resourceids.NewSegmentNotSpecifiedError(id, "runCommandName", *parsed)
+	}
+
+	return &id, nil
+}
+
+// ValidateMachineRunCommandID checks that 'input' can be parsed as a Machine Run Command ID
+func ValidateMachineRunCommandID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseMachineRunCommandID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Machine Run Command ID
+func (id MachineRunCommandId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/runCommands/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.MachineName, id.RunCommandName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Machine Run Command ID
+func (id MachineRunCommandId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHybridCompute", "Microsoft.HybridCompute", "Microsoft.HybridCompute"),
+		resourceids.StaticSegment("staticMachines", "machines", "machines"),
+		resourceids.UserSpecifiedSegment("machineName", "machineValue"),
+		resourceids.StaticSegment("staticRunCommands", "runCommands", "runCommands"),
+		resourceids.UserSpecifiedSegment("runCommandName", "runCommandValue"),
+	}
+}
+
+// String returns a human-readable description of this Machine Run Command ID
+func (id MachineRunCommandId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Machine Name: %q", id.MachineName),
+		fmt.Sprintf("Run Command Name: %q", id.RunCommandName),
+	}
+	return fmt.Sprintf("Machine Run Command (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,190 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type LicenseProfile struct {
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *LicenseProfileProperties `json:"properties,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type LicenseProfileProperties struct {
+	EsuProfile        *LicenseProfileEsuProperties               `json:"esuProfile,omitempty"`
+	ProductProfile    *LicenseProfileProductProfileProperties    `json:"productProfile,omitempty"`
+	ProvisioningState *string                                    `json:"provisioningState,omitempty"`
+	SoftwareAssurance *LicenseProfileSoftwareAssuranceProperties `json:"softwareAssurance,omitempty"`
+}
+
+type LicenseProfileEsuProperties struct {
+	AssignedLicense            *string `json:"assignedLicense,omitempty"`
+	AssignedLicenseImmutableId *string `json:"assignedLicenseImmutableId,omitempty"`
+	EsuEligibility             *string `json:"esuEligibility,omitempty"`
+	EsuKeyState                *string `json:"esuKeyState,omitempty"`
+	ServerType                 *string `json:"serverType,omitempty"`
+}
+
+type LicenseProfileProductProfileProperties struct {
+	BillingStartDate   *string           `json:"billingStartDate,omitempty"`
+	EnrollmentDate     *string           `json:"enrollmentDate,omitempty"`
+	ProductFeatures    *[]ProductFeature `json:"productFeatures,omitempty"`
+	ProductType        *string           `json:"productType,omitempty"`
+	SubscriptionStatus *string           `json:"subscriptionStatus,omitempty"`
+}
+
+type ProductFeature struct {
+	Name               *string `json:"name,omitempty"`
+	SubscriptionStatus *string `json:"subscriptionStatus,omitempty"`
+}
+
+type LicenseProfileSoftwareAssuranceProperties struct {
+	SoftwareAssuranceCustomer *bool `json:"softwareAssuranceCustomer,omitempty"`
+}
+
+type LicenseProfileCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type LicenseProfileGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *LicenseProfile
+}
+
+type LicenseProfileDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate ...
+func (c LicenseProfilesClient) CreateOrUpdate(ctx context.Context, id MachineLicenseProfileId, input LicenseProfile) (result LicenseProfileCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c LicenseProfilesClient) CreateOrUpdateThenPoll(ctx context.Context, id MachineLicenseProfileId, input LicenseProfile) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c LicenseProfilesClient) Get(ctx context.Context, id MachineLicenseProfileId) (result LicenseProfileGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c LicenseProfilesClient) Delete(ctx context.Context, id MachineLicenseProfileId) (result LicenseProfileDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "licenseprofiles.LicenseProfilesClient", "Delete", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c LicenseProfilesClient) DeleteThenPoll(ctx context.Context, id MachineLicenseProfileId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c LicenseProfilesClient) prepare(ctx context.Context, id MachineLicenseProfileId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
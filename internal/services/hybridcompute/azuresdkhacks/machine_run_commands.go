@@ -0,0 +1,201 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type MachineRunCommand struct {
+	Id         *string                      `json:"id,omitempty"`
+	Location   string                       `json:"location"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *MachineRunCommandProperties `json:"properties,omitempty"`
+	Tags       *map[string]string           `json:"tags,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type MachineRunCommandProperties struct {
+	AsyncExecution            *bool                          `json:"asyncExecution,omitempty"`
+	ErrorBlobManagedIdentity  *RunCommandManagedIdentity     `json:"errorBlobManagedIdentity,omitempty"`
+	ErrorBlobUri              *string                        `json:"errorBlobUri,omitempty"`
+	InstanceView              *MachineRunCommandInstanceView `json:"instanceView,omitempty"`
+	OutputBlobManagedIdentity *RunCommandManagedIdentity     `json:"outputBlobManagedIdentity,omitempty"`
+	OutputBlobUri             *string                        `json:"outputBlobUri,omitempty"`
+	Parameters                *[]RunCommandInputParameter    `json:"parameters,omitempty"`
+	ProtectedParameters       *[]RunCommandInputParameter    `json:"protectedParameters,omitempty"`
+	ProvisioningState         *string                        `json:"provisioningState,omitempty"`
+	RunAsPassword             *string                        `json:"runAsPassword,omitempty"`
+	RunAsUser                 *string                        `json:"runAsUser,omitempty"`
+	Source                    *MachineRunCommandScriptSource `json:"source,omitempty"`
+	TimeoutInSeconds          *int64                         `json:"timeoutInSeconds,omitempty"`
+}
+
+type MachineRunCommandScriptSource struct {
+	CommandId                *string                    `json:"commandId,omitempty"`
+	Script                   *string                    `json:"script,omitempty"`
+	ScriptUri                *string                    `json:"scriptUri,omitempty"`
+	ScriptUriManagedIdentity *RunCommandManagedIdentity `json:"scriptUriManagedIdentity,omitempty"`
+}
+
+type RunCommandManagedIdentity struct {
+	ClientId *string `json:"clientId,omitempty"`
+	ObjectId *string `json:"objectId,omitempty"`
+}
+
+type RunCommandInputParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type MachineRunCommandInstanceView struct {
+	EndTime          *string `json:"endTime,omitempty"`
+	Error            *string `json:"error,omitempty"`
+	ExecutionMessage *string `json:"executionMessage,omitempty"`
+	ExecutionState   *string `json:"executionState,omitempty"`
+	ExitCode         *int64  `json:"exitCode,omitempty"`
+	Output           *string `json:"output,omitempty"`
+	StartTime        *string `json:"startTime,omitempty"`
+}
+
+type MachineRunCommandCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type MachineRunCommandGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *MachineRunCommand
+}
+
+type MachineRunCommandDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateOrUpdate ...
+func (c MachineRunCommandsClient) CreateOrUpdate(ctx context.Context, id MachineRunCommandId, input MachineRunCommand) (result MachineRunCommandCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c MachineRunCommandsClient) CreateOrUpdateThenPoll(ctx context.Context, id MachineRunCommandId, input MachineRunCommand) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c MachineRunCommandsClient) Get(ctx context.Context, id MachineRunCommandId) (result MachineRunCommandGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c MachineRunCommandsClient) Delete(ctx context.Context, id MachineRunCommandId) (result MachineRunCommandDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machineruncommands.MachineRunCommandsClient", "Delete", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c MachineRunCommandsClient) DeleteThenPoll(ctx context.Context, id MachineRunCommandId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c MachineRunCommandsClient) prepare(ctx context.Context, id MachineRunCommandId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -5,19 +5,28 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privateendpointconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/azuresdkhacks"
 )
 
 type Client struct {
+	LicenseProfilesClient            *azuresdkhacks.LicenseProfilesClient
 	MachineExtensionsClient          *machineextensions.MachineExtensionsClient
+	MachineRunCommandsClient         *azuresdkhacks.MachineRunCommandsClient
 	MachinesClient                   *machines.MachinesClient
 	PrivateEndpointConnectionsClient *privateendpointconnections.PrivateEndpointConnectionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 
+	licenseProfilesClient := azuresdkhacks.NewLicenseProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&licenseProfilesClient.Client, o.ResourceManagerAuthorizer)
+
 	machineExtensionsClient := machineextensions.NewMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&machineExtensionsClient.Client, o.ResourceManagerAuthorizer)
 
+	machineRunCommandsClient := azuresdkhacks.NewMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&machineRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
 	machinesClient := machines.NewMachinesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&machinesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -25,7 +34,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&privateEndpointConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		LicenseProfilesClient:            &licenseProfilesClient,
 		MachineExtensionsClient:          &machineExtensionsClient,
+		MachineRunCommandsClient:         &machineRunCommandsClient,
 		MachinesClient:                   &machinesClient,
 		PrivateEndpointConnectionsClient: &privateEndpointConnectionsClient,
 	}
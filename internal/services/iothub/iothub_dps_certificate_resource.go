@@ -1,7 +1,17 @@
 package iothub
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -60,10 +70,34 @@ func resourceIotHubDPSCertificate() *pluginsdk.Resource {
 			},
 
 			"is_verified": {
-				Type:     pluginsdk.TypeBool,
-				Optional: true,
-				ForceNew: true,
-				Default:  false,
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"proof_of_possession_private_key"},
+			},
+
+			"proof_of_possession_private_key": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"is_verified"},
+			},
+
+			"expiry": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"subject": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
 			},
 		},
 	}
@@ -101,6 +135,12 @@ func resourceIotHubDPSCertificateCreate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	if privateKey := d.Get("proof_of_possession_private_key").(string); privateKey != "" {
+		if err := verifyIotHubDPSCertificate(ctx, client, id, d.Get("certificate_content").(string), privateKey); err != nil {
+			return fmt.Errorf("verifying %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceIotHubDPSCertificateRead(d, meta)
@@ -136,6 +176,9 @@ func resourceIotHubDPSCertificateRead(d *pluginsdk.ResourceData, meta interface{
 				isVerified = *props.IsVerified
 			}
 			d.Set("is_verified", isVerified)
+			d.Set("expiry", utils.NormalizeNilableString(props.Expiry))
+			d.Set("subject", utils.NormalizeNilableString(props.Subject))
+			d.Set("thumbprint", utils.NormalizeNilableString(props.Thumbprint))
 		}
 	}
 
@@ -166,12 +209,22 @@ func resourceIotHubDPSCertificateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 	if d.HasChange("certificate_content") {
 		existing.Model.Properties.Certificate = utils.String(d.Get("certificate_content").(string))
+		if d.Get("proof_of_possession_private_key").(string) != "" {
+			existing.Model.Properties.IsVerified = utils.Bool(false)
+		}
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, *existing.Model, dpscertificate.CreateOrUpdateOperationOptions{IfMatch: utils.String(etag)}); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
+	// uploading a new certificate resets the verification status, so proof of possession has to be completed again
+	if privateKey := d.Get("proof_of_possession_private_key").(string); privateKey != "" && d.HasChanges("certificate_content", "proof_of_possession_private_key") {
+		if err := verifyIotHubDPSCertificate(ctx, client, id, d.Get("certificate_content").(string), privateKey); err != nil {
+			return fmt.Errorf("verifying %s: %+v", id, err)
+		}
+	}
+
 	return resourceIotHubDPSCertificateRead(d, meta)
 }
 
@@ -206,3 +259,118 @@ func resourceIotHubDPSCertificateDelete(d *pluginsdk.ResourceData, meta interfac
 	}
 	return nil
 }
+
+// verifyIotHubDPSCertificate completes the proof of possession flow for the certificate by requesting a verification code,
+// signing a verification certificate for that code with the private key of the uploaded certificate and submitting it
+func verifyIotHubDPSCertificate(ctx context.Context, client *dpscertificate.DpsCertificateClient, id dpscertificate.CertificateId, certificateContent string, privateKey string) error {
+	existing, err := client.Get(ctx, id, dpscertificate.GetOperationOptions{IfMatch: utils.String("")})
+	if err != nil {
+		return fmt.Errorf("retrieving: %+v", err)
+	}
+	if existing.Model == nil || existing.Model.Etag == nil {
+		return fmt.Errorf("retrieving: `etag` was nil")
+	}
+
+	code, err := client.GenerateVerificationCode(ctx, id, dpscertificate.GenerateVerificationCodeOperationOptions{
+		IfMatch:         existing.Model.Etag,
+		CertificateName: utils.String(id.CertificateName),
+	})
+	if err != nil {
+		return fmt.Errorf("generating verification code: %+v", err)
+	}
+	if code.Model == nil || code.Model.Etag == nil || code.Model.Properties == nil || code.Model.Properties.VerificationCode == nil {
+		return fmt.Errorf("generating verification code: `verificationCode` was nil")
+	}
+
+	verificationCertificate, err := buildIotHubDPSVerificationCertificate(certificateContent, privateKey, *code.Model.Properties.VerificationCode)
+	if err != nil {
+		return err
+	}
+
+	input := dpscertificate.VerificationCodeRequest{
+		Certificate: utils.String(verificationCertificate),
+	}
+	if _, err := client.VerifyCertificate(ctx, id, input, dpscertificate.VerifyCertificateOperationOptions{
+		IfMatch:         code.Model.Etag,
+		CertificateName: utils.String(id.CertificateName),
+	}); err != nil {
+		return fmt.Errorf("submitting verification certificate: %+v", err)
+	}
+
+	return nil
+}
+
+// buildIotHubDPSVerificationCertificate returns a PEM encoded certificate with the verification code as the Common Name,
+// issued by the (base64 encoded) `certificate_content` and signed with the PEM encoded private key
+func buildIotHubDPSVerificationCertificate(certificateContent string, privateKey string, verificationCode string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(certificateContent)
+	if err != nil {
+		return "", fmt.Errorf("decoding `certificate_content`: %+v", err)
+	}
+	if block, _ := pem.Decode(raw); block != nil {
+		raw = block.Bytes
+	}
+	issuer, err := x509.ParseCertificate(raw)
+	if err != nil {
+		return "", fmt.Errorf("parsing `certificate_content`: %+v", err)
+	}
+
+	signer, err := parseIotHubDPSPrivateKey(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("parsing `proof_of_possession_private_key`: %+v", err)
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("generating key for verification certificate: %+v", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return "", fmt.Errorf("generating serial number for verification certificate: %+v", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: verificationCode,
+		},
+		NotBefore:   now.Add(-5 * time.Minute),
+		NotAfter:    now.Add(24 * time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &leafKey.PublicKey, signer)
+	if err != nil {
+		return "", fmt.Errorf("signing verification certificate: %+v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+func parseIotHubDPSPrivateKey(input string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(input))
+	if block == nil {
+		return nil, fmt.Errorf("expected a PEM encoded private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported private key type %q: %+v", block.Type, err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
@@ -85,6 +85,23 @@ func TestAccIotHubDPSCertificate_isVerified(t *testing.T) {
 	})
 }
 
+func TestAccIotHubDPSCertificate_proofOfPossession(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_certificate", "test")
+	r := IotHubDPSCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.proofOfPossession(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_verified").HasValue("true"),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep("certificate_content", "proof_of_possession_private_key"),
+	})
+}
+
 func (t IotHubDPSCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dpscertificate.ParseCertificateID(state.ID)
 	if err != nil {
@@ -213,3 +230,36 @@ resource "azurerm_iothub_dps_certificate" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (IotHubDPSCertificateResource) proofOfPossession(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iothub_dps" "test" {
+  name                = "acctestIoTDPS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+}
+
+resource "azurerm_iothub_dps_certificate" "test" {
+  name                = "acctestIoTDPSCertificate-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  iot_dps_name        = azurerm_iothub_dps.test.name
+
+  certificate_content             = filebase64("testdata/dps_proof_of_possession.cer")
+  proof_of_possession_private_key = file("testdata/dps_proof_of_possession.key")
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
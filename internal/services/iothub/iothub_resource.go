@@ -1,6 +1,7 @@
 package iothub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -35,6 +36,13 @@ import (
 
 var IothubResourceName = "azurerm_iothub"
 
+const (
+	// iotHubRouteManagementModeInline manages the `route` and `fallback_route` blocks as part of the IoT Hub
+	iotHubRouteManagementModeInline = "Inline"
+	// iotHubRouteManagementModeStandalone leaves Routes and the Fallback Route to the `azurerm_iothub_route` and `azurerm_iothub_fallback_route` resources
+	iotHubRouteManagementModeStandalone = "Standalone"
+)
+
 // nolint unparam
 func suppressIfTypeIsNot(t string) pluginsdk.SchemaDiffSuppressFunc {
 	return func(k, old, new string, d *pluginsdk.ResourceData) bool {
@@ -72,9 +80,10 @@ func resourceIotHub() *pluginsdk.Resource {
 		Update: resourceIotHubCreateUpdate,
 		Delete: resourceIotHubDelete,
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.IoTHubV0ToV1{},
+			1: migration.IoTHubV1ToV2{},
 		}),
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -501,6 +510,16 @@ func resourceIotHub() *pluginsdk.Resource {
 				},
 			},
 
+			"route_management_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  iotHubRouteManagementModeInline,
+				ValidateFunc: validation.StringInSlice([]string{
+					iotHubRouteManagementModeInline,
+					iotHubRouteManagementModeStandalone,
+				}, false),
+			},
+
 			"network_rule_set": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -649,6 +668,8 @@ func resourceIotHub() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(iotHubCustomizeDiff),
 	}
 }
 
@@ -691,22 +712,41 @@ func resourceIotHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	routingProperties := devices.RoutingProperties{}
 
-	if _, ok := d.GetOk("route"); ok {
-		routingProperties.Routes = expandIoTHubRoutes(d)
-	}
-
 	if _, ok := d.GetOk("enrichment"); ok {
 		routingProperties.Enrichments = expandIoTHubEnrichments(d)
 	}
 
-	if _, ok := d.GetOk("fallback_route"); ok {
-		routingProperties.FallbackRoute = expandIoTHubFallbackRoute(d)
+	defaultFallbackRoute := &devices.FallbackRouteProperties{
+		Source:        utils.String(string(devices.RoutingSourceDeviceMessages)),
+		Condition:     utils.String("true"),
+		EndpointNames: &[]string{"events"},
+		IsEnabled:     utils.Bool(true),
+	}
+
+	if d.Get("route_management_mode").(string) == iotHubRouteManagementModeStandalone {
+		// the Routes and Fallback Route are managed by the standalone resources, so the current values are retained as-is
+		routingProperties.FallbackRoute = defaultFallbackRoute
+		if !d.IsNewResource() {
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if props := existing.Properties; props != nil && props.Routing != nil {
+				routingProperties.Routes = props.Routing.Routes
+				if props.Routing.FallbackRoute != nil {
+					routingProperties.FallbackRoute = props.Routing.FallbackRoute
+				}
+			}
+		}
 	} else {
-		routingProperties.FallbackRoute = &devices.FallbackRouteProperties{
-			Source:        utils.String(string(devices.RoutingSourceDeviceMessages)),
-			Condition:     utils.String("true"),
-			EndpointNames: &[]string{"events"},
-			IsEnabled:     utils.Bool(true),
+		if _, ok := d.GetOk("route"); ok {
+			routingProperties.Routes = expandIoTHubRoutes(d)
+		}
+
+		if _, ok := d.GetOk("fallback_route"); ok {
+			routingProperties.FallbackRoute = expandIoTHubFallbackRoute(d)
+		} else {
+			routingProperties.FallbackRoute = defaultFallbackRoute
 		}
 	}
 
@@ -893,6 +933,13 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("min_tls_version", properties.MinTLSVersion)
 	}
 
+	// `route_management_mode` isn't returned by the API, so this is retained from the config (defaulting on import)
+	routeManagementMode := iotHubRouteManagementModeInline
+	if v, ok := d.GetOk("route_management_mode"); ok {
+		routeManagementMode = v.(string)
+	}
+	d.Set("route_management_mode", routeManagementMode)
+
 	identity, err := flattenIotHubIdentity(hub.Identity)
 	if err != nil {
 		return fmt.Errorf("flattening `identity`: %+v", err)
@@ -938,6 +985,26 @@ func resourceIotHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func iotHubCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Get("route_management_mode").(string) != iotHubRouteManagementModeStandalone {
+		return nil
+	}
+
+	// `route` and `fallback_route` are Computed, so the raw config is used to determine whether they've been specified
+	config := d.GetRawConfig()
+	for _, key := range []string{"route", "fallback_route"} {
+		v := config.GetAttr(key)
+		if v.IsNull() {
+			continue
+		}
+		if !v.IsKnown() || v.LengthInt() > 0 {
+			return fmt.Errorf("`%s` cannot be specified when `route_management_mode` is `%s`, use the `azurerm_iothub_route` and `azurerm_iothub_fallback_route` resources instead", key, iotHubRouteManagementModeStandalone)
+		}
+	}
+
+	return nil
+}
+
 func expandIoTHubRoutes(d *pluginsdk.ResourceData) *[]devices.RouteProperties {
 	routeList := d.Get("route").([]interface{})
 
@@ -260,6 +260,33 @@ func TestAccIotHub_fallbackRoute(t *testing.T) {
 	})
 }
 
+func TestAccIotHub_routeManagementModeStandalone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub", "test")
+	r := IotHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.routeManagementModeStandalone(data, "testing"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_iothub_route.test").ExistsInAzure(IotHubRouteResource{}),
+			),
+		},
+		data.ImportStep("route_management_mode"),
+		{
+			// updating the IoT Hub mustn't remove the Routes managed by the standalone resources
+			Config: r.routeManagementModeStandalone(data, "updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_iothub_route.test").ExistsInAzure(IotHubRouteResource{}),
+				check.That(data.ResourceName).Key("route.#").HasValue("1"),
+				check.That(data.ResourceName).Key("fallback_route.0.source").HasValue("DeviceConnectionStateEvents"),
+			),
+		},
+		data.ImportStep("route_management_mode"),
+	})
+}
+
 func TestAccIotHub_publicAccess(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_iothub", "test")
 	r := IotHubResource{}
@@ -1983,3 +2010,80 @@ resource "azurerm_role_assignment" "test_azure_event_hubs_data_sender_system" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (IotHubResource) routeManagementModeStandalone(data acceptance.TestData, purpose string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test-%[1]d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_iothub" "test" {
+  name                  = "acctestIoTHub-%[1]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  route_management_mode = "Standalone"
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "%[4]s"
+  }
+}
+
+resource "azurerm_iothub_endpoint_storage_container" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_id           = azurerm_iothub.test.id
+  name                = "acctest"
+
+  connection_string          = azurerm_storage_account.test.primary_blob_connection_string
+  batch_frequency_in_seconds = 60
+  max_chunk_size_in_bytes    = 10485760
+  container_name             = azurerm_storage_container.test.name
+  encoding                   = "Avro"
+  file_name_format           = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+}
+
+resource "azurerm_iothub_route" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest"
+
+  source         = "DeviceMessages"
+  condition      = "true"
+  endpoint_names = [azurerm_iothub_endpoint_storage_container.test.name]
+  enabled        = true
+}
+
+resource "azurerm_iothub_fallback_route" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+
+  source         = "DeviceConnectionStateEvents"
+  condition      = "true"
+  endpoint_names = [azurerm_iothub_endpoint_storage_container.test.name]
+  enabled        = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, purpose)
+}
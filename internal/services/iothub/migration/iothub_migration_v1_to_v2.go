@@ -0,0 +1,29 @@
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = IoTHubV1ToV2{}
+
+type IoTHubV1ToV2 struct{}
+
+func (IoTHubV1ToV2) Schema() map[string]*pluginsdk.Schema {
+	// the schema is unchanged between V0 and V1, only the format of the ID changed
+	return IoTHubV0ToV1{}.Schema()
+}
+
+func (IoTHubV1ToV2) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// Routes were always managed inline prior to the introduction of `route_management_mode`
+		if v, ok := rawState["route_management_mode"].(string); !ok || v == "" {
+			log.Printf("[DEBUG] Setting `route_management_mode` to %q", "Inline")
+			rawState["route_management_mode"] = "Inline"
+		}
+
+		return rawState, nil
+	}
+}
@@ -1,6 +1,7 @@
 package kusto
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -246,6 +247,8 @@ func resourceKustoCluster() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(kustoClusterCustomizeDiff),
 	}
 
 	if features.FourPointOhBeta() {
@@ -298,15 +301,6 @@ func resourceKustoClusterCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		if *sku.Capacity > optimizedAutoScale.Maximum {
 			sku.Capacity = utils.Int64(optimizedAutoScale.Maximum)
 		}
-
-		// Capacity must be set for the initial creation when using OptimizedAutoScaling but cannot be updated
-		if d.HasChange("sku.0.capacity") && !d.IsNewResource() {
-			return fmt.Errorf("cannot change `sku.capacity` when `optimized_auto_scaling.enabled` is set to `true`")
-		}
-
-		if optimizedAutoScale.Minimum > optimizedAutoScale.Maximum {
-			return fmt.Errorf("`optimized_auto_scaling.maximum_instances` must be >= `optimized_auto_scaling.minimum_instances`")
-		}
 	}
 
 	engine := clusters.EngineType(d.Get("engine").(string))
@@ -511,6 +505,29 @@ func resourceKustoClusterDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+func kustoClusterCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	autoScale := d.Get("optimized_auto_scale").([]interface{})
+	if len(autoScale) == 0 || autoScale[0] == nil {
+		return nil
+	}
+
+	config := autoScale[0].(map[string]interface{})
+	minimum := config["minimum_instances"].(int)
+	maximum := config["maximum_instances"].(int)
+	if minimum > maximum {
+		return fmt.Errorf("`optimized_auto_scale.0.maximum_instances` (%d) must be greater than or equal to `optimized_auto_scale.0.minimum_instances` (%d)", maximum, minimum)
+	}
+
+	// Capacity must be set for the initial creation when using Optimized Auto Scale but is then managed by the service
+	if d.Id() != "" && d.HasChange("sku.0.capacity") {
+		if _, ok := d.GetOk("sku.0.capacity"); ok {
+			return fmt.Errorf("`sku.0.capacity` cannot be changed when `optimized_auto_scale` is specified")
+		}
+	}
+
+	return nil
+}
+
 func expandOptimizedAutoScale(input []interface{}) *clusters.OptimizedAutoscale {
 	if len(input) == 0 || input[0] == nil {
 		return nil
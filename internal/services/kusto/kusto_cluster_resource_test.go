@@ -3,6 +3,7 @@ package kusto_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2022-02-01/clusters"
@@ -58,6 +59,7 @@ func TestAccKustoCluster_update(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_stop_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("disk_encryption_enabled").HasValue("false"),
 				check.That(data.ResourceName).Key("streaming_ingestion_enabled").HasValue("false"),
 				check.That(data.ResourceName).Key("purge_enabled").HasValue("false"),
@@ -69,6 +71,7 @@ func TestAccKustoCluster_update(t *testing.T) {
 			Config: r.update(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_stop_enabled").HasValue("false"),
 				check.That(data.ResourceName).Key("disk_encryption_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("streaming_ingestion_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("purge_enabled").HasValue("true"),
@@ -80,6 +83,7 @@ func TestAccKustoCluster_update(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_stop_enabled").HasValue("true"),
 				check.That(data.ResourceName).Key("disk_encryption_enabled").HasValue("false"),
 				check.That(data.ResourceName).Key("streaming_ingestion_enabled").HasValue("false"),
 				check.That(data.ResourceName).Key("purge_enabled").HasValue("false"),
@@ -301,6 +305,18 @@ func TestAccKustoCluster_optimizedAutoScale(t *testing.T) {
 	})
 }
 
+func TestAccKustoCluster_optimizedAutoScaleInvalidRange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cluster", "test")
+	r := KustoClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.optimizedAutoScaleInvalidRange(data),
+			ExpectError: regexp.MustCompile("must be greater than or equal to `optimized_auto_scale.0.minimum_instances`"),
+		},
+	})
+}
+
 func TestAccKustoCluster_engineV3(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kusto_cluster", "test")
 	r := KustoClusterResource{}
@@ -620,7 +636,7 @@ resource "azurerm_kusto_cluster" "test" {
   name                        = "acctestkc%s"
   location                    = azurerm_resource_group.test.location
   resource_group_name         = azurerm_resource_group.test.name
-  auto_stop_enabled           = true
+  auto_stop_enabled           = false
   disk_encryption_enabled     = true
   streaming_ingestion_enabled = true
   purge_enabled               = true
@@ -840,6 +856,34 @@ resource "azurerm_kusto_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (KustoClusterResource) optimizedAutoScaleInvalidRange(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name = "Standard_D11_v2"
+  }
+
+  optimized_auto_scale {
+    minimum_instances = 4
+    maximum_instances = 3
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (KustoClusterResource) vnet(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,227 @@
+package lighthouse
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2019-06-01/registrationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceLighthouseAssignments() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceLighthouseAssignmentsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.Any(commonids.ValidateSubscriptionID, commonids.ValidateResourceGroupID),
+			},
+
+			"assignments": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"lighthouse_definition_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"lighthouse_definition_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managing_tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"managing_tenant_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"provisioning_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"authorization": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"principal_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"principal_display_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"role_definition_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"delegated_role_definition_ids": {
+										Type:     pluginsdk.TypeList,
+										Computed: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLighthouseAssignmentsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Lighthouse.AssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := commonids.NewScopeID(d.Get("scope").(string))
+
+	// the Lighthouse Definition is expanded so that the managing tenant and principal display names are available
+	options := registrationassignments.ListOperationOptions{
+		ExpandRegistrationDefinition: utils.Bool(true),
+	}
+	resp, err := client.ListComplete(ctx, id, options)
+	if err != nil {
+		return fmt.Errorf("listing Lighthouse Assignments within %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("scope", id.Scope)
+
+	if err := d.Set("assignments", flattenLighthouseAssignments(resp.Items)); err != nil {
+		return fmt.Errorf("setting `assignments`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenLighthouseAssignments(input []registrationassignments.RegistrationAssignment) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		id := ""
+		if item.Id != nil {
+			id = *item.Id
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		definitionId := ""
+		definitionName := ""
+		description := ""
+		managingTenantId := ""
+		managingTenantName := ""
+		provisioningState := ""
+		authorizations := make([]interface{}, 0)
+		if props := item.Properties; props != nil {
+			definitionId = props.RegistrationDefinitionId
+			if props.ProvisioningState != nil {
+				provisioningState = string(*props.ProvisioningState)
+			}
+
+			if definition := props.RegistrationDefinition; definition != nil && definition.Properties != nil {
+				if v := definition.Properties.RegistrationDefinitionName; v != nil {
+					definitionName = *v
+				}
+				if v := definition.Properties.Description; v != nil {
+					description = *v
+				}
+				if v := definition.Properties.ManagedByTenantId; v != nil {
+					managingTenantId = *v
+				}
+				if v := definition.Properties.ManagedByTenantName; v != nil {
+					managingTenantName = *v
+				}
+				authorizations = flattenLighthouseAssignmentsAuthorization(definition.Properties.Authorizations)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                         id,
+			"name":                       name,
+			"lighthouse_definition_id":   definitionId,
+			"lighthouse_definition_name": definitionName,
+			"description":                description,
+			"managing_tenant_id":         managingTenantId,
+			"managing_tenant_name":       managingTenantName,
+			"provisioning_state":         provisioningState,
+			"authorization":              authorizations,
+		})
+	}
+
+	return results
+}
+
+func flattenLighthouseAssignmentsAuthorization(input *[]registrationassignments.Authorization) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		principalDisplayName := ""
+		if item.PrincipalIdDisplayName != nil {
+			principalDisplayName = *item.PrincipalIdDisplayName
+		}
+
+		delegatedRoleDefinitionIds := make([]interface{}, 0)
+		if item.DelegatedRoleDefinitionIds != nil {
+			for _, v := range *item.DelegatedRoleDefinitionIds {
+				delegatedRoleDefinitionIds = append(delegatedRoleDefinitionIds, v)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"principal_id":                  item.PrincipalId,
+			"principal_display_name":        principalDisplayName,
+			"role_definition_id":            item.RoleDefinitionId,
+			"delegated_role_definition_ids": delegatedRoleDefinitionIds,
+		})
+	}
+
+	return results
+}
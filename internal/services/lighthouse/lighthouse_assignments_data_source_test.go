@@ -0,0 +1,48 @@
+package lighthouse_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LighthouseAssignmentsDataSource struct{}
+
+func TestAccLighthouseAssignmentsDataSource_basic(t *testing.T) {
+	// Multiple tenants are needed to test this acceptance.
+	// Second tenant ID needs to be set as a environment variable ARM_TENANT_ID_ALT.
+	// ObjectId for user, usergroup or service principal from second Tenant needs to be set as a environment variable ARM_PRINCIPAL_ID_ALT_TENANT.
+	secondTenantID := os.Getenv("ARM_TENANT_ID_ALT")
+	principalID := os.Getenv("ARM_PRINCIPAL_ID_ALT_TENANT")
+	if secondTenantID == "" || principalID == "" {
+		t.Skip("Skipping as ARM_TENANT_ID_ALT and/or ARM_PRINCIPAL_ID_ALT_TENANT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_lighthouse_assignments", "test")
+	r := LighthouseAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(uuid.New().String(), secondTenantID, principalID, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("assignments.#").Exists(),
+				check.That(data.ResourceName).Key("assignments.0.lighthouse_definition_id").Exists(),
+				check.That(data.ResourceName).Key("assignments.0.managing_tenant_id").HasValue(secondTenantID),
+			),
+		},
+	})
+}
+
+func (LighthouseAssignmentsDataSource) basic(id string, secondTenantID string, principalID string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_lighthouse_assignments" "test" {
+  scope = azurerm_lighthouse_assignment.test.scope
+}
+`, LighthouseAssignmentResource{}.basic(id, secondTenantID, principalID, data))
+}
@@ -27,7 +27,9 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azurerm_lighthouse_assignments": dataSourceLighthouseAssignments(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
@@ -29,6 +29,7 @@ type BackendAddressPoolAddressModel struct {
 	VirtualNetworkId        string                      `tfschema:"virtual_network_id"`
 	IPAddress               string                      `tfschema:"ip_address"`
 	FrontendIPConfiguration string                      `tfschema:"backend_address_ip_configuration_id"`
+	AdminState              string                      `tfschema:"admin_state"`
 	PortMapping             []inboundNATRulePortMapping `tfschema:"inbound_nat_rule_port_mapping"`
 }
 
@@ -100,6 +101,18 @@ func (r BackendAddressPoolAddressResource) Arguments() map[string]*pluginsdk.Sch
 			ValidateFunc:  validate.LoadBalancerFrontendIpConfigurationID,
 			Description:   "For global load balancer, user needs to specify the `backend_address_ip_configuration_id` of the added regional load balancers",
 		},
+
+		"admin_state": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(network.LoadBalancerBackendAddressAdminStateNone),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.LoadBalancerBackendAddressAdminStateNone),
+				string(network.LoadBalancerBackendAddressAdminStateUp),
+				string(network.LoadBalancerBackendAddressAdminStateDown),
+			}, false),
+			Description: "The administrative state of the Backend Address, which overrides the health probe. `Up` always forwards new connections to the backend, `Down` denies new connections and resets existing connections.",
+		},
 	}
 }
 
@@ -186,6 +199,7 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 						LoadBalancerFrontendIPConfiguration: &network.SubResource{
 							ID: utils.String(model.FrontendIPConfiguration),
 						},
+						AdminState: network.LoadBalancerBackendAddressAdminState(model.AdminState),
 					},
 				})
 			} else {
@@ -195,6 +209,7 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 						VirtualNetwork: &network.SubResource{
 							ID: utils.String(model.VirtualNetworkId),
 						},
+						AdminState: network.LoadBalancerBackendAddressAdminState(model.AdminState),
 					},
 					Name: utils.String(id.AddressName),
 				})
@@ -264,6 +279,11 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 			}
 
 			if props := backendAddress.LoadBalancerBackendAddressPropertiesFormat; props != nil {
+				model.AdminState = string(network.LoadBalancerBackendAddressAdminStateNone)
+				if props.AdminState != "" {
+					model.AdminState = string(props.AdminState)
+				}
+
 				if lb.Sku.Tier == network.LoadBalancerSkuTierGlobal {
 					if props.LoadBalancerFrontendIPConfiguration != nil && props.LoadBalancerFrontendIPConfiguration.ID != nil {
 						model.FrontendIPConfiguration = *props.LoadBalancerFrontendIPConfiguration.ID
@@ -431,6 +451,7 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 						LoadBalancerFrontendIPConfiguration: &network.SubResource{
 							ID: utils.String(model.FrontendIPConfiguration),
 						},
+						AdminState: network.LoadBalancerBackendAddressAdminState(model.AdminState),
 					},
 				}
 			} else {
@@ -440,6 +461,7 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 						VirtualNetwork: &network.SubResource{
 							ID: utils.String(model.VirtualNetworkId),
 						},
+						AdminState: network.LoadBalancerBackendAddressAdminState(model.AdminState),
 					},
 					Name: utils.String(id.AddressName),
 				}
@@ -0,0 +1,168 @@
+package loadbalancer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceArmLoadBalancerFrontendIpConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceArmLoadBalancerFrontendIpConfigurationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"loadbalancer_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LoadBalancerID,
+			},
+
+			"load_balancer_sku": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"gateway_load_balancer_frontend_ip_configuration_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address_allocation": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_ip_address_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_address_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_prefix_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"zones": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmLoadBalancerFrontendIpConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).LoadBalancers.LoadBalancersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loadBalancerId, err := parse.LoadBalancerID(d.Get("loadbalancer_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewLoadBalancerFrontendIpConfigurationID(loadBalancerId.SubscriptionId, loadBalancerId.ResourceGroup, loadBalancerId.Name, d.Get("name").(string))
+
+	loadBalancer, err := client.Get(ctx, loadBalancerId.ResourceGroup, loadBalancerId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(loadBalancer.Response) {
+			return fmt.Errorf("parent %s was not found", *loadBalancerId)
+		}
+		return fmt.Errorf("retrieving parent %s: %+v", *loadBalancerId, err)
+	}
+
+	config, exists := FindLoadBalancerFrontEndIpConfigurationByName(&loadBalancer, id.FrontendIPConfigurationName)
+	if !exists {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	d.SetId(id.ID())
+
+	sku := ""
+	if loadBalancer.Sku != nil {
+		sku = string(loadBalancer.Sku.Name)
+	}
+	d.Set("load_balancer_sku", sku)
+
+	gatewayLoadBalancerId := ""
+	privateIpAddress := ""
+	privateIpAddressAllocation := ""
+	privateIpAddressVersion := ""
+	publicIpAddressId := ""
+	publicIpPrefixId := ""
+	subnetId := ""
+	if props := config.FrontendIPConfigurationPropertiesFormat; props != nil {
+		if props.GatewayLoadBalancer != nil && props.GatewayLoadBalancer.ID != nil {
+			gatewayLoadBalancerId = *props.GatewayLoadBalancer.ID
+			if parsed, err := parse.LoadBalancerFrontendIpConfigurationIDInsensitively(gatewayLoadBalancerId); err == nil {
+				gatewayLoadBalancerId = parsed.ID()
+			}
+		}
+
+		if props.PrivateIPAddress != nil {
+			privateIpAddress = *props.PrivateIPAddress
+		}
+		privateIpAddressAllocation = string(props.PrivateIPAllocationMethod)
+		privateIpAddressVersion = string(props.PrivateIPAddressVersion)
+
+		if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
+			publicIpAddressId = *props.PublicIPAddress.ID
+		}
+
+		if props.PublicIPPrefix != nil && props.PublicIPPrefix.ID != nil {
+			publicIpPrefixId = *props.PublicIPPrefix.ID
+		}
+
+		if props.Subnet != nil && props.Subnet.ID != nil {
+			subnetId = *props.Subnet.ID
+		}
+	}
+	d.Set("gateway_load_balancer_frontend_ip_configuration_id", gatewayLoadBalancerId)
+	d.Set("private_ip_address", privateIpAddress)
+	d.Set("private_ip_address_allocation", privateIpAddressAllocation)
+	d.Set("private_ip_address_version", privateIpAddressVersion)
+	d.Set("public_ip_address_id", publicIpAddressId)
+	d.Set("public_ip_prefix_id", publicIpPrefixId)
+	d.Set("subnet_id", subnetId)
+
+	if err := d.Set("zones", zones.FlattenUntyped(config.Zones)); err != nil {
+		return fmt.Errorf("setting `zones`: %+v", err)
+	}
+
+	return nil
+}
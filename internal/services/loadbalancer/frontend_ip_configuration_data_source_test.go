@@ -0,0 +1,66 @@
+package loadbalancer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type LoadBalancerFrontendIpConfigurationDataSource struct{}
+
+func TestAccAzureRMDataSourceLoadBalancerFrontendIpConfiguration_gateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfigurationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.gateway(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").MatchesOtherKey(check.That("azurerm_lb.test").Key("frontend_ip_configuration.0.id")),
+				check.That(data.ResourceName).Key("load_balancer_sku").HasValue("Gateway"),
+				check.That(data.ResourceName).Key("subnet_id").Exists(),
+				check.That(data.ResourceName).Key("private_ip_address").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccAzureRMDataSourceLoadBalancerFrontendIpConfiguration_chained(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_lb_frontend_ip_configuration", "test")
+	r := LoadBalancerFrontendIpConfigurationDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.chained(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("load_balancer_sku").HasValue("Standard"),
+				check.That(data.ResourceName).Key("public_ip_address_id").Exists(),
+				check.That(data.ResourceName).Key("gateway_load_balancer_frontend_ip_configuration_id").MatchesOtherKey(check.That("azurerm_lb.test").Key("frontend_ip_configuration.0.id")),
+			),
+		},
+	})
+}
+
+func (LoadBalancerFrontendIpConfigurationDataSource) gateway(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_lb_frontend_ip_configuration" "test" {
+  name            = "feip"
+  loadbalancer_id = azurerm_lb.test.id
+}
+`, LoadBalancer{}.pointToGatewayLB(data))
+}
+
+func (LoadBalancerFrontendIpConfigurationDataSource) chained(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_lb_frontend_ip_configuration" "test" {
+  name            = "gateway"
+  loadbalancer_id = azurerm_lb.consumer.id
+}
+`, LoadBalancer{}.pointToGatewayLB(data))
+}
@@ -314,6 +314,9 @@ func flattenLoadBalancerFrontendIpConfiguration(ipConfigs *[]network.FrontendIPC
 
 			if props.GatewayLoadBalancer != nil && props.GatewayLoadBalancer.ID != nil {
 				gatewayLoadBalancerId = *props.GatewayLoadBalancer.ID
+				if parsed, err := parse.LoadBalancerFrontendIpConfigurationIDInsensitively(gatewayLoadBalancerId); err == nil {
+					gatewayLoadBalancerId = parsed.ID()
+				}
 			}
 
 			if subnet := props.Subnet; subnet != nil {
@@ -482,10 +485,11 @@ func resourceArmLoadBalancerSchema() map[string]*pluginsdk.Schema {
 					},
 
 					"gateway_load_balancer_frontend_ip_configuration_id": {
-						Type:         pluginsdk.TypeString,
-						Optional:     true,
-						Computed:     true,
-						ValidateFunc: validate.LoadBalancerFrontendIpConfigurationID,
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						Computed:         true,
+						ValidateFunc:     validate.GatewayLoadBalancerFrontendIpConfigurationID,
+						DiffSuppressFunc: suppress.CaseDifference,
 					},
 
 					"load_balancer_rules": {
@@ -36,10 +36,11 @@ func (r Registration) DataSources() []sdk.DataSource {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_lb":                      dataSourceArmLoadBalancer(),
-		"azurerm_lb_backend_address_pool": dataSourceArmLoadBalancerBackendAddressPool(),
-		"azurerm_lb_rule":                 dataSourceArmLoadBalancerRule(),
-		"azurerm_lb_outbound_rule":        dataSourceArmLoadBalancerOutboundRule(),
+		"azurerm_lb":                           dataSourceArmLoadBalancer(),
+		"azurerm_lb_backend_address_pool":      dataSourceArmLoadBalancerBackendAddressPool(),
+		"azurerm_lb_frontend_ip_configuration": dataSourceArmLoadBalancerFrontendIpConfiguration(),
+		"azurerm_lb_rule":                      dataSourceArmLoadBalancerRule(),
+		"azurerm_lb_outbound_rule":             dataSourceArmLoadBalancerOutboundRule(),
 	}
 }
 
@@ -0,0 +1,24 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+)
+
+// GatewayLoadBalancerFrontendIpConfigurationID validates the ID of a Frontend IP Configuration on a Gateway Load Balancer.
+// The Gateway Load Balancer can live in another Subscription, where IDs returned by the API (e.g. from a data source
+// using an aliased provider) aren't guaranteed to use the canonical casing, so the ID is parsed insensitively.
+func GatewayLoadBalancerFrontendIpConfigurationID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := parse.LoadBalancerFrontendIpConfigurationIDInsensitively(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,126 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+)
+
+// NOTE: the `Auxiliary` plan and a `totalRetentionInDays` of up to 12 years aren't available in the 2022-10-01 API
+// used by the vendored SDK, as such Tables are retrieved and updated here against the 2023-01-01-preview API (using
+// the models from the vendored SDK) until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const tablesApiVersion = "2023-01-01-preview"
+
+const TablePlanEnumAuxiliary tables.TablePlanEnum = "Auxiliary"
+
+type TablesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTablesClientWithBaseURI(endpoint string) TablesClient {
+	return TablesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/tables/%s", tablesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type TableGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *tables.Table
+}
+
+type TableUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Get ...
+func (c TablesClient) Get(ctx context.Context, id tables.TableId) (result TableGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Update ...
+func (c TablesClient) Update(ctx context.Context, id tables.TableId, input tables.Table) (result TableUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPatch(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "Update", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tables.TablesClient", "Update", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// UpdateThenPoll performs Update then polls until it's completed
+func (c TablesClient) UpdateThenPoll(ctx context.Context, id tables.TableId, input tables.Table) error {
+	result, err := c.Update(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Update: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Update: %+v", err)
+	}
+
+	return nil
+}
+
+func (c TablesClient) prepare(ctx context.Context, id tables.TableId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": tablesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -14,6 +14,7 @@ import (
 	featureWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
 )
 
 type Client struct {
@@ -26,6 +27,7 @@ type Client struct {
 	SavedSearchesClient        *savedsearches.SavedSearchesClient
 	SolutionsClient            *solution.SolutionClient
 	StorageInsightsClient      *storageinsights.StorageInsightsClient
+	TablesClient               *azuresdkhacks.TablesClient
 	QueryPackQueriesClient     *querypackqueries.QueryPackQueriesClient
 	SharedKeyWorkspacesClient  *workspaces.WorkspacesClient
 	WorkspaceClient            *featureWorkspaces.WorkspacesClient // 2022-10-01 API version does not contain sharedkeys related API, so we keep two versions SDK of this API
@@ -62,6 +64,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	LinkedStorageAccountClient := linkedstorageaccounts.NewLinkedStorageAccountsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&LinkedStorageAccountClient.Client, o.ResourceManagerAuthorizer)
 
+	TablesClient := azuresdkhacks.NewTablesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&TablesClient.Client, o.ResourceManagerAuthorizer)
+
 	QueryPacksClient := querypacks.NewQueryPacksClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&QueryPacksClient.Client, o.ResourceManagerAuthorizer)
 
@@ -79,6 +84,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		SavedSearchesClient:        &SavedSearchesClient,
 		SolutionsClient:            &SolutionsClient,
 		StorageInsightsClient:      &StorageInsightsClient,
+		TablesClient:               &TablesClient,
 		SharedKeyWorkspacesClient:  &WorkspacesClient,
 		WorkspaceClient:            &featureWorkspaceClient,
 	}
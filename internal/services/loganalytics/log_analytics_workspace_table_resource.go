@@ -0,0 +1,298 @@
+package loganalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	// the interactive retention of a Table using the `Basic` or `Auxiliary` plan is fixed
+	logAnalyticsWorkspaceTableBasicRetentionInDays     = 8
+	logAnalyticsWorkspaceTableAuxiliaryRetentionInDays = 30
+
+	// a Table can be retained for up to 12 years in total
+	logAnalyticsWorkspaceTableMaxTotalRetentionInDays = 4383
+)
+
+type LogAnalyticsWorkspaceTableModel struct {
+	Name                 string `tfschema:"name"`
+	WorkspaceId          string `tfschema:"workspace_id"`
+	Plan                 string `tfschema:"plan"`
+	RetentionInDays      int64  `tfschema:"retention_in_days"`
+	TotalRetentionInDays int64  `tfschema:"total_retention_in_days"`
+}
+
+type LogAnalyticsWorkspaceTableResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTableResource{}
+	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
+)
+
+func (r LogAnalyticsWorkspaceTableResource) ResourceType() string {
+	return "azurerm_log_analytics_workspace_table"
+}
+
+func (r LogAnalyticsWorkspaceTableResource) ModelObject() interface{} {
+	return &LogAnalyticsWorkspaceTableModel{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return tables.ValidateTableID
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: tables.ValidateWorkspaceID,
+		},
+
+		"plan": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(tables.TablePlanEnumAnalytics),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(tables.TablePlanEnumAnalytics),
+				string(tables.TablePlanEnumBasic),
+				string(azuresdkhacks.TablePlanEnumAuxiliary),
+			}, false),
+		},
+
+		"retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(4, 730),
+		},
+
+		"total_retention_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(4, logAnalyticsWorkspaceTableMaxTotalRetentionInDays),
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			config := metadata.ResourceDiff.GetRawConfig()
+			if config.IsNull() || !config.IsKnown() {
+				return nil
+			}
+
+			values := config.AsValueMap()
+			plan := metadata.ResourceDiff.Get("plan").(string)
+			return validateLogAnalyticsWorkspaceTablePlan(plan, configuredInt(values["retention_in_days"]), configuredInt(values["total_retention_in_days"]))
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := tables.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			// Tables are created by the Log Analytics Workspace (or a Data Collection Rule) rather than by this resource,
+			// which manages the plan and retention of an existing Table
+			id := tables.NewTableID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - the Table must exist within the Workspace before its plan and retention can be managed", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := client.UpdateThenPoll(ctx, id, expandLogAnalyticsWorkspaceTable(metadata, model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LogAnalyticsWorkspaceTableModel{
+				Name:        id.TableName,
+				WorkspaceId: tables.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Plan = string(pointer.From(props.Plan))
+					state.RetentionInDays = pointer.From(props.RetentionInDays)
+					state.TotalRetentionInDays = pointer.From(props.TotalRetentionInDays)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, expandLogAnalyticsWorkspaceTable(metadata, model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LogAnalytics.TablesClient
+
+			id, err := tables.ParseTableID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LogAnalyticsWorkspaceTableModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Table itself can't be deleted, so instead the retention is reset to the Workspace defaults (which is
+			// represented by `-1`) - the interactive retention can only be reset for Tables using the `Analytics` plan
+			props := tables.TableProperties{
+				TotalRetentionInDays: pointer.To(int64(-1)),
+			}
+			if model.Plan == string(tables.TablePlanEnumAnalytics) {
+				props.RetentionInDays = pointer.To(int64(-1))
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, tables.Table{Properties: &props}); err != nil {
+				return fmt.Errorf("resetting the retention for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLogAnalyticsWorkspaceTable(metadata sdk.ResourceMetaData, input LogAnalyticsWorkspaceTableModel) tables.Table {
+	props := tables.TableProperties{
+		Plan: pointer.To(tables.TablePlanEnum(input.Plan)),
+	}
+
+	// the interactive retention is fixed for the `Basic` and `Auxiliary` plans, so it's only sent for `Analytics`
+	if input.Plan == string(tables.TablePlanEnumAnalytics) && input.RetentionInDays != 0 && metadata.ResourceData.HasChange("retention_in_days") {
+		props.RetentionInDays = pointer.To(input.RetentionInDays)
+	}
+
+	if input.TotalRetentionInDays != 0 && metadata.ResourceData.HasChange("total_retention_in_days") {
+		props.TotalRetentionInDays = pointer.To(input.TotalRetentionInDays)
+	}
+
+	return tables.Table{
+		Properties: &props,
+	}
+}
+
+// validateLogAnalyticsWorkspaceTablePlan validates the retention configured for a Table against the restrictions of
+// its plan, `retentionInDays` and `totalRetentionInDays` are nil when they're not set in the configuration
+func validateLogAnalyticsWorkspaceTablePlan(plan string, retentionInDays *int64, totalRetentionInDays *int64) error {
+	switch plan {
+	case string(tables.TablePlanEnumBasic), string(azuresdkhacks.TablePlanEnumAuxiliary):
+		if retentionInDays != nil {
+			return fmt.Errorf("`retention_in_days` can only be set when `plan` is `Analytics` - the interactive retention for the `Basic` plan is fixed at %d days and for the `Auxiliary` plan at %d days", logAnalyticsWorkspaceTableBasicRetentionInDays, logAnalyticsWorkspaceTableAuxiliaryRetentionInDays)
+		}
+
+		if plan == string(azuresdkhacks.TablePlanEnumAuxiliary) && totalRetentionInDays != nil && *totalRetentionInDays < logAnalyticsWorkspaceTableAuxiliaryRetentionInDays {
+			return fmt.Errorf("`total_retention_in_days` must be at least %d when `plan` is `Auxiliary`", logAnalyticsWorkspaceTableAuxiliaryRetentionInDays)
+		}
+
+	case string(tables.TablePlanEnumAnalytics):
+		if retentionInDays != nil && totalRetentionInDays != nil && *totalRetentionInDays < *retentionInDays {
+			return fmt.Errorf("`total_retention_in_days` (%d) must be greater than or equal to `retention_in_days` (%d)", *totalRetentionInDays, *retentionInDays)
+		}
+	}
+
+	return nil
+}
+
+func configuredInt(input cty.Value) *int64 {
+	if input.IsNull() || !input.IsKnown() || !input.Type().Equals(cty.Number) {
+		return nil
+	}
+
+	v, _ := input.AsBigFloat().Int64()
+	return pointer.To(v)
+}
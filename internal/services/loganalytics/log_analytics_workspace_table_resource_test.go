@@ -0,0 +1,152 @@
+package loganalytics_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LogAnalyticsWorkspaceTableResource struct{}
+
+func TestAccLogAnalyticsWorkspaceTable_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.analytics(data, 30, 365),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan").HasValue("Analytics"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.analytics(data, 30, 365),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.analytics(data, 90, 4383),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("total_retention_in_days").HasValue("4383"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.plan(data, "Basic", 365),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan").HasValue("Basic"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogAnalyticsWorkspaceTable_retentionWithBasicPlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_workspace_table", "test")
+	r := LogAnalyticsWorkspaceTableResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.retentionWithBasicPlan(data),
+			ExpectError: regexp.MustCompile("`retention_in_days` can only be set when `plan` is `Analytics`"),
+		},
+	})
+}
+
+func (r LogAnalyticsWorkspaceTableResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := tables.ParseTableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LogAnalytics.TablesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LogAnalyticsWorkspaceTableResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-law-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r LogAnalyticsWorkspaceTableResource) analytics(data acceptance.TestData, retentionInDays, totalRetentionInDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "AppTraces"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  plan                    = "Analytics"
+  retention_in_days       = %d
+  total_retention_in_days = %d
+}
+`, r.template(data), retentionInDays, totalRetentionInDays)
+}
+
+func (r LogAnalyticsWorkspaceTableResource) plan(data acceptance.TestData, plan string, totalRetentionInDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name                    = "AppTraces"
+  workspace_id            = azurerm_log_analytics_workspace.test.id
+  plan                    = "%s"
+  total_retention_in_days = %d
+}
+`, r.template(data), plan, totalRetentionInDays)
+}
+
+func (r LogAnalyticsWorkspaceTableResource) retentionWithBasicPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace_table" "test" {
+  name              = "AppTraces"
+  workspace_id      = azurerm_log_analytics_workspace.test.id
+  plan              = "Basic"
+  retention_in_days = 30
+}
+`, r.template(data))
+}
@@ -0,0 +1,181 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/configurationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
+	parseNetwork "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	validateNetwork "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmMaintenanceAssignmentVirtualNetworkGateway() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmMaintenanceAssignmentVirtualNetworkGatewayCreate,
+		Read:   resourceArmMaintenanceAssignmentVirtualNetworkGatewayRead,
+		Delete: resourceArmMaintenanceAssignmentVirtualNetworkGatewayDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.MaintenanceAssignmentVirtualNetworkGatewayID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"maintenance_configuration_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     maintenanceconfigurations.ValidateMaintenanceConfigurationID,
+				DiffSuppressFunc: suppress.CaseDifference, // TODO remove in 4.0 with a work around or when https://github.com/Azure/azure-rest-api-specs/issues/8653 is fixed
+			},
+
+			"virtual_network_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNetwork.VirtualNetworkGatewayID,
+			},
+		},
+	}
+}
+
+func resourceArmMaintenanceAssignmentVirtualNetworkGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualNetworkGatewayId, err := parseNetwork.VirtualNetworkGatewayID(d.Get("virtual_network_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	maintenanceConfigurationID, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(d.Get("maintenance_configuration_id").(string))
+	if err != nil {
+		return err
+	}
+
+	configAssignmentId := configurationassignments.NewConfigurationAssignmentID(virtualNetworkGatewayId.SubscriptionId, virtualNetworkGatewayId.ResourceGroup, "Microsoft.Network", "virtualNetworkGateways", virtualNetworkGatewayId.Name, maintenanceConfigurationID.MaintenanceConfigurationName)
+
+	existingList, err := getMaintenanceAssignmentVirtualNetworkGateway(ctx, client, virtualNetworkGatewayId)
+	if err != nil {
+		return err
+	}
+	if existingList != nil && len(*existingList) > 0 {
+		existing := (*existingList)[0]
+		if existing.Id != nil && *existing.Id != "" {
+			return tf.ImportAsExistsError("azurerm_maintenance_assignment_virtual_network_gateway", configAssignmentId.ID())
+		}
+	}
+
+	configurationAssignment := configurationassignments.ConfigurationAssignment{
+		Name:     utils.String(maintenanceConfigurationID.MaintenanceConfigurationName),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &configurationassignments.ConfigurationAssignmentProperties{
+			MaintenanceConfigurationId: utils.String(maintenanceConfigurationID.ID()),
+			ResourceId:                 utils.String(virtualNetworkGatewayId.ID()),
+		},
+	}
+
+	_, err = client.CreateOrUpdate(ctx, configAssignmentId, configurationAssignment)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(configAssignmentId.ID())
+	return resourceArmMaintenanceAssignmentVirtualNetworkGatewayRead(d, meta)
+}
+
+func resourceArmMaintenanceAssignmentVirtualNetworkGatewayRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MaintenanceAssignmentVirtualNetworkGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := getMaintenanceAssignmentVirtualNetworkGateway(ctx, client, id.VirtualNetworkGatewayId)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(*resp) == 0 {
+		d.SetId("")
+		return nil
+	}
+	assignment := (*resp)[0]
+	if assignment.Id == nil || *assignment.Id == "" {
+		return fmt.Errorf("empty or nil ID of Maintenance Assignment (virtual network gateway ID: %q)", id.VirtualNetworkGatewayIdRaw)
+	}
+
+	// in list api, `ResourceID` returned is always nil
+	virtualNetworkGatewayId := ""
+	if id.VirtualNetworkGatewayId != nil {
+		virtualNetworkGatewayId = id.VirtualNetworkGatewayId.ID()
+	}
+	d.Set("virtual_network_gateway_id", virtualNetworkGatewayId)
+	if props := assignment.Properties; props != nil {
+		maintenanceConfigurationId := ""
+		if props.MaintenanceConfigurationId != nil {
+			parsedId, err := maintenanceconfigurations.ParseMaintenanceConfigurationIDInsensitively(*props.MaintenanceConfigurationId)
+			if err != nil {
+				return fmt.Errorf("parsing %q: %+v", *props.MaintenanceConfigurationId, err)
+			}
+			maintenanceConfigurationId = parsedId.ID()
+		}
+		d.Set("maintenance_configuration_id", maintenanceConfigurationId)
+	}
+	return nil
+}
+
+func resourceArmMaintenanceAssignmentVirtualNetworkGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Maintenance.ConfigurationAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	maintenanceAssignmentVirtualNetworkGatewayId, err := parse.MaintenanceAssignmentVirtualNetworkGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id := configurationassignments.NewConfigurationAssignmentID(maintenanceAssignmentVirtualNetworkGatewayId.VirtualNetworkGatewayId.SubscriptionId, maintenanceAssignmentVirtualNetworkGatewayId.VirtualNetworkGatewayId.ResourceGroup, "Microsoft.Network", "virtualNetworkGateways", maintenanceAssignmentVirtualNetworkGatewayId.VirtualNetworkGatewayId.Name, maintenanceAssignmentVirtualNetworkGatewayId.Name)
+
+	if _, err := client.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting Maintenance Assignment to resource %q: %+v", maintenanceAssignmentVirtualNetworkGatewayId.VirtualNetworkGatewayIdRaw, err)
+	}
+
+	return nil
+}
+
+func getMaintenanceAssignmentVirtualNetworkGateway(ctx context.Context, client *configurationassignments.ConfigurationAssignmentsClient, virtualNetworkGatewayId *parseNetwork.VirtualNetworkGatewayId) (result *[]configurationassignments.ConfigurationAssignment, err error) {
+	id := configurationassignments.NewProviderID(virtualNetworkGatewayId.SubscriptionId, virtualNetworkGatewayId.ResourceGroup, "Microsoft.Network", "virtualNetworkGateways", virtualNetworkGatewayId.Name)
+
+	resp, err := client.List(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			err = fmt.Errorf("checking for presence of existing Maintenance assignment (virtual network gateway ID: %q): %+v", virtualNetworkGatewayId.ID(), err)
+			return
+		}
+	}
+	return resp.Model.Value, nil
+}
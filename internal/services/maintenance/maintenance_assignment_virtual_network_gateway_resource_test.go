@@ -0,0 +1,154 @@
+package maintenance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/configurationassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MaintenanceAssignmentVirtualNetworkGatewayResource struct{}
+
+func TestAccMaintenanceAssignmentVirtualNetworkGateway_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_virtual_network_gateway", "test")
+	r := MaintenanceAssignmentVirtualNetworkGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// location not returned by list rest api
+		data.ImportStep("location"),
+	})
+}
+
+func TestAccMaintenanceAssignmentVirtualNetworkGateway_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_maintenance_assignment_virtual_network_gateway", "test")
+	r := MaintenanceAssignmentVirtualNetworkGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (MaintenanceAssignmentVirtualNetworkGatewayResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	maVirtualNetworkGatewayID, err := parse.MaintenanceAssignmentVirtualNetworkGatewayID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := configurationassignments.NewProviderID(maVirtualNetworkGatewayID.VirtualNetworkGatewayId.SubscriptionId, maVirtualNetworkGatewayID.VirtualNetworkGatewayId.ResourceGroup, "Microsoft.Network", "virtualNetworkGateways", maVirtualNetworkGatewayID.VirtualNetworkGatewayId.Name)
+
+	resp, err := clients.Maintenance.ConfigurationAssignmentsClient.List(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Maintenance Assignment Virtual Network Gateway (target resource id: %q): %v", maVirtualNetworkGatewayID.VirtualNetworkGatewayIdRaw, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Value != nil && len(*resp.Model.Value) != 0), nil
+}
+
+func (r MaintenanceAssignmentVirtualNetworkGatewayResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maintenance_assignment_virtual_network_gateway" "test" {
+  location                     = azurerm_resource_group.test.location
+  maintenance_configuration_id = azurerm_maintenance_configuration.test.id
+  virtual_network_gateway_id   = azurerm_virtual_network_gateway.test.id
+}
+`, r.template(data))
+}
+
+func (r MaintenanceAssignmentVirtualNetworkGatewayResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_maintenance_assignment_virtual_network_gateway" "import" {
+  location                     = azurerm_maintenance_assignment_virtual_network_gateway.test.location
+  maintenance_configuration_id = azurerm_maintenance_assignment_virtual_network_gateway.test.maintenance_configuration_id
+  virtual_network_gateway_id   = azurerm_maintenance_assignment_virtual_network_gateway.test.virtual_network_gateway_id
+}
+`, r.basic(data))
+}
+
+func (MaintenanceAssignmentVirtualNetworkGatewayResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_maintenance_configuration" "test" {
+  name                = "acctest-MC%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scope               = "Resource"
+  visibility          = "Custom"
+
+  window {
+    start_date_time      = "5555-12-31 00:00"
+    expiration_date_time = "6666-12-31 00:00"
+    duration             = "06:00"
+    time_zone            = "Pacific Standard Time"
+    recur_every          = "1Days"
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Vpn"
+  vpn_type            = "RouteBased"
+  sku                 = "VpnGw1"
+
+  ip_configuration {
+    public_ip_address_id          = azurerm_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurerm_subnet.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
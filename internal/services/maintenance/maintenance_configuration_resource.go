@@ -67,6 +67,7 @@ func resourceArmMaintenanceConfiguration() *pluginsdk.Resource {
 					string(maintenanceconfigurations.MaintenanceScopeHost),
 					string(maintenanceconfigurations.MaintenanceScopeInGuestPatch),
 					string(maintenanceconfigurations.MaintenanceScopeOSImage),
+					string(maintenanceconfigurations.MaintenanceScopeResource),
 					string(maintenanceconfigurations.MaintenanceScopeSQLDB),
 					string(maintenanceconfigurations.MaintenanceScopeSQLManagedInstance),
 				}, false),
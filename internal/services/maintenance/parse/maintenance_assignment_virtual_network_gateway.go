@@ -0,0 +1,33 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+
+	parseNetwork "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type MaintenanceAssignmentVirtualNetworkGatewayId struct {
+	VirtualNetworkGatewayId    *parseNetwork.VirtualNetworkGatewayId
+	VirtualNetworkGatewayIdRaw string
+	Name                       string
+}
+
+func MaintenanceAssignmentVirtualNetworkGatewayID(input string) (*MaintenanceAssignmentVirtualNetworkGatewayId, error) {
+	groups := regexp.MustCompile(`^(.+)/providers/Microsoft\.Maintenance/configurationAssignments/([^/]+)$`).FindStringSubmatch(input)
+	if len(groups) != 3 {
+		return nil, fmt.Errorf("parsing Maintenance Assignment Virtual Network Gateway ID (%q)", input)
+	}
+
+	targetResourceId, name := groups[1], groups[2]
+	virtualNetworkGatewayId, err := parseNetwork.VirtualNetworkGatewayID(targetResourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Maintenance Assignment Virtual Network Gateway ID: %q: Expected valid virtual network gateway ID", input)
+	}
+
+	return &MaintenanceAssignmentVirtualNetworkGatewayId{
+		VirtualNetworkGatewayId:    virtualNetworkGatewayId,
+		VirtualNetworkGatewayIdRaw: targetResourceId,
+		Name:                       name,
+	}, nil
+}
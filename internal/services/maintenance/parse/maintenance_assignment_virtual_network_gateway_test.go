@@ -0,0 +1,105 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+
+	parseNetwork "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+func TestMaintenanceAssignmentVirtualNetworkGatewayID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *MaintenanceAssignmentVirtualNetworkGatewayId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "No Resource Groups Segment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+		{
+			Name:  "No Resource Groups Value",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/",
+			Error: true,
+		},
+		{
+			Name:  "No target resource type",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/",
+			Error: true,
+		},
+		{
+			Name:  "No target resource name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/",
+			Error: true,
+		},
+		{
+			Name:  "No Maintenance Assignment Segment",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/providers/Microsoft.Maintenance/",
+			Error: true,
+		},
+		{
+			Name:  "No Maintenance Assignment name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/providers/Microsoft.Maintenance/configurationAssignments/",
+			Error: true,
+		},
+		{
+			Name:  "Target resource is not a Virtual Network Gateway",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Maintenance/configurationAssignments/assign1",
+			Error: true,
+		},
+		{
+			Name:  "ID of Maintenance Assignment to Virtual Network Gateway",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/providers/Microsoft.Maintenance/configurationAssignments/assign1",
+			Error: false,
+			Expect: &MaintenanceAssignmentVirtualNetworkGatewayId{
+				VirtualNetworkGatewayIdRaw: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1",
+				VirtualNetworkGatewayId: &parseNetwork.VirtualNetworkGatewayId{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "resGroup1",
+					Name:           "gw1",
+				},
+				Name: "assign1",
+			},
+		},
+		{
+			Name:  "Wrong Casing",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/providers/Microsoft.Maintenance/ConfigurationAssignments/assign1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := MaintenanceAssignmentVirtualNetworkGatewayID(v.Input)
+		if err != nil {
+			if v.Expect == nil {
+				continue
+			}
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Name != v.Expect.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expect.Name, actual.Name)
+		}
+
+		if actual.VirtualNetworkGatewayIdRaw != v.Expect.VirtualNetworkGatewayIdRaw {
+			t.Fatalf("Expected %q but got %q for VirtualNetworkGatewayIdRaw", v.Expect.VirtualNetworkGatewayIdRaw, actual.VirtualNetworkGatewayIdRaw)
+		}
+
+		if !reflect.DeepEqual(v.Expect.VirtualNetworkGatewayId, actual.VirtualNetworkGatewayId) {
+			t.Fatalf("Expected %+v but got %+v", v.Expect.VirtualNetworkGatewayId, actual.VirtualNetworkGatewayId)
+		}
+	}
+}
@@ -35,6 +35,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_maintenance_assignment_dedicated_host":            resourceArmMaintenanceAssignmentDedicatedHost(),
 		"azurerm_maintenance_assignment_virtual_machine":           resourceArmMaintenanceAssignmentVirtualMachine(),
 		"azurerm_maintenance_assignment_virtual_machine_scale_set": resourceArmMaintenanceAssignmentVirtualMachineScaleSet(),
+		"azurerm_maintenance_assignment_virtual_network_gateway":   resourceArmMaintenanceAssignmentVirtualNetworkGateway(),
 		"azurerm_maintenance_configuration":                        resourceArmMaintenanceConfiguration(),
 	}
 }
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/parse"
+)
+
+// the Notification Policy, Deployment Policy and Storage Account of an Application Definition are only exposed in
+// newer API Versions than the one used for Managed Applications, as such Application Definitions are managed using
+// a separate client until the SDK can be upgraded
+const applicationDefinitionsApiVersion = "2021-07-01"
+
+type ApplicationDefinitionsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewApplicationDefinitionsClientWithBaseURI(api environments.Api) (*ApplicationDefinitionsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "applicationdefinitions", applicationDefinitionsApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ApplicationDefinitionsClient: %+v", err)
+	}
+
+	return &ApplicationDefinitionsClient{
+		Client: client,
+	}, nil
+}
+
+type ApplicationDefinition struct {
+	Id         *string                          `json:"id,omitempty"`
+	Location   *string                          `json:"location,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *ApplicationDefinitionProperties `json:"properties,omitempty"`
+	Tags       *map[string]string               `json:"tags,omitempty"`
+}
+
+type ApplicationDefinitionProperties struct {
+	Authorizations     *[]ApplicationAuthorization    `json:"authorizations,omitempty"`
+	CreateUiDefinition interface{}                    `json:"createUiDefinition,omitempty"`
+	DeploymentPolicy   *ApplicationDeploymentPolicy   `json:"deploymentPolicy,omitempty"`
+	Description        *string                        `json:"description,omitempty"`
+	DisplayName        *string                        `json:"displayName,omitempty"`
+	IsEnabled          *bool                          `json:"isEnabled,omitempty"`
+	LockLevel          string                         `json:"lockLevel"`
+	MainTemplate       interface{}                    `json:"mainTemplate,omitempty"`
+	NotificationPolicy *ApplicationNotificationPolicy `json:"notificationPolicy,omitempty"`
+	PackageFileUri     *string                        `json:"packageFileUri,omitempty"`
+	StorageAccountId   *string                        `json:"storageAccountId,omitempty"`
+}
+
+type ApplicationAuthorization struct {
+	PrincipalId      string `json:"principalId"`
+	RoleDefinitionId string `json:"roleDefinitionId"`
+}
+
+type ApplicationDeploymentPolicy struct {
+	DeploymentMode string `json:"deploymentMode"`
+}
+
+type ApplicationNotificationPolicy struct {
+	NotificationEndpoints []ApplicationNotificationEndpoint `json:"notificationEndpoints"`
+}
+
+type ApplicationNotificationEndpoint struct {
+	Uri string `json:"uri"`
+}
+
+type ApplicationDefinitionsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ApplicationDefinition
+}
+
+// Get retrieves the specified Application Definition
+func (c ApplicationDefinitionsClient) Get(ctx context.Context, id parse.ApplicationDefinitionId) (result ApplicationDefinitionsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdate creates or replaces the specified Application Definition, which completes synchronously
+func (c ApplicationDefinitionsClient) CreateOrUpdate(ctx context.Context, id parse.ApplicationDefinitionId, input ApplicationDefinition) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	if _, err = req.Execute(ctx); err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the specified Application Definition, which completes synchronously
+func (c ApplicationDefinitionsClient) Delete(ctx context.Context, id parse.ApplicationDefinitionId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if _, err = req.Execute(ctx); err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	return nil
+}
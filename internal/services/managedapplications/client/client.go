@@ -1,6 +1,8 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/managedapplications" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -8,17 +10,28 @@ import (
 type Client struct {
 	ApplicationClient           *managedapplications.ApplicationsClient
 	ApplicationDefinitionClient *managedapplications.ApplicationDefinitionsClient
+
+	// ApplicationDefinitionsClient uses a newer API Version than ApplicationDefinitionClient and is used to manage
+	// the `azurerm_managed_application_definition` resource
+	ApplicationDefinitionsClient *ApplicationDefinitionsClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	applicationClient := managedapplications.NewApplicationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&applicationClient.Client, o.ResourceManagerAuthorizer)
 
 	applicationDefinitionClient := managedapplications.NewApplicationDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&applicationDefinitionClient.Client, o.ResourceManagerAuthorizer)
 
-	return &Client{
-		ApplicationClient:           &applicationClient,
-		ApplicationDefinitionClient: &applicationDefinitionClient,
+	applicationDefinitionsClient, err := NewApplicationDefinitionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ApplicationDefinitions client: %+v", err)
 	}
+	o.Configure(applicationDefinitionsClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		ApplicationClient:            &applicationClient,
+		ApplicationDefinitionClient:  &applicationDefinitionClient,
+		ApplicationDefinitionsClient: applicationDefinitionsClient,
+	}, nil
 }
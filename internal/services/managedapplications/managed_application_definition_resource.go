@@ -3,16 +3,21 @@ package managedapplications
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-07-01/managedapplications" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	managedApplicationsClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -122,13 +127,37 @@ func resourceManagedApplicationDefinition() *pluginsdk.Resource {
 				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
 			},
 
+			"deployment_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Complete",
+					"Incremental",
+				}, false),
+			},
+
+			"notification_endpoints": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
 func resourceManagedApplicationDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -136,51 +165,59 @@ func resourceManagedApplicationDefinitionCreateUpdate(d *pluginsdk.ResourceData,
 	id := parse.NewApplicationDefinitionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("failed to check for presence of existing %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_managed_application_definition", id.ID())
 		}
 	}
 
-	parameters := managedapplications.ApplicationDefinition{
+	parameters := managedApplicationsClient.ApplicationDefinition{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location"))),
-		ApplicationDefinitionProperties: &managedapplications.ApplicationDefinitionProperties{
-			Authorizations: expandManagedApplicationDefinitionAuthorization(d.Get("authorization").(*pluginsdk.Set).List()),
-			Description:    utils.String(d.Get("description").(string)),
-			DisplayName:    utils.String(d.Get("display_name").(string)),
-			IsEnabled:      utils.Bool(d.Get("package_enabled").(bool)),
-			LockLevel:      managedapplications.ApplicationLockLevel(d.Get("lock_level").(string)),
+		Properties: &managedApplicationsClient.ApplicationDefinitionProperties{
+			Authorizations:     expandManagedApplicationDefinitionAuthorization(d.Get("authorization").(*pluginsdk.Set).List()),
+			Description:        utils.String(d.Get("description").(string)),
+			DisplayName:        utils.String(d.Get("display_name").(string)),
+			IsEnabled:          utils.Bool(d.Get("package_enabled").(bool)),
+			LockLevel:          d.Get("lock_level").(string),
+			NotificationPolicy: expandManagedApplicationDefinitionNotificationPolicy(d.Get("notification_endpoints").(*pluginsdk.Set).List()),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: pointer.To(tags.ToTypedObject(tags.Expand(d.Get("tags").(map[string]interface{})))),
 	}
 
 	if v, ok := d.GetOk("create_ui_definition"); ok {
-		parameters.CreateUIDefinition = utils.String(v.(string))
+		parameters.Properties.CreateUiDefinition = v.(string)
 	}
 
 	if v, ok := d.GetOk("main_template"); ok {
-		parameters.MainTemplate = utils.String(v.(string))
+		parameters.Properties.MainTemplate = v.(string)
 	}
 
-	if (parameters.CreateUIDefinition != nil && parameters.MainTemplate == nil) || (parameters.CreateUIDefinition == nil && parameters.MainTemplate != nil) {
+	if (parameters.Properties.CreateUiDefinition != nil && parameters.Properties.MainTemplate == nil) || (parameters.Properties.CreateUiDefinition == nil && parameters.Properties.MainTemplate != nil) {
 		return fmt.Errorf("if either `create_ui_definition` or `main_template` is set the other one must be too")
 	}
 
+	// the package is replaced in-place when `package_file_uri` is updated, since the whole definition is sent
 	if v, ok := d.GetOk("package_file_uri"); ok {
-		parameters.PackageFileURI = utils.String(v.(string))
+		parameters.Properties.PackageFileUri = utils.String(v.(string))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
-	if err != nil {
-		return fmt.Errorf("failed to create %s: %+v", id, err)
+	if v, ok := d.GetOk("deployment_mode"); ok {
+		parameters.Properties.DeploymentPolicy = &managedApplicationsClient.ApplicationDeploymentPolicy{
+			DeploymentMode: v.(string),
+		}
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("failed to wait for creation of %s: %+v", id, err)
+
+	if v, ok := d.GetOk("storage_account_id"); ok {
+		parameters.Properties.StorageAccountId = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("failed to create %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
@@ -189,7 +226,7 @@ func resourceManagedApplicationDefinitionCreateUpdate(d *pluginsdk.ResourceData,
 }
 
 func resourceManagedApplicationDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -198,9 +235,9 @@ func resourceManagedApplicationDefinitionRead(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Managed Application Definition %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -210,16 +247,39 @@ func resourceManagedApplicationDefinitionRead(d *pluginsdk.ResourceData, meta in
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if props := resp.ApplicationDefinitionProperties; props != nil {
-		if err := d.Set("authorization", flattenManagedApplicationDefinitionAuthorization(props.Authorizations)); err != nil {
-			return fmt.Errorf("setting `authorization`: %+v", err)
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("authorization", flattenManagedApplicationDefinitionAuthorization(props.Authorizations)); err != nil {
+				return fmt.Errorf("setting `authorization`: %+v", err)
+			}
+			d.Set("description", props.Description)
+			d.Set("display_name", props.DisplayName)
+			d.Set("package_enabled", props.IsEnabled)
+			d.Set("lock_level", props.LockLevel)
+
+			deploymentMode := ""
+			if props.DeploymentPolicy != nil && !strings.EqualFold(props.DeploymentPolicy.DeploymentMode, "NotSpecified") {
+				deploymentMode = props.DeploymentPolicy.DeploymentMode
+			}
+			d.Set("deployment_mode", deploymentMode)
+
+			if err := d.Set("notification_endpoints", flattenManagedApplicationDefinitionNotificationPolicy(props.NotificationPolicy)); err != nil {
+				return fmt.Errorf("setting `notification_endpoints`: %+v", err)
+			}
+
+			storageAccountId := ""
+			if props.StorageAccountId != nil {
+				storageAccountId = *props.StorageAccountId
+			}
+			d.Set("storage_account_id", storageAccountId)
+		}
+
+		if err := tags.FlattenAndSet(d, tags.FromTypedObject(pointer.From(model.Tags))); err != nil {
+			return err
 		}
-		d.Set("description", props.Description)
-		d.Set("display_name", props.DisplayName)
-		d.Set("package_enabled", props.IsEnabled)
-		d.Set("lock_level", string(props.LockLevel))
 	}
 
 	// the following are not returned from the API so lets pull it from state
@@ -233,11 +293,11 @@ func resourceManagedApplicationDefinitionRead(d *pluginsdk.ResourceData, meta in
 		d.Set("package_file_uri", v.(string))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceManagedApplicationDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -246,25 +306,20 @@ func resourceManagedApplicationDefinitionDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.Delete(ctx, *id); err != nil {
 		return fmt.Errorf("failed to delete Managed Application Definition %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("failed to wait for deleting Managed Application Definition (Managed Application Definition Name %q / Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
 	return nil
 }
 
-func expandManagedApplicationDefinitionAuthorization(input []interface{}) *[]managedapplications.ApplicationAuthorization {
-	results := make([]managedapplications.ApplicationAuthorization, 0)
+func expandManagedApplicationDefinitionAuthorization(input []interface{}) *[]managedApplicationsClient.ApplicationAuthorization {
+	results := make([]managedApplicationsClient.ApplicationAuthorization, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
-		result := managedapplications.ApplicationAuthorization{
-			RoleDefinitionID: utils.String(v["role_definition_id"].(string)),
-			PrincipalID:      utils.String(v["service_principal_id"].(string)),
+		result := managedApplicationsClient.ApplicationAuthorization{
+			RoleDefinitionId: v["role_definition_id"].(string),
+			PrincipalId:      v["service_principal_id"].(string),
 		}
 
 		results = append(results, result)
@@ -272,28 +327,48 @@ func expandManagedApplicationDefinitionAuthorization(input []interface{}) *[]man
 	return &results
 }
 
-func flattenManagedApplicationDefinitionAuthorization(input *[]managedapplications.ApplicationAuthorization) []interface{} {
+func flattenManagedApplicationDefinitionAuthorization(input *[]managedApplicationsClient.ApplicationAuthorization) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, item := range *input {
-		servicePrincipalId := ""
-		if item.PrincipalID != nil {
-			servicePrincipalId = *item.PrincipalID
-		}
+		results = append(results, map[string]interface{}{
+			"role_definition_id":   item.RoleDefinitionId,
+			"service_principal_id": item.PrincipalId,
+		})
+	}
 
-		roleDefinitionId := ""
-		if item.RoleDefinitionID != nil {
-			roleDefinitionId = *item.RoleDefinitionID
-		}
+	return results
+}
 
-		results = append(results, map[string]interface{}{
-			"role_definition_id":   roleDefinitionId,
-			"service_principal_id": servicePrincipalId,
+func expandManagedApplicationDefinitionNotificationPolicy(input []interface{}) *managedApplicationsClient.ApplicationNotificationPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	endpoints := make([]managedApplicationsClient.ApplicationNotificationEndpoint, 0)
+	for _, item := range input {
+		endpoints = append(endpoints, managedApplicationsClient.ApplicationNotificationEndpoint{
+			Uri: item.(string),
 		})
 	}
 
+	return &managedApplicationsClient.ApplicationNotificationPolicy{
+		NotificationEndpoints: endpoints,
+	}
+}
+
+func flattenManagedApplicationDefinitionNotificationPolicy(input *managedApplicationsClient.ApplicationNotificationPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range input.NotificationEndpoints {
+		results = append(results, item.Uri)
+	}
+
 	return results
 }
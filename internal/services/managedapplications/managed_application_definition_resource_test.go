@@ -106,6 +106,62 @@ func TestAccManagedApplicationDefinition_update(t *testing.T) {
 	})
 }
 
+func TestAccManagedApplicationDefinition_policies(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_application_definition", "test")
+	r := ManagedApplicationDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("package_file_uri"),
+		{
+			Config: r.policies(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deployment_mode").HasValue("Incremental"),
+				check.That(data.ResourceName).Key("notification_endpoints.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("package_file_uri"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deployment_mode").HasValue(""),
+				check.That(data.ResourceName).Key("notification_endpoints.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("package_file_uri"),
+	})
+}
+
+func TestAccManagedApplicationDefinition_updatePackageFileUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_application_definition", "test")
+	r := ManagedApplicationDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("package_file_uri"),
+		{
+			Config: r.updatedPackageFileUri(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("package_file_uri").HasValue("https://github.com/Azure/azure-managedapp-samples/raw/master/Managed Application Sample Packages/101-minimal-template/artifacts/ManagedAppZip/pkg.zip"),
+			),
+		},
+		data.ImportStep("package_file_uri"),
+	})
+}
+
 func (ManagedApplicationDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ApplicationDefinitionID(state.ID)
 	if err != nil {
@@ -137,6 +193,51 @@ resource "azurerm_managed_application_definition" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ManagedApplicationDefinitionResource) updatedPackageFileUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_application_definition" "test" {
+  name                = "acctestAppDef%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  lock_level          = "None"
+  package_file_uri    = "https://github.com/Azure/azure-managedapp-samples/raw/master/Managed Application Sample Packages/101-minimal-template/artifacts/ManagedAppZip/pkg.zip"
+  display_name        = "TestManagedApplicationDefinition"
+  description         = "Test Managed Application Definition"
+  package_enabled     = false
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagedApplicationDefinitionResource) policies(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_managed_application_definition" "test" {
+  name                   = "acctestAppDef%d"
+  location               = azurerm_resource_group.test.location
+  resource_group_name    = azurerm_resource_group.test.name
+  lock_level             = "None"
+  package_file_uri       = "https://github.com/Azure/azure-managedapp-samples/raw/master/Managed Application Sample Packages/201-managed-storage-account/managedstorage.zip"
+  display_name           = "TestManagedApplicationDefinition"
+  description            = "Test Managed Application Definition"
+  package_enabled        = false
+  deployment_mode        = "Incremental"
+  notification_endpoints = ["https://example.com/notifications"]
+  storage_account_id     = azurerm_storage_account.test.id
+}
+`, r.template(data), data.RandomString, data.RandomInteger)
+}
+
 func (r ManagedApplicationDefinitionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,168 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
+)
+
+// NOTE: running the query using a Managed Identity and the `ruleResolveConfiguration` aren't available in the
+// 2021-08-01 API used by the vendored SDK, as such Scheduled Query Rules are managed here against the
+// 2023-03-15-preview API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const scheduledQueryRulesApiVersion = "2023-03-15-preview"
+
+type ScheduledQueryRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewScheduledQueryRulesClientWithBaseURI(endpoint string) ScheduledQueryRulesClient {
+	return ScheduledQueryRulesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/scheduledqueryrules/%s", scheduledQueryRulesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type ScheduledQueryRuleResource struct {
+	Etag       *string                           `json:"etag,omitempty"`
+	Id         *string                           `json:"id,omitempty"`
+	Identity   *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Kind       *scheduledqueryrules.Kind         `json:"kind,omitempty"`
+	Location   string                            `json:"location"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties ScheduledQueryRuleProperties      `json:"properties"`
+	SystemData *systemdata.SystemData            `json:"systemData,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
+
+// ScheduledQueryRuleProperties extends the Scheduled Query Rule Properties from the SDK with the
+// `ruleResolveConfiguration` field
+type ScheduledQueryRuleProperties struct {
+	scheduledqueryrules.ScheduledQueryRuleProperties
+	RuleResolveConfiguration *RuleResolveConfiguration `json:"ruleResolveConfiguration,omitempty"`
+}
+
+type RuleResolveConfiguration struct {
+	AutoResolved  *bool   `json:"autoResolved,omitempty"`
+	TimeToResolve *string `json:"timeToResolve,omitempty"`
+}
+
+type ScheduledQueryRuleCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *ScheduledQueryRuleResource
+}
+
+type ScheduledQueryRuleDeleteOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+type ScheduledQueryRuleGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *ScheduledQueryRuleResource
+}
+
+// CreateOrUpdate ...
+func (c ScheduledQueryRulesClient) CreateOrUpdate(ctx context.Context, id scheduledqueryrules.ScheduledQueryRuleId, input ScheduledQueryRuleResource) (result ScheduledQueryRuleCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Delete ...
+func (c ScheduledQueryRulesClient) Delete(ctx context.Context, id scheduledqueryrules.ScheduledQueryRuleId) (result ScheduledQueryRuleDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "Delete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Get ...
+func (c ScheduledQueryRulesClient) Get(ctx context.Context, id scheduledqueryrules.ScheduledQueryRuleId) (result ScheduledQueryRuleGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scheduledqueryrules.ScheduledQueryRulesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c ScheduledQueryRulesClient) prepare(ctx context.Context, id scheduledqueryrules.ScheduledQueryRuleId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": scheduledQueryRulesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -102,31 +102,114 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 								"Error",
 								"Critical",
 							}, false),
+							ConflictsWith: []string{"criteria.0.levels"},
+						},
+						"levels": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Verbose",
+									"Informational",
+									"Warning",
+									"Error",
+									"Critical",
+								}, false),
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.level"},
 						},
 						"resource_provider": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.resource_providers"},
+						},
+						"resource_providers": {
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.resource_provider"},
 						},
 						"resource_type": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.resource_types"},
+						},
+						"resource_types": {
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.resource_type"},
 						},
 						"resource_group": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.resource_groups"},
+						},
+						"resource_groups": {
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.resource_group"},
 						},
 						"resource_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ValidateFunc:  azure.ValidateResourceID,
+							ConflictsWith: []string{"criteria.0.resource_ids"},
+						},
+						"resource_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.resource_id"},
 						},
 						"status": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.statuses"},
+						},
+						"statuses": {
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.status"},
 						},
 						"sub_status": {
-							Type:     pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"criteria.0.sub_statuses"},
+						},
+						"sub_statuses": {
+							Type:     pluginsdk.TypeSet,
 							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Set:           pluginsdk.HashString,
+							ConflictsWith: []string{"criteria.0.sub_status"},
 						},
 						"recommendation_category": {
 							Type:     pluginsdk.TypeString,
@@ -450,42 +533,63 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) activitylogalert
 			Equals: utils.String(level),
 		})
 	}
+	if v, ok := v["levels"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("level", v.List()))
+	}
 	if resourceProvider := v["resource_provider"].(string); resourceProvider != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("resourceProvider"),
 			Equals: utils.String(resourceProvider),
 		})
 	}
+	if v, ok := v["resource_providers"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("resourceProvider", v.List()))
+	}
 	if resourceType := v["resource_type"].(string); resourceType != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("resourceType"),
 			Equals: utils.String(resourceType),
 		})
 	}
+	if v, ok := v["resource_types"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("resourceType", v.List()))
+	}
 	if resourceGroup := v["resource_group"].(string); resourceGroup != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("resourceGroup"),
 			Equals: utils.String(resourceGroup),
 		})
 	}
+	if v, ok := v["resource_groups"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("resourceGroup", v.List()))
+	}
 	if id := v["resource_id"].(string); id != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("resourceId"),
 			Equals: utils.String(id),
 		})
 	}
+	if v, ok := v["resource_ids"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("resourceId", v.List()))
+	}
 	if status := v["status"].(string); status != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("status"),
 			Equals: utils.String(status),
 		})
 	}
+	if v, ok := v["statuses"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("status", v.List()))
+	}
 	if subStatus := v["sub_status"].(string); subStatus != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("subStatus"),
 			Equals: utils.String(subStatus),
 		})
 	}
+	if v, ok := v["sub_statuses"].(*pluginsdk.Set); ok && v.Len() > 0 {
+		conditions = append(conditions, expandMonitorActivityLogAlertAnyOfCondition("subStatus", v.List()))
+	}
 	if recommendationType := v["recommendation_type"].(string); recommendationType != "" {
 		conditions = append(conditions, activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
 			Field:  utils.String("properties.recommendationType"),
@@ -520,6 +624,21 @@ func expandMonitorActivityLogAlertCriteria(input []interface{}) activitylogalert
 	}
 }
 
+// expandMonitorActivityLogAlertAnyOfCondition returns a condition which matches when the field equals any of the values
+func expandMonitorActivityLogAlertAnyOfCondition(field string, values []interface{}) activitylogalertsapis.AlertRuleAnyOfOrLeafCondition {
+	leafConditions := make([]activitylogalertsapis.AlertRuleLeafCondition, 0)
+	for _, value := range values {
+		leafConditions = append(leafConditions, activitylogalertsapis.AlertRuleLeafCondition{
+			Field:  utils.String(field),
+			Equals: utils.String(value.(string)),
+		})
+	}
+
+	return activitylogalertsapis.AlertRuleAnyOfOrLeafCondition{
+		AnyOf: &leafConditions,
+	}
+}
+
 func expandResourceHealth(resourceHealth []interface{}, conditions []activitylogalertsapis.AlertRuleAnyOfOrLeafCondition) []activitylogalertsapis.AlertRuleAnyOfOrLeafCondition {
 	for _, serviceItem := range resourceHealth {
 		if serviceItem == nil {
@@ -670,6 +789,20 @@ func flattenMonitorActivityLogAlertCriteria(input activitylogalertsapis.AlertRul
 		}
 	}
 
+	anyOfValues := flattenMonitorActivityLogAlertAnyOfValues(input)
+	for field, key := range map[string]string{
+		"level":            "levels",
+		"resourceprovider": "resource_providers",
+		"resourcetype":     "resource_types",
+		"resourcegroup":    "resource_groups",
+		"resourceid":       "resource_ids",
+		"status":           "statuses",
+		"substatus":        "sub_statuses",
+	} {
+		values := make([]string, 0)
+		result[key] = append(values, anyOfValues[field]...)
+	}
+
 	if result["category"] == "ResourceHealth" {
 		flattenMonitorActivityLogAlertResourceHealth(input, result)
 	}
@@ -681,50 +814,68 @@ func flattenMonitorActivityLogAlertCriteria(input activitylogalertsapis.AlertRul
 	return []interface{}{result}
 }
 
-func flattenMonitorActivityLogAlertResourceHealth(input activitylogalertsapis.AlertRuleAllOfCondition, result map[string]interface{}) {
-	rhResult := make(map[string]interface{})
+// flattenMonitorActivityLogAlertAnyOfValues returns the values within each `anyOf` condition, keyed by the (lower-cased)
+// field - any conditions for the same field are merged together
+func flattenMonitorActivityLogAlertAnyOfValues(input activitylogalertsapis.AlertRuleAllOfCondition) map[string][]string {
+	result := make(map[string][]string)
 	for _, condition := range input.AllOf {
-		if condition.Field == nil && len(*condition.AnyOf) > 0 {
-			var values []string
-			for _, cond := range *condition.AnyOf {
-				if cond.Field != nil && cond.Equals != nil {
-					values = append(values, *cond.Equals)
-				}
-				switch strings.ToLower(*cond.Field) {
-				case "properties.currenthealthstatus":
-					rhResult["current"] = values
-				case "properties.previoushealthstatus":
-					rhResult["previous"] = values
-				case "properties.cause":
-					rhResult["reason"] = values
-				}
+		if condition.AnyOf == nil {
+			continue
+		}
+
+		for _, leaf := range *condition.AnyOf {
+			if leaf.Field == nil {
+				continue
+			}
+			field := strings.ToLower(*leaf.Field)
+			if leaf.Equals != nil {
+				result[field] = append(result[field], *leaf.Equals)
+			}
+			if leaf.ContainsAny != nil {
+				result[field] = append(result[field], *leaf.ContainsAny...)
 			}
 		}
 	}
 
-	result["resource_health"] = []interface{}{rhResult}
+	return result
 }
 
-func flattenMonitorActivityLogAlertServiceHealth(input activitylogalertsapis.AlertRuleAllOfCondition, result map[string]interface{}) {
-	shResult := make(map[string]interface{})
+// flattenMonitorActivityLogAlertHealthValues returns the values for the specified (lower-cased) field, which can
+// either be specified as a leaf condition or within an `anyOf` condition
+func flattenMonitorActivityLogAlertHealthValues(input activitylogalertsapis.AlertRuleAllOfCondition, anyOfValues map[string][]string, field string) []string {
+	values := make([]string, 0)
 	for _, condition := range input.AllOf {
-		if condition.Field != nil && condition.ContainsAny != nil && len(*condition.ContainsAny) > 0 {
-			switch strings.ToLower(*condition.Field) {
-			case "properties.impactedservices[*].impactedregions[*].regionname":
-				shResult["locations"] = *condition.ContainsAny
-			case "properties.impactedservices[*].servicename":
-				shResult["services"] = *condition.ContainsAny
-			}
+		if condition.Field == nil || !strings.EqualFold(*condition.Field, field) {
+			continue
 		}
-		if condition.Field == nil && len(*condition.AnyOf) > 0 {
-			events := []string{}
-			for _, evCond := range *condition.AnyOf {
-				if evCond.Field != nil && evCond.Equals != nil {
-					events = append(events, *evCond.Equals)
-				}
-			}
-			shResult["events"] = events
+		if condition.Equals != nil {
+			values = append(values, *condition.Equals)
 		}
+		if condition.ContainsAny != nil {
+			values = append(values, *condition.ContainsAny...)
+		}
+	}
+
+	return append(values, anyOfValues[field]...)
+}
+
+func flattenMonitorActivityLogAlertResourceHealth(input activitylogalertsapis.AlertRuleAllOfCondition, result map[string]interface{}) {
+	anyOfValues := flattenMonitorActivityLogAlertAnyOfValues(input)
+	rhResult := map[string]interface{}{
+		"current":  flattenMonitorActivityLogAlertHealthValues(input, anyOfValues, "properties.currenthealthstatus"),
+		"previous": flattenMonitorActivityLogAlertHealthValues(input, anyOfValues, "properties.previoushealthstatus"),
+		"reason":   flattenMonitorActivityLogAlertHealthValues(input, anyOfValues, "properties.cause"),
+	}
+
+	result["resource_health"] = []interface{}{rhResult}
+}
+
+func flattenMonitorActivityLogAlertServiceHealth(input activitylogalertsapis.AlertRuleAllOfCondition, result map[string]interface{}) {
+	anyOfValues := flattenMonitorActivityLogAlertAnyOfValues(input)
+	shResult := map[string]interface{}{
+		"events":    flattenMonitorActivityLogAlertHealthValues(input, anyOfValues, "properties.incidenttype"),
+		"locations": flattenMonitorActivityLogAlertHealthValues(input, anyOfValues, "properties.impactedservices[*].impactedregions[*].regionname"),
+		"services":  flattenMonitorActivityLogAlertHealthValues(input, anyOfValues, "properties.impactedservices[*].servicename"),
 	}
 
 	result["service_health"] = []interface{}{shResult}
@@ -224,6 +224,31 @@ func TestAccMonitorActivityLogAlert_ResourceHealth_basicAndUpdate(t *testing.T)
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ResourceHealth_multipleValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceHealth_multipleValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.statuses.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.current.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.resource_health.0.previous.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resourceHealth_basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorActivityLogAlert_ResourceHealth_basicAndDelete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -275,6 +300,24 @@ func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ServiceHealth_multipleValues(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.serviceHealth_multipleValues(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.levels.#").HasValue("2"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.events.#").HasValue("3"),
+				check.That(data.ResourceName).Key("criteria.0.service_health.0.locations.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorActivityLogAlert_ServiceHealth_basicAndDelete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -1076,3 +1119,75 @@ func (t MonitorActivityLogAlertResource) Exists(ctx context.Context, clients *cl
 
 	return utils.Bool(resp.Model != nil), nil
 }
+
+func (MonitorActivityLogAlertResource) resourceHealth_multipleValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category       = "ResourceHealth"
+    resource_types = ["Microsoft.Storage/storageAccounts", "Microsoft.Compute/virtualMachines"]
+    resource_ids   = [azurerm_storage_account.test.id]
+    statuses       = ["Active", "Resolved"]
+
+    resource_health {
+      current  = ["Degraded", "Unavailable"]
+      previous = ["Available"]
+      reason   = ["PlatformInitiated"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (MonitorActivityLogAlertResource) serviceHealth_multipleValues(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [data.azurerm_subscription.current.id]
+
+  criteria {
+    category = "ServiceHealth"
+    levels   = ["Error", "Critical"]
+
+    service_health {
+      events    = ["Incident", "Maintenance", "Security"]
+      locations = ["West Europe", "North Europe"]
+      services  = ["Virtual Machines"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
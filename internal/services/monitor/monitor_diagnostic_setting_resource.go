@@ -26,6 +26,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// monitorDiagnosticSettingAllLogsCategoryGroup is the Category Group which contains every Log Category for a resource,
+// including any Categories which Azure adds in the future
+const monitorDiagnosticSettingAllLogsCategoryGroup = "allLogs"
+
 func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceMonitorDiagnosticSettingCreate,
@@ -109,8 +113,8 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeSet,
 				Optional:      true,
 				Computed:      !features.FourPointOhBeta(),
-				ConflictsWith: []string{"log"},
-				AtLeastOneOf:  []string{"enabled_log", "log", "metric"},
+				ConflictsWith: []string{"log", "enable_all_logs", "category_groups"},
+				AtLeastOneOf:  []string{"enabled_log", "log", "metric", "enable_all_logs", "category_groups"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -147,10 +151,32 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 				Set: resourceMonitorDiagnosticLogSettingHash,
 			},
 
+			"enable_all_logs": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"enabled_log", "log", "category_groups"},
+				AtLeastOneOf:  []string{"enabled_log", "log", "metric", "enable_all_logs", "category_groups"},
+			},
+
+			"category_groups": {
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"enabled_log", "log", "enable_all_logs"},
+				AtLeastOneOf:  []string{"enabled_log", "log", "metric", "enable_all_logs", "category_groups"},
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringIsNotEmpty,
+						// `allLogs` is exposed via `enable_all_logs` instead
+						validation.StringNotInSlice([]string{monitorDiagnosticSettingAllLogsCategoryGroup}, true),
+					),
+				},
+			},
+
 			"metric": {
 				Type:         pluginsdk.TypeSet,
 				Optional:     true,
-				AtLeastOneOf: []string{"enabled_log", "log", "metric"},
+				AtLeastOneOf: []string{"enabled_log", "log", "metric", "enable_all_logs", "category_groups"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -194,7 +220,7 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			Type:         pluginsdk.TypeSet,
 			Optional:     true,
 			Computed:     true,
-			AtLeastOneOf: []string{"enabled_log", "log", "metric"},
+			AtLeastOneOf: []string{"enabled_log", "log", "metric", "enable_all_logs", "category_groups"},
 			Deprecated:   "`log` has been superseded by `enabled_log` and will be removed in version 4.0 of the AzureRM Provider.",
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
@@ -288,6 +314,11 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	if categoryGroupLogs := expandMonitorDiagnosticsSettingsCategoryGroups(d.Get("enable_all_logs").(bool), d.Get("category_groups").(*pluginsdk.Set).List()); len(categoryGroupLogs) > 0 {
+		logs = categoryGroupLogs
+		hasEnabledLogs = true
+	}
+
 	// if no logs/metrics are enabled the API "creates" but 404's on Read
 	hasEnabledMetrics := false
 	if !hasEnabledLogs {
@@ -399,6 +430,12 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	// the Category Groups are sent in full so that switching from `enabled_log` removes the individual Categories
+	if categoryGroupLogs := expandMonitorDiagnosticsSettingsCategoryGroups(d.Get("enable_all_logs").(bool), d.Get("category_groups").(*pluginsdk.Set).List()); len(categoryGroupLogs) > 0 {
+		logs = categoryGroupLogs
+		hasEnabledLogs = true
+	}
+
 	// if no logs/metrics are enabled the API "creates" but 404's on Read
 	hasEnabledMetrics := false
 	if !hasEnabledLogs {
@@ -529,7 +566,20 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			}
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
-			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
+			// when the Log Categories are tracked server-side via Category Groups, the individual Categories are
+			// intentionally not exposed so that no diff is shown when Azure adds new Categories to a Group
+			enableAllLogs := false
+			categoryGroups := make([]interface{}, 0)
+			enabledLogs := make([]interface{}, 0)
+			if d.Get("enable_all_logs").(bool) || d.Get("category_groups").(*pluginsdk.Set).Len() > 0 {
+				enableAllLogs, categoryGroups = flattenMonitorDiagnosticCategoryGroups(resp.Model.Properties.Logs)
+			} else {
+				enabledLogs = flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
+			}
+			d.Set("enable_all_logs", enableAllLogs)
+			if err = d.Set("category_groups", categoryGroups); err != nil {
+				return fmt.Errorf("setting `category_groups`: %+v", err)
+			}
 			if err = d.Set("enabled_log", enabledLogs); err != nil {
 				return fmt.Errorf("setting `enabled_log`: %+v", err)
 			}
@@ -754,6 +804,49 @@ func flattenMonitorDiagnosticEnabledLogs(input *[]diagnosticsettings.LogSettings
 	return enabledLogs
 }
 
+func expandMonitorDiagnosticsSettingsCategoryGroups(enableAllLogs bool, categoryGroups []interface{}) []diagnosticsettings.LogSettings {
+	results := make([]diagnosticsettings.LogSettings, 0)
+
+	if enableAllLogs {
+		results = append(results, diagnosticsettings.LogSettings{
+			CategoryGroup: utils.String(monitorDiagnosticSettingAllLogsCategoryGroup),
+			Enabled:       true,
+		})
+	}
+
+	for _, v := range categoryGroups {
+		results = append(results, diagnosticsettings.LogSettings{
+			CategoryGroup: utils.String(v.(string)),
+			Enabled:       true,
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorDiagnosticCategoryGroups(input *[]diagnosticsettings.LogSettings) (bool, []interface{}) {
+	enableAllLogs := false
+	categoryGroups := make([]interface{}, 0)
+	if input == nil {
+		return enableAllLogs, categoryGroups
+	}
+
+	for _, v := range *input {
+		if !v.Enabled || v.CategoryGroup == nil || *v.CategoryGroup == "" {
+			continue
+		}
+
+		if strings.EqualFold(*v.CategoryGroup, monitorDiagnosticSettingAllLogsCategoryGroup) {
+			enableAllLogs = true
+			continue
+		}
+
+		categoryGroups = append(categoryGroups, *v.CategoryGroup)
+	}
+
+	return enableAllLogs, categoryGroups
+}
+
 func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []diagnosticsettings.MetricSettings {
 	results := make([]diagnosticsettings.MetricSettings, 0)
 
@@ -7,38 +7,43 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type ScheduledQueryRulesAlertV2Model struct {
-	Name                                  string                                    `tfschema:"name"`
-	ResourceGroupName                     string                                    `tfschema:"resource_group_name"`
-	Actions                               []ScheduledQueryRulesAlertV2ActionsModel  `tfschema:"action"`
-	AutoMitigate                          bool                                      `tfschema:"auto_mitigation_enabled"`
-	CheckWorkspaceAlertsStorageConfigured bool                                      `tfschema:"workspace_alerts_storage_enabled"`
-	Criteria                              []ScheduledQueryRulesAlertV2CriteriaModel `tfschema:"criteria"`
-	Description                           string                                    `tfschema:"description"`
-	DisplayName                           string                                    `tfschema:"display_name"`
-	Enabled                               bool                                      `tfschema:"enabled"`
-	EvaluationFrequency                   string                                    `tfschema:"evaluation_frequency"`
-	Location                              string                                    `tfschema:"location"`
-	MuteActionsDuration                   string                                    `tfschema:"mute_actions_after_alert_duration"`
-	OverrideQueryTimeRange                string                                    `tfschema:"query_time_range_override"`
-	Scopes                                []string                                  `tfschema:"scopes"`
-	Severity                              scheduledqueryrules.AlertSeverity         `tfschema:"severity"`
-	SkipQueryValidation                   bool                                      `tfschema:"skip_query_validation"`
-	Tags                                  map[string]string                         `tfschema:"tags"`
-	TargetResourceTypes                   []string                                  `tfschema:"target_resource_types"`
-	WindowSize                            string                                    `tfschema:"window_duration"`
-	CreatedWithApiVersion                 string                                    `tfschema:"created_with_api_version"`
-	IsLegacyLogAnalyticsRule              bool                                      `tfschema:"is_a_legacy_log_analytics_rule"`
-	IsWorkspaceAlertsStorageConfigured    bool                                      `tfschema:"is_workspace_alerts_storage_configured"`
+	Name                                  string                                                    `tfschema:"name"`
+	ResourceGroupName                     string                                                    `tfschema:"resource_group_name"`
+	Actions                               []ScheduledQueryRulesAlertV2ActionsModel                  `tfschema:"action"`
+	AutoMitigate                          bool                                                      `tfschema:"auto_mitigation_enabled"`
+	CheckWorkspaceAlertsStorageConfigured bool                                                      `tfschema:"workspace_alerts_storage_enabled"`
+	Criteria                              []ScheduledQueryRulesAlertV2CriteriaModel                 `tfschema:"criteria"`
+	Description                           string                                                    `tfschema:"description"`
+	DisplayName                           string                                                    `tfschema:"display_name"`
+	Enabled                               bool                                                      `tfschema:"enabled"`
+	EvaluationFrequency                   string                                                    `tfschema:"evaluation_frequency"`
+	Identity                              []identity.ModelSystemAssignedUserAssigned                `tfschema:"identity"`
+	Location                              string                                                    `tfschema:"location"`
+	MuteActionsDuration                   string                                                    `tfschema:"mute_actions_after_alert_duration"`
+	OverrideQueryTimeRange                string                                                    `tfschema:"query_time_range_override"`
+	RuleResolveConfiguration              []ScheduledQueryRulesAlertV2RuleResolveConfigurationModel `tfschema:"rule_resolve_configuration"`
+	Scopes                                []string                                                  `tfschema:"scopes"`
+	Severity                              scheduledqueryrules.AlertSeverity                         `tfschema:"severity"`
+	SkipQueryValidation                   bool                                                      `tfschema:"skip_query_validation"`
+	Tags                                  map[string]string                                         `tfschema:"tags"`
+	TargetResourceTypes                   []string                                                  `tfschema:"target_resource_types"`
+	WindowSize                            string                                                    `tfschema:"window_duration"`
+	CreatedWithApiVersion                 string                                                    `tfschema:"created_with_api_version"`
+	IsLegacyLogAnalyticsRule              bool                                                      `tfschema:"is_a_legacy_log_analytics_rule"`
+	IsWorkspaceAlertsStorageConfigured    bool                                                      `tfschema:"is_workspace_alerts_storage_configured"`
 }
 
 type ScheduledQueryRulesAlertV2ActionsModel struct {
@@ -68,9 +73,17 @@ type ScheduledQueryRulesAlertV2FailingPeriodsModel struct {
 	NumberOfEvaluationPeriods int64 `tfschema:"number_of_evaluation_periods"`
 }
 
+type ScheduledQueryRulesAlertV2RuleResolveConfigurationModel struct {
+	AutoResolved  bool   `tfschema:"auto_resolved_enabled"`
+	TimeToResolve string `tfschema:"time_to_resolve"`
+}
+
 type ScheduledQueryRulesAlertV2Resource struct{}
 
-var _ sdk.ResourceWithUpdate = ScheduledQueryRulesAlertV2Resource{}
+var (
+	_ sdk.ResourceWithUpdate        = ScheduledQueryRulesAlertV2Resource{}
+	_ sdk.ResourceWithCustomizeDiff = ScheduledQueryRulesAlertV2Resource{}
+)
 
 func (r ScheduledQueryRulesAlertV2Resource) ResourceType() string {
 	return "azurerm_monitor_scheduled_query_rules_alert_v2"
@@ -235,7 +248,6 @@ func (r ScheduledQueryRulesAlertV2Resource) Arguments() map[string]*pluginsdk.Sc
 			Type:     pluginsdk.TypeList,
 			Required: true,
 			MinItems: 1,
-			MaxItems: 1,
 			ForceNew: true,
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
@@ -366,6 +378,29 @@ func (r ScheduledQueryRulesAlertV2Resource) Arguments() map[string]*pluginsdk.Sc
 			}, false),
 		},
 
+		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
+
+		"rule_resolve_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"auto_resolved_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"time_to_resolve": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+				},
+			},
+		},
+
 		"skip_query_validation": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -423,18 +458,28 @@ func (r ScheduledQueryRulesAlertV2Resource) Create() sdk.ResourceFunc {
 			if !response.WasNotFound(existing.HttpResponse) {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
+
+			identityValue, err := identity.ExpandSystemOrUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
 			kind := scheduledqueryrules.KindLogAlert
-			properties := &scheduledqueryrules.ScheduledQueryRuleResource{
+			properties := &azuresdkhacks.ScheduledQueryRuleResource{
+				Identity: identityValue,
 				Kind:     &kind,
 				Location: location.Normalize(model.Location),
-				Properties: scheduledqueryrules.ScheduledQueryRuleProperties{
-					AutoMitigate:                          &model.AutoMitigate,
-					CheckWorkspaceAlertsStorageConfigured: &model.CheckWorkspaceAlertsStorageConfigured,
-					Enabled:                               &model.Enabled,
-					Scopes:                                &model.Scopes,
-					Severity:                              &model.Severity,
-					SkipQueryValidation:                   &model.SkipQueryValidation,
-					TargetResourceTypes:                   &model.TargetResourceTypes,
+				Properties: azuresdkhacks.ScheduledQueryRuleProperties{
+					ScheduledQueryRuleProperties: scheduledqueryrules.ScheduledQueryRuleProperties{
+						AutoMitigate:                          &model.AutoMitigate,
+						CheckWorkspaceAlertsStorageConfigured: &model.CheckWorkspaceAlertsStorageConfigured,
+						Enabled:                               &model.Enabled,
+						Scopes:                                &model.Scopes,
+						Severity:                              &model.Severity,
+						SkipQueryValidation:                   &model.SkipQueryValidation,
+						TargetResourceTypes:                   &model.TargetResourceTypes,
+					},
+					RuleResolveConfiguration: expandScheduledQueryRulesAlertV2RuleResolveConfigurationModel(model.RuleResolveConfiguration),
 				},
 				Tags: &model.Tags,
 			}
@@ -510,6 +555,18 @@ func (r ScheduledQueryRulesAlertV2Resource) Update() sdk.ResourceFunc {
 				model.Properties.Actions = expandScheduledQueryRulesAlertV2ActionsModel(resourceModel.Actions)
 			}
 
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemOrUserAssignedMapFromModel(resourceModel.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				model.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("rule_resolve_configuration") {
+				model.Properties.RuleResolveConfiguration = expandScheduledQueryRulesAlertV2RuleResolveConfigurationModel(resourceModel.RuleResolveConfiguration)
+			}
+
 			if metadata.ResourceData.HasChange("auto_mitigation_enabled") {
 				model.Properties.AutoMitigate = &resourceModel.AutoMitigate
 			}
@@ -625,7 +682,14 @@ func (r ScheduledQueryRulesAlertV2Resource) Read() sdk.ResourceFunc {
 				Location:          location.Normalize(model.Location),
 			}
 
+			identityValue, err := identity.FlattenSystemOrUserAssignedMapToModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			state.Identity = *identityValue
+
 			properties := &model.Properties
+			state.RuleResolveConfiguration = flattenScheduledQueryRulesAlertV2RuleResolveConfigurationModel(properties.RuleResolveConfiguration)
 			state.Actions = flattenScheduledQueryRulesAlertV2ActionsModel(properties.Actions)
 
 			if properties.AutoMitigate != nil {
@@ -722,6 +786,36 @@ func (r ScheduledQueryRulesAlertV2Resource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r ScheduledQueryRulesAlertV2Resource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return validateScheduledQueryRulesAlertV2Scopes(metadata.ResourceDiff)
+		},
+	}
+}
+
+// validateScheduledQueryRulesAlertV2Scopes ensures that a rule scoped to multiple resources can map each result row
+// back to the resource it relates to, the check is skipped until the values are known
+func validateScheduledQueryRulesAlertV2Scopes(diff *pluginsdk.ResourceDiff) error {
+	if !diff.NewValueKnown("scopes") || len(diff.Get("scopes").([]interface{})) < 2 {
+		return nil
+	}
+
+	for i := range diff.Get("criteria").([]interface{}) {
+		key := fmt.Sprintf("criteria.%d.resource_id_column", i)
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		if diff.Get(key).(string) == "" {
+			return fmt.Errorf("`%s` must be specified when more than one resource is specified in `scopes`", key)
+		}
+	}
+
+	return nil
+}
+
 func expandScheduledQueryRulesAlertV2ActionsModel(inputList []ScheduledQueryRulesAlertV2ActionsModel) *scheduledqueryrules.Actions {
 	if len(inputList) == 0 {
 		return nil
@@ -798,6 +892,23 @@ func expandScheduledQueryRulesAlertV2FailingPeriodsModel(inputList []ScheduledQu
 	return &output
 }
 
+func expandScheduledQueryRulesAlertV2RuleResolveConfigurationModel(inputList []ScheduledQueryRulesAlertV2RuleResolveConfigurationModel) *azuresdkhacks.RuleResolveConfiguration {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	input := &inputList[0]
+	output := azuresdkhacks.RuleResolveConfiguration{
+		AutoResolved: &input.AutoResolved,
+	}
+
+	if input.TimeToResolve != "" {
+		output.TimeToResolve = &input.TimeToResolve
+	}
+
+	return &output
+}
+
 func flattenScheduledQueryRulesAlertV2ActionsModel(input *scheduledqueryrules.Actions) []ScheduledQueryRulesAlertV2ActionsModel {
 	var outputList []ScheduledQueryRulesAlertV2ActionsModel
 	if input == nil {
@@ -883,6 +994,25 @@ func flattenScheduledQueryRulesAlertV2DimensionModel(inputList *[]scheduledquery
 	return outputList
 }
 
+func flattenScheduledQueryRulesAlertV2RuleResolveConfigurationModel(input *azuresdkhacks.RuleResolveConfiguration) []ScheduledQueryRulesAlertV2RuleResolveConfigurationModel {
+	var outputList []ScheduledQueryRulesAlertV2RuleResolveConfigurationModel
+	if input == nil {
+		return outputList
+	}
+
+	output := ScheduledQueryRulesAlertV2RuleResolveConfigurationModel{}
+
+	if input.AutoResolved != nil {
+		output.AutoResolved = *input.AutoResolved
+	}
+
+	if input.TimeToResolve != nil {
+		output.TimeToResolve = *input.TimeToResolve
+	}
+
+	return append(outputList, output)
+}
+
 func flattenScheduledQueryRulesAlertV2FailingPeriodsModel(input *scheduledqueryrules.ConditionFailingPeriods) []ScheduledQueryRulesAlertV2FailingPeriodsModel {
 	var outputList []ScheduledQueryRulesAlertV2FailingPeriodsModel
 	if input == nil {
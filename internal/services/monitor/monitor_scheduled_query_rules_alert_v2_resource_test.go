@@ -3,6 +3,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -86,6 +87,47 @@ func TestAccMonitorScheduledQueryRulesAlertV2_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorScheduledQueryRulesAlertV2_multipleScopes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multipleScopes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_identityAndRuleResolveConfiguration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityAndRuleResolveConfiguration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("UserAssigned"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorScheduledQueryRulesAlertV2_multipleScopesWithoutResourceIdColumn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_scheduled_query_rules_alert_v2", "test")
+	r := MonitorScheduledQueryRulesAlertV2Resource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.multipleScopesWithoutResourceIdColumn(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`criteria.0.resource_id_column` must be specified when more than one resource is specified in `scopes`"),
+		},
+	})
+}
+
 func (r MonitorScheduledQueryRulesAlertV2Resource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(state.ID)
 	if err != nil {
@@ -294,3 +336,115 @@ resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
 }
 `, template, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) multipleScopes(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  count               = 2
+  name                = "acctestLAW-%[2]d-${count.index}"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                 = "acctest-isqr-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = "%[3]s"
+  evaluation_frequency = "PT5M"
+  window_duration      = "PT5M"
+  scopes               = azurerm_log_analytics_workspace.test.*.id
+  severity             = 3
+  criteria {
+    query                   = <<-QUERY
+      Heartbeat
+	    | summarize AggregatedValue=count() by _ResourceId
+	  QUERY
+    time_aggregation_method = "Count"
+    threshold               = 5.0
+    operator                = "Equal"
+    resource_id_column      = "_ResourceId"
+  }
+}
+`, template, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) multipleScopesWithoutResourceIdColumn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                 = "acctest-isqr-%[1]d"
+  resource_group_name  = "acctestRG-%[1]d"
+  location             = "%[2]s"
+  evaluation_frequency = "PT5M"
+  window_duration      = "PT5M"
+  scopes = [
+    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-%[1]d/providers/Microsoft.OperationalInsights/workspaces/acctestLAW-%[1]d-0",
+    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-%[1]d/providers/Microsoft.OperationalInsights/workspaces/acctestLAW-%[1]d-1",
+  ]
+  severity = 3
+  criteria {
+    query                   = "Heartbeat"
+    time_aggregation_method = "Count"
+    threshold               = 5.0
+    operator                = "Equal"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MonitorScheduledQueryRulesAlertV2Resource) identityAndRuleResolveConfiguration(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_application_insights.test.id
+  role_definition_name = "Reader"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_monitor_scheduled_query_rules_alert_v2" "test" {
+  name                 = "acctest-isqr-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  location             = "%[3]s"
+  evaluation_frequency = "PT5M"
+  window_duration      = "PT5M"
+  scopes               = [azurerm_application_insights.test.id]
+  severity             = 3
+  criteria {
+    query                   = <<-QUERY
+      requests
+	    | summarize CountByCountry=count() by client_CountryOrRegion
+	  QUERY
+    time_aggregation_method = "Count"
+    threshold               = 5.0
+    operator                = "Equal"
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  rule_resolve_configuration {
+    auto_resolved_enabled = true
+    time_to_resolve       = "PT10M"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, template, data.RandomInteger, data.Locations.Primary)
+}
@@ -291,6 +291,46 @@ func expandNetAppVolumeDataProtectionReplication(input []interface{}) *volumes.V
 	}
 }
 
+func expandNetAppVolumeZoneReplication(input []interface{}, location string) *volumes.VolumePropertiesDataProtection {
+	if len(input) == 0 {
+		return &volumes.VolumePropertiesDataProtection{}
+	}
+
+	replicationRaw := input[0].(map[string]interface{})
+
+	endpointType := volumes.EndpointTypeDst
+	replicationSchedule := volumes.ReplicationSchedule(translateTFSchedule(replicationRaw["replication_frequency"].(string)))
+
+	return &volumes.VolumePropertiesDataProtection{
+		Replication: &volumes.ReplicationObject{
+			EndpointType:           &endpointType,
+			RemoteVolumeRegion:     utils.String(location),
+			RemoteVolumeResourceId: replicationRaw["remote_volume_resource_id"].(string),
+			ReplicationSchedule:    &replicationSchedule,
+		},
+	}
+}
+
+func expandNetAppVolumeDataProtectionBackupPolicy(input []interface{}) *volumes.VolumeBackupProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	backupRaw := input[0].(map[string]interface{})
+
+	backup := volumes.VolumeBackupProperties{
+		BackupEnabled:  utils.Bool(backupRaw["backup_enabled"].(bool)),
+		BackupPolicyId: utils.String(backupRaw["backup_policy_id"].(string)),
+		PolicyEnforced: utils.Bool(backupRaw["policy_enforced"].(bool)),
+	}
+
+	if v := backupRaw["backup_vault_id"].(string); v != "" {
+		backup.VaultId = utils.String(v)
+	}
+
+	return &backup
+}
+
 func expandNetAppVolumeDataProtectionSnapshotPolicy(input []interface{}) *volumes.VolumePropertiesDataProtection {
 	if len(input) == 0 {
 		return &volumes.VolumePropertiesDataProtection{}
@@ -227,10 +227,11 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 			},
 
 			"data_protection_replication": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				ForceNew: true,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ForceNew:      true,
+				ConflictsWith: []string{"zone_replication"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"endpoint_type": {
@@ -263,6 +264,68 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				},
 			},
 
+			"zone_replication": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ForceNew:      true,
+				ConflictsWith: []string{"data_protection_replication"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"remote_volume_resource_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: volumes.ValidateVolumeID,
+						},
+
+						"replication_frequency": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"10minutes",
+								"daily",
+								"hourly",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"data_protection_backup_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				// backups can be enabled for the volume outside of Terraform (e.g. by a NetApp Backup Policy assignment)
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"backup_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"backup_vault_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"backup_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"policy_enforced": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"data_protection_snapshot_policy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -351,6 +414,15 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	dataProtectionReplication := expandNetAppVolumeDataProtectionReplication(dataProtectionReplicationRaw)
 	dataProtectionSnapshotPolicy := expandNetAppVolumeDataProtectionSnapshotPolicy(dataProtectionSnapshotPolicyRaw)
+	dataProtectionBackupPolicy := expandNetAppVolumeDataProtectionBackupPolicy(d.Get("data_protection_backup_policy").([]interface{}))
+
+	// Cross-Zone Replication uses the same replication object as Cross-Region Replication, with the remote volume in the same region
+	if zoneReplicationRaw := d.Get("zone_replication").([]interface{}); len(zoneReplicationRaw) > 0 {
+		if _, ok := d.GetOk("zone"); !ok {
+			return fmt.Errorf("`zone` must be specified when `zone_replication` is enabled for %s", id)
+		}
+		dataProtectionReplication = expandNetAppVolumeZoneReplication(zoneReplicationRaw, location)
+	}
 
 	authorizeReplication := false
 	volumeType := ""
@@ -449,6 +521,7 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 			VolumeType:      utils.String(volumeType),
 			SnapshotId:      utils.String(snapshotID),
 			DataProtection: &volumes.VolumePropertiesDataProtection{
+				Backup:      dataProtectionBackupPolicy,
 				Replication: dataProtectionReplication.Replication,
 				Snapshot:    dataProtectionSnapshotPolicy.Snapshot,
 			},
@@ -546,6 +619,18 @@ func resourceNetAppVolumeUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		update.Properties.DataProtection = dataProtectionSnapshotPolicy
 	}
 
+	// the backup policy (and vault) can be swapped in-place - since the block is Computed it's only sent when it's
+	// specified in the configuration, meaning backups are only disabled when `backup_enabled` is set to `false`
+	if raw := d.GetRawConfig().GetAttr("data_protection_backup_policy"); d.HasChange("data_protection_backup_policy") && raw.IsKnown() && !raw.IsNull() && raw.LengthInt() > 0 {
+		shouldUpdate = true
+		backup := expandNetAppVolumeDataProtectionBackupPolicy(d.Get("data_protection_backup_policy").([]interface{}))
+
+		if update.Properties.DataProtection == nil {
+			update.Properties.DataProtection = &volumes.VolumePatchPropertiesDataProtection{}
+		}
+		update.Properties.DataProtection.Backup = backup
+	}
+
 	if d.HasChange("throughput_in_mibps") {
 		shouldUpdate = true
 		throughputMibps := d.Get("throughput_in_mibps")
@@ -627,9 +712,22 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("mount_ip_addresses", flattenNetAppVolumeMountIPAddresses(props.MountTargets)); err != nil {
 			return fmt.Errorf("setting `mount_ip_addresses`: %+v", err)
 		}
-		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection)); err != nil {
+		// a replication with a remote volume in the same region is a Cross-Zone Replication rather than a Cross-Region Replication
+		dataProtectionReplication := flattenNetAppVolumeDataProtectionReplication(props.DataProtection)
+		zoneReplication := make([]interface{}, 0)
+		if len(dataProtectionReplication) > 0 && location.NormalizeNilable(props.DataProtection.Replication.RemoteVolumeRegion) == location.Normalize(model.Location) {
+			zoneReplication = flattenNetAppVolumeZoneReplication(props.DataProtection)
+			dataProtectionReplication = make([]interface{}, 0)
+		}
+		if err := d.Set("data_protection_replication", dataProtectionReplication); err != nil {
 			return fmt.Errorf("setting `data_protection_replication`: %+v", err)
 		}
+		if err := d.Set("zone_replication", zoneReplication); err != nil {
+			return fmt.Errorf("setting `zone_replication`: %+v", err)
+		}
+		if err := d.Set("data_protection_backup_policy", flattenNetAppVolumeDataProtectionBackupPolicy(props.DataProtection)); err != nil {
+			return fmt.Errorf("setting `data_protection_backup_policy`: %+v", err)
+		}
 		if err := d.Set("data_protection_snapshot_policy", flattenNetAppVolumeDataProtectionSnapshotPolicy(props.DataProtection)); err != nil {
 			return fmt.Errorf("setting `data_protection_snapshot_policy`: %+v", err)
 		}
@@ -935,3 +1033,36 @@ func flattenNetAppVolumeDataProtectionSnapshotPolicy(input *volumes.VolumeProper
 		},
 	}
 }
+
+func flattenNetAppVolumeZoneReplication(input *volumes.VolumePropertiesDataProtection) []interface{} {
+	if input == nil || input.Replication == nil {
+		return []interface{}{}
+	}
+
+	replicationFrequency := ""
+	if input.Replication.ReplicationSchedule != nil {
+		replicationFrequency = translateSDKSchedule(strings.ToLower(string(*input.Replication.ReplicationSchedule)))
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"remote_volume_resource_id": input.Replication.RemoteVolumeResourceId,
+			"replication_frequency":     replicationFrequency,
+		},
+	}
+}
+
+func flattenNetAppVolumeDataProtectionBackupPolicy(input *volumes.VolumePropertiesDataProtection) []interface{} {
+	if input == nil || input.Backup == nil || pointer.From(input.Backup.BackupPolicyId) == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"backup_policy_id": pointer.From(input.Backup.BackupPolicyId),
+			"backup_vault_id":  pointer.From(input.Backup.VaultId),
+			"backup_enabled":   pointer.From(input.Backup.BackupEnabled),
+			"policy_enforced":  pointer.From(input.Backup.PolicyEnforced),
+		},
+	}
+}
@@ -112,6 +112,65 @@ func TestAccNetAppVolume_crossRegionReplication(t *testing.T) {
 	})
 }
 
+func TestAccNetAppVolume_zoneReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_secondary")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.zoneReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("zone_replication.0.replication_frequency").HasValue("10minutes"),
+				check.That(data.ResourceName).Key("data_protection_replication.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetAppVolume_backupPolicyUpdate(t *testing.T) {
+	backupPolicyId := os.Getenv("ARM_TEST_NETAPP_BACKUP_POLICY_ID")
+	altBackupPolicyId := os.Getenv("ARM_TEST_NETAPP_BACKUP_POLICY_ID_ALT")
+	if backupPolicyId == "" || altBackupPolicyId == "" {
+		t.Skip("Skipping as ARM_TEST_NETAPP_BACKUP_POLICY_ID and ARM_TEST_NETAPP_BACKUP_POLICY_ID_ALT are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.backupPolicy(data, backupPolicyId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.backupPolicy(data, altBackupPolicyId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_backup_policy.0.backup_policy_id").HasValue(altBackupPolicyId),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.backupPolicyDisabled(data, altBackupPolicyId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_backup_policy.0.backup_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the block leaves the backup policy as-is, since it's Computed
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccNetAppVolume_nfsv3FromSnapshot(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_snapshot_vol")
 	r := NetAppVolumeResource{}
@@ -514,6 +573,116 @@ resource "azurerm_netapp_volume" "test_secondary" {
 `, template, data.RandomInteger, "eastus2")
 }
 
+func (NetAppVolumeResource) zoneReplication(data acceptance.TestData) string {
+	template := NetAppVolumeResource{}.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_netapp_volume" "test_primary" {
+  name                = "acctest-NetAppVolume-primary-%[2]d"
+  location            = azurerm_resource_group.test.location
+  zone                = "1"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  pool_name           = azurerm_netapp_pool.test.name
+  volume_path         = "my-unique-file-path-primary-%[2]d"
+  service_level       = "Standard"
+  subnet_id           = azurerm_subnet.test.id
+  protocols           = ["NFSv3"]
+  storage_quota_in_gb = 100
+
+  tags = {
+    "CreatedOnDate"    = "2022-07-08T23:50:21Z",
+    "SkipASMAzSecPack" = "true"
+  }
+}
+
+resource "azurerm_netapp_volume" "test_secondary" {
+  name                       = "acctest-NetAppVolume-secondary-%[2]d"
+  location                   = azurerm_resource_group.test.location
+  zone                       = "2"
+  resource_group_name        = azurerm_resource_group.test.name
+  account_name               = azurerm_netapp_account.test.name
+  pool_name                  = azurerm_netapp_pool.test.name
+  volume_path                = "my-unique-file-path-secondary-%[2]d"
+  service_level              = "Standard"
+  subnet_id                  = azurerm_subnet.test.id
+  protocols                  = ["NFSv3"]
+  storage_quota_in_gb        = 100
+  snapshot_directory_visible = false
+
+  zone_replication {
+    remote_volume_resource_id = azurerm_netapp_volume.test_primary.id
+    replication_frequency     = "10minutes"
+  }
+
+  tags = {
+    "CreatedOnDate"    = "2022-07-08T23:50:21Z",
+    "SkipASMAzSecPack" = "true"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (NetAppVolumeResource) backupPolicy(data acceptance.TestData, backupPolicyId string) string {
+	template := NetAppVolumeResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_netapp_volume" "test" {
+  name                = "acctest-NetAppVolume-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  pool_name           = azurerm_netapp_pool.test.name
+  volume_path         = "my-unique-file-path-%d"
+  service_level       = "Standard"
+  subnet_id           = azurerm_subnet.test.id
+  storage_quota_in_gb = 100
+  throughput_in_mibps = 1.562
+
+  data_protection_backup_policy {
+    backup_policy_id = "%s"
+  }
+
+  tags = {
+    "CreatedOnDate"    = "2022-07-08T23:50:21Z",
+    "SkipASMAzSecPack" = "true"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, backupPolicyId)
+}
+
+func (NetAppVolumeResource) backupPolicyDisabled(data acceptance.TestData, backupPolicyId string) string {
+	template := NetAppVolumeResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_netapp_volume" "test" {
+  name                = "acctest-NetAppVolume-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  pool_name           = azurerm_netapp_pool.test.name
+  volume_path         = "my-unique-file-path-%d"
+  service_level       = "Standard"
+  subnet_id           = azurerm_subnet.test.id
+  storage_quota_in_gb = 100
+  throughput_in_mibps = 1.562
+
+  data_protection_backup_policy {
+    backup_policy_id = "%s"
+    backup_enabled   = false
+  }
+
+  tags = {
+    "CreatedOnDate"    = "2022-07-08T23:50:21Z",
+    "SkipASMAzSecPack" = "true"
+  }
+}
+`, template, data.RandomInteger, data.RandomInteger, backupPolicyId)
+}
+
 func (NetAppVolumeResource) nfsv3FromSnapshot(data acceptance.TestData) string {
 	template := NetAppVolumeResource{}.template(data)
 	return fmt.Sprintf(`
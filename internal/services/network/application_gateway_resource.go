@@ -3,6 +3,11 @@ package network
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
@@ -1185,6 +1190,11 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
 						},
+
+						"thumbprint": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 				Set: applicationGatewaySSLCertificate,
@@ -4105,6 +4115,14 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 		if props := v.ApplicationGatewaySslCertificatePropertiesFormat; props != nil {
 			if data := props.PublicCertData; data != nil {
 				output["public_cert_data"] = *data
+
+				// when the certificate is sourced from Key Vault the public data tracks the version currently in use, so
+				// the thumbprint reflects the certificate being served after a rotation has been picked up
+				if thumbprint, err := applicationGatewayCertificateThumbprint(*data); err == nil {
+					output["thumbprint"] = thumbprint
+				} else {
+					log.Printf("[DEBUG] unable to determine the thumbprint for the SSL Certificate %q: %+v", name, err)
+				}
 			}
 
 			if kvsid := props.KeyVaultSecretID; kvsid != nil {
@@ -4139,6 +4157,58 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	return results
 }
 
+// applicationGatewayCertificateThumbprint returns the SHA-1 thumbprint of the leaf certificate within the public
+// certificate data, which is returned as either a PKCS#7 bundle or a single DER encoded certificate
+func applicationGatewayCertificateThumbprint(input string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		return "", fmt.Errorf("decoding public certificate data: %+v", err)
+	}
+
+	certificates, err := x509.ParseCertificates(raw)
+	if err != nil {
+		var contentInfo struct {
+			ContentType asn1.ObjectIdentifier
+			Content     asn1.RawValue `asn1:"explicit,tag:0"`
+		}
+		if _, err := asn1.Unmarshal(raw, &contentInfo); err != nil {
+			return "", fmt.Errorf("parsing PKCS#7 content info: %+v", err)
+		}
+
+		var signedData struct {
+			Version          int
+			DigestAlgorithms asn1.RawValue
+			ContentInfo      asn1.RawValue
+			Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+		}
+		if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+			return "", fmt.Errorf("parsing PKCS#7 signed data: %+v", err)
+		}
+
+		if certificates, err = x509.ParseCertificates(signedData.Certificates.Bytes); err != nil {
+			return "", fmt.Errorf("parsing PKCS#7 certificates: %+v", err)
+		}
+	}
+
+	// the bundle can contain the issuing chain, the leaf is the certificate which hasn't issued any of the others
+	for _, candidate := range certificates {
+		isIssuer := false
+		for _, other := range certificates {
+			if other != candidate && bytes.Equal(other.RawIssuer, candidate.RawSubject) {
+				isIssuer = true
+				break
+			}
+		}
+
+		if !isIssuer {
+			thumbprint := sha1.Sum(candidate.Raw)
+			return strings.ToUpper(hex.EncodeToString(thumbprint[:])), nil
+		}
+	}
+
+	return "", fmt.Errorf("no leaf certificate was found in the public certificate data")
+}
+
 func expandApplicationGatewayTrustedClientCertificates(d *pluginsdk.ResourceData) (*[]network.ApplicationGatewayTrustedClientCertificate, error) {
 	vs := d.Get("trusted_client_certificate").([]interface{})
 	results := make([]network.ApplicationGatewayTrustedClientCertificate, 0)
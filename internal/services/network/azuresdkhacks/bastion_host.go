@@ -0,0 +1,157 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+// NOTE: the `Developer` and `Premium` SKUs of a Bastion Host - together with the Virtual Network used by the `Developer`
+// SKU, Session Recording and Private Only Bastion Hosts - aren't available in API Version 2022-07-01 used by the
+// vendored SDK, as such Bastion Hosts are created and retrieved here using API Version 2024-01-01 until the SDK is updated
+
+const bastionHostApiVersion = "2024-01-01"
+
+const (
+	BastionHostSkuNameDeveloper network.BastionHostSkuName = "Developer"
+	BastionHostSkuNamePremium   network.BastionHostSkuName = "Premium"
+)
+
+// BastionHost is the vendored Bastion Host model with the properties added in newer API Versions, which are
+// (un)marshalled into the `properties` of the Bastion Host
+type BastionHost struct {
+	network.BastionHost
+
+	EnablePrivateOnlyBastion *bool                `json:"-"`
+	EnableSessionRecording   *bool                `json:"-"`
+	VirtualNetwork           *network.SubResource `json:"-"`
+}
+
+type bastionHostExtendedProperties struct {
+	Properties *struct {
+		EnablePrivateOnlyBastion *bool                `json:"enablePrivateOnlyBastion,omitempty"`
+		EnableSessionRecording   *bool                `json:"enableSessionRecording,omitempty"`
+		VirtualNetwork           *network.SubResource `json:"virtualNetwork,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+func (bh BastionHost) MarshalJSON() ([]byte, error) {
+	encoded, err := json.Marshal(bh.BastionHost)
+	if err != nil {
+		return nil, err
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		return nil, err
+	}
+
+	props, ok := out["properties"].(map[string]interface{})
+	if !ok {
+		props = make(map[string]interface{})
+		out["properties"] = props
+	}
+
+	if bh.EnablePrivateOnlyBastion != nil {
+		props["enablePrivateOnlyBastion"] = *bh.EnablePrivateOnlyBastion
+	}
+	if bh.EnableSessionRecording != nil {
+		props["enableSessionRecording"] = *bh.EnableSessionRecording
+	}
+	if bh.VirtualNetwork != nil {
+		props["virtualNetwork"] = bh.VirtualNetwork
+	}
+
+	return json.Marshal(out)
+}
+
+func (bh *BastionHost) UnmarshalJSON(body []byte) error {
+	if err := json.Unmarshal(body, &bh.BastionHost); err != nil {
+		return err
+	}
+
+	var extended bastionHostExtendedProperties
+	if err := json.Unmarshal(body, &extended); err != nil {
+		return err
+	}
+
+	if props := extended.Properties; props != nil {
+		bh.EnablePrivateOnlyBastion = props.EnablePrivateOnlyBastion
+		bh.EnableSessionRecording = props.EnableSessionRecording
+		bh.VirtualNetwork = props.VirtualNetwork
+	}
+
+	return nil
+}
+
+// CreateOrUpdateBastionHost creates or updates the specified Bastion Host using API Version 2024-01-01
+func CreateOrUpdateBastionHost(ctx context.Context, client *network.BastionHostsClient, resourceGroupName string, bastionHostName string, parameters BastionHost) (result network.BastionHostsCreateOrUpdateFuture, err error) {
+	parameters.Etag = nil
+	req, err := bastionHostPreparer(ctx, client, resourceGroupName, bastionHostName, autorest.AsPut(), autorest.WithJSON(parameters))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.BastionHostsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = client.CreateOrUpdateSender(req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.BastionHostsClient", "CreateOrUpdate", result.Response(), "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// GetBastionHost retrieves the specified Bastion Host using API Version 2024-01-01
+func GetBastionHost(ctx context.Context, client *network.BastionHostsClient, resourceGroupName string, bastionHostName string) (result BastionHost, err error) {
+	req, err := bastionHostPreparer(ctx, client, resourceGroupName, bastionHostName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.BastionHostsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "network.BastionHostsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "network.BastionHostsClient", "Get", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func bastionHostPreparer(ctx context.Context, client *network.BastionHostsClient, resourceGroupName string, bastionHostName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"bastionHostName":   autorest.Encode("path", bastionHostName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": bastionHostApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/bastionHosts/{bastionHostName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
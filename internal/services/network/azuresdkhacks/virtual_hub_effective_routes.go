@@ -0,0 +1,44 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+// GetVirtualHubEffectiveRoutes works around the Azure SDK for Go discarding the response body of the
+// GetEffectiveVirtualHubRoutes long running operation, which contains the list of effective routes
+func GetVirtualHubEffectiveRoutes(ctx context.Context, client *network.VirtualHubsClient, resourceGroupName string, virtualHubName string, parameters network.EffectiveRoutesParameters) (result network.VirtualHubEffectiveRouteList, err error) {
+	future, err := client.GetEffectiveVirtualHubRoutes(ctx, resourceGroupName, virtualHubName, &parameters)
+	if err != nil {
+		return result, err
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return result, err
+	}
+
+	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	resp, err := future.GetResult(sender)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "network.VirtualHubsClient", "GetEffectiveVirtualHubRoutes", resp, "Failure retrieving result")
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return result, nil
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "network.VirtualHubsClient", "GetEffectiveVirtualHubRoutes", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
@@ -1,15 +1,19 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -84,7 +88,7 @@ func resourceBastionHost() *pluginsdk.Resource {
 						},
 						"public_ip_address_id": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: validate.PublicIpAddressID,
 						},
@@ -98,6 +102,13 @@ func resourceBastionHost() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"private_only_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"scale_units": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -105,6 +116,12 @@ func resourceBastionHost() *pluginsdk.Resource {
 				Default:      2,
 			},
 
+			"session_recording_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"shareable_link_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -115,8 +132,10 @@ func resourceBastionHost() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ValidateFunc: validation.StringInSlice([]string{
+					string(azuresdkhacks.BastionHostSkuNameDeveloper),
 					string(network.BastionHostSkuNameBasic),
 					string(network.BastionHostSkuNameStandard),
+					string(azuresdkhacks.BastionHostSkuNamePremium),
 				}, false),
 				Default: string(network.BastionHostSkuNameBasic),
 			},
@@ -127,6 +146,13 @@ func resourceBastionHost() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"virtual_network_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateVirtualNetworkID,
+			},
+
 			"dns_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -134,6 +160,15 @@ func resourceBastionHost() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(bastionHostSkuCustomizeDiff),
+
+			// the `sku` of a Bastion Host can be upgraded but cannot be downgraded
+			pluginsdk.ForceNewIfChange("sku", func(ctx context.Context, old, new, meta interface{}) bool {
+				return bastionHostSkuTiers[old.(string)] > bastionHostSkuTiers[new.(string)]
+			}),
+		),
 	}
 }
 
@@ -148,32 +183,7 @@ func resourceBastionHostCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	id := parse.NewBastionHostID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
-	scaleUnits := d.Get("scale_units").(int)
 	sku := d.Get("sku").(string)
-	fileCopyEnabled := d.Get("file_copy_enabled").(bool)
-	ipConnectEnabled := d.Get("ip_connect_enabled").(bool)
-	shareableLinkEnabled := d.Get("shareable_link_enabled").(bool)
-	tunnelingEnabled := d.Get("tunneling_enabled").(bool)
-
-	if scaleUnits > 2 && sku == string(network.BastionHostSkuNameBasic) {
-		return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard`. `scale_units` is always `2` when `sku` is `Basic`")
-	}
-
-	if fileCopyEnabled && sku == string(network.BastionHostSkuNameBasic) {
-		return fmt.Errorf("`file_copy_enabled` is only supported when `sku` is `Standard`")
-	}
-
-	if ipConnectEnabled && sku == string(network.BastionHostSkuNameBasic) {
-		return fmt.Errorf("`ip_connect_enabled` is only supported when `sku` is `Standard`")
-	}
-
-	if shareableLinkEnabled && sku == string(network.BastionHostSkuNameBasic) {
-		return fmt.Errorf("`shareable_link_enabled` is only supported when `sku` is `Standard`")
-	}
-
-	if tunnelingEnabled && sku == string(network.BastionHostSkuNameBasic) {
-		return fmt.Errorf("`tunneling_enabled` is only supported when `sku` is `Standard`")
-	}
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
@@ -188,24 +198,34 @@ func resourceBastionHostCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	parameters := network.BastionHost{
-		Location: &location,
-		BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
-			DisableCopyPaste:    utils.Bool(!d.Get("copy_paste_enabled").(bool)),
-			EnableFileCopy:      utils.Bool(fileCopyEnabled),
-			EnableIPConnect:     utils.Bool(ipConnectEnabled),
-			EnableShareableLink: utils.Bool(shareableLinkEnabled),
-			EnableTunneling:     utils.Bool(tunnelingEnabled),
-			IPConfigurations:    expandBastionHostIPConfiguration(d.Get("ip_configuration").([]interface{})),
-			ScaleUnits:          utils.Int32(int32(d.Get("scale_units").(int))),
-		},
-		Sku: &network.Sku{
-			Name: network.BastionHostSkuName(sku),
+	parameters := azuresdkhacks.BastionHost{
+		BastionHost: network.BastionHost{
+			Location: &location,
+			BastionHostPropertiesFormat: &network.BastionHostPropertiesFormat{
+				DisableCopyPaste:    utils.Bool(!d.Get("copy_paste_enabled").(bool)),
+				EnableFileCopy:      utils.Bool(d.Get("file_copy_enabled").(bool)),
+				EnableIPConnect:     utils.Bool(d.Get("ip_connect_enabled").(bool)),
+				EnableShareableLink: utils.Bool(d.Get("shareable_link_enabled").(bool)),
+				EnableTunneling:     utils.Bool(d.Get("tunneling_enabled").(bool)),
+				IPConfigurations:    expandBastionHostIPConfiguration(d.Get("ip_configuration").([]interface{})),
+				ScaleUnits:          utils.Int32(int32(d.Get("scale_units").(int))),
+			},
+			Sku: &network.Sku{
+				Name: network.BastionHostSkuName(sku),
+			},
+			Tags: tags.Expand(t),
 		},
-		Tags: tags.Expand(t),
+		EnablePrivateOnlyBastion: utils.Bool(d.Get("private_only_enabled").(bool)),
+		EnableSessionRecording:   utils.Bool(d.Get("session_recording_enabled").(bool)),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if v := d.Get("virtual_network_id").(string); v != "" {
+		parameters.VirtualNetwork = &network.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
+	future, err := azuresdkhacks.CreateOrUpdateBastionHost(ctx, client, id.ResourceGroup, id.Name, parameters)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
@@ -219,6 +239,72 @@ func resourceBastionHostCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	return resourceBastionHostRead(d, meta)
 }
 
+// bastionHostSkuTiers orders the SKUs of a Bastion Host, from which a Bastion Host can only be upgraded in place
+var bastionHostSkuTiers = map[string]int{
+	string(azuresdkhacks.BastionHostSkuNameDeveloper): 0,
+	string(network.BastionHostSkuNameBasic):           1,
+	string(network.BastionHostSkuNameStandard):        2,
+	string(azuresdkhacks.BastionHostSkuNamePremium):   3,
+}
+
+// bastionHostSkuCustomizeDiff ensures the arguments which depend on the SKU are only specified when it supports them
+func bastionHostSkuCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	sku := d.Get("sku").(string)
+
+	if sku == string(network.BastionHostSkuNameBasic) || sku == string(azuresdkhacks.BastionHostSkuNameDeveloper) {
+		if d.Get("scale_units").(int) > 2 {
+			return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard` or `Premium`. `scale_units` is always `2` when `sku` is `%s`", sku)
+		}
+
+		for _, feature := range []string{"file_copy_enabled", "ip_connect_enabled", "shareable_link_enabled", "tunneling_enabled"} {
+			if d.Get(feature).(bool) {
+				return fmt.Errorf("`%s` is only supported when `sku` is `Standard` or `Premium`", feature)
+			}
+		}
+	}
+
+	privateOnlyEnabled := d.Get("private_only_enabled").(bool)
+	if sku != string(azuresdkhacks.BastionHostSkuNamePremium) {
+		for _, feature := range []string{"private_only_enabled", "session_recording_enabled"} {
+			if d.Get(feature).(bool) {
+				return fmt.Errorf("`%s` is only supported when `sku` is `Premium`", feature)
+			}
+		}
+	}
+
+	ipConfigurations := d.Get("ip_configuration").([]interface{})
+	if sku == string(azuresdkhacks.BastionHostSkuNameDeveloper) {
+		if len(ipConfigurations) > 0 {
+			return fmt.Errorf("`ip_configuration` isn't supported when `sku` is `Developer`")
+		}
+		if d.NewValueKnown("virtual_network_id") && d.Get("virtual_network_id").(string) == "" {
+			return fmt.Errorf("`virtual_network_id` must be specified when `sku` is `Developer`")
+		}
+
+		return nil
+	}
+
+	if len(ipConfigurations) == 0 || ipConfigurations[0] == nil {
+		return fmt.Errorf("`ip_configuration` must be specified when `sku` is `%s`", sku)
+	}
+	if d.Get("virtual_network_id").(string) != "" {
+		return fmt.Errorf("`virtual_network_id` is only supported when `sku` is `Developer`")
+	}
+
+	// the Public IP Address may not be known until apply when it's created alongside the Bastion Host
+	if d.NewValueKnown("ip_configuration.0.public_ip_address_id") {
+		publicIPAddressId := ipConfigurations[0].(map[string]interface{})["public_ip_address_id"].(string)
+		if privateOnlyEnabled && publicIPAddressId != "" {
+			return fmt.Errorf("`ip_configuration.0.public_ip_address_id` cannot be specified when `private_only_enabled` is `true`")
+		}
+		if !privateOnlyEnabled && publicIPAddressId == "" {
+			return fmt.Errorf("`ip_configuration.0.public_ip_address_id` must be specified when `private_only_enabled` is `false`")
+		}
+	}
+
+	return nil
+}
+
 func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.BastionHostsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -229,7 +315,7 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := azuresdkhacks.GetBastionHost(ctx, client, id.ResourceGroup, id.Name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			d.SetId("")
@@ -257,6 +343,18 @@ func resourceBastionHostRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("ip_connect_enabled", props.EnableIPConnect)
 		d.Set("shareable_link_enabled", props.EnableShareableLink)
 		d.Set("tunneling_enabled", props.EnableTunneling)
+		d.Set("private_only_enabled", pointer.From(resp.EnablePrivateOnlyBastion))
+		d.Set("session_recording_enabled", pointer.From(resp.EnableSessionRecording))
+
+		virtualNetworkId := ""
+		if resp.VirtualNetwork != nil && resp.VirtualNetwork.ID != nil {
+			parsed, err := commonids.ParseVirtualNetworkIDInsensitively(*resp.VirtualNetwork.ID)
+			if err != nil {
+				return err
+			}
+			virtualNetworkId = parsed.ID()
+		}
+		d.Set("virtual_network_id", virtualNetworkId)
 
 		copyPasteEnabled := true
 		if props.DisableCopyPaste != nil {
@@ -306,21 +404,24 @@ func expandBastionHostIPConfiguration(input []interface{}) (ipConfigs *[]network
 	property := input[0].(map[string]interface{})
 	ipConfName := property["name"].(string)
 	subID := property["subnet_id"].(string)
-	pipID := property["public_ip_address_id"].(string)
-
-	return &[]network.BastionHostIPConfiguration{
-		{
-			Name: &ipConfName,
-			BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
-				Subnet: &network.SubResource{
-					ID: &subID,
-				},
-				PublicIPAddress: &network.SubResource{
-					ID: &pipID,
-				},
+
+	ipConfig := network.BastionHostIPConfiguration{
+		Name: &ipConfName,
+		BastionHostIPConfigurationPropertiesFormat: &network.BastionHostIPConfigurationPropertiesFormat{
+			Subnet: &network.SubResource{
+				ID: &subID,
 			},
 		},
 	}
+
+	// a Private Only Bastion Host has no Public IP Address
+	if pipID := property["public_ip_address_id"].(string); pipID != "" {
+		ipConfig.BastionHostIPConfigurationPropertiesFormat.PublicIPAddress = &network.SubResource{
+			ID: &pipID,
+		}
+	}
+
+	return &[]network.BastionHostIPConfiguration{ipConfig}
 }
 
 func flattenBastionHostIPConfiguration(ipConfigs *[]network.BastionHostIPConfiguration) []interface{} {
@@ -341,7 +442,7 @@ func flattenBastionHostIPConfiguration(ipConfigs *[]network.BastionHostIPConfigu
 				ipConfig["subnet_id"] = *subnet.ID
 			}
 
-			if pip := props.PublicIPAddress; pip != nil {
+			if pip := props.PublicIPAddress; pip != nil && pip.ID != nil {
 				ipConfig["public_ip_address_id"] = *pip.ID
 			}
 		}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-09-01/networkmanagers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
@@ -23,11 +25,15 @@ type Client struct {
 	ExpressRoutePeeringsClient               *network.ExpressRouteCircuitPeeringsClient
 	ExpressRoutePortsClient                  *network.ExpressRoutePortsClient
 	ExpressRoutePortAuthorizationsClient     *network.ExpressRoutePortAuthorizationsClient
+	ExpressRouteTrafficCollectorsClient      *azuretrafficcollectors.AzureTrafficCollectorsClient
+	ExpressRouteCollectorPoliciesClient      *collectorpolicies.CollectorPoliciesClient
 	FlowLogsClient                           *network.FlowLogsClient
 	HubRouteTableClient                      *network.HubRouteTablesClient
 	HubVirtualNetworkConnectionClient        *network.HubVirtualNetworkConnectionsClient
 	InterfacesClient                         *network.InterfacesClient
 	IPGroupsClient                           *network.IPGroupsClient
+	IpamPoolsClient                          *IpamPoolsClient
+	IpamPoolStaticCidrsClient                *IpamPoolStaticCidrsClient
 	LocalNetworkGatewaysClient               *network.LocalNetworkGatewaysClient
 	ManagersClient                           *network.ManagersClient
 	ManagerAdminRulesClient                  *network.AdminRulesClient
@@ -47,6 +53,8 @@ type Client struct {
 	PrivateEndpointClient                    *network.PrivateEndpointsClient
 	PublicIPsClient                          *network.PublicIPAddressesClient
 	PublicIPPrefixesClient                   *network.PublicIPPrefixesClient
+	ReachabilityAnalysisIntentsClient        *ReachabilityAnalysisIntentsClient
+	ReachabilityAnalysisRunsClient           *ReachabilityAnalysisRunsClient
 	RouteMapsClient                          *network.RouteMapsClient
 	RoutesClient                             *network.RoutesClient
 	RouteFiltersClient                       *network.RouteFiltersClient
@@ -59,12 +67,15 @@ type Client struct {
 	ServiceTagsClient                        *network.ServiceTagsClient
 	SubnetsClient                            *network.SubnetsClient
 	NatGatewayClient                         *network.NatGatewaysClient
+	VerifierWorkspacesClient                 *VerifierWorkspacesClient
 	VirtualHubBgpConnectionClient            *network.VirtualHubBgpConnectionClient
+	VirtualHubBgpConnectionsClient           *network.VirtualHubBgpConnectionsClient
 	VirtualHubIPClient                       *network.VirtualHubIPConfigurationClient
 	VnetGatewayConnectionsClient             *network.VirtualNetworkGatewayConnectionsClient
 	VnetGatewayNatRuleClient                 *network.VirtualNetworkGatewayNatRulesClient
 	VnetGatewayClient                        *network.VirtualNetworkGatewaysClient
 	VnetClient                               *network.VirtualNetworksClient
+	VnetIpamClient                           *VirtualNetworkIpamClient
 	VnetPeeringsClient                       *network.VirtualNetworkPeeringsClient
 	VirtualWanClient                         *network.VirtualWansClient
 	VirtualHubClient                         *network.VirtualHubsClient
@@ -123,6 +134,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	ExpressRoutePortAuthorizationsClient := network.NewExpressRoutePortAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExpressRoutePortAuthorizationsClient.Client, o.ResourceManagerAuthorizer)
 
+	ExpressRouteTrafficCollectorsClient := azuretrafficcollectors.NewAzureTrafficCollectorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ExpressRouteTrafficCollectorsClient.Client, o.ResourceManagerAuthorizer)
+
+	ExpressRouteCollectorPoliciesClient := collectorpolicies.NewCollectorPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ExpressRouteCollectorPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	FlowLogsClient := network.NewFlowLogsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FlowLogsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -159,6 +176,42 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(ManagerDeploymentsClient.Client, o.Authorizers.ResourceManager)
 
+	IpamPoolsClient, err := NewIpamPoolsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ipam pools client: %+v", err)
+	}
+	o.Configure(IpamPoolsClient.Client, o.Authorizers.ResourceManager)
+
+	IpamPoolStaticCidrsClient, err := NewIpamPoolStaticCidrsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ipam pool static cidrs client: %+v", err)
+	}
+	o.Configure(IpamPoolStaticCidrsClient.Client, o.Authorizers.ResourceManager)
+
+	VnetIpamClient, err := NewVirtualNetworkIpamClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building virtual network ipam client: %+v", err)
+	}
+	o.Configure(VnetIpamClient.Client, o.Authorizers.ResourceManager)
+
+	VerifierWorkspacesClient, err := NewVerifierWorkspacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building verifier workspaces client: %+v", err)
+	}
+	o.Configure(VerifierWorkspacesClient.Client, o.Authorizers.ResourceManager)
+
+	ReachabilityAnalysisIntentsClient, err := NewReachabilityAnalysisIntentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building reachability analysis intents client: %+v", err)
+	}
+	o.Configure(ReachabilityAnalysisIntentsClient.Client, o.Authorizers.ResourceManager)
+
+	ReachabilityAnalysisRunsClient, err := NewReachabilityAnalysisRunsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building reachability analysis runs client: %+v", err)
+	}
+	o.Configure(ReachabilityAnalysisRunsClient.Client, o.Authorizers.ResourceManager)
+
 	ManagerDeploymentStatusClient := network.NewManagerDeploymentStatusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ManagerDeploymentStatusClient.Client, o.ResourceManagerAuthorizer)
 
@@ -252,6 +305,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	VirtualHubBgpConnectionClient := network.NewVirtualHubBgpConnectionClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubBgpConnectionClient.Client, o.ResourceManagerAuthorizer)
 
+	VirtualHubBgpConnectionsClient := network.NewVirtualHubBgpConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualHubBgpConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	VirtualHubIPClient := network.NewVirtualHubIPConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubIPClient.Client, o.ResourceManagerAuthorizer)
 
@@ -309,11 +365,15 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ExpressRoutePeeringsClient:               &ExpressRoutePeeringsClient,
 		ExpressRoutePortsClient:                  &ExpressRoutePortsClient,
 		ExpressRoutePortAuthorizationsClient:     &ExpressRoutePortAuthorizationsClient,
+		ExpressRouteTrafficCollectorsClient:      &ExpressRouteTrafficCollectorsClient,
+		ExpressRouteCollectorPoliciesClient:      &ExpressRouteCollectorPoliciesClient,
 		FlowLogsClient:                           &FlowLogsClient,
 		HubRouteTableClient:                      &HubRouteTableClient,
 		HubVirtualNetworkConnectionClient:        &HubVirtualNetworkConnectionClient,
 		InterfacesClient:                         &InterfacesClient,
 		IPGroupsClient:                           &IpGroupsClient,
+		IpamPoolsClient:                          IpamPoolsClient,
+		IpamPoolStaticCidrsClient:                IpamPoolStaticCidrsClient,
 		LocalNetworkGatewaysClient:               &LocalNetworkGatewaysClient,
 		ManagersClient:                           &ManagersClient,
 		ManagerAdminRulesClient:                  &ManagerAdminRulesClient,
@@ -333,6 +393,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		PrivateEndpointClient:                    &PrivateEndpointClient,
 		PublicIPsClient:                          &PublicIPsClient,
 		PublicIPPrefixesClient:                   &PublicIPPrefixesClient,
+		ReachabilityAnalysisIntentsClient:        ReachabilityAnalysisIntentsClient,
+		ReachabilityAnalysisRunsClient:           ReachabilityAnalysisRunsClient,
 		RouteMapsClient:                          &RouteMapsClient,
 		RoutesClient:                             &RoutesClient,
 		RouteFiltersClient:                       &RouteFiltersClient,
@@ -345,12 +407,15 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ServiceTagsClient:                        &ServiceTagsClient,
 		SubnetsClient:                            &SubnetsClient,
 		NatGatewayClient:                         &NatGatewayClient,
+		VerifierWorkspacesClient:                 VerifierWorkspacesClient,
 		VirtualHubBgpConnectionClient:            &VirtualHubBgpConnectionClient,
+		VirtualHubBgpConnectionsClient:           &VirtualHubBgpConnectionsClient,
 		VirtualHubIPClient:                       &VirtualHubIPClient,
 		VnetGatewayConnectionsClient:             &VnetGatewayConnectionsClient,
 		VnetGatewayNatRuleClient:                 &VnetGatewayNatRuleClient,
 		VnetGatewayClient:                        &VnetGatewayClient,
 		VnetClient:                               &VnetClient,
+		VnetIpamClient:                           VnetIpamClient,
 		VnetPeeringsClient:                       &VnetPeeringsClient,
 		VirtualWanClient:                         &VirtualWanClient,
 		VirtualHubClient:                         &VirtualHubClient,
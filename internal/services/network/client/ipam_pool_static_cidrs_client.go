@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type IpamPoolStaticCidrsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewIpamPoolStaticCidrsClientWithBaseURI(api environments.Api) (*IpamPoolStaticCidrsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "staticcidrs", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IpamPoolStaticCidrsClient: %+v", err)
+	}
+
+	return &IpamPoolStaticCidrsClient{
+		Client: client,
+	}, nil
+}
+
+type IpamPoolStaticCidr struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *IpamPoolStaticCidrProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
+
+type IpamPoolStaticCidrProperties struct {
+	AddressPrefixes               *[]string `json:"addressPrefixes,omitempty"`
+	Description                   *string   `json:"description,omitempty"`
+	NumberOfIPAddressesToAllocate *string   `json:"numberOfIPAddressesToAllocate,omitempty"`
+	ProvisioningState             *string   `json:"provisioningState,omitempty"`
+	TotalNumberOfIPAddresses      *string   `json:"totalNumberOfIPAddresses,omitempty"`
+}
+
+type IpamPoolStaticCidrsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *IpamPoolStaticCidr
+}
+
+// Get retrieves the specified Static CIDR within an IPAM Pool
+func (c IpamPoolStaticCidrsClient) Get(ctx context.Context, id parse.NetworkManagerIpamPoolStaticCidrId) (result IpamPoolStaticCidrsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// Create creates or replaces the specified Static CIDR within an IPAM Pool, which completes synchronously
+func (c IpamPoolStaticCidrsClient) Create(ctx context.Context, id parse.NetworkManagerIpamPoolStaticCidrId, input IpamPoolStaticCidr) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	if _, err = req.Execute(ctx); err != nil {
+		return fmt.Errorf("performing Create: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the specified Static CIDR within an IPAM Pool, then polls until it's been removed
+func (c IpamPoolStaticCidrsClient) DeleteThenPoll(ctx context.Context, id parse.NetworkManagerIpamPoolStaticCidrId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
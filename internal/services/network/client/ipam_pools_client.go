@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// IP Address Management (IPAM) for Network Managers is only available in newer API Versions than the ones used by the
+// Provider, as such these are managed using base-layer clients until the SDK can be upgraded
+const ipamApiVersion = "2024-05-01"
+
+type IpamPoolsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewIpamPoolsClientWithBaseURI(api environments.Api) (*IpamPoolsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "ipampools", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IpamPoolsClient: %+v", err)
+	}
+
+	return &IpamPoolsClient{
+		Client: client,
+	}, nil
+}
+
+type IpamPool struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties IpamPoolProperties `json:"properties"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type IpamPoolProperties struct {
+	AddressPrefixes   []string  `json:"addressPrefixes"`
+	Description       *string   `json:"description,omitempty"`
+	DisplayName       *string   `json:"displayName,omitempty"`
+	IPAddressType     *[]string `json:"ipAddressType,omitempty"`
+	ParentPoolName    *string   `json:"parentPoolName,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+}
+
+type IpamPoolsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *IpamPool
+}
+
+// Get retrieves the specified IPAM Pool
+func (c IpamPoolsClient) Get(ctx context.Context, id parse.NetworkManagerIpamPoolId) (result IpamPoolsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateThenPoll creates or replaces the specified IPAM Pool, then polls until it's been provisioned
+func (c IpamPoolsClient) CreateThenPoll(ctx context.Context, id parse.NetworkManagerIpamPoolId, input IpamPool) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Create: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Create: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the specified IPAM Pool, then polls until it's been removed
+func (c IpamPoolsClient) DeleteThenPoll(ctx context.Context, id parse.NetworkManagerIpamPoolId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type ReachabilityAnalysisIntentsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewReachabilityAnalysisIntentsClientWithBaseURI(api environments.Api) (*ReachabilityAnalysisIntentsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "reachabilityanalysisintents", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ReachabilityAnalysisIntentsClient: %+v", err)
+	}
+
+	return &ReachabilityAnalysisIntentsClient{
+		Client: client,
+	}, nil
+}
+
+type ReachabilityAnalysisIntent struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties ReachabilityAnalysisIntentProperties `json:"properties"`
+	Type       *string                              `json:"type,omitempty"`
+}
+
+type ReachabilityAnalysisIntentProperties struct {
+	Description           *string   `json:"description,omitempty"`
+	DestinationResourceId string    `json:"destinationResourceId"`
+	IPTraffic             IPTraffic `json:"ipTraffic"`
+	ProvisioningState     *string   `json:"provisioningState,omitempty"`
+	SourceResourceId      string    `json:"sourceResourceId"`
+}
+
+type IPTraffic struct {
+	DestinationIps   []string `json:"destinationIps"`
+	DestinationPorts []string `json:"destinationPorts"`
+	Protocols        []string `json:"protocols"`
+	SourceIps        []string `json:"sourceIps"`
+	SourcePorts      []string `json:"sourcePorts"`
+}
+
+type ReachabilityAnalysisIntentsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ReachabilityAnalysisIntent
+}
+
+// Get retrieves the specified Reachability Analysis Intent within a Verifier Workspace
+func (c ReachabilityAnalysisIntentsClient) Get(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId) (result ReachabilityAnalysisIntentsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// Create creates the specified Reachability Analysis Intent within a Verifier Workspace, which completes synchronously
+func (c ReachabilityAnalysisIntentsClient) Create(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId, input ReachabilityAnalysisIntent) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	if _, err = req.Execute(ctx); err != nil {
+		return fmt.Errorf("performing Create: %+v", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the specified Reachability Analysis Intent within a Verifier Workspace, which completes synchronously
+func (c ReachabilityAnalysisIntentsClient) Delete(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if _, err = req.Execute(ctx); err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	return nil
+}
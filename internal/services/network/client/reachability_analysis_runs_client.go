@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+type ReachabilityAnalysisRunsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewReachabilityAnalysisRunsClientWithBaseURI(api environments.Api) (*ReachabilityAnalysisRunsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "reachabilityanalysisruns", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ReachabilityAnalysisRunsClient: %+v", err)
+	}
+
+	return &ReachabilityAnalysisRunsClient{
+		Client: client,
+	}, nil
+}
+
+type ReachabilityAnalysisRun struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties ReachabilityAnalysisRunProperties `json:"properties"`
+	Type       *string                           `json:"type,omitempty"`
+}
+
+type ReachabilityAnalysisRunProperties struct {
+	AnalysisResult    *string                               `json:"analysisResult,omitempty"`
+	Description       *string                               `json:"description,omitempty"`
+	ErrorMessage      *string                               `json:"errorMessage,omitempty"`
+	IntentContent     *ReachabilityAnalysisIntentProperties `json:"intentContent,omitempty"`
+	IntentId          string                                `json:"intentId"`
+	ProvisioningState *string                               `json:"provisioningState,omitempty"`
+}
+
+type ReachabilityAnalysisRunsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ReachabilityAnalysisRun
+}
+
+// Get retrieves the specified Reachability Analysis Run within a Verifier Workspace
+func (c ReachabilityAnalysisRunsClient) Get(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId) (result ReachabilityAnalysisRunsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateThenPoll starts the specified Reachability Analysis Run within a Verifier Workspace, then polls until the
+// analysis has completed
+func (c ReachabilityAnalysisRunsClient) CreateThenPoll(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId, input ReachabilityAnalysisRun) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Create: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Create: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the specified Reachability Analysis Run within a Verifier Workspace, then polls until it's
+// been removed
+func (c ReachabilityAnalysisRunsClient) DeleteThenPoll(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
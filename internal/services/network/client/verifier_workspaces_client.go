@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// Verifier Workspaces for Network Managers are only available in the same newer API Version as IPAM, as such these are
+// managed using base-layer clients until the SDK can be upgraded
+type VerifierWorkspacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewVerifierWorkspacesClientWithBaseURI(api environments.Api) (*VerifierWorkspacesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "verifierworkspaces", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating VerifierWorkspacesClient: %+v", err)
+	}
+
+	return &VerifierWorkspacesClient{
+		Client: client,
+	}, nil
+}
+
+type VerifierWorkspace struct {
+	Id         *string                      `json:"id,omitempty"`
+	Location   string                       `json:"location"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *VerifierWorkspaceProperties `json:"properties,omitempty"`
+	Tags       *map[string]string           `json:"tags,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type VerifierWorkspaceProperties struct {
+	Description       *string `json:"description,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type VerifierWorkspacesGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *VerifierWorkspace
+}
+
+// Get retrieves the specified Verifier Workspace
+func (c VerifierWorkspacesClient) Get(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceId) (result VerifierWorkspacesGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the specified Verifier Workspace, then polls until it's been provisioned
+func (c VerifierWorkspacesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceId, input VerifierWorkspace) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the specified Verifier Workspace, then polls until it's been removed
+func (c VerifierWorkspacesClient) DeleteThenPoll(ctx context.Context, id parse.NetworkManagerVerifierWorkspaceId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
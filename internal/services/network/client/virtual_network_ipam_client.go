@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+// VirtualNetworkIpamClient is used to manage Virtual Networks which draw their address space from an IPAM Pool, since
+// the API Version used by the Virtual Networks Client doesn't support IPAM Pool Prefix Allocations
+type VirtualNetworkIpamClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewVirtualNetworkIpamClientWithBaseURI(api environments.Api) (*VirtualNetworkIpamClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "virtualnetworks", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating VirtualNetworkIpamClient: %+v", err)
+	}
+
+	return &VirtualNetworkIpamClient{
+		Client: client,
+	}, nil
+}
+
+type VirtualNetworkIpam struct {
+	Properties *VirtualNetworkIpamProperties `json:"properties,omitempty"`
+}
+
+type VirtualNetworkIpamProperties struct {
+	AddressSpace *VirtualNetworkIpamAddressSpace `json:"addressSpace,omitempty"`
+}
+
+type VirtualNetworkIpamAddressSpace struct {
+	AddressPrefixes           *[]string                   `json:"addressPrefixes,omitempty"`
+	IpamPoolPrefixAllocations *[]IpamPoolPrefixAllocation `json:"ipamPoolPrefixAllocations,omitempty"`
+}
+
+type IpamPoolPrefixAllocation struct {
+	AllocatedAddressPrefixes *[]string                     `json:"allocatedAddressPrefixes,omitempty"`
+	NumberOfIPAddresses      *string                       `json:"numberOfIpAddresses,omitempty"`
+	Pool                     *IpamPoolPrefixAllocationPool `json:"pool,omitempty"`
+}
+
+type IpamPoolPrefixAllocationPool struct {
+	Id *string `json:"id,omitempty"`
+}
+
+type VirtualNetworkIpamGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *VirtualNetworkIpam
+}
+
+// Get retrieves the IPAM Pool Prefix Allocations for the specified Virtual Network
+func (c VirtualNetworkIpamClient) Get(ctx context.Context, id parse.VirtualNetworkId) (result VirtualNetworkIpamGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the specified Virtual Network using the payload as-is, then polls until
+// it's been provisioned - the payload is untyped so that the Virtual Network can be built using the existing models
+func (c VirtualNetworkIpamClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.VirtualNetworkId, input map[string]interface{}) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
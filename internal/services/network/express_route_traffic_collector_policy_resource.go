@@ -0,0 +1,288 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExpressRouteTrafficCollectorPolicyModel struct {
+	Name                           string            `tfschema:"name"`
+	ExpressRouteTrafficCollectorId string            `tfschema:"express_route_traffic_collector_id"`
+	Location                       string            `tfschema:"location"`
+	ExpressRouteCircuitIds         []string          `tfschema:"express_route_circuit_ids"`
+	EmissionDestinationTypes       []string          `tfschema:"emission_destination_types"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+type ExpressRouteTrafficCollectorPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ExpressRouteTrafficCollectorPolicyResource{}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) ResourceType() string {
+	return "azurerm_express_route_traffic_collector_policy"
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) ModelObject() interface{} {
+	return &ExpressRouteTrafficCollectorPolicyModel{}
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return collectorpolicies.ValidateCollectorPolicyID
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"express_route_traffic_collector_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuretrafficcollectors.ValidateAzureTrafficCollectorID,
+		},
+
+		"location": commonschema.Location(),
+
+		"express_route_circuit_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.ExpressRouteCircuitID,
+			},
+		},
+
+		"emission_destination_types": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(collectorpolicies.PossibleValuesForDestinationType(), false),
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCollectorPoliciesClient
+
+			var model ExpressRouteTrafficCollectorPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			collectorId, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(model.ExpressRouteTrafficCollectorId)
+			if err != nil {
+				return err
+			}
+
+			id := collectorpolicies.NewCollectorPolicyID(collectorId.SubscriptionId, collectorId.ResourceGroupName, collectorId.AzureTrafficCollectorName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandExpressRouteTrafficCollectorPolicy(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCollectorPoliciesClient
+
+			id, err := collectorpolicies.ParseCollectorPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ExpressRouteTrafficCollectorPolicyModel{
+				Name:                           id.CollectorPolicyName,
+				ExpressRouteTrafficCollectorId: azuretrafficcollectors.NewAzureTrafficCollectorID(id.SubscriptionId, id.ResourceGroupName, id.AzureTrafficCollectorName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ExpressRouteCircuitIds = flattenExpressRouteTrafficCollectorPolicyIngestionSources(props.IngestionPolicy)
+					state.EmissionDestinationTypes = flattenExpressRouteTrafficCollectorPolicyEmissionDestinationTypes(props.EmissionPolicies)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCollectorPoliciesClient
+
+			id, err := collectorpolicies.ParseCollectorPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ExpressRouteTrafficCollectorPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("express_route_circuit_ids", "emission_destination_types") {
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, expandExpressRouteTrafficCollectorPolicy(model)); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+				return nil
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := collectorpolicies.TagsObject{
+					Tags: pointer.To(model.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteCollectorPoliciesClient
+
+			id, err := collectorpolicies.ParseCollectorPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandExpressRouteTrafficCollectorPolicy(input ExpressRouteTrafficCollectorPolicyModel) collectorpolicies.CollectorPolicy {
+	ingestionSources := make([]collectorpolicies.IngestionSourcesPropertiesFormat, 0)
+	for _, v := range input.ExpressRouteCircuitIds {
+		ingestionSources = append(ingestionSources, collectorpolicies.IngestionSourcesPropertiesFormat{
+			ResourceId: pointer.To(v),
+			SourceType: pointer.To(collectorpolicies.SourceTypeResource),
+		})
+	}
+
+	emissionDestinations := make([]collectorpolicies.EmissionPolicyDestination, 0)
+	for _, v := range input.EmissionDestinationTypes {
+		emissionDestinations = append(emissionDestinations, collectorpolicies.EmissionPolicyDestination{
+			DestinationType: pointer.To(collectorpolicies.DestinationType(v)),
+		})
+	}
+
+	return collectorpolicies.CollectorPolicy{
+		Location: location.Normalize(input.Location),
+		Properties: &collectorpolicies.CollectorPolicyPropertiesFormat{
+			IngestionPolicy: &collectorpolicies.IngestionPolicyPropertiesFormat{
+				IngestionSources: &ingestionSources,
+				IngestionType:    pointer.To(collectorpolicies.IngestionTypeIPFIX),
+			},
+			EmissionPolicies: &[]collectorpolicies.EmissionPoliciesPropertiesFormat{
+				{
+					EmissionDestinations: &emissionDestinations,
+					EmissionType:         pointer.To(collectorpolicies.EmissionTypeIPFIX),
+				},
+			},
+		},
+		Tags: pointer.To(input.Tags),
+	}
+}
+
+func flattenExpressRouteTrafficCollectorPolicyIngestionSources(input *collectorpolicies.IngestionPolicyPropertiesFormat) []string {
+	output := make([]string, 0)
+	if input == nil || input.IngestionSources == nil {
+		return output
+	}
+
+	for _, v := range *input.IngestionSources {
+		if v.ResourceId != nil {
+			output = append(output, *v.ResourceId)
+		}
+	}
+
+	return output
+}
+
+func flattenExpressRouteTrafficCollectorPolicyEmissionDestinationTypes(input *[]collectorpolicies.EmissionPoliciesPropertiesFormat) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, policy := range *input {
+		if policy.EmissionDestinations == nil {
+			continue
+		}
+
+		for _, v := range *policy.EmissionDestinations {
+			if v.DestinationType != nil {
+				output = append(output, string(*v.DestinationType))
+			}
+		}
+	}
+
+	return output
+}
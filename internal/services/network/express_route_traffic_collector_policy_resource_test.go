@@ -0,0 +1,186 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ExpressRouteTrafficCollectorPolicyResource struct{}
+
+func TestAccExpressRouteTrafficCollectorPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_traffic_collector_policy", "test")
+	r := ExpressRouteTrafficCollectorPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccExpressRouteTrafficCollectorPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_traffic_collector_policy", "test")
+	r := ExpressRouteTrafficCollectorPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccExpressRouteTrafficCollectorPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_traffic_collector_policy", "test")
+	r := ExpressRouteTrafficCollectorPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("express_route_circuit_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := collectorpolicies.ParseCollectorPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ExpressRouteCollectorPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_traffic_collector" "test" {
+  name                = "acctest-ertc-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+
+resource "azurerm_express_route_circuit" "second" {
+  name                  = "acctest-erc2-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_traffic_collector_policy" "test" {
+  name                               = "acctest-ertcp-%d"
+  express_route_traffic_collector_id = azurerm_express_route_traffic_collector.test.id
+  location                           = azurerm_express_route_traffic_collector.test.location
+  express_route_circuit_ids          = [azurerm_express_route_circuit.test.id]
+  emission_destination_types         = ["AzureMonitor"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_traffic_collector_policy" "import" {
+  name                               = azurerm_express_route_traffic_collector_policy.test.name
+  express_route_traffic_collector_id = azurerm_express_route_traffic_collector_policy.test.express_route_traffic_collector_id
+  location                           = azurerm_express_route_traffic_collector_policy.test.location
+  express_route_circuit_ids          = azurerm_express_route_traffic_collector_policy.test.express_route_circuit_ids
+  emission_destination_types         = azurerm_express_route_traffic_collector_policy.test.emission_destination_types
+}
+`, r.basic(data))
+}
+
+func (r ExpressRouteTrafficCollectorPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_traffic_collector_policy" "test" {
+  name                               = "acctest-ertcp-%d"
+  express_route_traffic_collector_id = azurerm_express_route_traffic_collector.test.id
+  location                           = azurerm_express_route_traffic_collector.test.location
+  express_route_circuit_ids = [
+    azurerm_express_route_circuit.test.id,
+    azurerm_express_route_circuit.second.id,
+  ]
+  emission_destination_types = ["AzureMonitor"]
+
+  tags = {
+    Environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,211 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExpressRouteTrafficCollectorModel struct {
+	Name               string            `tfschema:"name"`
+	ResourceGroupName  string            `tfschema:"resource_group_name"`
+	Location           string            `tfschema:"location"`
+	CollectorPolicyIds []string          `tfschema:"collector_policy_ids"`
+	VirtualHubId       string            `tfschema:"virtual_hub_id"`
+	Tags               map[string]string `tfschema:"tags"`
+}
+
+type ExpressRouteTrafficCollectorResource struct{}
+
+var _ sdk.ResourceWithUpdate = ExpressRouteTrafficCollectorResource{}
+
+func (r ExpressRouteTrafficCollectorResource) ResourceType() string {
+	return "azurerm_express_route_traffic_collector"
+}
+
+func (r ExpressRouteTrafficCollectorResource) ModelObject() interface{} {
+	return &ExpressRouteTrafficCollectorModel{}
+}
+
+func (r ExpressRouteTrafficCollectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuretrafficcollectors.ValidateAzureTrafficCollectorID
+}
+
+func (r ExpressRouteTrafficCollectorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ExpressRouteTrafficCollectorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"collector_policy_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"virtual_hub_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteTrafficCollectorsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ExpressRouteTrafficCollectorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := azuretrafficcollectors.NewAzureTrafficCollectorID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := azuretrafficcollectors.AzureTrafficCollector{
+				Location:   location.Normalize(model.Location),
+				Properties: &azuretrafficcollectors.AzureTrafficCollectorPropertiesFormat{},
+				Tags:       pointer.To(model.Tags),
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteTrafficCollectorsClient
+
+			id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ExpressRouteTrafficCollectorModel{
+				Name:              id.AzureTrafficCollectorName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					collectorPolicyIds := make([]string, 0)
+					if props.CollectorPolicies != nil {
+						for _, v := range *props.CollectorPolicies {
+							if v.Id != nil {
+								collectorPolicyIds = append(collectorPolicyIds, *v.Id)
+							}
+						}
+					}
+					state.CollectorPolicyIds = collectorPolicyIds
+
+					if props.VirtualHub != nil {
+						state.VirtualHubId = pointer.From(props.VirtualHub.Id)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteTrafficCollectorsClient
+
+			id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ExpressRouteTrafficCollectorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := azuretrafficcollectors.TagsObject{
+					Tags: pointer.To(model.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ExpressRouteTrafficCollectorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ExpressRouteTrafficCollectorsClient
+
+			id, err := azuretrafficcollectors.ParseAzureTrafficCollectorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -165,3 +165,26 @@ func (NetworkWatcherFlowLogV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
 		return rawState, nil
 	}
 }
+
+var _ pluginsdk.StateUpgrade = NetworkWatcherFlowLogV1ToV2{}
+
+type NetworkWatcherFlowLogV1ToV2 struct{}
+
+func (NetworkWatcherFlowLogV1ToV2) Schema() map[string]*pluginsdk.Schema {
+	// the schema is unchanged between V0 and V1, only the format of the ID changed
+	return NetworkWatcherFlowLogV0ToV1{}.Schema()
+}
+
+func (NetworkWatcherFlowLogV1ToV2) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// `target_resource_id` supersedes `network_security_group_id`, which was the only supported target prior to V2
+		if v, ok := rawState["network_security_group_id"].(string); ok && v != "" {
+			if _, exists := rawState["target_resource_id"]; !exists {
+				log.Printf("[DEBUG] Setting `target_resource_id` to %q", v)
+				rawState["target_resource_id"] = v
+			}
+		}
+
+		return rawState, nil
+	}
+}
@@ -111,10 +111,11 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 						},
 
 						"gateway_load_balancer_frontend_ip_configuration_id": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: lbvalidate.LoadBalancerFrontendIpConfigurationID,
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     lbvalidate.GatewayLoadBalancerFrontendIpConfigurationID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 					},
 				},
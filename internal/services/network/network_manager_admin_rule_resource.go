@@ -3,6 +3,7 @@ package network
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -35,7 +36,10 @@ type AddressPrefixItemModel struct {
 
 type ManagerAdminRuleResource struct{}
 
-var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ManagerAdminRuleResource{}
+	_ sdk.ResourceWithCustomizeDiff = ManagerAdminRuleResource{}
+)
 
 func (r ManagerAdminRuleResource) ResourceType() string {
 	return "azurerm_network_manager_admin_rule"
@@ -179,6 +183,44 @@ func (r ManagerAdminRuleResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r ManagerAdminRuleResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+			if diff.Id() != "" && !diff.HasChanges("admin_rule_collection_id", "direction", "priority") {
+				return nil
+			}
+
+			// the conflict can only be checked once the rule collection, direction and priority are all known
+			for _, key := range []string{"admin_rule_collection_id", "direction", "priority"} {
+				if !diff.NewValueKnown(key) {
+					return nil
+				}
+			}
+
+			ruleCollectionId, err := parse.NetworkManagerAdminRuleCollectionID(diff.Get("admin_rule_collection_id").(string))
+			if err != nil {
+				return err
+			}
+
+			direction := network.SecurityConfigurationRuleDirection(diff.Get("direction").(string))
+			priority := int32(diff.Get("priority").(int))
+
+			conflict, err := findConflictingManagerAdminRule(ctx, metadata, *ruleCollectionId, diff.Id(), direction, priority)
+			if err != nil {
+				return err
+			}
+
+			if conflict != nil {
+				return fmt.Errorf("the %s rule priority %d is already in use by the Admin Rule %q - priorities must be unique per direction across all Rule Collections within a Security Admin Configuration", direction, priority, *conflict)
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -400,6 +442,51 @@ func (r ManagerAdminRuleResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+// findConflictingManagerAdminRule returns the ID of the Admin Rule within any Rule Collection of the same Security Admin
+// Configuration which uses the same direction and priority as the Admin Rule being planned, if one exists
+func findConflictingManagerAdminRule(ctx context.Context, metadata sdk.ResourceMetaData, ruleCollectionId parse.NetworkManagerAdminRuleCollectionId, currentId string, direction network.SecurityConfigurationRuleDirection, priority int32) (*string, error) {
+	collectionsClient := metadata.Client.Network.ManagerAdminRuleCollectionsClient
+	rulesClient := metadata.Client.Network.ManagerAdminRulesClient
+
+	configurationId := parse.NewNetworkManagerSecurityAdminConfigurationID(ruleCollectionId.SubscriptionId, ruleCollectionId.ResourceGroup, ruleCollectionId.NetworkManagerName, ruleCollectionId.SecurityAdminConfigurationName)
+
+	collectionsIterator, err := collectionsClient.ListComplete(ctx, configurationId.ResourceGroup, configurationId.NetworkManagerName, configurationId.SecurityAdminConfigurationName, nil, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(collectionsIterator.Response().Response) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("listing Rule Collections within %s: %+v", configurationId, err)
+	}
+
+	for collectionsIterator.NotDone() {
+		collection := collectionsIterator.Value()
+		if collection.Name != nil {
+			rulesIterator, err := rulesClient.ListComplete(ctx, configurationId.ResourceGroup, configurationId.NetworkManagerName, configurationId.SecurityAdminConfigurationName, *collection.Name, nil, "")
+			if err != nil {
+				return nil, fmt.Errorf("listing Admin Rules within Rule Collection %q of %s: %+v", *collection.Name, configurationId, err)
+			}
+
+			for rulesIterator.NotDone() {
+				if rule, ok := rulesIterator.Value().AsAdminRule(); ok && rule.ID != nil && !strings.EqualFold(*rule.ID, currentId) {
+					if props := rule.AdminPropertiesFormat; props != nil && props.Direction == direction && props.Priority != nil && *props.Priority == priority {
+						return rule.ID, nil
+					}
+				}
+
+				if err := rulesIterator.NextWithContext(ctx); err != nil {
+					return nil, fmt.Errorf("listing Admin Rules within Rule Collection %q of %s: %+v", *collection.Name, configurationId, err)
+				}
+			}
+		}
+
+		if err := collectionsIterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Rule Collections within %s: %+v", configurationId, err)
+		}
+	}
+
+	return nil, nil
+}
+
 func expandAddressPrefixItemModel(inputList []AddressPrefixItemModel) *[]network.AddressPrefixItem {
 	var outputList []network.AddressPrefixItem
 	for _, v := range inputList {
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -85,6 +86,24 @@ func testAccNetworkManagerAdminRule_update(t *testing.T) {
 	})
 }
 
+func testAccNetworkManagerAdminRule_priorityConflict(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := ManagerAdminRuleResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			// the second Rule Collection needs to exist before the conflict can be detected at plan time
+			Config: r.additionalRuleCollection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.priorityConflict(data),
+			ExpectError: regexp.MustCompile("rule priority 1 is already in use"),
+		},
+	})
+}
+
 func (r ManagerAdminRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NetworkManagerAdminRuleID(state.ID)
 	if err != nil {
@@ -234,3 +253,32 @@ resource "azurerm_network_manager_admin_rule" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r ManagerAdminRuleResource) additionalRuleCollection(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule_collection" "test2" {
+  name                            = "acctest-nmarc2-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+}
+`, config, data.RandomInteger)
+}
+
+func (r ManagerAdminRuleResource) priorityConflict(data acceptance.TestData) string {
+	config := r.additionalRuleCollection(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_admin_rule" "test2" {
+  name                     = "acctest-nmar2-%d"
+  admin_rule_collection_id = azurerm_network_manager_admin_rule_collection.test2.id
+  action                   = "Allow"
+  direction                = "Outbound"
+  protocol                 = "Udp"
+  priority                 = 1
+}
+`, config, data.RandomInteger)
+}
@@ -40,7 +40,10 @@ type HubModel struct {
 
 type ManagerConnectivityConfigurationResource struct{}
 
-var _ sdk.ResourceWithUpdate = ManagerConnectivityConfigurationResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ManagerConnectivityConfigurationResource{}
+	_ sdk.ResourceWithCustomizeDiff = ManagerConnectivityConfigurationResource{}
+)
 
 func (r ManagerConnectivityConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_connectivity_configuration"
@@ -155,6 +158,50 @@ func (r ManagerConnectivityConfigurationResource) Attributes() map[string]*plugi
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r ManagerConnectivityConfigurationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerConnectivityConfigurationModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the topology may not be known until apply when it's sourced from another resource
+			if model.ConnectivityTopology == "" {
+				return nil
+			}
+
+			isHubAndSpoke := model.ConnectivityTopology == network.ConnectivityTopologyHubAndSpoke
+			if isHubAndSpoke && len(model.Hub) == 0 && metadata.ResourceDiff.NewValueKnown("hub") {
+				return fmt.Errorf("`hub` must be specified when `connectivity_topology` is `%s`", network.ConnectivityTopologyHubAndSpoke)
+			}
+
+			hasDirectlyConnectedGroup := false
+			for i, group := range model.AppliesToGroups {
+				if group.GroupConnectivity == network.GroupConnectivityDirectlyConnected {
+					hasDirectlyConnectedGroup = true
+				}
+
+				if group.UseHubGateway && !isHubAndSpoke {
+					return fmt.Errorf("`applies_to_group.%d.use_hub_gateway` can only be enabled when `connectivity_topology` is `%s`", i, network.ConnectivityTopologyHubAndSpoke)
+				}
+
+				// direct connectivity within the group is what's extended into a global mesh, so it's required for the group to opt in
+				if group.GlobalMeshEnabled && group.GroupConnectivity != network.GroupConnectivityDirectlyConnected {
+					return fmt.Errorf("`applies_to_group.%d.global_mesh_enabled` can only be enabled when `applies_to_group.%d.group_connectivity` is `%s`", i, i, network.GroupConnectivityDirectlyConnected)
+				}
+			}
+
+			if model.GlobalMeshEnabled && isHubAndSpoke && !hasDirectlyConnectedGroup {
+				return fmt.Errorf("`global_mesh_enabled` requires either a `connectivity_topology` of `%s` or at least one `applies_to_group` with a `group_connectivity` of `%s`", network.ConnectivityTopologyMesh, network.GroupConnectivityDirectlyConnected)
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ManagerConnectivityConfigurationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -43,6 +44,31 @@ func testAccNetworkManagerConnectivityConfiguration_basicTopologyMesh(t *testing
 	})
 }
 
+func testAccNetworkManagerConnectivityConfiguration_meshDirectlyConnected(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
+	r := ManagerConnectivityConfigurationResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.meshDirectlyConnected(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerConnectivityConfiguration_globalMeshWithoutDirectConnectivity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
+	r := ManagerConnectivityConfigurationResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.globalMeshWithoutDirectConnectivity(data),
+			ExpectError: regexp.MustCompile("`global_mesh_enabled` requires either a `connectivity_topology` of `Mesh`"),
+		},
+	})
+}
+
 func testAccNetworkManagerConnectivityConfiguration_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_manager_connectivity_configuration", "test")
 	r := ManagerConnectivityConfigurationResource{}
@@ -193,6 +219,47 @@ resource "azurerm_network_manager_connectivity_configuration" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ManagerConnectivityConfigurationResource) meshDirectlyConnected(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                  = "acctest-nmcc-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "Mesh"
+  global_mesh_enabled   = true
+  applies_to_group {
+    group_connectivity  = "DirectlyConnected"
+    network_group_id    = azurerm_network_manager_network_group.test.id
+    global_mesh_enabled = true
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r ManagerConnectivityConfigurationResource) globalMeshWithoutDirectConnectivity(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                  = "acctest-nmcc-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "HubAndSpoke"
+  global_mesh_enabled   = true
+  applies_to_group {
+    group_connectivity = "None"
+    network_group_id   = azurerm_network_manager_network_group.test.id
+  }
+  hub {
+    resource_id   = azurerm_virtual_network.test.id
+    resource_type = "Microsoft.Network/virtualNetworks"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r ManagerConnectivityConfigurationResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data)
 	return fmt.Sprintf(`
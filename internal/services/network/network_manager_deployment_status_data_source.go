@@ -0,0 +1,194 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2022-09-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerDeploymentStatusDataSource struct{}
+
+var _ sdk.DataSource = ManagerDeploymentStatusDataSource{}
+
+type ManagerDeploymentStatusDataSourceModel struct {
+	NetworkManagerId string                         `tfschema:"network_manager_id"`
+	Locations        []string                       `tfschema:"locations"`
+	DeploymentTypes  []string                       `tfschema:"deployment_types"`
+	Deployments      []ManagerDeploymentStatusModel `tfschema:"deployment"`
+}
+
+type ManagerDeploymentStatusModel struct {
+	Location         string   `tfschema:"location"`
+	DeploymentType   string   `tfschema:"deployment_type"`
+	Status           string   `tfschema:"status"`
+	ConfigurationIds []string `tfschema:"configuration_ids"`
+	CommitTime       string   `tfschema:"commit_time"`
+	ErrorMessage     string   `tfschema:"error_message"`
+}
+
+func (r ManagerDeploymentStatusDataSource) ResourceType() string {
+	return "azurerm_network_manager_deployment_status"
+}
+
+func (r ManagerDeploymentStatusDataSource) ModelObject() interface{} {
+	return &ManagerDeploymentStatusDataSourceModel{}
+}
+
+func (r ManagerDeploymentStatusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NetworkManagerID,
+		},
+
+		"locations": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: location.EnhancedValidate,
+			},
+		},
+
+		"deployment_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(networkmanagers.PossibleValuesForConfigurationType(), false),
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentStatusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"deployment": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"location": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"deployment_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"configuration_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"commit_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentStatusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ManagerDeploymentsClient
+
+			var state ManagerDeploymentStatusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(state.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			input := networkmanagers.NetworkManagerDeploymentStatusParameter{}
+			if len(state.Locations) > 0 {
+				locations := make([]string, 0)
+				for _, v := range state.Locations {
+					locations = append(locations, location.Normalize(v))
+				}
+				input.Regions = &locations
+			}
+
+			if len(state.DeploymentTypes) > 0 {
+				deploymentTypes := make([]networkmanagers.ConfigurationType, 0)
+				for _, v := range state.DeploymentTypes {
+					deploymentTypes = append(deploymentTypes, networkmanagers.ConfigurationType(v))
+				}
+				input.DeploymentTypes = &deploymentTypes
+			}
+
+			// the status is returned a page at a time, with the skip token from the previous page sent in the next request
+			deployments := make([]ManagerDeploymentStatusModel, 0)
+			for {
+				resp, err := client.NetworkManagerDeploymentStatusList(ctx, *networkManagerId, input)
+				if err != nil {
+					return fmt.Errorf("listing deployment status for %s: %+v", *networkManagerId, err)
+				}
+
+				if resp.Model == nil {
+					return fmt.Errorf("listing deployment status for %s: model was nil", *networkManagerId)
+				}
+
+				for _, item := range pointer.From(resp.Model.Value) {
+					deployment := ManagerDeploymentStatusModel{
+						Location:         location.NormalizeNilable(item.Region),
+						ConfigurationIds: pointer.From(item.ConfigurationIds),
+						CommitTime:       pointer.From(item.CommitTime),
+						ErrorMessage:     pointer.From(item.ErrorMessage),
+					}
+
+					if item.DeploymentType != nil {
+						deployment.DeploymentType = string(*item.DeploymentType)
+					}
+
+					if item.DeploymentStatus != nil {
+						deployment.Status = string(*item.DeploymentStatus)
+					}
+
+					deployments = append(deployments, deployment)
+				}
+
+				if pointer.From(resp.Model.SkipToken) == "" {
+					break
+				}
+				input.SkipToken = resp.Model.SkipToken
+			}
+
+			state.Deployments = deployments
+
+			metadata.SetID(networkManagerId)
+			return metadata.Encode(&state)
+		},
+	}
+}
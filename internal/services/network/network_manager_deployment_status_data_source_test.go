@@ -0,0 +1,42 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagerDeploymentStatusDataSource struct{}
+
+func testAccNetworkManagerDeploymentStatusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_manager_deployment_status", "test")
+	d := ManagerDeploymentStatusDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deployment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("deployment.0.location").HasValue("eastus"),
+				check.That(data.ResourceName).Key("deployment.0.deployment_type").HasValue("Connectivity"),
+				check.That(data.ResourceName).Key("deployment.0.status").HasValue("Deployed"),
+				check.That(data.ResourceName).Key("deployment.0.configuration_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("deployment.0.commit_time").Exists(),
+			),
+		},
+	})
+}
+
+func (d ManagerDeploymentStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_manager_deployment_status" "test" {
+  network_manager_id = azurerm_network_manager_deployment.test.network_manager_id
+  locations          = [azurerm_network_manager_deployment.test.location]
+  deployment_types   = [azurerm_network_manager_deployment.test.scope_access]
+}
+`, ManagerDeploymentResource{}.basic(data))
+}
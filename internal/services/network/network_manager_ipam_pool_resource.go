@@ -0,0 +1,244 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerIpamPoolModel struct {
+	Name             string            `tfschema:"name"`
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Location         string            `tfschema:"location"`
+	AddressPrefixes  []string          `tfschema:"address_prefixes"`
+	Description      string            `tfschema:"description"`
+	DisplayName      string            `tfschema:"display_name"`
+	ParentPoolName   string            `tfschema:"parent_pool_name"`
+	Tags             map[string]string `tfschema:"tags"`
+}
+
+type ManagerIpamPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerIpamPoolResource{}
+
+func (r ManagerIpamPoolResource) ResourceType() string {
+	return "azurerm_network_manager_ipam_pool"
+}
+
+func (r ManagerIpamPoolResource) ModelObject() interface{} {
+	return &ManagerIpamPoolModel{}
+}
+
+func (r ManagerIpamPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerIpamPoolID
+}
+
+func (r ManagerIpamPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerID,
+		},
+
+		"location": commonschema.Location(),
+
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parent_pool_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagerIpamPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerIpamPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			var model ManagerIpamPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := parse.NetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerIpamPoolID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, expandManagerIpamPool(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			id, err := parse.NetworkManagerIpamPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerIpamPoolModel{
+				Name:             id.IpamPoolName,
+				NetworkManagerId: parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				props := model.Properties
+				state.AddressPrefixes = props.AddressPrefixes
+				state.Description = pointer.From(props.Description)
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.ParentPoolName = pointer.From(props.ParentPoolName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerIpamPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			id, err := parse.NetworkManagerIpamPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerIpamPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateThenPoll(ctx, *id, expandManagerIpamPool(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			id, err := parse.NetworkManagerIpamPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagerIpamPool(input ManagerIpamPoolModel) client.IpamPool {
+	output := client.IpamPool{
+		Location: location.Normalize(input.Location),
+		Properties: client.IpamPoolProperties{
+			AddressPrefixes: input.AddressPrefixes,
+		},
+		Tags: pointer.To(input.Tags),
+	}
+
+	if input.Description != "" {
+		output.Properties.Description = pointer.To(input.Description)
+	}
+
+	if input.DisplayName != "" {
+		output.Properties.DisplayName = pointer.To(input.DisplayName)
+	}
+
+	if input.ParentPoolName != "" {
+		output.Properties.ParentPoolName = pointer.To(input.ParentPoolName)
+	}
+
+	return output
+}
@@ -0,0 +1,206 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerIpamPoolStaticCidrModel struct {
+	Name                          string   `tfschema:"name"`
+	IpamPoolId                    string   `tfschema:"ipam_pool_id"`
+	AddressPrefixes               []string `tfschema:"address_prefixes"`
+	Description                   string   `tfschema:"description"`
+	NumberOfIPAddressesToAllocate string   `tfschema:"number_of_ip_addresses_to_allocate"`
+	TotalNumberOfIPAddresses      string   `tfschema:"total_number_of_ip_addresses"`
+}
+
+type ManagerIpamPoolStaticCidrResource struct{}
+
+var _ sdk.Resource = ManagerIpamPoolStaticCidrResource{}
+
+func (r ManagerIpamPoolStaticCidrResource) ResourceType() string {
+	return "azurerm_network_manager_ipam_pool_static_cidr"
+}
+
+func (r ManagerIpamPoolStaticCidrResource) ModelObject() interface{} {
+	return &ManagerIpamPoolStaticCidrModel{}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerIpamPoolStaticCidrID
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ipam_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerIpamPoolID,
+		},
+
+		"address_prefixes": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"number_of_ip_addresses_to_allocate": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "`number_of_ip_addresses_to_allocate` must be a positive number"),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"total_number_of_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			staticCidrsClient := metadata.Client.Network.IpamPoolStaticCidrsClient
+
+			var model ManagerIpamPoolStaticCidrModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			poolId, err := parse.NetworkManagerIpamPoolID(model.IpamPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerIpamPoolStaticCidrID(poolId.SubscriptionId, poolId.ResourceGroup, poolId.NetworkManagerName, poolId.IpamPoolName, model.Name)
+			existing, err := staticCidrsClient.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := client.IpamPoolStaticCidr{
+				Properties: &client.IpamPoolStaticCidrProperties{},
+			}
+
+			if len(model.AddressPrefixes) > 0 {
+				payload.Properties.AddressPrefixes = pointer.To(model.AddressPrefixes)
+			}
+
+			if model.NumberOfIPAddressesToAllocate != "" {
+				payload.Properties.NumberOfIPAddressesToAllocate = pointer.To(model.NumberOfIPAddressesToAllocate)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := staticCidrsClient.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolStaticCidrsClient
+
+			id, err := parse.NetworkManagerIpamPoolStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerIpamPoolStaticCidrModel{
+				Name:       id.StaticCidrName,
+				IpamPoolId: parse.NewNetworkManagerIpamPoolID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.IpamPoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AddressPrefixes = pointer.From(props.AddressPrefixes)
+					state.Description = pointer.From(props.Description)
+					state.NumberOfIPAddressesToAllocate = pointer.From(props.NumberOfIPAddressesToAllocate)
+					state.TotalNumberOfIPAddresses = pointer.From(props.TotalNumberOfIPAddresses)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolStaticCidrsClient
+
+			id, err := parse.NetworkManagerIpamPoolStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -56,11 +56,13 @@ func TestAccNetworkManager(t *testing.T) {
 			"requiresImport": testAccNetworkManagerStaticMember_requiresImport,
 		},
 		"ConnectivityConfiguration": {
-			"basic":             testAccNetworkManagerConnectivityConfiguration_basic,
-			"basicTopologyMesh": testAccNetworkManagerConnectivityConfiguration_basicTopologyMesh,
-			"complete":          testAccNetworkManagerConnectivityConfiguration_complete,
-			"update":            testAccNetworkManagerConnectivityConfiguration_update,
-			"requiresImport":    testAccNetworkManagerConnectivityConfiguration_requiresImport,
+			"basic":                               testAccNetworkManagerConnectivityConfiguration_basic,
+			"basicTopologyMesh":                   testAccNetworkManagerConnectivityConfiguration_basicTopologyMesh,
+			"complete":                            testAccNetworkManagerConnectivityConfiguration_complete,
+			"update":                              testAccNetworkManagerConnectivityConfiguration_update,
+			"requiresImport":                      testAccNetworkManagerConnectivityConfiguration_requiresImport,
+			"meshDirectlyConnected":               testAccNetworkManagerConnectivityConfiguration_meshDirectlyConnected,
+			"globalMeshWithoutDirectConnectivity": testAccNetworkManagerConnectivityConfiguration_globalMeshWithoutDirectConnectivity,
 		},
 		"SecurityAdminConfiguration": {
 			"basic":          testAccNetworkManagerSecurityAdminConfiguration_basic,
@@ -75,10 +77,39 @@ func TestAccNetworkManager(t *testing.T) {
 			"requiresImport": testAccNetworkManagerAdminRuleCollection_requiresImport,
 		},
 		"AdminRule": {
-			"basic":          testAccNetworkManagerAdminRule_basic,
-			"complete":       testAccNetworkManagerAdminRule_complete,
-			"update":         testAccNetworkManagerAdminRule_update,
-			"requiresImport": testAccNetworkManagerAdminRule_requiresImport,
+			"basic":            testAccNetworkManagerAdminRule_basic,
+			"complete":         testAccNetworkManagerAdminRule_complete,
+			"update":           testAccNetworkManagerAdminRule_update,
+			"requiresImport":   testAccNetworkManagerAdminRule_requiresImport,
+			"priorityConflict": testAccNetworkManagerAdminRule_priorityConflict,
+		},
+		"IpamPool": {
+			"basic":          testAccNetworkManagerIpamPool_basic,
+			"complete":       testAccNetworkManagerIpamPool_complete,
+			"update":         testAccNetworkManagerIpamPool_update,
+			"requiresImport": testAccNetworkManagerIpamPool_requiresImport,
+			"virtualNetwork": testAccVirtualNetwork_addressSpaceFromPool,
+		},
+		"IpamPoolStaticCidr": {
+			"basic":               testAccNetworkManagerIpamPoolStaticCidr_basic,
+			"numberOfIPAddresses": testAccNetworkManagerIpamPoolStaticCidr_numberOfIPAddresses,
+			"requiresImport":      testAccNetworkManagerIpamPoolStaticCidr_requiresImport,
+		},
+		"VerifierWorkspace": {
+			"basic":          testAccNetworkManagerVerifierWorkspace_basic,
+			"complete":       testAccNetworkManagerVerifierWorkspace_complete,
+			"update":         testAccNetworkManagerVerifierWorkspace_update,
+			"requiresImport": testAccNetworkManagerVerifierWorkspace_requiresImport,
+		},
+		"VerifierWorkspaceReachabilityAnalysisIntent": {
+			"basic":          testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_basic,
+			"complete":       testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_complete,
+			"requiresImport": testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_requiresImport,
+		},
+		"VerifierWorkspaceReachabilityAnalysisRun": {
+			"basic":          testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_basic,
+			"complete":       testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_complete,
+			"requiresImport": testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_requiresImport,
 		},
 		"Deployment": {
 			"basic":          testAccNetworkManagerDeployment_basic,
@@ -88,6 +119,9 @@ func TestAccNetworkManager(t *testing.T) {
 			"withTriggers":   testAccNetworkManagerDeployment_withTriggers,
 			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
 		},
+		"DeploymentStatusDataSource": {
+			"basic": testAccNetworkManagerDeploymentStatusDataSource_basic,
+		},
 	}
 
 	for group, m := range testCases {
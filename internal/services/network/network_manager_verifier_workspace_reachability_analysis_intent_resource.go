@@ -0,0 +1,289 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerVerifierWorkspaceReachabilityAnalysisIntentModel struct {
+	Name                  string           `tfschema:"name"`
+	VerifierWorkspaceId   string           `tfschema:"verifier_workspace_id"`
+	SourceResourceId      string           `tfschema:"source_resource_id"`
+	DestinationResourceId string           `tfschema:"destination_resource_id"`
+	IPTraffic             []IPTrafficModel `tfschema:"ip_traffic"`
+	Description           string           `tfschema:"description"`
+}
+
+type IPTrafficModel struct {
+	SourceIps        []string `tfschema:"source_ips"`
+	DestinationIps   []string `tfschema:"destination_ips"`
+	SourcePorts      []string `tfschema:"source_ports"`
+	DestinationPorts []string `tfschema:"destination_ports"`
+	Protocols        []string `tfschema:"protocols"`
+}
+
+type ManagerVerifierWorkspaceReachabilityAnalysisIntentResource struct{}
+
+var _ sdk.Resource = ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) ResourceType() string {
+	return "azurerm_network_manager_verifier_workspace_reachability_analysis_intent"
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) ModelObject() interface{} {
+	return &ManagerVerifierWorkspaceReachabilityAnalysisIntentModel{}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"verifier_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerVerifierWorkspaceID,
+		},
+
+		"source_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"destination_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"ip_traffic": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_ips": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"destination_ips": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"source_ports": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"destination_ports": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"protocols": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Any",
+								"ICMP",
+								"TCP",
+								"UDP",
+							}, false),
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			intentsClient := metadata.Client.Network.ReachabilityAnalysisIntentsClient
+
+			var model ManagerVerifierWorkspaceReachabilityAnalysisIntentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := parse.NetworkManagerVerifierWorkspaceID(model.VerifierWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.NetworkManagerName, workspaceId.VerifierWorkspaceName, model.Name)
+			existing, err := intentsClient.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := client.ReachabilityAnalysisIntent{
+				Properties: client.ReachabilityAnalysisIntentProperties{
+					DestinationResourceId: model.DestinationResourceId,
+					IPTraffic:             expandIPTrafficModel(model.IPTraffic),
+					SourceResourceId:      model.SourceResourceId,
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := intentsClient.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisIntentsClient
+
+			id, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerVerifierWorkspaceReachabilityAnalysisIntentModel{
+				Name:                id.ReachabilityAnalysisIntentName,
+				VerifierWorkspaceId: parse.NewNetworkManagerVerifierWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.VerifierWorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.Description = pointer.From(props.Description)
+				state.DestinationResourceId = props.DestinationResourceId
+				state.IPTraffic = flattenIPTrafficModel(props.IPTraffic)
+				state.SourceResourceId = props.SourceResourceId
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisIntentsClient
+
+			id, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandIPTrafficModel(input []IPTrafficModel) client.IPTraffic {
+	if len(input) == 0 {
+		return client.IPTraffic{}
+	}
+
+	v := input[0]
+	return client.IPTraffic{
+		DestinationIps:   v.DestinationIps,
+		DestinationPorts: v.DestinationPorts,
+		Protocols:        v.Protocols,
+		SourceIps:        v.SourceIps,
+		SourcePorts:      v.SourcePorts,
+	}
+}
+
+func flattenIPTrafficModel(input client.IPTraffic) []IPTrafficModel {
+	return []IPTrafficModel{
+		{
+			DestinationIps:   input.DestinationIps,
+			DestinationPorts: input.DestinationPorts,
+			Protocols:        input.Protocols,
+			SourceIps:        input.SourceIps,
+			SourcePorts:      input.SourcePorts,
+		},
+	}
+}
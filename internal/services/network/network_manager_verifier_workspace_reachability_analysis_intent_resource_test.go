@@ -0,0 +1,194 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerVerifierWorkspaceReachabilityAnalysisIntentResource struct{}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_intent", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_intent", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisIntent_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_intent", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ReachabilityAnalysisIntentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.2.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctest-subnet-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.2.1.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctest-nic-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctest-vm-%[2]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_B1s"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@ssw0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids           = [azurerm_network_interface.test.id]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, ManagerVerifierWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_intent" "test" {
+  name                    = "acctest-intent-%d"
+  verifier_workspace_id   = azurerm_network_manager_verifier_workspace.test.id
+  source_resource_id      = azurerm_linux_virtual_machine.test.id
+  destination_resource_id = azurerm_linux_virtual_machine.test.id
+
+  ip_traffic {
+    source_ips        = ["10.2.1.4"]
+    source_ports      = ["*"]
+    destination_ips   = ["10.2.1.5"]
+    destination_ports = ["*"]
+    protocols         = ["Any"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_intent" "import" {
+  name                    = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.name
+  verifier_workspace_id   = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.verifier_workspace_id
+  source_resource_id      = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.source_resource_id
+  destination_resource_id = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.destination_resource_id
+
+  ip_traffic {
+    source_ips        = ["10.2.1.4"]
+    source_ports      = ["*"]
+    destination_ips   = ["10.2.1.5"]
+    destination_ports = ["*"]
+    protocols         = ["Any"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisIntentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_intent" "test" {
+  name                    = "acctest-intent-%d"
+  verifier_workspace_id   = azurerm_network_manager_verifier_workspace.test.id
+  source_resource_id      = azurerm_linux_virtual_machine.test.id
+  destination_resource_id = azurerm_linux_virtual_machine.test.id
+  description             = "This is a test reachability analysis intent"
+
+  ip_traffic {
+    source_ips        = ["10.2.1.4", "10.2.1.6"]
+    source_ports      = ["80", "443"]
+    destination_ips   = ["10.2.1.5"]
+    destination_ports = ["22"]
+    protocols         = ["TCP", "UDP"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,194 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerVerifierWorkspaceReachabilityAnalysisRunModel struct {
+	Name                 string `tfschema:"name"`
+	VerifierWorkspaceId  string `tfschema:"verifier_workspace_id"`
+	ReachabilityIntentId string `tfschema:"reachability_analysis_intent_id"`
+	Description          string `tfschema:"description"`
+	AnalysisResult       string `tfschema:"analysis_result"`
+	ErrorMessage         string `tfschema:"error_message"`
+}
+
+type ManagerVerifierWorkspaceReachabilityAnalysisRunResource struct{}
+
+var _ sdk.Resource = ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) ResourceType() string {
+	return "azurerm_network_manager_verifier_workspace_reachability_analysis_run"
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) ModelObject() interface{} {
+	return &ManagerVerifierWorkspaceReachabilityAnalysisRunModel{}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunID
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"verifier_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerVerifierWorkspaceID,
+		},
+
+		"reachability_analysis_intent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"analysis_result": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			runsClient := metadata.Client.Network.ReachabilityAnalysisRunsClient
+
+			var model ManagerVerifierWorkspaceReachabilityAnalysisRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := parse.NetworkManagerVerifierWorkspaceID(model.VerifierWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerVerifierWorkspaceReachabilityAnalysisRunID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.NetworkManagerName, workspaceId.VerifierWorkspaceName, model.Name)
+			existing, err := runsClient.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := client.ReachabilityAnalysisRun{
+				Properties: client.ReachabilityAnalysisRunProperties{
+					IntentId: model.ReachabilityIntentId,
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = pointer.To(model.Description)
+			}
+
+			if err := runsClient.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisRunsClient
+
+			id, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerVerifierWorkspaceReachabilityAnalysisRunModel{
+				Name:                id.ReachabilityAnalysisRunName,
+				VerifierWorkspaceId: parse.NewNetworkManagerVerifierWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.VerifierWorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.AnalysisResult = pointer.From(props.AnalysisResult)
+				state.Description = pointer.From(props.Description)
+				state.ErrorMessage = pointer.From(props.ErrorMessage)
+
+				intentId, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentIDInsensitively(props.IntentId)
+				if err != nil {
+					return err
+				}
+				state.ReachabilityIntentId = intentId.ID()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.ReachabilityAnalysisRunsClient
+
+			id, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerVerifierWorkspaceReachabilityAnalysisRunResource struct{}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_run", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("analysis_result").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_run", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerVerifierWorkspaceReachabilityAnalysisRun_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_verifier_workspace_reachability_analysis_run", "test")
+	r := ManagerVerifierWorkspaceReachabilityAnalysisRunResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerVerifierWorkspaceReachabilityAnalysisRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ReachabilityAnalysisRunsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_run" "test" {
+  name                            = "acctest-run-%d"
+  verifier_workspace_id           = azurerm_network_manager_verifier_workspace.test.id
+  reachability_analysis_intent_id = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.id
+}
+`, ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_run" "import" {
+  name                            = azurerm_network_manager_verifier_workspace_reachability_analysis_run.test.name
+  verifier_workspace_id           = azurerm_network_manager_verifier_workspace_reachability_analysis_run.test.verifier_workspace_id
+  reachability_analysis_intent_id = azurerm_network_manager_verifier_workspace_reachability_analysis_run.test.reachability_analysis_intent_id
+}
+`, r.basic(data))
+}
+
+func (r ManagerVerifierWorkspaceReachabilityAnalysisRunResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_verifier_workspace_reachability_analysis_run" "test" {
+  name                            = "acctest-run-%d"
+  verifier_workspace_id           = azurerm_network_manager_verifier_workspace.test.id
+  reachability_analysis_intent_id = azurerm_network_manager_verifier_workspace_reachability_analysis_intent.test.id
+  description                     = "This is a test reachability analysis run"
+}
+`, ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{}.basic(data), data.RandomInteger)
+}
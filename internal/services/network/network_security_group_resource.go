@@ -58,119 +58,7 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 				ConfigMode: pluginsdk.SchemaConfigModeAttr,
 				Optional:   true,
 				Computed:   true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"description": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 140),
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.SecurityRuleProtocolAsterisk),
-								string(network.SecurityRuleProtocolTCP),
-								string(network.SecurityRuleProtocolUDP),
-								string(network.SecurityRuleProtocolIcmp),
-								string(network.SecurityRuleProtocolAh),
-								string(network.SecurityRuleProtocolEsp),
-							}, false),
-						},
-
-						"source_port_range": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"source_port_ranges": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_port_range": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"destination_port_ranges": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"source_address_prefix": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"source_address_prefixes": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_address_prefix": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"destination_address_prefixes": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_application_security_group_ids": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"source_application_security_group_ids": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"access": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.SecurityRuleAccessAllow),
-								string(network.SecurityRuleAccessDeny),
-							}, false),
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(100, 4096),
-						},
-
-						"direction": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.SecurityRuleDirectionInbound),
-								string(network.SecurityRuleDirectionOutbound),
-							}, false),
-						},
-					},
-				},
+				Elem:       networkSecurityGroupRuleSchema(),
 			},
 
 			"tags": tags.Schema(),
@@ -178,6 +66,122 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 	}
 }
 
+func networkSecurityGroupRuleSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 140),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleProtocolAsterisk),
+					string(network.SecurityRuleProtocolTCP),
+					string(network.SecurityRuleProtocolUDP),
+					string(network.SecurityRuleProtocolIcmp),
+					string(network.SecurityRuleProtocolAh),
+					string(network.SecurityRuleProtocolEsp),
+				}, false),
+			},
+
+			"source_port_range": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"source_port_ranges": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"destination_port_range": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"destination_port_ranges": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"source_address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"source_address_prefixes": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"destination_address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"destination_address_prefixes": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"destination_application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"source_application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleAccessAllow),
+					string(network.SecurityRuleAccessDeny),
+				}, false),
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 4096),
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleDirectionInbound),
+					string(network.SecurityRuleDirectionOutbound),
+				}, false),
+			},
+		},
+	}
+}
+
 func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SecurityGroupClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -202,7 +206,7 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	sgRules, sgErr := expandAzureRmSecurityRules(d)
+	sgRules, sgErr := expandAzureRmSecurityRules(d.Get("security_rule").(*pluginsdk.Set).List())
 	if sgErr != nil {
 		return fmt.Errorf("Building list of Network Security Group Rules: %+v", sgErr)
 	}
@@ -290,8 +294,7 @@ func resourceNetworkSecurityGroupDelete(d *pluginsdk.ResourceData, meta interfac
 	return err
 }
 
-func expandAzureRmSecurityRules(d *pluginsdk.ResourceData) ([]network.SecurityRule, error) {
-	sgRules := d.Get("security_rule").(*pluginsdk.Set).List()
+func expandAzureRmSecurityRules(sgRules []interface{}) ([]network.SecurityRule, error) {
 	rules := make([]network.SecurityRule, 0)
 
 	for _, sgRaw := range sgRules {
@@ -0,0 +1,224 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+func resourceNetworkSecurityGroupRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkSecurityGroupRulesCreate,
+		Read:   resourceNetworkSecurityGroupRulesRead,
+		Update: resourceNetworkSecurityGroupRulesUpdate,
+		Delete: resourceNetworkSecurityGroupRulesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkSecurityGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_security_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityGroupID,
+			},
+
+			"security_rule": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     networkSecurityGroupRuleSchema(),
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(networkSecurityGroupRulesCustomizeDiff),
+	}
+}
+
+func resourceNetworkSecurityGroupRulesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := existing.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil && len(*props.SecurityRules) > 0 {
+		return tf.ImportAsExistsError("azurerm_network_security_group_rules", id.ID())
+	}
+
+	if err := updateNetworkSecurityGroupRules(ctx, client, *id, existing, d.Get("security_rule").(*pluginsdk.Set).List()); err != nil {
+		return fmt.Errorf("creating Security Rules for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkSecurityGroupRulesRead(d, meta)
+}
+
+func resourceNetworkSecurityGroupRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("network_security_group_id", id.ID())
+
+	if props := resp.SecurityGroupPropertiesFormat; props != nil {
+		if err := d.Set("security_rule", flattenNetworkSecurityRules(props.SecurityRules)); err != nil {
+			return fmt.Errorf("setting `security_rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkSecurityGroupRulesUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if err := updateNetworkSecurityGroupRules(ctx, client, *id, existing, d.Get("security_rule").(*pluginsdk.Set).List()); err != nil {
+		return fmt.Errorf("updating Security Rules for %s: %+v", *id, err)
+	}
+
+	return resourceNetworkSecurityGroupRulesRead(d, meta)
+}
+
+func resourceNetworkSecurityGroupRulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.Name, networkSecurityGroupResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if err := updateNetworkSecurityGroupRules(ctx, client, *id, existing, []interface{}{}); err != nil {
+		return fmt.Errorf("removing Security Rules from %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// updateNetworkSecurityGroupRules replaces all of the Security Rules within the Network Security Group in a single request
+func updateNetworkSecurityGroupRules(ctx context.Context, client *network.SecurityGroupsClient, id parse.NetworkSecurityGroupId, existing network.SecurityGroup, input []interface{}) error {
+	rules, err := expandAzureRmSecurityRules(input)
+	if err != nil {
+		return fmt.Errorf("building list of Network Security Group Rules: %+v", err)
+	}
+
+	if existing.SecurityGroupPropertiesFormat == nil {
+		existing.SecurityGroupPropertiesFormat = &network.SecurityGroupPropertiesFormat{}
+	}
+	existing.SecurityGroupPropertiesFormat.SecurityRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+	if err != nil {
+		return err
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update: %+v", err)
+	}
+
+	return nil
+}
+
+func networkSecurityGroupRulesCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	names := make(map[string]struct{})
+	priorities := make(map[string]string)
+
+	for _, raw := range d.Get("security_rule").(*pluginsdk.Set).List() {
+		rule := raw.(map[string]interface{})
+		name := rule["name"].(string)
+		direction := rule["direction"].(string)
+		priority := rule["priority"].(int)
+
+		// values may not be known until apply, in which case the API performs this validation
+		if name == "" || direction == "" || priority == 0 {
+			continue
+		}
+
+		if _, ok := names[strings.ToLower(name)]; ok {
+			return fmt.Errorf("the Security Rule name %q is used more than once", name)
+		}
+		names[strings.ToLower(name)] = struct{}{}
+
+		key := fmt.Sprintf("%s/%d", direction, priority)
+		if other, ok := priorities[key]; ok {
+			return fmt.Errorf("the %s Security Rules %q and %q have the same priority %d", direction, other, name, priority)
+		}
+		priorities[key] = name
+	}
+
+	return nil
+}
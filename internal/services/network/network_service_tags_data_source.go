@@ -60,6 +60,16 @@ func dataSourceNetworkServiceTags() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"change_number": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -102,20 +112,9 @@ func dataSourceNetworkServiceTagsRead(d *pluginsdk.ResourceData, meta interface{
 					return fmt.Errorf("setting `address_prefixes`: %+v", err)
 				}
 
-				var IPv4 []string
-				var IPv6 []string
-
-				for _, prefix := range addressPrefixes {
-					ip, ipNet, err := net.ParseCIDR(prefix)
-					if err != nil {
-						return err
-					}
-
-					if ip.To4() != nil {
-						IPv4 = append(IPv4, ipNet.String())
-					} else {
-						IPv6 = append(IPv6, ipNet.String())
-					}
+				IPv4, IPv6, err := splitServiceTagAddressPrefixes(addressPrefixes)
+				if err != nil {
+					return err
 				}
 
 				err = d.Set("ipv4_cidrs", IPv4)
@@ -128,6 +127,9 @@ func dataSourceNetworkServiceTagsRead(d *pluginsdk.ResourceData, meta interface{
 					return fmt.Errorf("setting `ipv6_cidrs`: %+v", err)
 				}
 
+				d.Set("name", sti.Name)
+				d.Set("change_number", props.ChangeNumber)
+
 				if sti.ID == nil {
 					return fmt.Errorf("unexcepted nil ID for service tag")
 				}
@@ -155,3 +157,24 @@ func isServiceTagOf(stName, serviceName string) bool {
 	}
 	return stNameComponents[0] == serviceName
 }
+
+// splitServiceTagAddressPrefixes splits the address prefixes of a service tag into IPv4 and IPv6 CIDRs.
+func splitServiceTagAddressPrefixes(addressPrefixes []string) ([]string, []string, error) {
+	var IPv4 []string
+	var IPv6 []string
+
+	for _, prefix := range addressPrefixes {
+		ip, ipNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if ip.To4() != nil {
+			IPv4 = append(IPv4, ipNet.String())
+		} else {
+			IPv6 = append(IPv6, ipNet.String())
+		}
+	}
+
+	return IPv4, IPv6, nil
+}
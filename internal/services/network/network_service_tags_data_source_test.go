@@ -20,6 +20,8 @@ func TestAccDataSourceAzureRMServiceTags_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("address_prefixes.#").Exists(),
 				check.That(data.ResourceName).Key("ipv4_cidrs.#").Exists(),
 				check.That(data.ResourceName).Key("ipv6_cidrs.#").Exists(),
+				check.That(data.ResourceName).Key("name").HasValue("AzureKeyVault"),
+				check.That(data.ResourceName).Key("change_number").Exists(),
 			),
 		},
 	})
@@ -0,0 +1,184 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkServiceTagsList() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkServiceTagsListRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"service": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"location_filter": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				StateFunc:        azure.NormalizeLocation,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"cloud": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"change_number": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"service_tags": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"system_service": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"region": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"change_number": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"ipv4_cidrs": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"ipv6_cidrs": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkServiceTagsListRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ServiceTagsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	location := azure.NormalizeLocation(d.Get("location"))
+	res, err := client.List(ctx, location)
+	if err != nil {
+		return fmt.Errorf("listing network service tags: %+v", err)
+	}
+
+	if res.Values == nil {
+		return fmt.Errorf("unexpected nil value for service tag information")
+	}
+
+	if res.ID == nil {
+		return fmt.Errorf("unexpected nil ID for service tags in %q", location)
+	}
+
+	service := d.Get("service").(string)
+	locationFilter := azure.NormalizeLocation(d.Get("location_filter"))
+
+	serviceTags := make([]interface{}, 0)
+	for _, sti := range *res.Values {
+		if sti.Name == nil {
+			continue
+		}
+		if service != "" && !isServiceTagOf(*sti.Name, service) {
+			continue
+		}
+
+		props := sti.Properties
+		if props == nil {
+			continue
+		}
+
+		region := ""
+		if props.Region != nil {
+			region = azure.NormalizeLocation(*props.Region)
+		}
+		if locationFilter != "" && region != locationFilter {
+			continue
+		}
+
+		addressPrefixes := make([]string, 0)
+		if props.AddressPrefixes != nil {
+			addressPrefixes = *props.AddressPrefixes
+		}
+
+		IPv4, IPv6, err := splitServiceTagAddressPrefixes(addressPrefixes)
+		if err != nil {
+			return fmt.Errorf("parsing address prefixes for service tag %q: %+v", *sti.Name, err)
+		}
+
+		serviceTags = append(serviceTags, map[string]interface{}{
+			"id":               utils.NormalizeNilableString(sti.ID),
+			"name":             *sti.Name,
+			"system_service":   utils.NormalizeNilableString(props.SystemService),
+			"region":           region,
+			"change_number":    utils.NormalizeNilableString(props.ChangeNumber),
+			"address_prefixes": addressPrefixes,
+			"ipv4_cidrs":       IPv4,
+			"ipv6_cidrs":       IPv6,
+		})
+	}
+
+	d.SetId(*res.ID)
+
+	d.Set("cloud", res.Cloud)
+	d.Set("change_number", res.ChangeNumber)
+	if err := d.Set("service_tags", serviceTags); err != nil {
+		return fmt.Errorf("setting `service_tags`: %+v", err)
+	}
+
+	return nil
+}
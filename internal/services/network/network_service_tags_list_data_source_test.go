@@ -0,0 +1,58 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkServiceTagsListDataSource struct{}
+
+func TestAccDataSourceAzureRMServiceTagsList_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_service_tags_list", "test")
+	r := NetworkServiceTagsListDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("cloud").HasValue("Public"),
+				check.That(data.ResourceName).Key("change_number").Exists(),
+				check.That(data.ResourceName).Key("service_tags.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMServiceTagsList_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_service_tags_list", "test")
+	r := NetworkServiceTagsListDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("service_tags.#").HasValue("1"),
+				check.That(data.ResourceName).Key("service_tags.0.name").HasValue("AzureKeyVault.AustraliaCentral"),
+				check.That(data.ResourceName).Key("service_tags.0.region").HasValue("australiacentral"),
+				check.That(data.ResourceName).Key("service_tags.0.ipv4_cidrs.#").Exists(),
+				check.That(data.ResourceName).Key("service_tags.0.ipv6_cidrs.#").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkServiceTagsListDataSource) basic() string {
+	return `data "azurerm_network_service_tags_list" "test" {
+  location = "westcentralus"
+}`
+}
+
+func (NetworkServiceTagsListDataSource) filtered() string {
+	return `data "azurerm_network_service_tags_list" "test" {
+  location        = "westcentralus"
+  service         = "AzureKeyVault"
+  location_filter = "australiacentral"
+}`
+}
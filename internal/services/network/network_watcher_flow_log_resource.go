@@ -30,9 +30,10 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 		Update: resourceNetworkWatcherFlowLogCreateUpdate,
 		Delete: resourceNetworkWatcherFlowLogDelete,
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.NetworkWatcherFlowLogV0ToV1{},
+			1: migration.NetworkWatcherFlowLogV1ToV2{},
 		}),
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -67,9 +68,26 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 
 			"network_security_group_id": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.NetworkSecurityGroupID,
+				ExactlyOneOf: []string{"network_security_group_id", "target_resource_id"},
+				Deprecated:   "Network Security Group flow logs are being retired in favour of Virtual Network flow logs. `network_security_group_id` has been superseded by `target_resource_id` and will be removed in a future major version of the provider",
+			},
+
+			"target_resource_id": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					validate.NetworkSecurityGroupID,
+					validate.VirtualNetworkID,
+					validate.SubnetID,
+					validate.NetworkInterfaceID,
+				),
+				ExactlyOneOf: []string{"network_security_group_id", "target_resource_id"},
 			},
 
 			"storage_account_id": {
@@ -189,8 +207,16 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *pluginsdk.ResourceData, meta i
 	name := d.Get("name").(string)
 	id := parse.NewFlowLogID(subscriptionId, resourceGroupName, networkWatcherName, name)
 
-	networkSecurityGroupID := d.Get("network_security_group_id").(string)
-	nsgId, _ := parse.NetworkSecurityGroupID(networkSecurityGroupID)
+	// both `network_security_group_id` and `target_resource_id` are Computed, so the value in the state for the field
+	// which isn't specified in the configuration may be stale (e.g. when migrating from `network_security_group_id`)
+	targetResourceIdRaw := d.Get("network_security_group_id").(string)
+	if v := d.GetRawConfig().GetAttr("target_resource_id"); v.IsKnown() && !v.IsNull() {
+		targetResourceIdRaw = v.AsString()
+	}
+	targetResourceId, err := normalizeFlowLogTargetResourceID(targetResourceIdRaw)
+	if err != nil {
+		return err
+	}
 
 	if d.IsNewResource() {
 		// For newly created resources, the "name" is required, it is set as Optional and Computed is merely for the existing ones for the sake of backward compatibility.
@@ -210,8 +236,8 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *pluginsdk.ResourceData, meta i
 		}
 	}
 
-	locks.ByID(nsgId.ID())
-	defer locks.UnlockByID(nsgId.ID())
+	locks.ByID(targetResourceId)
+	defer locks.UnlockByID(targetResourceId)
 
 	loc := d.Get("location").(string)
 	if loc == "" {
@@ -229,7 +255,7 @@ func resourceNetworkWatcherFlowLogCreateUpdate(d *pluginsdk.ResourceData, meta i
 	parameters := network.FlowLog{
 		Location: utils.String(location.Normalize(loc)),
 		FlowLogPropertiesFormat: &network.FlowLogPropertiesFormat{
-			TargetResourceID: utils.String(nsgId.ID()),
+			TargetResourceID: utils.String(targetResourceId),
 			StorageID:        utils.String(d.Get("storage_account_id").(string)),
 			Enabled:          utils.Bool(d.Get("enabled").(bool)),
 			RetentionPolicy:  expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
@@ -309,12 +335,18 @@ func resourceNetworkWatcherFlowLogRead(d *pluginsdk.ResourceData, meta interface
 			d.Set("storage_account_id", prop.StorageID)
 		}
 
-		networkSecurityGroupId := ""
-		if nsgIdRaw := prop.TargetResourceID; nsgIdRaw != nil {
-			nsgId, err := parse.NetworkSecurityGroupIDInsensitively(*nsgIdRaw)
+		targetResourceId := ""
+		if prop.TargetResourceID != nil {
+			targetResourceId, err = normalizeFlowLogTargetResourceID(*prop.TargetResourceID)
 			if err != nil {
 				return err
 			}
+		}
+		d.Set("target_resource_id", targetResourceId)
+
+		// `network_security_group_id` is only populated when the Flow Log targets a Network Security Group
+		networkSecurityGroupId := ""
+		if nsgId, err := parse.NetworkSecurityGroupIDInsensitively(targetResourceId); err == nil {
 			networkSecurityGroupId = nsgId.ID()
 		}
 		d.Set("network_security_group_id", networkSecurityGroupId)
@@ -345,13 +377,13 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("retreiving %s: `properties` or `properties.TargetResourceID` was nil", id)
 	}
 
-	networkSecurityGroupId, err := parse.NetworkSecurityGroupIDInsensitively(*resp.FlowLogPropertiesFormat.TargetResourceID)
+	targetResourceId, err := normalizeFlowLogTargetResourceID(*resp.FlowLogPropertiesFormat.TargetResourceID)
 	if err != nil {
-		return fmt.Errorf("parsing %q as a Network Security Group ID: %+v", *resp.FlowLogPropertiesFormat.TargetResourceID, err)
+		return err
 	}
 
-	locks.ByID(networkSecurityGroupId.ID())
-	defer locks.UnlockByID(networkSecurityGroupId.ID())
+	locks.ByID(targetResourceId)
+	defer locks.UnlockByID(targetResourceId)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 	if err != nil {
@@ -365,6 +397,25 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+// normalizeFlowLogTargetResourceID parses the target of a Flow Log, which can be a Network Security Group,
+// Virtual Network, Subnet or Network Interface, returning the ID in its normalized casing
+func normalizeFlowLogTargetResourceID(input string) (string, error) {
+	if id, err := parse.NetworkSecurityGroupIDInsensitively(input); err == nil {
+		return id.ID(), nil
+	}
+	if id, err := parse.SubnetIDInsensitively(input); err == nil {
+		return id.ID(), nil
+	}
+	if id, err := parse.VirtualNetworkIDInsensitively(input); err == nil {
+		return id.ID(), nil
+	}
+	if id, err := parse.NetworkInterfaceIDInsensitively(input); err == nil {
+		return id.ID(), nil
+	}
+
+	return "", fmt.Errorf("parsing %q as the target of a Flow Log: expected a Network Security Group, Virtual Network, Subnet or Network Interface ID", input)
+}
+
 func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
 	if len(input) < 1 || input[0] == nil {
 		return nil
@@ -30,6 +30,83 @@ func testAccNetworkWatcherFlowLog_basic(t *testing.T) {
 	})
 }
 
+func testAccNetworkWatcherFlowLog_virtualNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
+	r := NetworkWatcherFlowLogResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.targetResourceConfig(data, "azurerm_virtual_network.test.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_security_group_id").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkWatcherFlowLog_subnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
+	r := NetworkWatcherFlowLogResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.targetResourceConfig(data, "azurerm_subnet.test.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkWatcherFlowLog_networkSecurityGroupAsTargetResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
+	r := NetworkWatcherFlowLogResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_id").MatchesOtherKey(check.That(data.ResourceName).Key("network_security_group_id")),
+			),
+		},
+		data.ImportStep(),
+		{
+			// switching from `network_security_group_id` to `target_resource_id` for the same NSG is a no-op
+			Config:   r.targetResourceConfig(data, "azurerm_network_security_group.test.id"),
+			PlanOnly: true,
+		},
+	})
+}
+
+func testAccNetworkWatcherFlowLog_networkSecurityGroupToVirtualNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
+	r := NetworkWatcherFlowLogResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the `network_security_group_id` in the state mustn't take precedence over `target_resource_id`
+			Config: r.targetResourceConfig(data, "azurerm_virtual_network.test.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_id").MatchesOtherKey(check.That("azurerm_virtual_network.test").Key("id")),
+				check.That(data.ResourceName).Key("network_security_group_id").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func testAccNetworkWatcherFlowLog_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
 	r := NetworkWatcherFlowLogResource{}
@@ -302,6 +379,41 @@ resource "azurerm_network_watcher_flow_log" "test" {
 `, r.prerequisites(data), data.RandomInteger)
 }
 
+func (r NetworkWatcherFlowLogResource) targetResourceConfig(data acceptance.TestData, targetResourceId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = azurerm_network_watcher.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+  name                 = "flowlog-%d"
+
+  target_resource_id = %s
+  storage_account_id = azurerm_storage_account.test.id
+  enabled            = true
+
+  retention_policy {
+    enabled = false
+    days    = 0
+  }
+}
+`, r.prerequisites(data), data.RandomInteger, data.RandomInteger, targetResourceId)
+}
+
 func (r NetworkWatcherFlowLogResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -75,6 +75,10 @@ func TestAccNetworkWatcher(t *testing.T) {
 		},
 		"FlowLog": {
 			"basic":                testAccNetworkWatcherFlowLog_basic,
+			"virtualNetwork":       testAccNetworkWatcherFlowLog_virtualNetwork,
+			"subnet":               testAccNetworkWatcherFlowLog_subnet,
+			"nsgAsTargetResource":  testAccNetworkWatcherFlowLog_networkSecurityGroupAsTargetResource,
+			"nsgToVirtualNetwork":  testAccNetworkWatcherFlowLog_networkSecurityGroupToVirtualNetwork,
 			"requiresImport":       testAccNetworkWatcherFlowLog_requiresImport,
 			"disabled":             testAccNetworkWatcherFlowLog_disabled,
 			"reenabled":            testAccNetworkWatcherFlowLog_reenabled,
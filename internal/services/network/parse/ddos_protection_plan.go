@@ -67,3 +67,47 @@ func DdosProtectionPlanID(input string) (*DdosProtectionPlanId, error) {
 
 	return &resourceId, nil
 }
+
+// DdosProtectionPlanIDInsensitively parses an DdosProtectionPlan ID into an DdosProtectionPlanId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DdosProtectionPlanID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DdosProtectionPlanIDInsensitively(input string) (*DdosProtectionPlanId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DdosProtectionPlanId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'ddosProtectionPlans' segment
+	ddosProtectionPlansKey := "ddosProtectionPlans"
+	for key := range id.Path {
+		if strings.EqualFold(key, ddosProtectionPlansKey) {
+			ddosProtectionPlansKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(ddosProtectionPlansKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
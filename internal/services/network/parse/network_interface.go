@@ -67,3 +67,47 @@ func NetworkInterfaceID(input string) (*NetworkInterfaceId, error) {
 
 	return &resourceId, nil
 }
+
+// NetworkInterfaceIDInsensitively parses an NetworkInterface ID into an NetworkInterfaceId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NetworkInterfaceID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NetworkInterfaceIDInsensitively(input string) (*NetworkInterfaceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkInterfaceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'networkInterfaces' segment
+	networkInterfacesKey := "networkInterfaces"
+	for key := range id.Path {
+		if strings.EqualFold(key, networkInterfacesKey) {
+			networkInterfacesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(networkInterfacesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
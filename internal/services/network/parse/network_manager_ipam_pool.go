@@ -0,0 +1,131 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NetworkManagerIpamPoolId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	IpamPoolName       string
+}
+
+func NewNetworkManagerIpamPoolID(subscriptionId, resourceGroup, networkManagerName, ipamPoolName string) NetworkManagerIpamPoolId {
+	return NetworkManagerIpamPoolId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		IpamPoolName:       ipamPoolName,
+	}
+}
+
+func (id NetworkManagerIpamPoolId) String() string {
+	segments := []string{
+		fmt.Sprintf("Ipam Pool Name %q", id.IpamPoolName),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Ipam Pool", segmentsStr)
+}
+
+func (id NetworkManagerIpamPoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/ipamPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.IpamPoolName)
+}
+
+// NetworkManagerIpamPoolID parses a NetworkManagerIpamPool ID into an NetworkManagerIpamPoolId struct
+func NetworkManagerIpamPoolID(input string) (*NetworkManagerIpamPoolId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NetworkManagerIpamPool ID: %+v", input, err)
+	}
+
+	resourceId := NetworkManagerIpamPoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkManagerName, err = id.PopSegment("networkManagers"); err != nil {
+		return nil, err
+	}
+	if resourceId.IpamPoolName, err = id.PopSegment("ipamPools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NetworkManagerIpamPoolIDInsensitively parses an NetworkManagerIpamPool ID into an NetworkManagerIpamPoolId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NetworkManagerIpamPoolID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NetworkManagerIpamPoolIDInsensitively(input string) (*NetworkManagerIpamPoolId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkManagerIpamPoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'networkManagers' segment
+	networkManagersKey := "networkManagers"
+	for key := range id.Path {
+		if strings.EqualFold(key, networkManagersKey) {
+			networkManagersKey = key
+			break
+		}
+	}
+	if resourceId.NetworkManagerName, err = id.PopSegment(networkManagersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'ipamPools' segment
+	ipamPoolsKey := "ipamPools"
+	for key := range id.Path {
+		if strings.EqualFold(key, ipamPoolsKey) {
+			ipamPoolsKey = key
+			break
+		}
+	}
+	if resourceId.IpamPoolName, err = id.PopSegment(ipamPoolsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NetworkManagerVerifierWorkspaceId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	NetworkManagerName    string
+	VerifierWorkspaceName string
+}
+
+func NewNetworkManagerVerifierWorkspaceID(subscriptionId, resourceGroup, networkManagerName, verifierWorkspaceName string) NetworkManagerVerifierWorkspaceId {
+	return NetworkManagerVerifierWorkspaceId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		NetworkManagerName:    networkManagerName,
+		VerifierWorkspaceName: verifierWorkspaceName,
+	}
+}
+
+func (id NetworkManagerVerifierWorkspaceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Verifier Workspace Name %q", id.VerifierWorkspaceName),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Verifier Workspace", segmentsStr)
+}
+
+func (id NetworkManagerVerifierWorkspaceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/verifierWorkspaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.VerifierWorkspaceName)
+}
+
+// NetworkManagerVerifierWorkspaceID parses a NetworkManagerVerifierWorkspace ID into an NetworkManagerVerifierWorkspaceId struct
+func NetworkManagerVerifierWorkspaceID(input string) (*NetworkManagerVerifierWorkspaceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NetworkManagerVerifierWorkspace ID: %+v", input, err)
+	}
+
+	resourceId := NetworkManagerVerifierWorkspaceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkManagerName, err = id.PopSegment("networkManagers"); err != nil {
+		return nil, err
+	}
+	if resourceId.VerifierWorkspaceName, err = id.PopSegment("verifierWorkspaces"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,149 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId struct {
+	SubscriptionId                 string
+	ResourceGroup                  string
+	NetworkManagerName             string
+	VerifierWorkspaceName          string
+	ReachabilityAnalysisIntentName string
+}
+
+func NewNetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(subscriptionId, resourceGroup, networkManagerName, verifierWorkspaceName, reachabilityAnalysisIntentName string) NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId {
+	return NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+		SubscriptionId:                 subscriptionId,
+		ResourceGroup:                  resourceGroup,
+		NetworkManagerName:             networkManagerName,
+		VerifierWorkspaceName:          verifierWorkspaceName,
+		ReachabilityAnalysisIntentName: reachabilityAnalysisIntentName,
+	}
+}
+
+func (id NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Reachability Analysis Intent Name %q", id.ReachabilityAnalysisIntentName),
+		fmt.Sprintf("Verifier Workspace Name %q", id.VerifierWorkspaceName),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Verifier Workspace Reachability Analysis Intent", segmentsStr)
+}
+
+func (id NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/verifierWorkspaces/%s/reachabilityAnalysisIntents/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.VerifierWorkspaceName, id.ReachabilityAnalysisIntentName)
+}
+
+// NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID parses a NetworkManagerVerifierWorkspaceReachabilityAnalysisIntent ID into an NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId struct
+func NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(input string) (*NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NetworkManagerVerifierWorkspaceReachabilityAnalysisIntent ID: %+v", input, err)
+	}
+
+	resourceId := NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkManagerName, err = id.PopSegment("networkManagers"); err != nil {
+		return nil, err
+	}
+	if resourceId.VerifierWorkspaceName, err = id.PopSegment("verifierWorkspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ReachabilityAnalysisIntentName, err = id.PopSegment("reachabilityAnalysisIntents"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentIDInsensitively parses an NetworkManagerVerifierWorkspaceReachabilityAnalysisIntent ID into an NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentIDInsensitively(input string) (*NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'networkManagers' segment
+	networkManagersKey := "networkManagers"
+	for key := range id.Path {
+		if strings.EqualFold(key, networkManagersKey) {
+			networkManagersKey = key
+			break
+		}
+	}
+	if resourceId.NetworkManagerName, err = id.PopSegment(networkManagersKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'verifierWorkspaces' segment
+	verifierWorkspacesKey := "verifierWorkspaces"
+	for key := range id.Path {
+		if strings.EqualFold(key, verifierWorkspacesKey) {
+			verifierWorkspacesKey = key
+			break
+		}
+	}
+	if resourceId.VerifierWorkspaceName, err = id.PopSegment(verifierWorkspacesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'reachabilityAnalysisIntents' segment
+	reachabilityAnalysisIntentsKey := "reachabilityAnalysisIntents"
+	for key := range id.Path {
+		if strings.EqualFold(key, reachabilityAnalysisIntentsKey) {
+			reachabilityAnalysisIntentsKey = key
+			break
+		}
+	}
+	if resourceId.ReachabilityAnalysisIntentName, err = id.PopSegment(reachabilityAnalysisIntentsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,299 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{}
+
+func TestNetworkManagerVerifierWorkspaceReachabilityAnalysisIntentIDFormatter(t *testing.T) {
+	actual := NewNetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID("12345678-1234-9876-4563-123456789012", "resGroup1", "manager1", "workspace1", "intent1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/reachabilityAnalysisIntents/intent1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing NetworkManagerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for NetworkManagerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/",
+			Error: true,
+		},
+
+		{
+			// missing VerifierWorkspaceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/",
+			Error: true,
+		},
+
+		{
+			// missing value for VerifierWorkspaceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/",
+			Error: true,
+		},
+
+		{
+			// missing ReachabilityAnalysisIntentName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/",
+			Error: true,
+		},
+
+		{
+			// missing value for ReachabilityAnalysisIntentName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/reachabilityAnalysisIntents/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/reachabilityAnalysisIntents/intent1",
+			Expected: &NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+				SubscriptionId:                 "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                  "resGroup1",
+				NetworkManagerName:             "manager1",
+				VerifierWorkspaceName:          "workspace1",
+				ReachabilityAnalysisIntentName: "intent1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/NETWORKMANAGERS/MANAGER1/VERIFIERWORKSPACES/WORKSPACE1/REACHABILITYANALYSISINTENTS/INTENT1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.NetworkManagerName != v.Expected.NetworkManagerName {
+			t.Fatalf("Expected %q but got %q for NetworkManagerName", v.Expected.NetworkManagerName, actual.NetworkManagerName)
+		}
+		if actual.VerifierWorkspaceName != v.Expected.VerifierWorkspaceName {
+			t.Fatalf("Expected %q but got %q for VerifierWorkspaceName", v.Expected.VerifierWorkspaceName, actual.VerifierWorkspaceName)
+		}
+		if actual.ReachabilityAnalysisIntentName != v.Expected.ReachabilityAnalysisIntentName {
+			t.Fatalf("Expected %q but got %q for ReachabilityAnalysisIntentName", v.Expected.ReachabilityAnalysisIntentName, actual.ReachabilityAnalysisIntentName)
+		}
+	}
+}
+
+func TestNetworkManagerVerifierWorkspaceReachabilityAnalysisIntentIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing NetworkManagerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for NetworkManagerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/",
+			Error: true,
+		},
+
+		{
+			// missing VerifierWorkspaceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/",
+			Error: true,
+		},
+
+		{
+			// missing value for VerifierWorkspaceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/",
+			Error: true,
+		},
+
+		{
+			// missing ReachabilityAnalysisIntentName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/",
+			Error: true,
+		},
+
+		{
+			// missing value for ReachabilityAnalysisIntentName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/reachabilityAnalysisIntents/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkManagers/manager1/verifierWorkspaces/workspace1/reachabilityAnalysisIntents/intent1",
+			Expected: &NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+				SubscriptionId:                 "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                  "resGroup1",
+				NetworkManagerName:             "manager1",
+				VerifierWorkspaceName:          "workspace1",
+				ReachabilityAnalysisIntentName: "intent1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkmanagers/manager1/verifierworkspaces/workspace1/reachabilityanalysisintents/intent1",
+			Expected: &NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+				SubscriptionId:                 "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                  "resGroup1",
+				NetworkManagerName:             "manager1",
+				VerifierWorkspaceName:          "workspace1",
+				ReachabilityAnalysisIntentName: "intent1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/NETWORKMANAGERS/manager1/VERIFIERWORKSPACES/workspace1/REACHABILITYANALYSISINTENTS/intent1",
+			Expected: &NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+				SubscriptionId:                 "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                  "resGroup1",
+				NetworkManagerName:             "manager1",
+				VerifierWorkspaceName:          "workspace1",
+				ReachabilityAnalysisIntentName: "intent1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/NeTwOrKmAnAgErS/manager1/VeRiFiErWoRkSpAcEs/workspace1/ReAcHaBiLiTyAnAlYsIsInTeNtS/intent1",
+			Expected: &NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentId{
+				SubscriptionId:                 "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                  "resGroup1",
+				NetworkManagerName:             "manager1",
+				VerifierWorkspaceName:          "workspace1",
+				ReachabilityAnalysisIntentName: "intent1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NetworkManagerVerifierWorkspaceReachabilityAnalysisIntentIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.NetworkManagerName != v.Expected.NetworkManagerName {
+			t.Fatalf("Expected %q but got %q for NetworkManagerName", v.Expected.NetworkManagerName, actual.NetworkManagerName)
+		}
+		if actual.VerifierWorkspaceName != v.Expected.VerifierWorkspaceName {
+			t.Fatalf("Expected %q but got %q for VerifierWorkspaceName", v.Expected.VerifierWorkspaceName, actual.VerifierWorkspaceName)
+		}
+		if actual.ReachabilityAnalysisIntentName != v.Expected.ReachabilityAnalysisIntentName {
+			t.Fatalf("Expected %q but got %q for ReachabilityAnalysisIntentName", v.Expected.ReachabilityAnalysisIntentName, actual.ReachabilityAnalysisIntentName)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId struct {
+	SubscriptionId              string
+	ResourceGroup               string
+	NetworkManagerName          string
+	VerifierWorkspaceName       string
+	ReachabilityAnalysisRunName string
+}
+
+func NewNetworkManagerVerifierWorkspaceReachabilityAnalysisRunID(subscriptionId, resourceGroup, networkManagerName, verifierWorkspaceName, reachabilityAnalysisRunName string) NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId {
+	return NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId{
+		SubscriptionId:              subscriptionId,
+		ResourceGroup:               resourceGroup,
+		NetworkManagerName:          networkManagerName,
+		VerifierWorkspaceName:       verifierWorkspaceName,
+		ReachabilityAnalysisRunName: reachabilityAnalysisRunName,
+	}
+}
+
+func (id NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId) String() string {
+	segments := []string{
+		fmt.Sprintf("Reachability Analysis Run Name %q", id.ReachabilityAnalysisRunName),
+		fmt.Sprintf("Verifier Workspace Name %q", id.VerifierWorkspaceName),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Verifier Workspace Reachability Analysis Run", segmentsStr)
+}
+
+func (id NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/verifierWorkspaces/%s/reachabilityAnalysisRuns/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName, id.VerifierWorkspaceName, id.ReachabilityAnalysisRunName)
+}
+
+// NetworkManagerVerifierWorkspaceReachabilityAnalysisRunID parses a NetworkManagerVerifierWorkspaceReachabilityAnalysisRun ID into an NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId struct
+func NetworkManagerVerifierWorkspaceReachabilityAnalysisRunID(input string) (*NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an NetworkManagerVerifierWorkspaceReachabilityAnalysisRun ID: %+v", input, err)
+	}
+
+	resourceId := NetworkManagerVerifierWorkspaceReachabilityAnalysisRunId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkManagerName, err = id.PopSegment("networkManagers"); err != nil {
+		return nil, err
+	}
+	if resourceId.VerifierWorkspaceName, err = id.PopSegment("verifierWorkspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.ReachabilityAnalysisRunName, err = id.PopSegment("reachabilityAnalysisRuns"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PrivateLinkServiceAutoApprovalSubscriptionId struct {
+	SubscriptionId               string
+	ResourceGroup                string
+	PrivateLinkServiceName       string
+	AutoApprovalSubscriptionName string
+}
+
+func NewPrivateLinkServiceAutoApprovalSubscriptionID(subscriptionId, resourceGroup, privateLinkServiceName, autoApprovalSubscriptionName string) PrivateLinkServiceAutoApprovalSubscriptionId {
+	return PrivateLinkServiceAutoApprovalSubscriptionId{
+		SubscriptionId:               subscriptionId,
+		ResourceGroup:                resourceGroup,
+		PrivateLinkServiceName:       privateLinkServiceName,
+		AutoApprovalSubscriptionName: autoApprovalSubscriptionName,
+	}
+}
+
+func (id PrivateLinkServiceAutoApprovalSubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Auto Approval Subscription Name %q", id.AutoApprovalSubscriptionName),
+		fmt.Sprintf("Private Link Service Name %q", id.PrivateLinkServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Private Link Service Auto Approval Subscription", segmentsStr)
+}
+
+func (id PrivateLinkServiceAutoApprovalSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateLinkServices/%s/autoApprovalSubscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateLinkServiceName, id.AutoApprovalSubscriptionName)
+}
+
+// PrivateLinkServiceAutoApprovalSubscriptionID parses a PrivateLinkServiceAutoApprovalSubscription ID into an PrivateLinkServiceAutoApprovalSubscriptionId struct
+func PrivateLinkServiceAutoApprovalSubscriptionID(input string) (*PrivateLinkServiceAutoApprovalSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an PrivateLinkServiceAutoApprovalSubscription ID: %+v", input, err)
+	}
+
+	resourceId := PrivateLinkServiceAutoApprovalSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.PrivateLinkServiceName, err = id.PopSegment("privateLinkServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.AutoApprovalSubscriptionName, err = id.PopSegment("autoApprovalSubscriptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,128 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = PrivateLinkServiceAutoApprovalSubscriptionId{}
+
+func TestPrivateLinkServiceAutoApprovalSubscriptionIDFormatter(t *testing.T) {
+	actual := NewPrivateLinkServiceAutoApprovalSubscriptionID("12345678-1234-9876-4563-123456789012", "resGroup1", "privateLinkService1", "00000000-0000-0000-0000-000000000000").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateLinkServices/privateLinkService1/autoApprovalSubscriptions/00000000-0000-0000-0000-000000000000"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPrivateLinkServiceAutoApprovalSubscriptionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateLinkServiceAutoApprovalSubscriptionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing PrivateLinkServiceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for PrivateLinkServiceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateLinkServices/",
+			Error: true,
+		},
+
+		{
+			// missing AutoApprovalSubscriptionName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateLinkServices/privateLinkService1/",
+			Error: true,
+		},
+
+		{
+			// missing value for AutoApprovalSubscriptionName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateLinkServices/privateLinkService1/autoApprovalSubscriptions/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateLinkServices/privateLinkService1/autoApprovalSubscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: &PrivateLinkServiceAutoApprovalSubscriptionId{
+				SubscriptionId:               "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                "resGroup1",
+				PrivateLinkServiceName:       "privateLinkService1",
+				AutoApprovalSubscriptionName: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/PRIVATELINKSERVICES/PRIVATELINKSERVICE1/AUTOAPPROVALSUBSCRIPTIONS/00000000-0000-0000-0000-000000000000",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PrivateLinkServiceAutoApprovalSubscriptionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.PrivateLinkServiceName != v.Expected.PrivateLinkServiceName {
+			t.Fatalf("Expected %q but got %q for PrivateLinkServiceName", v.Expected.PrivateLinkServiceName, actual.PrivateLinkServiceName)
+		}
+		if actual.AutoApprovalSubscriptionName != v.Expected.AutoApprovalSubscriptionName {
+			t.Fatalf("Expected %q but got %q for AutoApprovalSubscriptionName", v.Expected.AutoApprovalSubscriptionName, actual.AutoApprovalSubscriptionName)
+		}
+	}
+}
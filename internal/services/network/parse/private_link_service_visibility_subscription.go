@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PrivateLinkServiceVisibilitySubscriptionId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	PrivateLinkServiceName     string
+	VisibilitySubscriptionName string
+}
+
+func NewPrivateLinkServiceVisibilitySubscriptionID(subscriptionId, resourceGroup, privateLinkServiceName, visibilitySubscriptionName string) PrivateLinkServiceVisibilitySubscriptionId {
+	return PrivateLinkServiceVisibilitySubscriptionId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		PrivateLinkServiceName:     privateLinkServiceName,
+		VisibilitySubscriptionName: visibilitySubscriptionName,
+	}
+}
+
+func (id PrivateLinkServiceVisibilitySubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Visibility Subscription Name %q", id.VisibilitySubscriptionName),
+		fmt.Sprintf("Private Link Service Name %q", id.PrivateLinkServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Private Link Service Visibility Subscription", segmentsStr)
+}
+
+func (id PrivateLinkServiceVisibilitySubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateLinkServices/%s/visibilitySubscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateLinkServiceName, id.VisibilitySubscriptionName)
+}
+
+// PrivateLinkServiceVisibilitySubscriptionID parses a PrivateLinkServiceVisibilitySubscription ID into an PrivateLinkServiceVisibilitySubscriptionId struct
+func PrivateLinkServiceVisibilitySubscriptionID(input string) (*PrivateLinkServiceVisibilitySubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an PrivateLinkServiceVisibilitySubscription ID: %+v", input, err)
+	}
+
+	resourceId := PrivateLinkServiceVisibilitySubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.PrivateLinkServiceName, err = id.PopSegment("privateLinkServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.VisibilitySubscriptionName, err = id.PopSegment("visibilitySubscriptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
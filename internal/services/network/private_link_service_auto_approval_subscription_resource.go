@@ -0,0 +1,192 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+type PrivateLinkServiceAutoApprovalSubscriptionModel struct {
+	PrivateLinkServiceId string `tfschema:"private_link_service_id"`
+	SubscriptionId       string `tfschema:"subscription_id"`
+}
+
+type PrivateLinkServiceAutoApprovalSubscriptionResource struct{}
+
+var _ sdk.Resource = PrivateLinkServiceAutoApprovalSubscriptionResource{}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) ResourceType() string {
+	return "azurerm_private_link_service_auto_approval_subscription"
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) ModelObject() interface{} {
+	return &PrivateLinkServiceAutoApprovalSubscriptionModel{}
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateLinkServiceAutoApprovalSubscriptionID
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_link_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateLinkServiceID,
+		},
+
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServiceClient
+
+			var model PrivateLinkServiceAutoApprovalSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			privateLinkServiceId, err := parse.PrivateLinkServiceID(model.PrivateLinkServiceId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+			defer locks.UnlockByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+
+			id := parse.NewPrivateLinkServiceAutoApprovalSubscriptionID(privateLinkServiceId.SubscriptionId, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, model.SubscriptionId)
+
+			existing, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *privateLinkServiceId, err)
+			}
+			if existing.PrivateLinkServiceProperties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *privateLinkServiceId)
+			}
+
+			props := existing.PrivateLinkServiceProperties
+			if props.AutoApproval == nil {
+				props.AutoApproval = &network.PrivateLinkServicePropertiesAutoApproval{}
+			}
+			if privateLinkServiceSubscriptionsContain(props.AutoApproval.Subscriptions, model.SubscriptionId) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			subscriptions := make([]string, 0)
+			if props.AutoApproval.Subscriptions != nil {
+				subscriptions = append(subscriptions, *props.AutoApproval.Subscriptions...)
+			}
+			subscriptions = append(subscriptions, model.SubscriptionId)
+			props.AutoApproval.Subscriptions = &subscriptions
+
+			if err := updatePrivateLinkService(ctx, client, *privateLinkServiceId, existing); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServiceClient
+
+			id, err := parse.PrivateLinkServiceAutoApprovalSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			privateLinkServiceId := parse.NewPrivateLinkServiceID(id.SubscriptionId, id.ResourceGroup, id.PrivateLinkServiceName)
+
+			resp, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", privateLinkServiceId, err)
+			}
+
+			var subscriptions *[]string
+			if props := resp.PrivateLinkServiceProperties; props != nil && props.AutoApproval != nil {
+				subscriptions = props.AutoApproval.Subscriptions
+			}
+			if !privateLinkServiceSubscriptionsContain(subscriptions, id.AutoApprovalSubscriptionName) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := PrivateLinkServiceAutoApprovalSubscriptionModel{
+				PrivateLinkServiceId: privateLinkServiceId.ID(),
+				SubscriptionId:       id.AutoApprovalSubscriptionName,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServiceClient
+
+			id, err := parse.PrivateLinkServiceAutoApprovalSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			privateLinkServiceId := parse.NewPrivateLinkServiceID(id.SubscriptionId, id.ResourceGroup, id.PrivateLinkServiceName)
+
+			locks.ByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+			defer locks.UnlockByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+
+			existing, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", privateLinkServiceId, err)
+			}
+
+			props := existing.PrivateLinkServiceProperties
+			if props == nil || props.AutoApproval == nil || !privateLinkServiceSubscriptionsContain(props.AutoApproval.Subscriptions, id.AutoApprovalSubscriptionName) {
+				return nil
+			}
+
+			props.AutoApproval.Subscriptions = privateLinkServiceSubscriptionsWithout(props.AutoApproval.Subscriptions, id.AutoApprovalSubscriptionName)
+
+			if err := updatePrivateLinkService(ctx, client, privateLinkServiceId, existing); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
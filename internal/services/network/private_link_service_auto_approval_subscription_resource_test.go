@@ -0,0 +1,93 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateLinkServiceAutoApprovalSubscriptionResource struct{}
+
+func TestAccPrivateLinkServiceAutoApprovalSubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_link_service_auto_approval_subscription", "test")
+	r := PrivateLinkServiceAutoApprovalSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateLinkServiceAutoApprovalSubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_link_service_auto_approval_subscription", "test")
+	r := PrivateLinkServiceAutoApprovalSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateLinkServiceAutoApprovalSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.PrivateLinkServiceClient.Get(ctx, id.ResourceGroup, id.PrivateLinkServiceName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Private Link Service %q (Resource Group %q): %+v", id.PrivateLinkServiceName, id.ResourceGroup, err)
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil && props.AutoApproval != nil && props.AutoApproval.Subscriptions != nil {
+		for _, v := range *props.AutoApproval.Subscriptions {
+			if strings.EqualFold(v, id.AutoApprovalSubscriptionName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_private_link_service_auto_approval_subscription" "test" {
+  private_link_service_id = azurerm_private_link_service.test.id
+  subscription_id         = data.azurerm_client_config.current.subscription_id
+}
+`, PrivateLinkServiceResource{}.basic(data))
+}
+
+func (r PrivateLinkServiceAutoApprovalSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_link_service_auto_approval_subscription" "import" {
+  private_link_service_id = azurerm_private_link_service_auto_approval_subscription.test.private_link_service_id
+  subscription_id         = azurerm_private_link_service_auto_approval_subscription.test.subscription_id
+}
+`, r.basic(data))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -51,9 +52,12 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			// these can also be managed using the `azurerm_private_link_service_auto_approval_subscription` and
+			// `azurerm_private_link_service_visibility_subscription` resources, so they're Computed to avoid a diff
 			"auto_approval_subscription_ids": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -70,6 +74,7 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 			"visibility_subscription_ids": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Computed: true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.Any(validation.IsUUID, validation.StringInSlice([]string{"*"}, false)),
@@ -88,7 +93,8 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 
 			// Required by the API you can't create the resource without at least
 			// one ip configuration once primary is set it is set forever unless
-			// you destroy the resource and recreate it.
+			// you destroy the resource and recreate it - secondary ip configurations
+			// can be added and removed in-place, which is checked in the CustomizeDiff
 			"nat_ip_configuration": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
@@ -98,7 +104,6 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 						"name": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: networkValidate.PrivateLinkName,
 						},
 						"private_ip_address": {
@@ -124,7 +129,6 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 						"primary": {
 							Type:     pluginsdk.TypeBool,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -155,6 +159,18 @@ func resourcePrivateLinkService() *pluginsdk.Resource {
 				return err
 			}
 
+			// the primary ip configuration can't be changed once it's been set, so the resource has to be recreated
+			if d.HasChange("nat_ip_configuration") {
+				o, n := d.GetChange("nat_ip_configuration")
+				oldPrimary := privateLinkServicePrimaryNatIpConfigurationName(o.([]interface{}))
+				newPrimary := privateLinkServicePrimaryNatIpConfigurationName(n.([]interface{}))
+				if oldPrimary != "" && oldPrimary != newPrimary {
+					if err := d.ForceNew("nat_ip_configuration"); err != nil {
+						return err
+					}
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -168,6 +184,9 @@ func resourcePrivateLinkServiceCreateUpdate(d *pluginsdk.ResourceData, meta inte
 
 	id := parse.NewPrivateLinkServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
+	locks.ByName(id.Name, privateLinkServiceResourceName)
+	defer locks.UnlockByName(id.Name, privateLinkServiceResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 		if err != nil {
@@ -308,6 +327,9 @@ func resourcePrivateLinkServiceDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, privateLinkServiceResourceName)
+	defer locks.UnlockByName(id.Name, privateLinkServiceResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -458,6 +480,20 @@ func privateLinkServiceWaitForReadyRefreshFunc(ctx context.Context, client *netw
 	}
 }
 
+func privateLinkServicePrimaryNatIpConfigurationName(input []interface{}) string {
+	for _, item := range input {
+		v, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if primary, ok := v["primary"].(bool); ok && primary {
+			return v["name"].(string)
+		}
+	}
+
+	return ""
+}
+
 func validatePrivateLinkNatIpConfiguration(d *pluginsdk.ResourceDiff) error {
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+const privateLinkServiceResourceName = "azurerm_private_link_service"
+
+func privateLinkServiceSubscriptionsContain(input *[]string, subscriptionId string) bool {
+	if input == nil {
+		return false
+	}
+
+	for _, v := range *input {
+		if strings.EqualFold(v, subscriptionId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func privateLinkServiceSubscriptionsWithout(input *[]string, subscriptionId string) *[]string {
+	results := make([]string, 0)
+	if input == nil {
+		return &results
+	}
+
+	for _, v := range *input {
+		if !strings.EqualFold(v, subscriptionId) {
+			results = append(results, v)
+		}
+	}
+
+	return &results
+}
+
+// updatePrivateLinkService updates the Private Link Service and then waits for the changes to be applied, since the
+// Private Link Service remains in an Updating state after the long running operation completes
+func updatePrivateLinkService(ctx context.Context, client *network.PrivateLinkServicesClient, id parse.PrivateLinkServiceId, parameters network.PrivateLinkService) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return err
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update: %+v", err)
+	}
+
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending", "Updating", "Creating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    privateLinkServiceWaitForReadyRefreshFunc(ctx, client, id.ResourceGroup, id.Name),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the changes to be applied: %+v", err)
+	}
+
+	return nil
+}
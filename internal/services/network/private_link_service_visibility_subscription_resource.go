@@ -0,0 +1,192 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+type PrivateLinkServiceVisibilitySubscriptionModel struct {
+	PrivateLinkServiceId string `tfschema:"private_link_service_id"`
+	SubscriptionId       string `tfschema:"subscription_id"`
+}
+
+type PrivateLinkServiceVisibilitySubscriptionResource struct{}
+
+var _ sdk.Resource = PrivateLinkServiceVisibilitySubscriptionResource{}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) ResourceType() string {
+	return "azurerm_private_link_service_visibility_subscription"
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) ModelObject() interface{} {
+	return &PrivateLinkServiceVisibilitySubscriptionModel{}
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrivateLinkServiceVisibilitySubscriptionID
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_link_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PrivateLinkServiceID,
+		},
+
+		"subscription_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(validation.IsUUID, validation.StringInSlice([]string{"*"}, false)),
+		},
+	}
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServiceClient
+
+			var model PrivateLinkServiceVisibilitySubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			privateLinkServiceId, err := parse.PrivateLinkServiceID(model.PrivateLinkServiceId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+			defer locks.UnlockByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+
+			id := parse.NewPrivateLinkServiceVisibilitySubscriptionID(privateLinkServiceId.SubscriptionId, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, model.SubscriptionId)
+
+			existing, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *privateLinkServiceId, err)
+			}
+			if existing.PrivateLinkServiceProperties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *privateLinkServiceId)
+			}
+
+			props := existing.PrivateLinkServiceProperties
+			if props.Visibility == nil {
+				props.Visibility = &network.PrivateLinkServicePropertiesVisibility{}
+			}
+			if privateLinkServiceSubscriptionsContain(props.Visibility.Subscriptions, model.SubscriptionId) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			subscriptions := make([]string, 0)
+			if props.Visibility.Subscriptions != nil {
+				subscriptions = append(subscriptions, *props.Visibility.Subscriptions...)
+			}
+			subscriptions = append(subscriptions, model.SubscriptionId)
+			props.Visibility.Subscriptions = &subscriptions
+
+			if err := updatePrivateLinkService(ctx, client, *privateLinkServiceId, existing); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServiceClient
+
+			id, err := parse.PrivateLinkServiceVisibilitySubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			privateLinkServiceId := parse.NewPrivateLinkServiceID(id.SubscriptionId, id.ResourceGroup, id.PrivateLinkServiceName)
+
+			resp, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", privateLinkServiceId, err)
+			}
+
+			var subscriptions *[]string
+			if props := resp.PrivateLinkServiceProperties; props != nil && props.Visibility != nil {
+				subscriptions = props.Visibility.Subscriptions
+			}
+			if !privateLinkServiceSubscriptionsContain(subscriptions, id.VisibilitySubscriptionName) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := PrivateLinkServiceVisibilitySubscriptionModel{
+				PrivateLinkServiceId: privateLinkServiceId.ID(),
+				SubscriptionId:       id.VisibilitySubscriptionName,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateLinkServiceClient
+
+			id, err := parse.PrivateLinkServiceVisibilitySubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			privateLinkServiceId := parse.NewPrivateLinkServiceID(id.SubscriptionId, id.ResourceGroup, id.PrivateLinkServiceName)
+
+			locks.ByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+			defer locks.UnlockByName(privateLinkServiceId.Name, privateLinkServiceResourceName)
+
+			existing, err := client.Get(ctx, privateLinkServiceId.ResourceGroup, privateLinkServiceId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", privateLinkServiceId, err)
+			}
+
+			props := existing.PrivateLinkServiceProperties
+			if props == nil || props.Visibility == nil || !privateLinkServiceSubscriptionsContain(props.Visibility.Subscriptions, id.VisibilitySubscriptionName) {
+				return nil
+			}
+
+			props.Visibility.Subscriptions = privateLinkServiceSubscriptionsWithout(props.Visibility.Subscriptions, id.VisibilitySubscriptionName)
+
+			if err := updatePrivateLinkService(ctx, client, privateLinkServiceId, existing); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateLinkServiceVisibilitySubscriptionResource struct{}
+
+func TestAccPrivateLinkServiceVisibilitySubscription_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_link_service_visibility_subscription", "test")
+	r := PrivateLinkServiceVisibilitySubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateLinkServiceVisibilitySubscription_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_link_service_visibility_subscription", "test")
+	r := PrivateLinkServiceVisibilitySubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateLinkServiceVisibilitySubscription_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_link_service_visibility_subscription", "test")
+	r := PrivateLinkServiceVisibilitySubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_private_link_service_visibility_subscription.all").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateLinkServiceVisibilitySubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.PrivateLinkServiceClient.Get(ctx, id.ResourceGroup, id.PrivateLinkServiceName, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Private Link Service %q (Resource Group %q): %+v", id.PrivateLinkServiceName, id.ResourceGroup, err)
+	}
+
+	if props := resp.PrivateLinkServiceProperties; props != nil && props.Visibility != nil && props.Visibility.Subscriptions != nil {
+		for _, v := range *props.Visibility.Subscriptions {
+			if strings.EqualFold(v, id.VisibilitySubscriptionName) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_private_link_service_visibility_subscription" "test" {
+  private_link_service_id = azurerm_private_link_service.test.id
+  subscription_id         = data.azurerm_client_config.current.subscription_id
+}
+`, PrivateLinkServiceResource{}.basic(data))
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_link_service_visibility_subscription" "import" {
+  private_link_service_id = azurerm_private_link_service_visibility_subscription.test.private_link_service_id
+  subscription_id         = azurerm_private_link_service_visibility_subscription.test.subscription_id
+}
+`, r.basic(data))
+}
+
+func (r PrivateLinkServiceVisibilitySubscriptionResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_link_service_visibility_subscription" "all" {
+  private_link_service_id = azurerm_private_link_service.test.id
+  subscription_id         = "*"
+}
+`, r.basic(data))
+}
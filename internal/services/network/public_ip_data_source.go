@@ -148,12 +148,9 @@ func dataSourcePublicIPRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if ddosSetting := props.DdosSettings; ddosSetting != nil {
-		d.Set("ddos_protection_mode", string(ddosSetting.ProtectionMode))
-		if subResource := ddosSetting.DdosProtectionPlan; subResource != nil {
-			d.Set("ddos_protection_plan_id", subResource.ID)
-		}
-	}
+	ddosProtectionMode, ddosProtectionPlanId := flattenPublicIpDdosSettings(props.DdosSettings)
+	d.Set("ddos_protection_mode", ddosProtectionMode)
+	d.Set("ddos_protection_plan_id", ddosProtectionPlanId)
 
 	d.Set("domain_name_label", domainNameLabel)
 	d.Set("fqdn", fqdn)
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -152,9 +153,10 @@ func resourcePublicIp() *pluginsdk.Resource {
 			},
 
 			"ip_tags": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PublicIpTags,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
@@ -164,6 +166,8 @@ func resourcePublicIp() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(publicIpDdosProtectionCustomizeDiff),
 	}
 }
 
@@ -224,11 +228,7 @@ func resourcePublicIpCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		},
 		Tags: tags.Expand(t),
 	}
-	ddosProtectionPlanId, planOk := d.GetOk("ddos_protection_plan_id")
-	if planOk {
-		if !strings.EqualFold(ddosProtectionMode, "enabled") {
-			return fmt.Errorf("ddos protection plan id can only be set when ddos protection is enabled")
-		}
+	if ddosProtectionPlanId, ok := d.GetOk("ddos_protection_plan_id"); ok {
 		publicIp.PublicIPAddressPropertiesFormat.DdosSettings.DdosProtectionPlan = &network.SubResource{
 			ID: utils.String(ddosProtectionPlanId.(string)),
 		}
@@ -337,14 +337,9 @@ func resourcePublicIpRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("domain_name_label", settings.DomainNameLabel)
 		}
 
-		ddosProtectionMode := string(network.DdosSettingsProtectionModeVirtualNetworkInherited)
-		if ddosSetting := props.DdosSettings; ddosSetting != nil {
-			ddosProtectionMode = string(ddosSetting.ProtectionMode)
-			if subResource := ddosSetting.DdosProtectionPlan; subResource != nil {
-				d.Set("ddos_protection_plan_id", subResource.ID)
-			}
-		}
+		ddosProtectionMode, ddosProtectionPlanId := flattenPublicIpDdosSettings(props.DdosSettings)
 		d.Set("ddos_protection_mode", ddosProtectionMode)
+		d.Set("ddos_protection_plan_id", ddosProtectionPlanId)
 
 		d.Set("ip_tags", flattenPublicIpPropsIpTags(props.IPTags))
 
@@ -377,6 +372,40 @@ func resourcePublicIpDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// publicIpDdosProtectionCustomizeDiff validates the DDoS Protection settings at plan time, rather than having the API reject them during apply
+func publicIpDdosProtectionCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	ddosProtectionMode := d.Get("ddos_protection_mode").(string)
+
+	if ddosProtectionMode == string(network.DdosSettingsProtectionModeEnabled) && d.Get("sku").(string) == string(network.PublicIPAddressSkuNameBasic) {
+		return fmt.Errorf("`ddos_protection_mode` can only be set to `Enabled` when `sku` is `Standard`")
+	}
+
+	if v := d.Get("ddos_protection_plan_id").(string); v != "" && ddosProtectionMode != string(network.DdosSettingsProtectionModeEnabled) {
+		return fmt.Errorf("`ddos_protection_plan_id` can only be set when `ddos_protection_mode` is `Enabled`")
+	}
+
+	return nil
+}
+
+func flattenPublicIpDdosSettings(input *network.DdosSettings) (string, string) {
+	ddosProtectionMode := string(network.DdosSettingsProtectionModeVirtualNetworkInherited)
+	ddosProtectionPlanId := ""
+
+	if input != nil {
+		if input.ProtectionMode != "" {
+			ddosProtectionMode = string(input.ProtectionMode)
+		}
+		if input.DdosProtectionPlan != nil && input.DdosProtectionPlan.ID != nil {
+			ddosProtectionPlanId = *input.DdosProtectionPlan.ID
+			if parsed, err := parse.DdosProtectionPlanIDInsensitively(ddosProtectionPlanId); err == nil {
+				ddosProtectionPlanId = parsed.ID()
+			}
+		}
+	}
+
+	return ddosProtectionMode, ddosProtectionPlanId
+}
+
 func flattenPublicIpPropsIpTags(input *[]network.IPTag) map[string]interface{} {
 	out := make(map[string]interface{})
 
@@ -217,6 +217,30 @@ func TestAccPublicIpStatic_standard_withDDoS(t *testing.T) {
 	})
 }
 
+func TestAccPublicIpStatic_ddosProtectionPlanRequiresEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.standardDDoSPlanWithMode(data, "VirtualNetworkInherited"),
+			ExpectError: regexp.MustCompile("`ddos_protection_plan_id` can only be set when `ddos_protection_mode` is `Enabled`"),
+		},
+	})
+}
+
+func TestAccPublicIpStatic_invalidIpTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
+	r := PublicIPResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidIpTags(data),
+			ExpectError: regexp.MustCompile("which is not supported"),
+		},
+	})
+}
+
 func TestAccPublicIpStatic_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_public_ip", "test")
 	r := PublicIPResource{}
@@ -627,6 +651,60 @@ resource "azurerm_public_ip" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (PublicIPResource) standardDDoSPlanWithMode(data acceptance.TestData, mode string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_public_ip" "test" {
+  name                    = "acctestpublicip-%[1]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  allocation_method       = "Static"
+  sku                     = "Standard"
+  ddos_protection_mode    = "%[3]s"
+  ddos_protection_plan_id = azurerm_network_ddos_protection_plan.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, mode)
+}
+
+func (PublicIPResource) invalidIpTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  ip_tags = {
+    NetworkDomain = "Internet"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (PublicIPResource) standardPrefix(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
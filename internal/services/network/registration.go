@@ -29,15 +29,21 @@ func (r Registration) WebsiteCategories() []string {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		ManagerDeploymentStatusDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ExpressRouteTrafficCollectorResource{},
+		ExpressRouteTrafficCollectorPolicyResource{},
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
 		ManagerDeploymentResource{},
 		ManagerConnectivityConfigurationResource{},
+		ManagerIpamPoolResource{},
+		ManagerIpamPoolStaticCidrResource{},
 		ManagerManagementGroupConnectionResource{},
 		ManagerNetworkGroupResource{},
 		ManagerResource{},
@@ -45,7 +51,12 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerSecurityAdminConfigurationResource{},
 		ManagerStaticMemberResource{},
 		ManagerSubscriptionConnectionResource{},
+		ManagerVerifierWorkspaceResource{},
+		ManagerVerifierWorkspaceReachabilityAnalysisIntentResource{},
+		ManagerVerifierWorkspaceReachabilityAnalysisRunResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
+		PrivateLinkServiceAutoApprovalSubscriptionResource{},
+		PrivateLinkServiceVisibilitySubscriptionResource{},
 		RouteMapResource{},
 	}
 }
@@ -70,12 +81,15 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_public_ips":                                dataSourcePublicIPs(),
 		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
 		"azurerm_route_filter":                              dataSourceRouteFilter(),
+		"azurerm_route_server_bgp_peer_status":              dataSourceRouteServerBgpPeerStatus(),
 		"azurerm_route_table":                               dataSourceRouteTable(),
 		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
+		"azurerm_network_service_tags_list":                 dataSourceNetworkServiceTagsList(),
 		"azurerm_subnet":                                    dataSourceSubnet(),
 		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
 		"azurerm_virtual_hub_connection":                    dataSourceVirtualHubConnection(),
 		"azurerm_virtual_hub_route_table":                   dataSourceVirtualHubRouteTable(),
+		"azurerm_virtual_hub_route_table_effective_routes":  dataSourceVirtualHubRouteTableEffectiveRoutes(),
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
@@ -124,6 +138,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_public_ip":                                 resourcePublicIp(),
 		"azurerm_public_ip_prefix":                          resourcePublicIpPrefix(),
 		"azurerm_network_security_group":                    resourceNetworkSecurityGroup(),
+		"azurerm_network_security_group_rules":              resourceNetworkSecurityGroupRules(),
 		"azurerm_network_security_rule":                     resourceNetworkSecurityRule(),
 		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                           resourceNetworkWatcher(),
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+func dataSourceRouteServerBgpPeerStatus() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceRouteServerBgpPeerStatusRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"route_server_bgp_connection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.BgpConnectionID,
+			},
+
+			"connection_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"peer_asn": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"peer_ip": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"learned_route": routeServerPeerRouteSchema(),
+
+			"advertised_route": routeServerPeerRouteSchema(),
+		},
+	}
+}
+
+func routeServerPeerRouteSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"network": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"as_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRouteServerBgpPeerStatusRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionClient
+	routesClient := meta.(*clients.Client).Network.VirtualHubBgpConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BgpConnectionID(d.Get("route_server_bgp_connection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.BgpConnectionProperties; props != nil {
+		d.Set("connection_state", string(props.ConnectionState))
+
+		peerAsn := 0
+		if props.PeerAsn != nil {
+			peerAsn = int(*props.PeerAsn)
+		}
+		d.Set("peer_asn", peerAsn)
+		d.Set("peer_ip", props.PeerIP)
+	}
+
+	learnedFuture, err := routesClient.ListLearnedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing learned routes for %s: %+v", *id, err)
+	}
+	if err := learnedFuture.WaitForCompletionRef(ctx, routesClient.Client); err != nil {
+		return fmt.Errorf("waiting for the learned routes for %s: %+v", *id, err)
+	}
+	learned, err := learnedFuture.Result(*routesClient)
+	if err != nil {
+		return fmt.Errorf("retrieving learned routes for %s: %+v", *id, err)
+	}
+	if err := d.Set("learned_route", flattenRouteServerPeerRoutes(learned.Value)); err != nil {
+		return fmt.Errorf("setting `learned_route`: %+v", err)
+	}
+
+	advertisedFuture, err := routesClient.ListAdvertisedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing advertised routes for %s: %+v", *id, err)
+	}
+	if err := advertisedFuture.WaitForCompletionRef(ctx, routesClient.Client); err != nil {
+		return fmt.Errorf("waiting for the advertised routes for %s: %+v", *id, err)
+	}
+	advertised, err := advertisedFuture.Result(*routesClient)
+	if err != nil {
+		return fmt.Errorf("retrieving advertised routes for %s: %+v", *id, err)
+	}
+	if err := d.Set("advertised_route", flattenRouteServerPeerRoutes(advertised.Value)); err != nil {
+		return fmt.Errorf("setting `advertised_route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenRouteServerPeerRoutes(input *[]network.PeerRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		weight := 0
+		if item.Weight != nil {
+			weight = int(*item.Weight)
+		}
+
+		results = append(results, map[string]interface{}{
+			"network":       utils.NormalizeNilableString(item.NetworkProperty),
+			"next_hop":      utils.NormalizeNilableString(item.NextHop),
+			"as_path":       utils.NormalizeNilableString(item.AsPath),
+			"origin":        utils.NormalizeNilableString(item.Origin),
+			"source_peer":   utils.NormalizeNilableString(item.SourcePeer),
+			"local_address": utils.NormalizeNilableString(item.LocalAddress),
+			"weight":        weight,
+		})
+	}
+
+	return results
+}
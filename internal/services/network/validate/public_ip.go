@@ -3,6 +3,8 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 )
 
 func PublicIpDomainNameLabel(v interface{}, k string) (warnings []string, errors []error) {
@@ -12,3 +14,27 @@ func PublicIpDomainNameLabel(v interface{}, k string) (warnings []string, errors
 	}
 	return warnings, errors
 }
+
+func PublicIpTags(v interface{}, k string) (warnings []string, errors []error) {
+	allowed := []string{"FirstPartyUsage", "RoutingPreference"}
+
+	keys := make([]string, 0)
+	for key := range v.(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		valid := false
+		for _, a := range allowed {
+			if key == a {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			errors = append(errors, fmt.Errorf("%s contains the key %q which is not supported, supported keys are %s", k, key, strings.Join(allowed, ", ")))
+		}
+	}
+	return warnings, errors
+}
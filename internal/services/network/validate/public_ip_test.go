@@ -39,3 +39,39 @@ func TestPublicIpDomainNameLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestPublicIpTags(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"RoutingPreference": "Internet"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"FirstPartyUsage": "/Sql", "RoutingPreference": "Internet"},
+			ErrCount: 0,
+		},
+		{
+			Value:    map[string]interface{}{"routingpreference": "Internet"},
+			ErrCount: 1,
+		},
+		{
+			Value:    map[string]interface{}{"NetworkDomain": "Internet", "Other": "Value"},
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PublicIpTags(tc.Value, "ip_tags")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for the Public IP Tags %v but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+func dataSourceVirtualHubRouteTableEffectiveRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualHubRouteTableEffectiveRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"route_table_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.HubRouteTableID,
+			},
+
+			"route": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"address_prefixes": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hops": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"next_hop_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"as_path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"route_origin": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualHubRouteTableEffectiveRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.HubRouteTableID(d.Get("route_table_id").(string))
+	if err != nil {
+		return err
+	}
+
+	parameters := network.EffectiveRoutesParameters{
+		ResourceID:             utils.String(id.ID()),
+		VirtualWanResourceType: utils.String("RouteTable"),
+	}
+
+	resp, err := azuresdkhacks.GetVirtualHubEffectiveRoutes(ctx, client, id.ResourceGroup, id.VirtualHubName, parameters)
+	if err != nil {
+		return fmt.Errorf("retrieving effective routes for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("route", flattenVirtualHubEffectiveRoutes(resp.Value)); err != nil {
+		return fmt.Errorf("setting `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualHubEffectiveRoutes(input *[]network.VirtualHubEffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"address_prefixes": utils.FlattenStringSlice(item.AddressPrefixes),
+			"next_hops":        utils.FlattenStringSlice(item.NextHops),
+			"next_hop_type":    utils.NormalizeNilableString(item.NextHopType),
+			"as_path":          utils.NormalizeNilableString(item.AsPath),
+			"route_origin":     utils.NormalizeNilableString(item.RouteOrigin),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,36 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualHubRouteTableEffectiveRoutesDataSource struct{}
+
+func TestAccDataSourceVirtualHubRouteTableEffectiveRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_hub_route_table_effective_routes", "test")
+	r := VirtualHubRouteTableEffectiveRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route_table_id").Exists(),
+				check.That(data.ResourceName).Key("route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualHubRouteTableEffectiveRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_hub_route_table_effective_routes" "test" {
+  route_table_id = azurerm_virtual_hub_route_table.test.id
+}
+`, VirtualHubRouteTableResource{}.basic(data))
+}
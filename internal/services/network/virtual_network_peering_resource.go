@@ -1,7 +1,9 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -39,6 +41,8 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualNetworkPeeringCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -85,6 +89,12 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"sync_remote_address_space": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"triggers": {
 				Type:     pluginsdk.TypeMap,
 				Optional: true,
@@ -92,6 +102,16 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 					Type: pluginsdk.TypeString,
 				},
 			},
+
+			"peering_sync_level": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"remote_subscription_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -111,7 +131,19 @@ func resourceVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	if !utils.ResponseWasNotFound(existing.Response) {
-		return tf.ImportAsExistsError("azurerm_virtual_network_peering", id.ID())
+		// a peering becomes Disconnected when the remote Virtual Network is recreated and can't be reconnected, so it
+		// has been removed from the state during the Read - as such it's replaced rather than requiring an import
+		if props := existing.VirtualNetworkPeeringPropertiesFormat; props == nil || props.PeeringState != network.VirtualNetworkPeeringStateDisconnected {
+			return tf.ImportAsExistsError("azurerm_virtual_network_peering", id.ID())
+		}
+
+		future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+		if err != nil {
+			return fmt.Errorf("deleting disconnected %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for deletion of disconnected %s: %+v", id, err)
+		}
 	}
 
 	peer := network.VirtualNetworkPeering{
@@ -235,6 +267,12 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	if peer := resp.VirtualNetworkPeeringPropertiesFormat; peer != nil && peer.PeeringState == network.VirtualNetworkPeeringStateDisconnected {
+		log.Printf("[DEBUG] %s is Disconnected, which happens when the remote Virtual Network is recreated - removing from state so that it's recreated", *id)
+		d.SetId("")
+		return nil
+	}
+
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("virtual_network_name", id.VirtualNetworkName)
@@ -244,16 +282,39 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("allow_forwarded_traffic", peer.AllowForwardedTraffic)
 		d.Set("allow_gateway_transit", peer.AllowGatewayTransit)
 		d.Set("use_remote_gateways", peer.UseRemoteGateways)
+		d.Set("peering_sync_level", string(peer.PeeringSyncLevel))
 
 		remoteVirtualNetworkId := ""
+		remoteSubscriptionId := ""
 		if network := peer.RemoteVirtualNetwork; network != nil {
 			parsed, err := parse.VirtualNetworkIDInsensitively(*network.ID)
 			if err != nil {
 				return fmt.Errorf("parsing %q as a Virtual Network ID: %+v", *network.ID, err)
 			}
 			remoteVirtualNetworkId = parsed.ID()
+			remoteSubscriptionId = parsed.SubscriptionId
 		}
 		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+		d.Set("remote_subscription_id", remoteSubscriptionId)
+	}
+
+	return nil
+}
+
+func resourceVirtualNetworkPeeringCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// when the address space of either Virtual Network changes the peering needs to be synced, which is done by
+	// updating the peering - so when this is enabled and the peering is out of sync an update is planned
+	if diff.Get("sync_remote_address_space").(bool) {
+		syncLevel := diff.Get("peering_sync_level").(string)
+		if syncLevel != "" && syncLevel != string(network.VirtualNetworkPeeringLevelFullyInSync) {
+			if err := diff.SetNewComputed("peering_sync_level"); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
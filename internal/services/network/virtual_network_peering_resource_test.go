@@ -118,6 +118,34 @@ func TestAccVirtualNetworkPeering_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkPeering_syncRemoteAddressSpace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering", "test1")
+	r := VirtualNetworkPeeringResource{}
+	secondResourceName := "azurerm_virtual_network_peering.test2"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.syncRemoteAddressSpace(data, `"10.0.2.0/24"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("peering_sync_level").HasValue("FullyInSync"),
+				check.That(data.ResourceName).Key("remote_subscription_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.syncRemoteAddressSpace(data, `"10.0.2.0/24", "10.0.3.0/24"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("peering_sync_level").HasValue("FullyInSync"),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
 func (r VirtualNetworkPeeringResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VirtualNetworkPeeringID(state.ID)
 	if err != nil {
@@ -209,6 +237,54 @@ resource "azurerm_virtual_network_peering" "test2" {
 `, template, data.RandomInteger)
 }
 
+func (r VirtualNetworkPeeringResource) syncRemoteAddressSpace(data acceptance.TestData, addressSpace string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvirtnet-2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = [%[3]s]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                         = "acctestpeer-1-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  virtual_network_name         = azurerm_virtual_network.test1.name
+  remote_virtual_network_id    = azurerm_virtual_network.test2.id
+  allow_virtual_network_access = true
+  sync_remote_address_space    = true
+  triggers = {
+    remote_address_space = join(",", azurerm_virtual_network.test2.address_space)
+  }
+}
+
+resource "azurerm_virtual_network_peering" "test2" {
+  name                         = "acctestpeer-2-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  virtual_network_name         = azurerm_virtual_network.test2.name
+  remote_virtual_network_id    = azurerm_virtual_network.test1.id
+  allow_virtual_network_access = true
+  sync_remote_address_space    = true
+}
+`, data.RandomInteger, data.Locations.Primary, addressSpace)
+}
+
 func (r VirtualNetworkPeeringResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -3,9 +3,11 @@ package network
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -62,15 +65,47 @@ func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
 		"location": commonschema.Location(),
 
 		"address_space": {
-			Type:     pluginsdk.TypeList,
-			Required: true,
-			MinItems: 1,
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"address_space", "address_space_from_pool"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
 
+		"address_space_from_pool": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"address_space", "address_space_from_pool"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ipam_pool_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.NetworkManagerIpamPoolID,
+					},
+
+					"number_of_ip_addresses": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "`number_of_ip_addresses` must be a positive number"),
+					},
+
+					"allocated_address_prefixes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
 		// Optional
 		"bgp_community": {
 			Type:         pluginsdk.TypeString,
@@ -216,13 +251,26 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	if v := d.Get("address_space_from_pool").([]interface{}); len(v) > 0 {
+		// the API Version used by the Virtual Networks Client doesn't support IPAM Pool Prefix Allocations, so the
+		// Virtual Network is built as usual and then sent with the allocations using the newer API Version
+		payload, err := expandVirtualNetworkWithIpamPoolPrefixAllocations(vnet, v)
+		if err != nil {
+			return err
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		if err := meta.(*clients.Client).Network.VnetIpamClient.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	} else {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, vnet)
+		if err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		}
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -297,6 +345,21 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	// the IPAM Pool Prefix Allocations aren't exposed in the version of the API used by the SDK, so these are only
+	// retrieved when the address space is allocated from an IPAM Pool
+	if v := d.Get("address_space_from_pool").([]interface{}); len(v) > 0 {
+		ipamResp, err := meta.(*clients.Client).Network.VnetIpamClient.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving IPAM Pool Prefix Allocations for %s: %+v", *id, err)
+		}
+
+		if model := ipamResp.Model; model != nil && model.Properties != nil && model.Properties.AddressSpace != nil {
+			if err := d.Set("address_space_from_pool", flattenVirtualNetworkIpamPoolPrefixAllocations(model.Properties.AddressSpace.IpamPoolPrefixAllocations)); err != nil {
+				return fmt.Errorf("setting `address_space_from_pool`: %+v", err)
+			}
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -409,6 +472,69 @@ func expandVirtualNetworkProperties(ctx context.Context, d *pluginsdk.ResourceDa
 	return properties, nil
 }
 
+func expandVirtualNetworkWithIpamPoolPrefixAllocations(vnet network.VirtualNetwork, input []interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(vnet)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling Virtual Network: %+v", err)
+	}
+
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshalling Virtual Network: %+v", err)
+	}
+
+	allocations := make([]client.IpamPoolPrefixAllocation, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		allocations = append(allocations, client.IpamPoolPrefixAllocation{
+			NumberOfIPAddresses: utils.String(v["number_of_ip_addresses"].(string)),
+			Pool: &client.IpamPoolPrefixAllocationPool{
+				Id: utils.String(v["ipam_pool_id"].(string)),
+			},
+		})
+	}
+
+	properties, ok := payload["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["addressSpace"] = client.VirtualNetworkIpamAddressSpace{
+		IpamPoolPrefixAllocations: &allocations,
+	}
+	payload["properties"] = properties
+
+	return payload, nil
+}
+
+func flattenVirtualNetworkIpamPoolPrefixAllocations(input *[]client.IpamPoolPrefixAllocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		poolId := ""
+		if item.Pool != nil && item.Pool.Id != nil {
+			if parsed, err := parse.NetworkManagerIpamPoolIDInsensitively(*item.Pool.Id); err == nil {
+				poolId = parsed.ID()
+			}
+		}
+
+		numberOfIPAddresses := ""
+		if item.NumberOfIPAddresses != nil {
+			numberOfIPAddresses = *item.NumberOfIPAddresses
+		}
+
+		results = append(results, map[string]interface{}{
+			"ipam_pool_id":               poolId,
+			"number_of_ip_addresses":     numberOfIPAddresses,
+			"allocated_address_prefixes": utils.FlattenStringSlice(item.AllocatedAddressPrefixes),
+		})
+	}
+
+	return results
+}
+
 func flattenVirtualNetworkDDoSProtectionPlan(input *network.VirtualNetworkPropertiesFormat) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -607,3 +607,48 @@ resource "azurerm_virtual_network" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func testAccVirtualNetwork_addressSpaceFromPool(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
+	r := VirtualNetworkResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addressSpaceFromPool(data, "256"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_space.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_space_from_pool.0.allocated_address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_space_from_pool.0.allocated_address_prefixes.0").MatchesOtherKey(check.That(data.ResourceName).Key("address_space.0")),
+			),
+		},
+		data.ImportStep("address_space_from_pool"),
+		{
+			Config: r.addressSpaceFromPool(data, "512"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_space_from_pool.0.number_of_ip_addresses").HasValue("512"),
+				check.That(data.ResourceName).Key("address_space_from_pool.0.allocated_address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_space_from_pool.0.allocated_address_prefixes.0").MatchesOtherKey(check.That(data.ResourceName).Key("address_space.0")),
+			),
+		},
+		data.ImportStep("address_space_from_pool"),
+	})
+}
+
+func (VirtualNetworkResource) addressSpaceFromPool(data acceptance.TestData, numberOfIPAddresses string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  address_space_from_pool {
+    ipam_pool_id           = azurerm_network_manager_ipam_pool.test.id
+    number_of_ip_addresses = "%s"
+  }
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger, numberOfIPAddresses)
+}
@@ -83,7 +83,6 @@ func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
 			"is_default": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
 
@@ -93,6 +92,14 @@ func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
 				Default:      0,
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+
+			"p2s_connection_configuration_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -178,6 +185,10 @@ func resourceVPNServerConfigurationPolicyGroupRead(d *pluginsdk.ResourceData, me
 		if err := d.Set("policy", flattenVPNServerConfigurationPolicyGroupPolicyMembers(props.PolicyMembers)); err != nil {
 			return fmt.Errorf("setting `policy`: %+v", err)
 		}
+
+		if err := d.Set("p2s_connection_configuration_ids", flattenSubResourcesToIDs(props.P2SConnectionConfigurations)); err != nil {
+			return fmt.Errorf("setting `p2s_connection_configuration_ids`: %+v", err)
+		}
 	}
 
 	return nil
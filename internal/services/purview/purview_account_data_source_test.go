@@ -0,0 +1,97 @@
+package purview_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PurviewAccountDataSource struct{}
+
+func TestAccPurviewAccountDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_purview_account", "test")
+	r := PurviewAccountDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("catalog_endpoint").Exists(),
+				check.That(data.ResourceName).Key("managed_resources.0.event_hub_namespace_id").Exists(),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
+			),
+		},
+	})
+}
+
+func TestAccPurviewAccountDataSource_privateEndpoint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_purview_account", "test")
+	r := PurviewAccountDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.privateEndpoint(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_endpoint_connection.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_endpoint_connection.0.private_endpoint_id").Exists(),
+				check.That(data.ResourceName).Key("private_endpoint_connection.0.status").HasValue("Approved"),
+			),
+		},
+	})
+}
+
+func (PurviewAccountDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_purview_account" "test" {
+  name                = azurerm_purview_account.test.name
+  resource_group_name = azurerm_purview_account.test.resource_group_name
+}
+`, PurviewAccountResource{}.basic(data))
+}
+
+func (PurviewAccountDataSource) privateEndpoint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  private_endpoint_network_policies_enabled = false
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestpsc-%[2]d"
+    private_connection_resource_id = azurerm_purview_account.test.id
+    subresource_names              = ["account"]
+    is_manual_connection           = false
+  }
+}
+
+data "azurerm_purview_account" "test" {
+  name                = azurerm_purview_account.test.name
+  resource_group_name = azurerm_purview_account.test.resource_group_name
+
+  depends_on = [azurerm_private_endpoint.test]
+}
+`, PurviewAccountResource{}.basic(data), data.RandomInteger)
+}
@@ -134,6 +134,10 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 				return fmt.Errorf("flattening `managed_resources`: %+v", err)
 			}
 
+			if err := d.Set("private_endpoint_connection", flattenPurviewAccountPrivateEndpointConnections(props.PrivateEndpointConnections)); err != nil {
+				return fmt.Errorf("setting `private_endpoint_connection`: %+v", err)
+			}
+
 			publicNetworkAccessEnabled := false
 			if props.PublicNetworkAccess != nil {
 				publicNetworkAccessEnabled = *props.PublicNetworkAccess == account.PublicNetworkAccessEnabled
@@ -215,6 +219,71 @@ func flattenPurviewAccountManagedResources(managedResources *account.ManagedReso
 	}
 }
 
+func flattenPurviewAccountPrivateEndpointConnections(input *[]account.PrivateEndpointConnection) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		id := ""
+		if v.Id != nil {
+			id = *v.Id
+		}
+		name := ""
+		if v.Name != nil {
+			name = *v.Name
+		}
+
+		privateEndpointId := ""
+		status := ""
+		if props := v.Properties; props != nil {
+			if props.PrivateEndpoint != nil && props.PrivateEndpoint.Id != nil {
+				privateEndpointId = *props.PrivateEndpoint.Id
+			}
+			if props.PrivateLinkServiceConnectionState != nil && props.PrivateLinkServiceConnectionState.Status != nil {
+				status = string(*props.PrivateLinkServiceConnectionState.Status)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                  id,
+			"name":                name,
+			"private_endpoint_id": privateEndpointId,
+			"status":              status,
+		})
+	}
+
+	return results
+}
+
+func purviewAccountPrivateEndpointConnectionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"private_endpoint_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
 func resourcePurviewSchema() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -267,6 +336,8 @@ func resourcePurviewSchema() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"private_endpoint_connection": purviewAccountPrivateEndpointConnectionSchema(),
+
 		"catalog_endpoint": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -0,0 +1,41 @@
+package relay_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RelayHybridConnectionDataSource struct{}
+
+func TestAccDataSourceRelayHybridConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_relay_hybrid_connection", "test")
+	r := RelayHybridConnectionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("listener_count").HasValue("0"),
+				check.That(data.ResourceName).Key("online").HasValue("false"),
+				check.That(data.ResourceName).Key("requires_client_authorization").HasValue("true"),
+				check.That(data.ResourceName).Key("user_metadata").HasValue("metadatatest"),
+			),
+		},
+	})
+}
+
+func (RelayHybridConnectionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_relay_hybrid_connection" "test" {
+  name                 = azurerm_relay_hybrid_connection.test.name
+  resource_group_name  = azurerm_relay_hybrid_connection.test.resource_group_name
+  relay_namespace_name = azurerm_relay_hybrid_connection.test.relay_namespace_name
+}
+`, RelayHybridConnectionResource{}.full(data))
+}
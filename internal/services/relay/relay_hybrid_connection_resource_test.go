@@ -70,6 +70,36 @@ func TestAccRelayHybridConnection_update(t *testing.T) {
 	})
 }
 
+func TestAccRelayHybridConnection_updateUserMetadata(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_hybrid_connection", "test")
+	r := RelayHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.full(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_metadata").HasValue("metadatatest"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccRelayHybridConnection_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_relay_hybrid_connection", "test")
 	r := RelayHybridConnectionResource{}
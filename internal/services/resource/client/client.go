@@ -1,6 +1,8 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2019-06-01-preview/templatespecs" // nolint: staticcheck
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2015-12-01/features"                      // nolint: staticcheck
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"                     // nolint: staticcheck
@@ -12,6 +14,7 @@ import (
 
 type Client struct {
 	DeploymentsClient           *resources.DeploymentsClient
+	DeploymentStacksClient      *DeploymentStacksClient
 	DeploymentScriptsClient     *deploymentscripts.DeploymentScriptsClient
 	FeaturesClient              *features.Client
 	GroupsClient                *resources.GroupsClient
@@ -24,10 +27,16 @@ type Client struct {
 	options *common.ClientOptions
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
+	deploymentStacksClient, err := NewDeploymentStacksClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DeploymentStacks client: %+v", err)
+	}
+	o.Configure(deploymentStacksClient.Client, o.Authorizers.ResourceManager)
+
 	deploymentScriptsClient := deploymentscripts.NewDeploymentScriptsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&deploymentScriptsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -55,6 +64,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		GroupsClient:                &groupsClient,
 		DeploymentsClient:           &deploymentsClient,
+		DeploymentStacksClient:      deploymentStacksClient,
 		DeploymentScriptsClient:     &deploymentScriptsClient,
 		FeaturesClient:              &featuresClient,
 		LocksClient:                 &locksClient,
@@ -64,7 +74,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		TemplateSpecsVersionsClient: &templatespecsVersionsClient,
 
 		options: o,
-	}
+	}, nil
 }
 
 func (c Client) TagsClientForSubscription(subscriptionID string) *resources.TagsClient {
@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// Deployment Stacks aren't available in the version of the SDK currently used by the Provider, as such these
+// are managed using a base-layer client until the SDK can be upgraded
+const deploymentStacksApiVersion = "2024-03-01"
+
+type DeploymentStacksClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewDeploymentStacksClientWithBaseURI(api environments.Api) (*DeploymentStacksClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "deploymentstacks", deploymentStacksApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DeploymentStacksClient: %+v", err)
+	}
+
+	return &DeploymentStacksClient{
+		Client: client,
+	}, nil
+}
+
+type DeploymentStack struct {
+	Id         *string                    `json:"id,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *DeploymentStackProperties `json:"properties,omitempty"`
+	Tags       *map[string]string         `json:"tags,omitempty"`
+}
+
+type DeploymentStackProperties struct {
+	ActionOnUnmanage          DeploymentStackActionOnUnmanage   `json:"actionOnUnmanage"`
+	BypassStackOutOfSyncError *bool                             `json:"bypassStackOutOfSyncError,omitempty"`
+	DenySettings              DeploymentStackDenySettings       `json:"denySettings"`
+	DeploymentId              *string                           `json:"deploymentId,omitempty"`
+	DeploymentScope           *string                           `json:"deploymentScope,omitempty"`
+	Description               *string                           `json:"description,omitempty"`
+	Outputs                   *interface{}                      `json:"outputs,omitempty"`
+	Parameters                *map[string]interface{}           `json:"parameters,omitempty"`
+	ProvisioningState         *string                           `json:"provisioningState,omitempty"`
+	Resources                 *[]DeploymentStackManagedResource `json:"resources,omitempty"`
+	Template                  *map[string]interface{}           `json:"template,omitempty"`
+	TemplateLink              *DeploymentStackTemplateLink      `json:"templateLink,omitempty"`
+}
+
+type DeploymentStackActionOnUnmanage struct {
+	ManagementGroups *string `json:"managementGroups,omitempty"`
+	ResourceGroups   *string `json:"resourceGroups,omitempty"`
+	Resources        string  `json:"resources"`
+}
+
+type DeploymentStackDenySettings struct {
+	ApplyToChildScopes *bool     `json:"applyToChildScopes,omitempty"`
+	ExcludedActions    *[]string `json:"excludedActions,omitempty"`
+	ExcludedPrincipals *[]string `json:"excludedPrincipals,omitempty"`
+	Mode               string    `json:"mode"`
+}
+
+type DeploymentStackManagedResource struct {
+	DenyStatus *string `json:"denyStatus,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
+
+type DeploymentStackTemplateLink struct {
+	Id *string `json:"id,omitempty"`
+}
+
+type DeploymentStacksGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *DeploymentStack
+}
+
+// Get retrieves the specified Deployment Stack, which can be scoped to a Resource Group, Subscription or Management Group
+func (c DeploymentStacksClient) Get(ctx context.Context, id resourceids.Id) (result DeploymentStacksGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the specified Deployment Stack, then polls until the Deployment Stack
+// (and the resources it manages) have been provisioned
+func (c DeploymentStacksClient) CreateOrUpdateThenPoll(ctx context.Context, id resourceids.Id, input DeploymentStack) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+type DeploymentStacksDeleteOperationOptions struct {
+	BypassStackOutOfSyncError *bool
+	UnmanageManagementGroups  *string
+	UnmanageResourceGroups    *string
+	UnmanageResources         *string
+}
+
+func (o DeploymentStacksDeleteOperationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o DeploymentStacksDeleteOperationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o DeploymentStacksDeleteOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	if o.BypassStackOutOfSyncError != nil {
+		out.Append("bypassStackOutOfSyncError", fmt.Sprintf("%v", *o.BypassStackOutOfSyncError))
+	}
+	if o.UnmanageManagementGroups != nil {
+		out.Append("unmanageAction.ManagementGroups", *o.UnmanageManagementGroups)
+	}
+	if o.UnmanageResourceGroups != nil {
+		out.Append("unmanageAction.ResourceGroups", *o.UnmanageResourceGroups)
+	}
+	if o.UnmanageResources != nil {
+		out.Append("unmanageAction.Resources", *o.UnmanageResources)
+	}
+	return &out
+}
+
+// DeleteThenPoll deletes the specified Deployment Stack, with the resources it manages either being deleted or
+// detached as specified in the options, then polls until this has completed
+func (c DeploymentStacksClient) DeleteThenPoll(ctx context.Context, id resourceids.Id, options DeploymentStacksDeleteOperationOptions) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		OptionsObject: options,
+		Path:          id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// GenericResourcesClient is a client which can manage any Resource Manager resource using an arbitrary API Version
+// and payload - which is used for resources which aren't (yet) supported natively by the Provider
+type GenericResourcesClient struct {
+	Client *resourcemanager.Client
+}
+
+// GenericResourcesClientWithApiVersion returns a GenericResourcesClient for the specified API Version, configured
+// using the same authentication and options as every other client within the Provider
+func (c Client) GenericResourcesClientWithApiVersion(apiVersion string) (*GenericResourcesClient, error) {
+	resourceManagerClient, err := resourcemanager.NewResourceManagerClient(c.options.Environment.ResourceManager, "genericresources", apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building Generic Resources client: %+v", err)
+	}
+	c.options.Configure(resourceManagerClient, c.options.Authorizers.ResourceManager)
+
+	return &GenericResourcesClient{
+		Client: resourceManagerClient,
+	}, nil
+}
+
+type GenericResourceGetResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        map[string]interface{}
+}
+
+// Get retrieves the Resource with the specified ID
+func (c GenericResourcesClient) Get(ctx context.Context, id string) (result GenericResourceGetResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model map[string]interface{}
+	if err = resp.Unmarshal(&model); err != nil {
+		return
+	}
+	result.Model = model
+
+	return
+}
+
+// CreateOrUpdateThenPoll sends the payload to the Resource with the specified ID using the specified HTTP Method
+// (either PUT or PATCH) and then polls until the operation has completed
+func (c GenericResourcesClient) CreateOrUpdateThenPoll(ctx context.Context, id string, method string, input map[string]interface{}) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: method,
+		Path:       id,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing %s: %+v", method, err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after %s: %+v", method, err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the Resource with the specified ID and then polls until it's gone
+func (c GenericResourcesClient) DeleteThenPoll(ctx context.Context, id string) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,355 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DeploymentStackScope string
+
+const (
+	DeploymentStackScopeManagementGroup DeploymentStackScope = "ManagementGroup"
+	DeploymentStackScopeResourceGroup   DeploymentStackScope = "ResourceGroup"
+	DeploymentStackScopeSubscription    DeploymentStackScope = "Subscription"
+)
+
+const (
+	deploymentStackUnmanageActionDelete = "delete"
+	deploymentStackUnmanageActionDetach = "detach"
+)
+
+type DeploymentStackActionOnUnmanageModel struct {
+	ResourceGroups string `tfschema:"resource_groups"`
+	Resources      string `tfschema:"resources"`
+}
+
+// ManagementGroupDeploymentStackActionOnUnmanageModel is used by Deployment Stacks at the Management Group scope, since
+// `management_groups` is only present in the schema of the `action_on_unmanage` block at that scope
+type ManagementGroupDeploymentStackActionOnUnmanageModel struct {
+	ManagementGroups string `tfschema:"management_groups"`
+	ResourceGroups   string `tfschema:"resource_groups"`
+	Resources        string `tfschema:"resources"`
+}
+
+type DeploymentStackDenySettingsModel struct {
+	ApplyToChildScopes bool     `tfschema:"apply_to_child_scopes"`
+	ExcludedActions    []string `tfschema:"excluded_actions"`
+	ExcludedPrincipals []string `tfschema:"excluded_principals"`
+	Mode               string   `tfschema:"mode"`
+}
+
+// deploymentStackArguments returns the arguments common to each Deployment Stack resource, the resources
+// themselves are responsible for defining the arguments which identify the scope of the Deployment Stack
+func deploymentStackArguments(scope DeploymentStackScope) map[string]*pluginsdk.Schema {
+	unmanageActionSchema := map[string]*pluginsdk.Schema{
+		"resources": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(deploymentStackUnmanageActions(), false),
+		},
+
+		"resource_groups": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      deploymentStackUnmanageActionDetach,
+			ValidateFunc: validation.StringInSlice(deploymentStackUnmanageActions(), false),
+		},
+	}
+
+	// only Deployment Stacks at the Management Group scope can manage Management Groups
+	if scope == DeploymentStackScopeManagementGroup {
+		unmanageActionSchema["management_groups"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      deploymentStackUnmanageActionDetach,
+			ValidateFunc: validation.StringInSlice(deploymentStackUnmanageActions(), false),
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"action_on_unmanage": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: unmanageActionSchema,
+			},
+		},
+
+		"deny_settings": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"denyDelete",
+							"denyWriteAndDelete",
+							"none",
+						}, false),
+					},
+
+					"apply_to_child_scopes": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"excluded_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 200,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"excluded_principals": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 5,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+		},
+
+		"template_content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"template_content", "template_spec_version_id"},
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"template_spec_version_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"template_content", "template_spec_version_id"},
+			ValidateFunc: validate.TemplateSpecVersionID,
+		},
+
+		"parameters_content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"bypass_stack_out_of_sync_error": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 4096),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func deploymentStackAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"deployment_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"managed_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"output_content": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func deploymentStackUnmanageActions() []string {
+	return []string{
+		deploymentStackUnmanageActionDelete,
+		deploymentStackUnmanageActionDetach,
+	}
+}
+
+func expandDeploymentStackProperties(templateContent, templateSpecVersionId, parametersContent, description string, bypassStackOutOfSyncError bool, actionOnUnmanage client.DeploymentStackActionOnUnmanage, denySettings []DeploymentStackDenySettingsModel) (*client.DeploymentStackProperties, error) {
+	properties := client.DeploymentStackProperties{
+		ActionOnUnmanage:          actionOnUnmanage,
+		BypassStackOutOfSyncError: pointer.To(bypassStackOutOfSyncError),
+		DenySettings:              expandDeploymentStackDenySettings(denySettings),
+	}
+
+	if templateContent != "" {
+		template, err := expandTemplateDeploymentBody(templateContent)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if templateSpecVersionId != "" {
+		properties.TemplateLink = &client.DeploymentStackTemplateLink{
+			Id: pointer.To(templateSpecVersionId),
+		}
+	}
+
+	if parametersContent != "" {
+		parameters, err := expandTemplateDeploymentBody(parametersContent)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	if description != "" {
+		properties.Description = pointer.To(description)
+	}
+
+	return &properties, nil
+}
+
+func expandDeploymentStackActionOnUnmanage(input []DeploymentStackActionOnUnmanageModel) client.DeploymentStackActionOnUnmanage {
+	output := client.DeploymentStackActionOnUnmanage{
+		Resources: deploymentStackUnmanageActionDetach,
+	}
+	if len(input) == 0 {
+		return output
+	}
+
+	v := input[0]
+	output.Resources = v.Resources
+	if v.ResourceGroups != "" {
+		output.ResourceGroups = pointer.To(v.ResourceGroups)
+	}
+
+	return output
+}
+
+func expandManagementGroupDeploymentStackActionOnUnmanage(input []ManagementGroupDeploymentStackActionOnUnmanageModel) client.DeploymentStackActionOnUnmanage {
+	if len(input) == 0 {
+		return expandDeploymentStackActionOnUnmanage(nil)
+	}
+
+	v := input[0]
+	output := expandDeploymentStackActionOnUnmanage([]DeploymentStackActionOnUnmanageModel{
+		{
+			ResourceGroups: v.ResourceGroups,
+			Resources:      v.Resources,
+		},
+	})
+	if v.ManagementGroups != "" {
+		output.ManagementGroups = pointer.To(v.ManagementGroups)
+	}
+
+	return output
+}
+
+func flattenDeploymentStackActionOnUnmanage(input client.DeploymentStackActionOnUnmanage) []DeploymentStackActionOnUnmanageModel {
+	return []DeploymentStackActionOnUnmanageModel{
+		{
+			ResourceGroups: pointer.From(input.ResourceGroups),
+			Resources:      input.Resources,
+		},
+	}
+}
+
+func flattenManagementGroupDeploymentStackActionOnUnmanage(input client.DeploymentStackActionOnUnmanage) []ManagementGroupDeploymentStackActionOnUnmanageModel {
+	return []ManagementGroupDeploymentStackActionOnUnmanageModel{
+		{
+			ManagementGroups: pointer.From(input.ManagementGroups),
+			ResourceGroups:   pointer.From(input.ResourceGroups),
+			Resources:        input.Resources,
+		},
+	}
+}
+
+func expandDeploymentStackDenySettings(input []DeploymentStackDenySettingsModel) client.DeploymentStackDenySettings {
+	output := client.DeploymentStackDenySettings{
+		Mode: "none",
+	}
+	if len(input) == 0 {
+		return output
+	}
+
+	v := input[0]
+	output.Mode = v.Mode
+	output.ApplyToChildScopes = pointer.To(v.ApplyToChildScopes)
+	output.ExcludedActions = pointer.To(v.ExcludedActions)
+	output.ExcludedPrincipals = pointer.To(v.ExcludedPrincipals)
+
+	return output
+}
+
+func flattenDeploymentStackDenySettings(input client.DeploymentStackDenySettings) []DeploymentStackDenySettingsModel {
+	return []DeploymentStackDenySettingsModel{
+		{
+			ApplyToChildScopes: pointer.From(input.ApplyToChildScopes),
+			ExcludedActions:    pointer.From(input.ExcludedActions),
+			ExcludedPrincipals: pointer.From(input.ExcludedPrincipals),
+			Mode:               input.Mode,
+		},
+	}
+}
+
+func flattenDeploymentStackManagedResourceIds(input *[]client.DeploymentStackManagedResource) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Id != nil {
+			output = append(output, *v.Id)
+		}
+	}
+
+	return output
+}
+
+func flattenDeploymentStackOutputs(input *interface{}) (string, error) {
+	if input == nil || *input == nil {
+		return "", nil
+	}
+
+	outputs, err := json.Marshal(*input)
+	if err != nil {
+		return "", fmt.Errorf("marshalling outputs: %+v", err)
+	}
+
+	return string(outputs), nil
+}
+
+// deploymentStackDeleteOptions returns the options used to delete a Deployment Stack, which ensures that the
+// resources managed by the Deployment Stack are deleted or detached as configured in `action_on_unmanage`
+func deploymentStackDeleteOptions(action client.DeploymentStackActionOnUnmanage, bypassStackOutOfSyncError bool) client.DeploymentStacksDeleteOperationOptions {
+	return client.DeploymentStacksDeleteOperationOptions{
+		BypassStackOutOfSyncError: pointer.To(bypassStackOutOfSyncError),
+		UnmanageManagementGroups:  action.ManagementGroups,
+		UnmanageResourceGroups:    action.ResourceGroups,
+		UnmanageResources:         pointer.To(action.Resources),
+	}
+}
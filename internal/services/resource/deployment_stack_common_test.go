@@ -0,0 +1,73 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestDeploymentStackActionOnUnmanageEncodesIntoEachScope(t *testing.T) {
+	// the API returns `managementGroups` regardless of the scope of the Deployment Stack
+	actionOnUnmanage := client.DeploymentStackActionOnUnmanage{
+		ManagementGroups: pointer.To(deploymentStackUnmanageActionDelete),
+		ResourceGroups:   pointer.To(deploymentStackUnmanageActionDelete),
+		Resources:        deploymentStackUnmanageActionDelete,
+	}
+
+	testData := []struct {
+		name     string
+		resource sdk.ResourceWithUpdate
+		state    interface{}
+	}{
+		{
+			name:     "management group",
+			resource: ManagementGroupDeploymentStackResource{},
+			state: &ManagementGroupDeploymentStackModel{
+				ActionOnUnmanage: flattenManagementGroupDeploymentStackActionOnUnmanage(actionOnUnmanage),
+			},
+		},
+		{
+			name:     "resource group",
+			resource: ResourceGroupDeploymentStackResource{},
+			state: &ResourceGroupDeploymentStackModel{
+				ActionOnUnmanage: flattenDeploymentStackActionOnUnmanage(actionOnUnmanage),
+			},
+		},
+		{
+			name:     "subscription",
+			resource: SubscriptionDeploymentStackResource{},
+			state: &SubscriptionDeploymentStackModel{
+				ActionOnUnmanage: flattenDeploymentStackActionOnUnmanage(actionOnUnmanage),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		resourceSchema := make(map[string]*pluginsdk.Schema)
+		for k, s := range v.resource.Arguments() {
+			resourceSchema[k] = s
+		}
+		for k, s := range v.resource.Attributes() {
+			resourceSchema[k] = s
+		}
+
+		metadata := sdk.ResourceMetaData{
+			Logger:       sdk.ConsoleLogger{},
+			ResourceData: schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{}),
+		}
+
+		if err := metadata.Encode(v.state); err != nil {
+			t.Fatalf("encoding the %s Deployment Stack: %+v", v.name, err)
+		}
+
+		if actual := metadata.ResourceData.Get("action_on_unmanage.0.resource_groups").(string); actual != deploymentStackUnmanageActionDelete {
+			t.Fatalf("expected `resource_groups` to be %q but got %q for the %s Deployment Stack", deploymentStackUnmanageActionDelete, actual, v.name)
+		}
+	}
+}
@@ -0,0 +1,585 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate         = GenericResource{}
+	_ sdk.ResourceWithCustomImporter = GenericResource{}
+)
+
+// GenericResource allows managing Resource Manager resources which aren't (yet) supported natively by the Provider,
+// using an arbitrary API Version and JSON payload - whilst using the same authentication, clients and features as
+// every other resource within the Provider.
+type GenericResource struct{}
+
+type GenericResourceModel struct {
+	Name                 string            `tfschema:"name"`
+	ParentId             string            `tfschema:"parent_id"`
+	Type                 string            `tfschema:"type"`
+	ApiVersion           string            `tfschema:"api_version"`
+	Body                 string            `tfschema:"body"`
+	Location             string            `tfschema:"location"`
+	Tags                 map[string]string `tfschema:"tags"`
+	UpdateMethod         string            `tfschema:"update_method"`
+	DeleteOnDestroy      bool              `tfschema:"delete_on_destroy"`
+	ResponseExportValues []string          `tfschema:"response_export_values"`
+	Output               string            `tfschema:"output"`
+}
+
+// genericResourceReadOnlyFields are the top-level fields returned by Resource Manager which can't be specified in the `body`
+var genericResourceReadOnlyFields = []string{"id", "name", "type", "location", "tags", "etag", "systemData"}
+
+func (r GenericResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GenericResourceParentID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GenericResourceType,
+		},
+
+		"api_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ApiVersion,
+		},
+
+		"body": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		// not all resources support a location, so this is Computed to allow the value returned by the API to be used
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+
+		"tags": commonschema.Tags(),
+
+		"update_method": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  http.MethodPut,
+			ValidateFunc: validation.StringInSlice([]string{
+				http.MethodPatch,
+				http.MethodPut,
+			}, false),
+		},
+
+		"delete_on_destroy": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"response_export_values": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r GenericResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r GenericResource) ModelObject() interface{} {
+	return &GenericResourceModel{}
+}
+
+func (r GenericResource) ResourceType() string {
+	return "azurerm_generic_resource"
+}
+
+func (r GenericResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if _, _, _, err := parseGenericResourceID(v); err != nil {
+			errors = append(errors, err)
+		}
+		return
+	}
+}
+
+func (r GenericResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model GenericResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Resource.GenericResourcesClientWithApiVersion(model.ApiVersion)
+			if err != nil {
+				return err
+			}
+
+			id := genericResourceID(model.ParentId, model.Type, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s %q: %+v", model.Type, id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), genericResourceId(id))
+			}
+
+			payload, err := expandGenericResourcePayload(model, true)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, http.MethodPut, payload); err != nil {
+				return fmt.Errorf("creating %s %q: %+v", model.Type, id, err)
+			}
+
+			metadata.SetID(genericResourceId(id))
+			return nil
+		},
+	}
+}
+
+func (r GenericResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id := metadata.ResourceData.Id()
+			parentId, resourceType, name, err := parseGenericResourceID(id)
+			if err != nil {
+				return err
+			}
+
+			var config GenericResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client, err := metadata.Client.Resource.GenericResourcesClientWithApiVersion(config.ApiVersion)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(genericResourceId(id))
+				}
+
+				return fmt.Errorf("retrieving %s %q: %+v", resourceType, id, err)
+			}
+
+			state := GenericResourceModel{
+				Name:                 name,
+				ParentId:             parentId,
+				Type:                 resourceType,
+				ApiVersion:           config.ApiVersion,
+				UpdateMethod:         config.UpdateMethod,
+				DeleteOnDestroy:      config.DeleteOnDestroy,
+				ResponseExportValues: config.ResponseExportValues,
+			}
+
+			if v, ok := resp.Model["location"].(string); ok {
+				state.Location = location.Normalize(v)
+			}
+
+			if v, ok := resp.Model["tags"].(map[string]interface{}); ok {
+				tags := make(map[string]string)
+				for key, value := range v {
+					tags[key] = fmt.Sprintf("%v", value)
+				}
+				state.Tags = tags
+			}
+
+			body, err := flattenGenericResourceBody(config.Body, resp.Model)
+			if err != nil {
+				return fmt.Errorf("flattening `body`: %+v", err)
+			}
+			state.Body = body
+
+			output, err := flattenGenericResourceOutput(resp.Model, config.ResponseExportValues)
+			if err != nil {
+				return fmt.Errorf("flattening `output`: %+v", err)
+			}
+			state.Output = output
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r GenericResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id := metadata.ResourceData.Id()
+
+			var model GenericResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("api_version", "body", "tags") {
+				return nil
+			}
+
+			client, err := metadata.Client.Resource.GenericResourcesClientWithApiVersion(model.ApiVersion)
+			if err != nil {
+				return err
+			}
+
+			// the location can't be changed, and some APIs reject it when patching - so it's only sent using PUT
+			payload, err := expandGenericResourcePayload(model, model.UpdateMethod == http.MethodPut)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, model.UpdateMethod, payload); err != nil {
+				return fmt.Errorf("updating %s %q: %+v", model.Type, id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GenericResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id := metadata.ResourceData.Id()
+
+			var model GenericResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !model.DeleteOnDestroy {
+				metadata.Logger.Infof("`delete_on_destroy` is disabled - removing %s %q from the state without deleting it", model.Type, id)
+				return nil
+			}
+
+			client, err := metadata.Client.Resource.GenericResourcesClientWithApiVersion(model.ApiVersion)
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, id); err != nil {
+				return fmt.Errorf("deleting %s %q: %+v", model.Type, id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r GenericResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id := metadata.ResourceData.Id()
+		parentId, resourceType, name, err := parseGenericResourceID(id)
+		if err != nil {
+			return err
+		}
+
+		// the API Version isn't part of the Resource ID, so we default to the latest stable API Version for this Resource Type
+		apiVersion, err := latestApiVersionForResourceType(ctx, metadata, id, resourceType)
+		if err != nil {
+			return err
+		}
+
+		metadata.ResourceData.Set("name", name)
+		metadata.ResourceData.Set("parent_id", parentId)
+		metadata.ResourceData.Set("type", resourceType)
+		metadata.ResourceData.Set("api_version", apiVersion)
+		metadata.ResourceData.Set("update_method", http.MethodPut)
+		metadata.ResourceData.Set("delete_on_destroy", true)
+		return nil
+	}
+}
+
+type genericResourceId string
+
+func (id genericResourceId) ID() string {
+	return string(id)
+}
+
+func (id genericResourceId) String() string {
+	return fmt.Sprintf("Generic Resource %q", string(id))
+}
+
+// genericResourceID builds the Resource ID for a Resource of the specified Type within the specified Parent, where the
+// Parent is either the scope the Resource is created within (e.g. a Resource Group) or the parent Resource for a
+// nested Resource Type (e.g. a Virtual Network for a Subnet)
+func genericResourceID(parentId, resourceType, name string) string {
+	segments := strings.Split(resourceType, "/")
+	if len(segments) > 2 {
+		return fmt.Sprintf("%s/%s/%s", parentId, segments[len(segments)-1], name)
+	}
+
+	return fmt.Sprintf("%s/providers/%s/%s", parentId, resourceType, name)
+}
+
+// parseGenericResourceID parses the Parent ID, Resource Type and Name from a Resource ID
+func parseGenericResourceID(input string) (parentId string, resourceType string, name string, err error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	providersIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") && i+1 < len(segments) {
+			providersIndex = i
+		}
+	}
+
+	// after the (last) providers segment we expect `{Namespace}/{type}/{name}` followed by zero or more `{type}/{name}` pairs
+	if !strings.HasPrefix(input, "/") || providersIndex == -1 || len(segments)-providersIndex < 4 || (len(segments)-providersIndex)%2 != 0 {
+		return "", "", "", fmt.Errorf("expected %q to be a Resource Manager ID in the format `{scope}/providers/{Namespace}/{type}/{name}`", input)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return "", "", "", fmt.Errorf("expected %q to be a Resource Manager ID but it contained an empty segment", input)
+		}
+	}
+
+	namespace := segments[providersIndex+1]
+	typeNames := make([]string, 0)
+	for i := providersIndex + 2; i < len(segments); i += 2 {
+		typeNames = append(typeNames, segments[i])
+	}
+
+	resourceType = fmt.Sprintf("%s/%s", namespace, strings.Join(typeNames, "/"))
+	name = segments[len(segments)-1]
+
+	if len(typeNames) > 1 {
+		parentId = "/" + strings.Join(segments[:len(segments)-2], "/")
+	} else {
+		parentId = "/" + strings.Join(segments[:providersIndex], "/")
+	}
+
+	return parentId, resourceType, name, nil
+}
+
+func expandGenericResourcePayload(model GenericResourceModel, includeLocation bool) (map[string]interface{}, error) {
+	payload := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(model.Body), &payload); err != nil {
+		return nil, fmt.Errorf("parsing `body`: %+v", err)
+	}
+
+	for _, field := range genericResourceReadOnlyFields {
+		if _, ok := payload[field]; ok {
+			return nil, fmt.Errorf("the field %q cannot be specified within `body` - use the %q argument if available", field, field)
+		}
+	}
+
+	if includeLocation && model.Location != "" {
+		payload["location"] = location.Normalize(model.Location)
+	}
+
+	if model.Tags != nil {
+		payload["tags"] = model.Tags
+	}
+
+	return payload, nil
+}
+
+// flattenGenericResourceBody returns the fields from the API response which are specified in the configured `body`, so
+// that drift is detected for these - whilst any other (e.g. read-only or defaulted) fields returned by the API are ignored.
+// When no `body` is configured (for example during import) all non read-only fields are returned.
+func flattenGenericResourceBody(configured string, model map[string]interface{}) (string, error) {
+	var out interface{}
+
+	if configured == "" {
+		body := make(map[string]interface{})
+		for key, value := range model {
+			body[key] = value
+		}
+		for _, field := range genericResourceReadOnlyFields {
+			delete(body, field)
+		}
+		out = body
+	} else {
+		var config interface{}
+		if err := json.Unmarshal([]byte(configured), &config); err != nil {
+			return "", err
+		}
+		out = intersectGenericResourceBody(config, model)
+	}
+
+	v, err := json.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
+func intersectGenericResourceBody(config interface{}, actual interface{}) interface{} {
+	configMap, ok := config.(map[string]interface{})
+	if !ok {
+		// scalar values and lists are compared as a whole
+		if actual == nil {
+			return config
+		}
+		return actual
+	}
+
+	actualMap, ok := actual.(map[string]interface{})
+	if !ok {
+		return config
+	}
+
+	out := make(map[string]interface{})
+	for key, value := range configMap {
+		if actualValue, ok := actualMap[key]; ok {
+			out[key] = intersectGenericResourceBody(value, actualValue)
+			continue
+		}
+
+		// write-only fields (e.g. secrets) aren't returned by the API, so we use the configured value
+		out[key] = value
+	}
+	return out
+}
+
+// flattenGenericResourceOutput returns a JSON object containing the values at the specified (dot-separated) paths
+// within the API response - for example `properties.provisioningState`
+func flattenGenericResourceOutput(model map[string]interface{}, paths []string) (string, error) {
+	output := make(map[string]interface{})
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+
+		var value interface{} = model
+		found := true
+		for _, segment := range segments {
+			v, ok := value.(map[string]interface{})
+			if !ok {
+				found = false
+				break
+			}
+			if value, ok = v[segment]; !ok {
+				found = false
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		current := output
+		for i, segment := range segments {
+			if i == len(segments)-1 {
+				current[segment] = value
+				break
+			}
+			next, ok := current[segment].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[segment] = next
+			}
+			current = next
+		}
+	}
+
+	v, err := json.Marshal(output)
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
+func latestApiVersionForResourceType(ctx context.Context, metadata sdk.ResourceMetaData, id, resourceType string) (string, error) {
+	client := metadata.Client.Resource.ResourceProvidersClient
+
+	subscriptionId := metadata.Client.Account.SubscriptionId
+	if segments := strings.Split(strings.TrimPrefix(id, "/"), "/"); len(segments) > 1 && strings.EqualFold(segments[0], "subscriptions") {
+		subscriptionId = segments[1]
+	}
+
+	namespace, typeName, _ := strings.Cut(resourceType, "/")
+	providerId := providers.NewSubscriptionProviderID(subscriptionId, namespace)
+
+	resp, err := client.Get(ctx, providerId, providers.DefaultGetOperationOptions())
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s to determine the API Version for %q: %+v", providerId, resourceType, err)
+	}
+
+	apiVersions := make([]string, 0)
+	if model := resp.Model; model != nil && model.ResourceTypes != nil {
+		for _, item := range *model.ResourceTypes {
+			if item.ResourceType != nil && strings.EqualFold(*item.ResourceType, typeName) && item.ApiVersions != nil {
+				apiVersions = *item.ApiVersions
+			}
+		}
+	}
+
+	return latestApiVersion(apiVersions, resourceType)
+}
+
+func latestApiVersion(input []string, resourceType string) (string, error) {
+	if len(input) == 0 {
+		return "", fmt.Errorf("no API Versions were found for %q", resourceType)
+	}
+
+	apiVersions := make([]string, len(input))
+	copy(apiVersions, input)
+	sort.Sort(sort.Reverse(sort.StringSlice(apiVersions)))
+
+	// API Versions are date-based so sort lexically - prefer the latest stable version, falling back to a preview
+	for _, v := range apiVersions {
+		if !strings.Contains(strings.ToLower(v), "preview") {
+			return v, nil
+		}
+	}
+
+	return apiVersions[0], nil
+}
@@ -0,0 +1,207 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type GenericResource struct{}
+
+func TestAccGenericResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("body"),
+	})
+}
+
+func TestAccGenericResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccGenericResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "test")
+	r := GenericResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("body"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").HasValue(`{"properties":{"provisioningState":"Succeeded"}}`),
+			),
+		},
+		data.ImportStep("body", "response_export_values", "output", "update_method"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("body"),
+	})
+}
+
+func TestAccGenericResource_nested(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_generic_resource", "subnet")
+	r := GenericResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nested(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("type").HasValue("Microsoft.Network/virtualNetworks/subnets"),
+			),
+		},
+		data.ImportStep("body"),
+	})
+}
+
+func (GenericResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client, err := clients.Resource.GenericResourcesClientWithApiVersion(state.Attributes["api_version"])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, state.ID)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (GenericResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-generic-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r GenericResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "test" {
+  name        = "acctestvnet-%d"
+  parent_id   = azurerm_resource_group.test.id
+  type        = "Microsoft.Network/virtualNetworks"
+  api_version = "2022-07-01"
+  location    = azurerm_resource_group.test.location
+
+  body = jsonencode({
+    properties = {
+      addressSpace = {
+        addressPrefixes = ["10.0.0.0/16"]
+      }
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GenericResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "import" {
+  name        = azurerm_generic_resource.test.name
+  parent_id   = azurerm_generic_resource.test.parent_id
+  type        = azurerm_generic_resource.test.type
+  api_version = azurerm_generic_resource.test.api_version
+  location    = azurerm_generic_resource.test.location
+  body        = azurerm_generic_resource.test.body
+}
+`, r.basic(data))
+}
+
+func (r GenericResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "test" {
+  name          = "acctestvnet-%d"
+  parent_id     = azurerm_resource_group.test.id
+  type          = "Microsoft.Network/virtualNetworks"
+  api_version   = "2022-07-01"
+  location      = azurerm_resource_group.test.location
+  update_method = "PUT"
+
+  body = jsonencode({
+    properties = {
+      addressSpace = {
+        addressPrefixes = ["10.0.0.0/16", "10.1.0.0/16"]
+      }
+    }
+  })
+
+  response_export_values = ["properties.provisioningState"]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r GenericResource) nested(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_generic_resource" "subnet" {
+  name        = "acctestsubnet-%d"
+  parent_id   = azurerm_generic_resource.test.id
+  type        = "Microsoft.Network/virtualNetworks/subnets"
+  api_version = "2022-07-01"
+
+  body = jsonencode({
+    properties = {
+      addressPrefix = "10.0.1.0/24"
+    }
+  })
+}
+`, r.basic(data), data.RandomInteger)
+}
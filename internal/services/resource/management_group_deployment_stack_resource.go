@@ -0,0 +1,237 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	mgParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	mgValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupDeploymentStackModel struct {
+	Name                      string                                                `tfschema:"name"`
+	ManagementGroupId         string                                                `tfschema:"management_group_id"`
+	Location                  string                                                `tfschema:"location"`
+	ActionOnUnmanage          []ManagementGroupDeploymentStackActionOnUnmanageModel `tfschema:"action_on_unmanage"`
+	BypassStackOutOfSyncError bool                                                  `tfschema:"bypass_stack_out_of_sync_error"`
+	DenySettings              []DeploymentStackDenySettingsModel                    `tfschema:"deny_settings"`
+	Description               string                                                `tfschema:"description"`
+	ParametersContent         string                                                `tfschema:"parameters_content"`
+	TemplateContent           string                                                `tfschema:"template_content"`
+	TemplateSpecVersionId     string                                                `tfschema:"template_spec_version_id"`
+	Tags                      map[string]string                                     `tfschema:"tags"`
+	DeploymentId              string                                                `tfschema:"deployment_id"`
+	ManagedResourceIds        []string                                              `tfschema:"managed_resource_ids"`
+	OutputContent             string                                                `tfschema:"output_content"`
+}
+
+type ManagementGroupDeploymentStackResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagementGroupDeploymentStackResource{}
+
+func (r ManagementGroupDeploymentStackResource) ResourceType() string {
+	return "azurerm_management_group_deployment_stack"
+}
+
+func (r ManagementGroupDeploymentStackResource) ModelObject() interface{} {
+	return &ManagementGroupDeploymentStackModel{}
+}
+
+func (r ManagementGroupDeploymentStackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagementGroupDeploymentStackID
+}
+
+func (r ManagementGroupDeploymentStackResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DeploymentStackName,
+		},
+
+		"management_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mgValidate.ManagementGroupID,
+		},
+
+		"location": commonschema.Location(),
+	}
+
+	for k, v := range deploymentStackArguments(DeploymentStackScopeManagementGroup) {
+		arguments[k] = v
+	}
+
+	return arguments
+}
+
+func (r ManagementGroupDeploymentStackResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentStackAttributes()
+}
+
+func (r ManagementGroupDeploymentStackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			var model ManagementGroupDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managementGroupId, err := mgParse.ManagementGroupID(model.ManagementGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewManagementGroupDeploymentStackID(managementGroupId.Name, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := r.createOrUpdate(ctx, metadata, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := parse.ManagementGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return the Template or Parameters, so these are retained from the config/state
+			var state ManagementGroupDeploymentStackModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Name = id.DeploymentStackName
+			state.ManagementGroupId = mgParse.NewManagementGroupId(id.ManagementGroupName).ID()
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ActionOnUnmanage = flattenManagementGroupDeploymentStackActionOnUnmanage(props.ActionOnUnmanage)
+					state.BypassStackOutOfSyncError = pointer.From(props.BypassStackOutOfSyncError)
+					state.DenySettings = flattenDeploymentStackDenySettings(props.DenySettings)
+					state.DeploymentId = pointer.From(props.DeploymentId)
+					state.Description = pointer.From(props.Description)
+					state.ManagedResourceIds = flattenDeploymentStackManagedResourceIds(props.Resources)
+
+					state.TemplateSpecVersionId = ""
+					if props.TemplateLink != nil {
+						state.TemplateSpecVersionId = pointer.From(props.TemplateLink.Id)
+					}
+
+					if state.OutputContent, err = flattenDeploymentStackOutputs(props.Outputs); err != nil {
+						return fmt.Errorf("flattening `output_content`: %+v", err)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagementGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagementGroupDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API doesn't support PATCH, so the Deployment Stack is redeployed in full
+			if err := r.createOrUpdate(ctx, metadata, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := parse.ManagementGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagementGroupDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := deploymentStackDeleteOptions(expandManagementGroupDeploymentStackActionOnUnmanage(model.ActionOnUnmanage), model.BypassStackOutOfSyncError)
+			if err := client.DeleteThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagementGroupDeploymentStackId, model ManagementGroupDeploymentStackModel) error {
+	properties, err := expandDeploymentStackProperties(model.TemplateContent, model.TemplateSpecVersionId, model.ParametersContent, model.Description, model.BypassStackOutOfSyncError, expandManagementGroupDeploymentStackActionOnUnmanage(model.ActionOnUnmanage), model.DenySettings)
+	if err != nil {
+		return err
+	}
+
+	payload := client.DeploymentStack{
+		Location:   pointer.To(location.Normalize(model.Location)),
+		Properties: properties,
+		Tags:       pointer.To(model.Tags),
+	}
+
+	return metadata.Client.Resource.DeploymentStacksClient.CreateOrUpdateThenPoll(ctx, id, payload)
+}
@@ -0,0 +1,143 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagementGroupDeploymentStackResource struct{}
+
+func TestAccManagementGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content"),
+	})
+}
+
+func TestAccManagementGroupDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ManagementGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagementGroupDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ManagementGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  name = "TestAcc-Stack-%[1]d"
+}
+
+resource "azurerm_management_group_deployment_stack" "test" {
+  name                = "acctest-stack-%[1]d"
+  management_group_id = azurerm_management_group.test.id
+  location            = %[2]q
+
+  action_on_unmanage {
+    resources         = "delete"
+    resource_groups   = "delete"
+    management_groups = "delete"
+  }
+
+  deny_settings {
+    mode = "none"
+  }
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Authorization/policyDefinitions",
+      "apiVersion": "2021-06-01",
+      "name": "acctestpol-stack-%[1]d",
+      "properties": {
+        "policyType": "Custom",
+        "mode": "All",
+        "displayName": "acctestpol-stack-%[1]d",
+        "policyRule": {
+          "if": {
+            "field": "location",
+            "equals": "westeurope"
+          },
+          "then": {
+            "effect": "audit"
+          }
+        }
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ManagementGroupDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_deployment_stack" "import" {
+  name                = azurerm_management_group_deployment_stack.test.name
+  management_group_id = azurerm_management_group_deployment_stack.test.management_group_id
+  location            = azurerm_management_group_deployment_stack.test.location
+  template_content    = azurerm_management_group_deployment_stack.test.template_content
+
+  action_on_unmanage {
+    resources         = "delete"
+    resource_groups   = "delete"
+    management_groups = "delete"
+  }
+
+  deny_settings {
+    mode = "none"
+  }
+}
+`, r.basic(data))
+}
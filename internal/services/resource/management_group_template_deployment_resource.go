@@ -40,6 +40,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentBicepCustomizeDiff),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		// lintignore:S033
@@ -65,16 +67,29 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ExactlyOneOf: []string{
+					"template_bicep_content",
 					"template_content",
 					"template_spec_version_id",
 				},
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"template_bicep_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_bicep_content",
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"template_spec_version_id": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ExactlyOneOf: []string{
+					"template_bicep_content",
 					"template_content",
 					"template_spec_version_id",
 				},
@@ -147,6 +162,14 @@ func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData,
 		deployment.Properties.Template = template
 	}
 
+	if bicepRaw, ok := d.GetOk("template_bicep_content"); ok {
+		template, err := compileTemplateDeploymentBicep(ctx, bicepRaw.(string))
+		if err != nil {
+			return fmt.Errorf("compiling `template_bicep_content`: %+v", err)
+		}
+		deployment.Properties.Template = template
+	}
+
 	if templateSpecVersionID, ok := d.GetOk("template_spec_version_id"); ok {
 		deployment.Properties.TemplateLink = &resources.TemplateLink{
 			ID: utils.String(templateSpecVersionID.(string)),
@@ -223,12 +246,12 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 		deployment.Properties.Parameters = parameters
 	}
 
-	if d.HasChange("template_content") {
-		templateContents, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
-		if err != nil {
-			return fmt.Errorf("expanding `template_content`: %+v", err)
-		}
+	templateContents, err := expandTemplateDeploymentTemplateForUpdate(ctx, d)
+	if err != nil {
+		return err
+	}
 
+	if templateContents != nil {
 		deployment.Properties.Template = templateContents
 	} else {
 		// retrieve the existing content and reuse that
@@ -0,0 +1,93 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+var _ resourceids.Id = ManagementGroupDeploymentStackId{}
+
+type ManagementGroupDeploymentStackId struct {
+	ManagementGroupName string
+	DeploymentStackName string
+}
+
+func NewManagementGroupDeploymentStackID(managementGroupName, deploymentName string) ManagementGroupDeploymentStackId {
+	return ManagementGroupDeploymentStackId{
+		ManagementGroupName: managementGroupName,
+		DeploymentStackName: deploymentName,
+	}
+}
+
+func (id ManagementGroupDeploymentStackId) String() string {
+	segments := []string{
+		fmt.Sprintf("Deployment Stack Name %q", id.DeploymentStackName),
+		fmt.Sprintf("Management Group Name %q", id.ManagementGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Group Deployment Stack", segmentsStr)
+}
+
+func (id ManagementGroupDeploymentStackId) ID() string {
+	fmtString := "/providers/Microsoft.Management/managementGroups/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.ManagementGroupName, id.DeploymentStackName)
+}
+
+// ManagementGroupDeploymentStackID parses a ManagementGroupDeploymentStack ID into an ManagementGroupDeploymentStackId struct
+func ManagementGroupDeploymentStackID(input string) (*ManagementGroupDeploymentStackId, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Azure ID: %s", err)
+	}
+
+	path := idURL.Path
+
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimSuffix(path, "/")
+
+	components := strings.Split(path, "/")
+
+	if len(components)%2 != 0 {
+		return nil, fmt.Errorf("The number of path segments is not divisible by 2 in %q", path)
+	}
+
+	componentMap := make(map[string]string, len(components)/2)
+	for current := 0; current < len(components); current += 2 {
+		key := components[current]
+		value := components[current+1]
+
+		// Check key/value for empty strings.
+		if key == "" || value == "" {
+			return nil, fmt.Errorf("Key/Value cannot be empty strings. Key: '%s', Value: '%s'", key, value)
+		}
+		componentMap[key] = value
+	}
+
+	// Build up a TargetResourceID from the map
+	id := &azure.ResourceID{}
+	id.Path = componentMap
+
+	if provider, ok := componentMap["providers"]; ok {
+		id.Provider = provider
+		delete(componentMap, "providers")
+	}
+
+	resourceId := ManagementGroupDeploymentStackId{}
+
+	if resourceId.ManagementGroupName, err = id.PopSegment("managementGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.DeploymentStackName, err = id.PopSegment("deploymentStacks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,87 @@
+package parse
+
+import "testing"
+
+func TestManagementGroupDeploymentStackIDFormatter(t *testing.T) {
+	actual := NewManagementGroupDeploymentStackID("my-management-group-id", "stack1").ID()
+	expected := "/providers/Microsoft.Management/managementGroups/my-management-group-id/providers/Microsoft.Resources/deploymentStacks/stack1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagementGroupDeploymentStackID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementGroupDeploymentStackId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing ManagementGroupName
+			Input: "/providers/Microsoft.Management/",
+			Error: true,
+		},
+
+		{
+			// missing value for ManagementGroupName
+			Input: "/providers/Microsoft.Management/managementGroups/",
+			Error: true,
+		},
+
+		{
+			// missing DeploymentStackName
+			Input: "/providers/Microsoft.Management/managementGroups/my-management-group-id/providers/Microsoft.Resources/",
+			Error: true,
+		},
+
+		{
+			// missing value for DeploymentStackName
+			Input: "/providers/Microsoft.Management/managementGroups/my-management-group-id/providers/Microsoft.Resources/deploymentStacks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/providers/Microsoft.Management/managementGroups/my-management-group-id/providers/Microsoft.Resources/deploymentStacks/stack1",
+			Expected: &ManagementGroupDeploymentStackId{
+				ManagementGroupName: "my-management-group-id",
+				DeploymentStackName: "stack1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/MY-MANAGEMENT-GROUP-ID/PROVIDERS/MICROSOFT.RESOURCES/DEPLOYMENTSTACKS/STACK1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementGroupDeploymentStackID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ManagementGroupName != v.Expected.ManagementGroupName {
+			t.Fatalf("Expected %q but got %q for ManagementGroupName", v.Expected.ManagementGroupName, actual.ManagementGroupName)
+		}
+		if actual.DeploymentStackName != v.Expected.DeploymentStackName {
+			t.Fatalf("Expected %q but got %q for DeploymentStackName", v.Expected.DeploymentStackName, actual.DeploymentStackName)
+		}
+	}
+}
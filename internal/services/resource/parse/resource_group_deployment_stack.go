@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ResourceGroupDeploymentStackId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	DeploymentStackName string
+}
+
+func NewResourceGroupDeploymentStackID(subscriptionId, resourceGroup, deploymentStackName string) ResourceGroupDeploymentStackId {
+	return ResourceGroupDeploymentStackId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+func (id ResourceGroupDeploymentStackId) String() string {
+	segments := []string{
+		fmt.Sprintf("Deployment Stack Name %q", id.DeploymentStackName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Group Deployment Stack", segmentsStr)
+}
+
+func (id ResourceGroupDeploymentStackId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DeploymentStackName)
+}
+
+// ResourceGroupDeploymentStackID parses a ResourceGroupDeploymentStack ID into an ResourceGroupDeploymentStackId struct
+func ResourceGroupDeploymentStackID(input string) (*ResourceGroupDeploymentStackId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ResourceGroupDeploymentStack ID: %+v", input, err)
+	}
+
+	resourceId := ResourceGroupDeploymentStackId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DeploymentStackName, err = id.PopSegment("deploymentStacks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SubscriptionDeploymentStackId struct {
+	SubscriptionId      string
+	DeploymentStackName string
+}
+
+func NewSubscriptionDeploymentStackID(subscriptionId, deploymentStackName string) SubscriptionDeploymentStackId {
+	return SubscriptionDeploymentStackId{
+		SubscriptionId:      subscriptionId,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+func (id SubscriptionDeploymentStackId) String() string {
+	segments := []string{
+		fmt.Sprintf("Deployment Stack Name %q", id.DeploymentStackName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Subscription Deployment Stack", segmentsStr)
+}
+
+func (id SubscriptionDeploymentStackId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DeploymentStackName)
+}
+
+// SubscriptionDeploymentStackID parses a SubscriptionDeploymentStack ID into an SubscriptionDeploymentStackId struct
+func SubscriptionDeploymentStackID(input string) (*SubscriptionDeploymentStackId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SubscriptionDeploymentStack ID: %+v", input, err)
+	}
+
+	resourceId := SubscriptionDeploymentStackId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.DeploymentStackName, err = id.PopSegment("deploymentStacks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -60,6 +60,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		GenericResource{},
+		ManagementGroupDeploymentStackResource{},
+		ResourceGroupDeploymentStackResource{},
+		SubscriptionDeploymentStackResource{},
 		ResourceProviderRegistrationResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
@@ -0,0 +1,220 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceGroupDeploymentStackModel struct {
+	Name                      string                                 `tfschema:"name"`
+	ResourceGroupName         string                                 `tfschema:"resource_group_name"`
+	ActionOnUnmanage          []DeploymentStackActionOnUnmanageModel `tfschema:"action_on_unmanage"`
+	BypassStackOutOfSyncError bool                                   `tfschema:"bypass_stack_out_of_sync_error"`
+	DenySettings              []DeploymentStackDenySettingsModel     `tfschema:"deny_settings"`
+	Description               string                                 `tfschema:"description"`
+	ParametersContent         string                                 `tfschema:"parameters_content"`
+	TemplateContent           string                                 `tfschema:"template_content"`
+	TemplateSpecVersionId     string                                 `tfschema:"template_spec_version_id"`
+	Tags                      map[string]string                      `tfschema:"tags"`
+	DeploymentId              string                                 `tfschema:"deployment_id"`
+	ManagedResourceIds        []string                               `tfschema:"managed_resource_ids"`
+	OutputContent             string                                 `tfschema:"output_content"`
+}
+
+type ResourceGroupDeploymentStackResource struct{}
+
+var _ sdk.ResourceWithUpdate = ResourceGroupDeploymentStackResource{}
+
+func (r ResourceGroupDeploymentStackResource) ResourceType() string {
+	return "azurerm_resource_group_deployment_stack"
+}
+
+func (r ResourceGroupDeploymentStackResource) ModelObject() interface{} {
+	return &ResourceGroupDeploymentStackModel{}
+}
+
+func (r ResourceGroupDeploymentStackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ResourceGroupDeploymentStackID
+}
+
+func (r ResourceGroupDeploymentStackResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DeploymentStackName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+	}
+
+	for k, v := range deploymentStackArguments(DeploymentStackScopeResourceGroup) {
+		arguments[k] = v
+	}
+
+	return arguments
+}
+
+func (r ResourceGroupDeploymentStackResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentStackAttributes()
+}
+
+func (r ResourceGroupDeploymentStackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ResourceGroupDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewResourceGroupDeploymentStackID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := r.createOrUpdate(ctx, metadata, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := parse.ResourceGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return the Template or Parameters, so these are retained from the config/state
+			var state ResourceGroupDeploymentStackModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Name = id.DeploymentStackName
+			state.ResourceGroupName = id.ResourceGroup
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ActionOnUnmanage = flattenDeploymentStackActionOnUnmanage(props.ActionOnUnmanage)
+					state.BypassStackOutOfSyncError = pointer.From(props.BypassStackOutOfSyncError)
+					state.DenySettings = flattenDeploymentStackDenySettings(props.DenySettings)
+					state.DeploymentId = pointer.From(props.DeploymentId)
+					state.Description = pointer.From(props.Description)
+					state.ManagedResourceIds = flattenDeploymentStackManagedResourceIds(props.Resources)
+
+					state.TemplateSpecVersionId = ""
+					if props.TemplateLink != nil {
+						state.TemplateSpecVersionId = pointer.From(props.TemplateLink.Id)
+					}
+
+					if state.OutputContent, err = flattenDeploymentStackOutputs(props.Outputs); err != nil {
+						return fmt.Errorf("flattening `output_content`: %+v", err)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ResourceGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceGroupDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API doesn't support PATCH, so the Deployment Stack is redeployed in full
+			if err := r.createOrUpdate(ctx, metadata, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := parse.ResourceGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ResourceGroupDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := deploymentStackDeleteOptions(expandDeploymentStackActionOnUnmanage(model.ActionOnUnmanage), model.BypassStackOutOfSyncError)
+			if err := client.DeleteThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ResourceGroupDeploymentStackId, model ResourceGroupDeploymentStackModel) error {
+	properties, err := expandDeploymentStackProperties(model.TemplateContent, model.TemplateSpecVersionId, model.ParametersContent, model.Description, model.BypassStackOutOfSyncError, expandDeploymentStackActionOnUnmanage(model.ActionOnUnmanage), model.DenySettings)
+	if err != nil {
+		return err
+	}
+
+	payload := client.DeploymentStack{
+		Properties: properties,
+		Tags:       pointer.To(model.Tags),
+	}
+
+	return metadata.Client.Resource.DeploymentStacksClient.CreateOrUpdateThenPoll(ctx, id, payload)
+}
@@ -0,0 +1,256 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceGroupDeploymentStackResource struct{}
+
+func TestAccResourceGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output_content").Exists(),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+		{
+			Config: r.complete(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+		{
+			Config: r.complete(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+	})
+}
+
+func (r ResourceGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ResourceGroupDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ResourceGroupDeploymentStackResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-stack-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                = "acctest-stack-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+
+  deny_settings {
+    mode = "none"
+  }
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2022-07-01",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ResourceGroupDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "import" {
+  name                = azurerm_resource_group_deployment_stack.test.name
+  resource_group_name = azurerm_resource_group_deployment_stack.test.resource_group_name
+  template_content    = azurerm_resource_group_deployment_stack.test.template_content
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+
+  deny_settings {
+    mode = "none"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ResourceGroupDeploymentStackResource) complete(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                           = "acctest-stack-%d"
+  resource_group_name            = azurerm_resource_group.test.name
+  description                    = "Managed by Terraform"
+  bypass_stack_out_of_sync_error = true
+
+  action_on_unmanage {
+    resources       = "delete"
+    resource_groups = "delete"
+  }
+
+  deny_settings {
+    mode                  = "denyWriteAndDelete"
+    apply_to_child_scopes = true
+    excluded_actions      = ["Microsoft.Network/publicIPAddresses/write"]
+    excluded_principals   = [data.azurerm_client_config.current.object_id]
+  }
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "tagValue": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2022-07-01",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      },
+      "tags": {
+        "Hello": "[parameters('tagValue')]"
+      }
+    }
+  ],
+  "outputs": {
+    "tagValue": {
+      "type": "string",
+      "value": "[parameters('tagValue')]"
+    }
+  }
+}
+TEMPLATE
+
+  parameters_content = jsonencode({
+    tagValue = {
+      value = %q
+    }
+  })
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, tagValue)
+}
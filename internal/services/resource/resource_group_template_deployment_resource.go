@@ -2,11 +2,16 @@ package resource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -37,6 +42,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceGroupTemplateDeploymentResourceCustomizeDiff),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		// lintignore:S033
@@ -64,16 +71,29 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ExactlyOneOf: []string{
+					"template_bicep_content",
 					"template_content",
 					"template_spec_version_id",
 				},
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"template_bicep_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_bicep_content",
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"template_spec_version_id": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ExactlyOneOf: []string{
+					"template_bicep_content",
 					"template_content",
 					"template_spec_version_id",
 				},
@@ -96,6 +116,12 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"validate_with_what_if": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -103,6 +129,24 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"change_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -141,6 +185,14 @@ func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, me
 		deployment.Properties.Template = template
 	}
 
+	if bicepRaw, ok := d.GetOk("template_bicep_content"); ok {
+		template, err := compileTemplateDeploymentBicep(ctx, bicepRaw.(string))
+		if err != nil {
+			return fmt.Errorf("compiling `template_bicep_content`: %+v", err)
+		}
+		deployment.Properties.Template = template
+	}
+
 	if templateSpecVersionID, ok := d.GetOk("template_spec_version_id"); ok {
 		deployment.Properties.TemplateLink = &resources.TemplateLink{
 			ID: utils.String(templateSpecVersionID.(string)),
@@ -218,12 +270,12 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 	}
 	deployment.Properties.Parameters = parameters
 
-	if d.HasChange("template_content") {
-		templateContents, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
-		if err != nil {
-			return fmt.Errorf("expanding `template_content`: %+v", err)
-		}
+	templateContents, err := expandTemplateDeploymentTemplateForUpdate(ctx, d)
+	if err != nil {
+		return err
+	}
 
+	if templateContents != nil {
 		deployment.Properties.Template = templateContents
 	} else {
 		// retrieve the existing content and reuse that
@@ -400,3 +452,143 @@ func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.Resou
 
 	return nil
 }
+
+func resourceGroupTemplateDeploymentResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if err := templateDeploymentBicepCustomizeDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if !diff.Get("validate_with_what_if").(bool) {
+		return nil
+	}
+
+	// What-If is only useful when the Template Deployment is going to be (re)deployed
+	whatIfFields := []string{"resource_group_name", "name", "deployment_mode", "template_bicep_content", "template_content", "template_spec_version_id", "parameters_content"}
+	if diff.Id() != "" && !diff.HasChanges(whatIfFields...) {
+		return nil
+	}
+
+	for _, field := range whatIfFields {
+		if !diff.NewValueKnown(field) {
+			log.Printf("[DEBUG] Skipping What-If for Template Deployment since %q isn't known until apply", field)
+			return diff.SetNewComputed("what_if_changes")
+		}
+	}
+
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	id := parse.NewResourceGroupTemplateDeploymentID(subscriptionId, diff.Get("resource_group_name").(string), diff.Get("name").(string))
+
+	properties := resources.DeploymentWhatIfProperties{
+		Mode: resources.DeploymentMode(diff.Get("deployment_mode").(string)),
+	}
+
+	if templateSpecVersionID := diff.Get("template_spec_version_id").(string); templateSpecVersionID != "" {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(templateSpecVersionID),
+		}
+	} else if bicepRaw := diff.Get("template_bicep_content").(string); bicepRaw != "" {
+		template, err := compileTemplateDeploymentBicep(ctx, bicepRaw)
+		if err != nil {
+			return fmt.Errorf("compiling `template_bicep_content`: %+v", err)
+		}
+		properties.Template = template
+	} else if templateRaw := diff.Get("template_content").(string); templateRaw != "" {
+		template, err := expandTemplateDeploymentBody(templateRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if v := diff.Get("parameters_content").(string); v != "" {
+		parameters, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	defer cancel()
+
+	log.Printf("[DEBUG] Running What-If for %s..", id)
+	future, err := client.WhatIf(ctx, id.ResourceGroup, id.DeploymentName, resources.DeploymentWhatIf{
+		Properties: &properties,
+	})
+	if err != nil {
+		// the Resource Group may not exist yet when it's being created within the same plan
+		if templateDeploymentWhatIfResourceGroupNotFound(future.FutureAPI, err) {
+			log.Printf("[DEBUG] Skipping What-If for %s since the Resource Group doesn't exist yet", id)
+			return diff.SetNewComputed("what_if_changes")
+		}
+		return fmt.Errorf("running What-If for %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for What-If for %s: %+v", id, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving What-If result for %s: %+v", id, err)
+	}
+	if result.Error != nil {
+		if result.Error.Message != nil {
+			return fmt.Errorf("running What-If for %s: %s", id, *result.Error.Message)
+		}
+		return fmt.Errorf("running What-If for %s: %+v", id, *result.Error)
+	}
+
+	// the predicted changes are surfaced in the plan through `what_if_changes`, rather than as warnings
+	changes := flattenTemplateDeploymentWhatIfChanges(result.WhatIfOperationProperties)
+	log.Printf("[DEBUG] What-If for %s reported %d change(s)", id, len(changes))
+
+	// only set `what_if_changes` when there's a difference, to avoid an empty list being planned for every deployment
+	// which doesn't change any resources
+	if len(changes) == 0 && len(diff.Get("what_if_changes").([]interface{})) == 0 {
+		log.Printf("[DEBUG] What-If for %s reported no changes", id)
+		return nil
+	}
+
+	return diff.SetNew("what_if_changes", changes)
+}
+
+// templateDeploymentWhatIfResourceGroupNotFound returns whether the What-If request failed because the Resource Group
+// doesn't exist - the initial response isn't available when the request couldn't be sent, in which case the status code
+// is taken from the error (if present)
+func templateDeploymentWhatIfResourceGroupNotFound(future azure.FutureAPI, err error) bool {
+	if future != nil {
+		if resp := future.Response(); resp != nil {
+			return resp.StatusCode == http.StatusNotFound
+		}
+	}
+
+	var detailedErr autorest.DetailedError
+	if errors.As(err, &detailedErr) {
+		if statusCode, ok := detailedErr.StatusCode.(int); ok {
+			return statusCode == http.StatusNotFound
+		}
+	}
+
+	return false
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	for _, change := range *input.Changes {
+		// only surface the resources which are going to be changed by the deployment
+		if change.ChangeType == resources.ChangeTypeNoChange || change.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id": pointer.From(change.ResourceID),
+			"change_type": string(change.ChangeType),
+		})
+	}
+
+	return output
+}
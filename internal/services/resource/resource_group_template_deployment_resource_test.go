@@ -139,6 +139,54 @@ func TestAccResourceGroupTemplateDeployment_singleItemUpdatingParams(t *testing.
 	})
 }
 
+func TestAccResourceGroupTemplateDeployment_validateWithWhatIf(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.validateWithWhatIfConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("what_if_changes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("what_if_changes.0.change_type").HasValue("Create"),
+			),
+		},
+		data.ImportStep("validate_with_what_if", "what_if_changes"),
+		{
+			Config: r.validateWithWhatIfConfig(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("what_if_changes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("what_if_changes.0.change_type").HasValue("Modify"),
+			),
+		},
+		data.ImportStep("validate_with_what_if", "what_if_changes"),
+	})
+}
+
+func TestAccResourceGroupTemplateDeployment_bicep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
+	r := ResourceGroupTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.bicepConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_bicep_content"),
+		{
+			Config: r.bicepConfig(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_bicep_content"),
+	})
+}
+
 func TestAccResourceGroupTemplateDeployment_singleItemUpdatingTemplate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group_template_deployment", "test")
 	r := ResourceGroupTemplateDeploymentResource{}
@@ -697,6 +745,81 @@ TEMPLATE
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
 }
 
+func (ResourceGroupTemplateDeploymentResource) validateWithWhatIfConfig(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                  = "acctest"
+  resource_group_name   = azurerm_resource_group.test.name
+  deployment_mode       = "Complete"
+  validate_with_what_if = true
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2015-06-15",
+      "name": "acctestpip-%d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      },
+      "tags": {
+        "Hello": %q
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
+}
+
+func (ResourceGroupTemplateDeploymentResource) bicepConfig(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Complete"
+
+  template_bicep_content = <<BICEP
+resource publicIP 'Microsoft.Network/publicIPAddresses@2022-07-01' = {
+  name: 'acctestpip-%d'
+  location: resourceGroup().location
+  properties: {
+    publicIPAllocationMethod: 'Dynamic'
+  }
+  tags: {
+    Hello: '%s'
+  }
+}
+BICEP
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, tagValue)
+}
+
 func (ResourceGroupTemplateDeploymentResource) withOutputsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
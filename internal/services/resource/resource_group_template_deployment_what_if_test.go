@@ -0,0 +1,65 @@
+package resource
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestTemplateDeploymentWhatIfResourceGroupNotFound(t *testing.T) {
+	notFound, notFoundErr := azure.NewFutureFromResponse(&http.Response{
+		StatusCode: http.StatusNotFound,
+		Request:    &http.Request{Method: http.MethodPost},
+		Body:       http.NoBody,
+	})
+
+	testData := []struct {
+		name     string
+		future   azure.FutureAPI
+		err      error
+		expected bool
+	}{
+		{
+			name:     "no future and no status code",
+			future:   nil,
+			err:      errors.New("connection reset"),
+			expected: false,
+		},
+		{
+			name:     "empty future when the request couldn't be sent",
+			future:   &azure.Future{},
+			err:      autorest.NewErrorWithError(errors.New("connection reset"), "resources.DeploymentsClient", "WhatIf", nil, "Failure sending request"),
+			expected: false,
+		},
+		{
+			name:     "empty future with a not found status code",
+			future:   &azure.Future{},
+			err:      autorest.NewErrorWithError(errors.New("not found"), "resources.DeploymentsClient", "WhatIf", &http.Response{StatusCode: http.StatusNotFound}, "Failure sending request"),
+			expected: true,
+		},
+		{
+			name:     "empty future with a different status code",
+			future:   &azure.Future{},
+			err:      autorest.NewErrorWithError(errors.New("forbidden"), "resources.DeploymentsClient", "WhatIf", &http.Response{StatusCode: http.StatusForbidden}, "Failure sending request"),
+			expected: false,
+		},
+		{
+			name:     "future with a not found response",
+			future:   &notFound,
+			err:      notFoundErr,
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := templateDeploymentWhatIfResourceGroupNotFound(v.future, v.err)
+		if actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SubscriptionDeploymentStackModel struct {
+	Name                      string                                 `tfschema:"name"`
+	Location                  string                                 `tfschema:"location"`
+	ActionOnUnmanage          []DeploymentStackActionOnUnmanageModel `tfschema:"action_on_unmanage"`
+	BypassStackOutOfSyncError bool                                   `tfschema:"bypass_stack_out_of_sync_error"`
+	DenySettings              []DeploymentStackDenySettingsModel     `tfschema:"deny_settings"`
+	Description               string                                 `tfschema:"description"`
+	ParametersContent         string                                 `tfschema:"parameters_content"`
+	TemplateContent           string                                 `tfschema:"template_content"`
+	TemplateSpecVersionId     string                                 `tfschema:"template_spec_version_id"`
+	Tags                      map[string]string                      `tfschema:"tags"`
+	DeploymentId              string                                 `tfschema:"deployment_id"`
+	ManagedResourceIds        []string                               `tfschema:"managed_resource_ids"`
+	OutputContent             string                                 `tfschema:"output_content"`
+}
+
+type SubscriptionDeploymentStackResource struct{}
+
+var _ sdk.ResourceWithUpdate = SubscriptionDeploymentStackResource{}
+
+func (r SubscriptionDeploymentStackResource) ResourceType() string {
+	return "azurerm_subscription_deployment_stack"
+}
+
+func (r SubscriptionDeploymentStackResource) ModelObject() interface{} {
+	return &SubscriptionDeploymentStackModel{}
+}
+
+func (r SubscriptionDeploymentStackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SubscriptionDeploymentStackID
+}
+
+func (r SubscriptionDeploymentStackResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DeploymentStackName,
+		},
+
+		"location": commonschema.Location(),
+	}
+
+	for k, v := range deploymentStackArguments(DeploymentStackScopeSubscription) {
+		arguments[k] = v
+	}
+
+	return arguments
+}
+
+func (r SubscriptionDeploymentStackResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentStackAttributes()
+}
+
+func (r SubscriptionDeploymentStackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model SubscriptionDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewSubscriptionDeploymentStackID(subscriptionId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := r.createOrUpdate(ctx, metadata, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := parse.SubscriptionDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return the Template or Parameters, so these are retained from the config/state
+			var state SubscriptionDeploymentStackModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Name = id.DeploymentStackName
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ActionOnUnmanage = flattenDeploymentStackActionOnUnmanage(props.ActionOnUnmanage)
+					state.BypassStackOutOfSyncError = pointer.From(props.BypassStackOutOfSyncError)
+					state.DenySettings = flattenDeploymentStackDenySettings(props.DenySettings)
+					state.DeploymentId = pointer.From(props.DeploymentId)
+					state.Description = pointer.From(props.Description)
+					state.ManagedResourceIds = flattenDeploymentStackManagedResourceIds(props.Resources)
+
+					state.TemplateSpecVersionId = ""
+					if props.TemplateLink != nil {
+						state.TemplateSpecVersionId = pointer.From(props.TemplateLink.Id)
+					}
+
+					if state.OutputContent, err = flattenDeploymentStackOutputs(props.Outputs); err != nil {
+						return fmt.Errorf("flattening `output_content`: %+v", err)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.SubscriptionDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SubscriptionDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API doesn't support PATCH, so the Deployment Stack is redeployed in full
+			if err := r.createOrUpdate(ctx, metadata, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := parse.SubscriptionDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SubscriptionDeploymentStackModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			options := deploymentStackDeleteOptions(expandDeploymentStackActionOnUnmanage(model.ActionOnUnmanage), model.BypassStackOutOfSyncError)
+			if err := client.DeleteThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) createOrUpdate(ctx context.Context, metadata sdk.ResourceMetaData, id parse.SubscriptionDeploymentStackId, model SubscriptionDeploymentStackModel) error {
+	properties, err := expandDeploymentStackProperties(model.TemplateContent, model.TemplateSpecVersionId, model.ParametersContent, model.Description, model.BypassStackOutOfSyncError, expandDeploymentStackActionOnUnmanage(model.ActionOnUnmanage), model.DenySettings)
+	if err != nil {
+		return err
+	}
+
+	payload := client.DeploymentStack{
+		Location:   pointer.To(location.Normalize(model.Location)),
+		Properties: properties,
+		Tags:       pointer.To(model.Tags),
+	}
+
+	return metadata.Client.Resource.DeploymentStacksClient.CreateOrUpdateThenPoll(ctx, id, payload)
+}
@@ -0,0 +1,145 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SubscriptionDeploymentStackResource struct{}
+
+func TestAccSubscriptionDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_deployment_stack", "test")
+	r := SubscriptionDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content", "parameters_content"),
+	})
+}
+
+func TestAccSubscriptionDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_deployment_stack", "test")
+	r := SubscriptionDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SubscriptionDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.SubscriptionDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SubscriptionDeploymentStackResource) basic(data acceptance.TestData, tagValue string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_subscription_deployment_stack" "test" {
+  name     = "acctest-stack-%[1]d"
+  location = %[2]q
+
+  action_on_unmanage {
+    resources       = "delete"
+    resource_groups = "delete"
+  }
+
+  deny_settings {
+    mode = "denyDelete"
+  }
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "tagValue": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2022-09-01",
+      "name": "acctestRG-stack-%[1]d",
+      "location": %[2]q,
+      "tags": {
+        "Hello": "[parameters('tagValue')]"
+      }
+    }
+  ]
+}
+TEMPLATE
+
+  parameters_content = jsonencode({
+    tagValue = {
+      value = %[3]q
+    }
+  })
+}
+`, data.RandomInteger, data.Locations.Primary, tagValue)
+}
+
+func (r SubscriptionDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_deployment_stack" "import" {
+  name               = azurerm_subscription_deployment_stack.test.name
+  location           = azurerm_subscription_deployment_stack.test.location
+  template_content   = azurerm_subscription_deployment_stack.test.template_content
+  parameters_content = azurerm_subscription_deployment_stack.test.parameters_content
+
+  action_on_unmanage {
+    resources       = "delete"
+    resource_groups = "delete"
+  }
+
+  deny_settings {
+    mode = "denyDelete"
+  }
+}
+`, r.basic(data, "first"))
+}
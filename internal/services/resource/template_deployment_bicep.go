@@ -0,0 +1,102 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// compileTemplateDeploymentBicep compiles the specified Bicep content into an ARM Template using the Bicep CLI,
+// either the standalone `bicep` binary or (when that's unavailable) the version bundled with the Azure CLI
+func compileTemplateDeploymentBicep(ctx context.Context, input string) (*map[string]interface{}, error) {
+	command, args, err := findTemplateDeploymentBicepCommand()
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "terraform-azurerm-bicep")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "main.bicep")
+	if err := os.WriteFile(path, []byte(input), 0600); err != nil {
+		return nil, fmt.Errorf("writing Bicep content to %q: %+v", path, err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command, append(args, path, "--stdout")...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("[DEBUG] Compiling Bicep content using %q..", cmd.String())
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("compiling Bicep content using %q: %+v\n\n%s", command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	template, err := expandTemplateDeploymentBody(stdout.String())
+	if err != nil {
+		return nil, fmt.Errorf("parsing the compiled Bicep content: %+v", err)
+	}
+
+	return template, nil
+}
+
+func findTemplateDeploymentBicepCommand() (string, []string, error) {
+	if path, err := exec.LookPath("bicep"); err == nil {
+		return path, []string{"build"}, nil
+	}
+
+	if path, err := exec.LookPath("az"); err == nil {
+		return path, []string{"bicep", "build", "--file"}, nil
+	}
+
+	return "", nil, fmt.Errorf("compiling `template_bicep_content` requires either the Bicep CLI (`bicep`) or the Azure CLI (`az`) to be available on the PATH")
+}
+
+func templateDeploymentBicepCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the compiled template is only known once the Bicep content has been deployed
+	if diff.Id() != "" && diff.HasChange("template_bicep_content") && diff.Get("template_bicep_content").(string) != "" {
+		return diff.SetNewComputed("template_content")
+	}
+
+	return nil
+}
+
+type templateDeploymentUpdateData interface {
+	Get(key string) interface{}
+	HasChange(key string) bool
+}
+
+// expandTemplateDeploymentTemplateForUpdate returns the Template which should be deployed when updating a Template
+// Deployment, or nil when the existing Template should be reused. Changes to `template_bicep_content` are checked
+// first since `template_content` is marked as computed (and as such also changes) when the Bicep content changes.
+func expandTemplateDeploymentTemplateForUpdate(ctx context.Context, d templateDeploymentUpdateData) (*map[string]interface{}, error) {
+	if bicepRaw := d.Get("template_bicep_content").(string); bicepRaw != "" && d.HasChange("template_bicep_content") {
+		template, err := compileTemplateDeploymentBicep(ctx, bicepRaw)
+		if err != nil {
+			return nil, fmt.Errorf("compiling `template_bicep_content`: %+v", err)
+		}
+
+		return template, nil
+	}
+
+	if templateRaw := d.Get("template_content").(string); templateRaw != "" && d.HasChange("template_content") {
+		template, err := expandTemplateDeploymentBody(templateRaw)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+
+		return template, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,147 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestCompileTemplateDeploymentBicep(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Bicep CLI is a shell script")
+	}
+
+	dir := t.TempDir()
+	script := `#!/bin/sh
+if [ "$1" != "build" ] || [ "$3" != "--stdout" ]; then
+  echo "unexpected arguments: $*" >&2
+  exit 1
+fi
+read -r content < "$2"
+if [ "$content" = "invalid" ]; then
+  echo "Error BCP007: This declaration type is not recognized." >&2
+  exit 1
+fi
+echo '{"contentVersion": "1.0.0.0", "resources": []}'
+`
+	if err := os.WriteFile(filepath.Join(dir, "bicep"), []byte(script), 0700); err != nil {
+		t.Fatalf("writing fake Bicep CLI: %+v", err)
+	}
+	t.Setenv("PATH", dir)
+
+	template, err := compileTemplateDeploymentBicep(context.TODO(), "param location string = resourceGroup().location")
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if template == nil || (*template)["contentVersion"] != "1.0.0.0" {
+		t.Fatalf("expected the compiled template to be returned but got: %+v", template)
+	}
+
+	if _, err := compileTemplateDeploymentBicep(context.TODO(), "invalid"); err == nil {
+		t.Fatalf("expected an error when the Bicep content fails to compile")
+	}
+}
+
+type templateDeploymentUpdateTestData struct {
+	values  map[string]string
+	changed map[string]bool
+}
+
+func (d templateDeploymentUpdateTestData) Get(key string) interface{} {
+	return d.values[key]
+}
+
+func (d templateDeploymentUpdateTestData) HasChange(key string) bool {
+	return d.changed[key]
+}
+
+func TestExpandTemplateDeploymentTemplateForUpdate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake Bicep CLI is a shell script")
+	}
+
+	dir := t.TempDir()
+	script := `#!/bin/sh
+echo '{"contentVersion": "2.0.0.0", "resources": []}'
+`
+	if err := os.WriteFile(filepath.Join(dir, "bicep"), []byte(script), 0700); err != nil {
+		t.Fatalf("writing fake Bicep CLI: %+v", err)
+	}
+	t.Setenv("PATH", dir)
+
+	testData := []struct {
+		name            string
+		input           templateDeploymentUpdateTestData
+		expectedVersion string
+		expectError     bool
+	}{
+		{
+			// `template_content` is computed (and as such empty) when the Bicep content changes
+			name: "bicep content changed",
+			input: templateDeploymentUpdateTestData{
+				values:  map[string]string{"template_bicep_content": "param location string", "template_content": ""},
+				changed: map[string]bool{"template_bicep_content": true, "template_content": true},
+			},
+			expectedVersion: "2.0.0.0",
+		},
+		{
+			name: "template content changed",
+			input: templateDeploymentUpdateTestData{
+				values:  map[string]string{"template_content": `{"contentVersion": "1.0.0.0"}`},
+				changed: map[string]bool{"template_content": true},
+			},
+			expectedVersion: "1.0.0.0",
+		},
+		{
+			name: "invalid template content",
+			input: templateDeploymentUpdateTestData{
+				values:  map[string]string{"template_content": "{"},
+				changed: map[string]bool{"template_content": true},
+			},
+			expectError: true,
+		},
+		{
+			name: "nothing changed",
+			input: templateDeploymentUpdateTestData{
+				values:  map[string]string{"template_content": `{"contentVersion": "1.0.0.0"}`},
+				changed: map[string]bool{},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		template, err := expandTemplateDeploymentTemplateForUpdate(context.TODO(), v.input)
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if v.expectedVersion == "" {
+			if template != nil {
+				t.Fatalf("expected no template but got: %+v", *template)
+			}
+			continue
+		}
+
+		if template == nil || (*template)["contentVersion"] != v.expectedVersion {
+			t.Fatalf("expected a template with the version %q but got: %+v", v.expectedVersion, template)
+		}
+	}
+}
+
+func TestCompileTemplateDeploymentBicepNoCLI(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	if _, err := compileTemplateDeploymentBicep(context.TODO(), "param location string"); err == nil {
+		t.Fatalf("expected an error when neither the Bicep CLI or the Azure CLI are available")
+	}
+}
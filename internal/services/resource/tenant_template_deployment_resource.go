@@ -38,6 +38,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(templateDeploymentBicepCustomizeDiff),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		// lintignore:S033
@@ -56,16 +58,29 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ExactlyOneOf: []string{
+					"template_bicep_content",
 					"template_content",
 					"template_spec_version_id",
 				},
 				StateFunc: utils.NormalizeJson,
 			},
 
+			"template_bicep_content": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"template_bicep_content",
+					"template_content",
+					"template_spec_version_id",
+				},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"template_spec_version_id": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ExactlyOneOf: []string{
+					"template_bicep_content",
 					"template_content",
 					"template_spec_version_id",
 				},
@@ -133,6 +148,14 @@ func tenantTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta inte
 		deployment.Properties.Template = template
 	}
 
+	if bicepRaw, ok := d.GetOk("template_bicep_content"); ok {
+		template, err := compileTemplateDeploymentBicep(ctx, bicepRaw.(string))
+		if err != nil {
+			return fmt.Errorf("compiling `template_bicep_content`: %+v", err)
+		}
+		deployment.Properties.Template = template
+	}
+
 	if templateSpecVersionID, ok := d.GetOk("template_spec_version_id"); ok {
 		deployment.Properties.TemplateLink = &resources.TemplateLink{
 			ID: utils.String(templateSpecVersionID.(string)),
@@ -209,12 +232,12 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 		deployment.Properties.Parameters = parameters
 	}
 
-	if d.HasChange("template_content") {
-		templateContents, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
-		if err != nil {
-			return fmt.Errorf("expanding `template_content`: %+v", err)
-		}
+	templateContents, err := expandTemplateDeploymentTemplateForUpdate(ctx, d)
+	if err != nil {
+		return err
+	}
 
+	if templateContents != nil {
 		deployment.Properties.Template = templateContents
 	} else {
 		// retrieve the existing content and reuse that
@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeploymentStackName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// invalid char
+			Input: "stack/1",
+			Valid: false,
+		},
+		{
+			// too long - 91 chars
+			Input: strings.Repeat("a", 91),
+			Valid: false,
+		},
+		{
+			// max length - 90 chars
+			Input: strings.Repeat("a", 90),
+			Valid: true,
+		},
+		{
+			// valid special
+			Input: "stack_(1).prod-west",
+			Valid: true,
+		},
+		{
+			// sensible value
+			Input: "Production",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing value %s", tc.Input)
+		_, errors := DeploymentStackName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// GenericResourceType validates a Resource Type in the format `{Namespace}/{type}`, or `{Namespace}/{type}/{childType}`
+// for nested resources - for example `Microsoft.Network/virtualNetworks/subnets`
+func GenericResourceType(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	segments := strings.Split(input, "/")
+	if len(segments) < 2 || !regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)+$`).MatchString(segments[0]) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `{Namespace}/{type}` (for example `Microsoft.Network/virtualNetworks`), got %q", k, input))
+		return
+	}
+
+	for _, segment := range segments[1:] {
+		if segment == "" || strings.Contains(segment, "@") {
+			errors = append(errors, fmt.Errorf("%q must be in the format `{Namespace}/{type}` and must not contain an API Version, got %q", k, input))
+			return
+		}
+	}
+
+	if strings.EqualFold(input, "Microsoft.Resources/resourceGroups") {
+		errors = append(errors, fmt.Errorf("%q cannot be `Microsoft.Resources/resourceGroups` - use the `azurerm_resource_group` resource instead", k))
+	}
+
+	return
+}
+
+// GenericResourceParentID validates the scope within which a Generic Resource should be created, which is any
+// Resource Manager ID (for example a Subscription, Resource Group or Resource ID)
+func GenericResourceParentID(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !strings.HasPrefix(input, "/") || strings.HasSuffix(input, "/") || strings.Contains(input, "//") {
+		errors = append(errors, fmt.Errorf("%q must be a Resource Manager ID (for example `/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example`), got %q", k, input))
+	}
+
+	return
+}
+
+// ApiVersion validates a Resource Manager API Version in the format `YYYY-MM-DD` or `YYYY-MM-DD-preview`
+func ApiVersion(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-[A-Za-z]+)?$`).MatchString(input) {
+		errors = append(errors, fmt.Errorf("%q must be in the format `YYYY-MM-DD` or `YYYY-MM-DD-preview`, got %q", k, input))
+	}
+
+	return
+}
@@ -0,0 +1,70 @@
+package validate
+
+import "testing"
+
+func TestGenericResourceType(t *testing.T) {
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{"", false},
+		{"Microsoft.Network", false},
+		{"virtualNetworks", false},
+		{"Microsoft.Network/virtualNetworks", true},
+		{"Microsoft.Network/virtualNetworks/subnets", true},
+		{"Microsoft.Network/virtualNetworks@2022-07-01", false},
+		{"Microsoft.Network/virtualNetworks/", false},
+		{"Microsoft.Resources/resourceGroups", false},
+	}
+
+	for _, test := range testCases {
+		_, es := GenericResourceType(test.input, "type")
+
+		if valid := len(es) == 0; valid != test.valid {
+			t.Fatalf("expected %q to be valid %t but got %t", test.input, test.valid, valid)
+		}
+	}
+}
+
+func TestGenericResourceParentID(t *testing.T) {
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{"", false},
+		{"subscriptions/00000000-0000-0000-0000-000000000000", false},
+		{"/subscriptions/00000000-0000-0000-0000-000000000000/", false},
+		{"/subscriptions/00000000-0000-0000-0000-000000000000", true},
+		{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", true},
+		{"/providers/Microsoft.Management/managementGroups/example", true},
+	}
+
+	for _, test := range testCases {
+		_, es := GenericResourceParentID(test.input, "parent_id")
+
+		if valid := len(es) == 0; valid != test.valid {
+			t.Fatalf("expected %q to be valid %t but got %t", test.input, test.valid, valid)
+		}
+	}
+}
+
+func TestApiVersion(t *testing.T) {
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{"", false},
+		{"latest", false},
+		{"2022-07-01", true},
+		{"2023-03-02-preview", true},
+		{"2022-7-1", false},
+	}
+
+	for _, test := range testCases {
+		_, es := ApiVersion(test.input, "api_version")
+
+		if valid := len(es) == 0; valid != test.valid {
+			t.Fatalf("expected %q to be valid %t but got %t", test.input, test.valid, valid)
+		}
+	}
+}
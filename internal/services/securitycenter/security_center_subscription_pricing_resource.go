@@ -1,8 +1,11 @@
 package securitycenter
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v3.0/security" // nolint: staticcheck
@@ -41,6 +44,8 @@ func resourceSecurityCenterSubscriptionPricing() *pluginsdk.Resource {
 			0: migration.SubscriptionPricingV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceSecurityCenterSubscriptionPricingCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"tier": {
 				Type:     pluginsdk.TypeString,
@@ -75,10 +80,117 @@ func resourceSecurityCenterSubscriptionPricing() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 			},
+			"extension": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"additional_extension_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+						"exclusion_tags": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+const (
+	pricingExtensionAgentlessVmScanning = "AgentlessVmScanning"
+
+	// pricingExtensionExclusionTagsKey is the key within `additionalExtensionProperties` holding the (JSON encoded)
+	// list of tags used to exclude machines from agentless scanning
+	pricingExtensionExclusionTagsKey = "ExclusionTags"
+)
+
+// pricingExtensionsByResourceType contains the extensions available for each plan, mapped to the subplan (if any)
+// which is required for the extension to be enabled.
+var pricingExtensionsByResourceType = map[string]map[string]string{
+	"CloudPosture": {
+		"AgentlessDiscoveryForKubernetes":             "",
+		pricingExtensionAgentlessVmScanning:           "",
+		"ContainerRegistriesVulnerabilityAssessments": "",
+		"EntraPermissionsManagement":                  "",
+		"SensitiveDataDiscovery":                      "",
+	},
+	"Containers": {
+		"AgentlessDiscoveryForKubernetes":             "",
+		"ContainerRegistriesVulnerabilityAssessments": "",
+		"ContainerSensor":                             "",
+	},
+	"StorageAccounts": {
+		"OnUploadMalwareScanning": "DefenderForStorageV2",
+		"SensitiveDataDiscovery":  "DefenderForStorageV2",
+	},
+	"VirtualMachines": {
+		pricingExtensionAgentlessVmScanning: "P2",
+		"MdeDesignatedSubscription":         "",
+	},
+}
+
+func resourceSecurityCenterSubscriptionPricingCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	extensions := diff.Get("extension").(*pluginsdk.Set).List()
+	if len(extensions) == 0 {
+		return nil
+	}
+
+	resourceType := diff.Get("resource_type").(string)
+	subPlan := diff.Get("subplan").(string)
+
+	if tier := diff.Get("tier").(string); tier != string(pricings_v2023_01_01.PricingTierStandard) {
+		return fmt.Errorf("`extension` can only be specified when `tier` is `%s`", pricings_v2023_01_01.PricingTierStandard)
+	}
+
+	available, ok := pricingExtensionsByResourceType[resourceType]
+	if !ok {
+		return fmt.Errorf("`extension` is not supported when `resource_type` is `%s`", resourceType)
+	}
+
+	for _, raw := range extensions {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+
+		requiredSubPlan, ok := available[name]
+		if !ok {
+			names := make([]string, 0, len(available))
+			for k := range available {
+				names = append(names, k)
+			}
+			return fmt.Errorf("the extension %q is not supported when `resource_type` is `%s`, possible values are `%s`", name, resourceType, strings.Join(names, "`, `"))
+		}
+
+		if requiredSubPlan != "" && !strings.EqualFold(subPlan, requiredSubPlan) {
+			return fmt.Errorf("the extension %q requires `subplan` to be `%s`", name, requiredSubPlan)
+		}
+
+		if len(v["exclusion_tags"].(map[string]interface{})) > 0 && name != pricingExtensionAgentlessVmScanning {
+			return fmt.Errorf("`exclusion_tags` can only be specified for the %q extension", pricingExtensionAgentlessVmScanning)
+		}
+
+		if _, ok := v["additional_extension_properties"].(map[string]interface{})[pricingExtensionExclusionTagsKey]; ok {
+			return fmt.Errorf("%q should be specified using `exclusion_tags` rather than `additional_extension_properties`", pricingExtensionExclusionTagsKey)
+		}
+	}
+
+	return nil
+}
+
 func resourceSecurityCenterSubscriptionPricingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).SecurityCenter.PricingClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -98,6 +210,15 @@ func resourceSecurityCenterSubscriptionPricingUpdate(d *pluginsdk.ResourceData,
 		pricing.Properties.SubPlan = utils.String(v.(string))
 	}
 
+	if pricing.Properties.PricingTier == pricings_v2023_01_01.PricingTierStandard {
+		oldRaw, newRaw := d.GetChange("extension")
+		extensions, err := expandSecurityCenterSubscriptionPricingExtensions(newRaw.(*pluginsdk.Set).List(), oldRaw.(*pluginsdk.Set).List())
+		if err != nil {
+			return fmt.Errorf("expanding `extension`: %+v", err)
+		}
+		pricing.Properties.Extensions = extensions
+	}
+
 	if _, err := client.Update(ctx, id, pricing); err != nil {
 		return fmt.Errorf("setting %s: %+v", id, err)
 	}
@@ -132,6 +253,14 @@ func resourceSecurityCenterSubscriptionPricingRead(d *pluginsdk.ResourceData, me
 		if properties := resp.Model.Properties; properties != nil {
 			d.Set("tier", properties.PricingTier)
 			d.Set("subplan", properties.SubPlan)
+
+			extensions, err := flattenSecurityCenterSubscriptionPricingExtensions(properties.Extensions)
+			if err != nil {
+				return fmt.Errorf("flattening `extension`: %+v", err)
+			}
+			if err := d.Set("extension", extensions); err != nil {
+				return fmt.Errorf("setting `extension`: %+v", err)
+			}
 		}
 	}
 
@@ -144,3 +273,112 @@ func resourceSecurityCenterSubscriptionPricingDelete(_ *pluginsdk.ResourceData,
 	log.Printf("[DEBUG] Security Center Subscription deletion invocation")
 	return nil
 }
+
+type pricingExtensionExclusionTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func expandSecurityCenterSubscriptionPricingExtensions(input []interface{}, previous []interface{}) (*[]pricings_v2023_01_01.Extension, error) {
+	output := make([]pricings_v2023_01_01.Extension, 0)
+	enabled := make(map[string]struct{})
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		enabled[name] = struct{}{}
+
+		properties := make(map[string]interface{})
+		for key, value := range v["additional_extension_properties"].(map[string]interface{}) {
+			properties[key] = value
+		}
+
+		if tags := v["exclusion_tags"].(map[string]interface{}); len(tags) > 0 {
+			exclusionTags := make([]pricingExtensionExclusionTag, 0)
+			for key, value := range tags {
+				exclusionTags = append(exclusionTags, pricingExtensionExclusionTag{
+					Key:   key,
+					Value: value.(string),
+				})
+			}
+
+			encoded, err := json.Marshal(exclusionTags)
+			if err != nil {
+				return nil, fmt.Errorf("encoding `exclusion_tags`: %+v", err)
+			}
+			properties[pricingExtensionExclusionTagsKey] = string(encoded)
+		}
+
+		extension := pricings_v2023_01_01.Extension{
+			Name:      name,
+			IsEnabled: pricings_v2023_01_01.IsEnabledTrue,
+		}
+		if len(properties) > 0 {
+			var additionalProperties interface{} = properties
+			extension.AdditionalExtensionProperties = &additionalProperties
+		}
+
+		output = append(output, extension)
+	}
+
+	// extensions which are omitted from the request are left as-is by the API, so any which have been
+	// removed from the configuration need to be explicitly disabled
+	for _, raw := range previous {
+		name := raw.(map[string]interface{})["name"].(string)
+		if _, ok := enabled[name]; !ok {
+			output = append(output, pricings_v2023_01_01.Extension{
+				Name:      name,
+				IsEnabled: pricings_v2023_01_01.IsEnabledFalse,
+			})
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenSecurityCenterSubscriptionPricingExtensions(input *[]pricings_v2023_01_01.Extension) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, extension := range *input {
+		if extension.IsEnabled != pricings_v2023_01_01.IsEnabledTrue {
+			continue
+		}
+
+		additionalProperties := make(map[string]interface{})
+		exclusionTags := make(map[string]interface{})
+		if extension.AdditionalExtensionProperties != nil {
+			if properties, ok := (*extension.AdditionalExtensionProperties).(map[string]interface{}); ok {
+				for key, value := range properties {
+					if key == pricingExtensionExclusionTagsKey && extension.Name == pricingExtensionAgentlessVmScanning {
+						raw, ok := value.(string)
+						if !ok || raw == "" {
+							continue
+						}
+
+						var tags []pricingExtensionExclusionTag
+						if err := json.Unmarshal([]byte(raw), &tags); err != nil {
+							return nil, fmt.Errorf("decoding %q for extension %q: %+v", pricingExtensionExclusionTagsKey, extension.Name, err)
+						}
+						for _, tag := range tags {
+							exclusionTags[tag.Key] = tag.Value
+						}
+						continue
+					}
+
+					additionalProperties[key] = fmt.Sprintf("%v", value)
+				}
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                            extension.Name,
+			"additional_extension_properties": additionalProperties,
+			"exclusion_tags":                  exclusionTags,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,197 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+)
+
+// NOTE: Summary Rules (`Microsoft.OperationalInsights/workspaces/summaryLogs`) aren't available in the vendored SDK,
+// as such they're managed here against the 2023-01-01-preview API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const summaryRulesApiVersion = "2023-01-01-preview"
+
+type SummaryRuleType string
+
+const (
+	SummaryRuleTypeUser SummaryRuleType = "User"
+)
+
+type SummaryRule struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SummaryRuleProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type SummaryRuleProperties struct {
+	Description       *string                `json:"description,omitempty"`
+	DisplayName       *string                `json:"displayName,omitempty"`
+	IsActive          *bool                  `json:"isActive,omitempty"`
+	ProvisioningState *string                `json:"provisioningState,omitempty"`
+	RuleDefinition    *SummaryRuleDefinition `json:"ruleDefinition,omitempty"`
+	RuleType          *SummaryRuleType       `json:"ruleType,omitempty"`
+}
+
+type SummaryRuleDefinition struct {
+	BinDelay         *int64  `json:"binDelay,omitempty"`
+	BinSize          *int64  `json:"binSize,omitempty"`
+	BinStartTime     *string `json:"binStartTime,omitempty"`
+	DestinationTable *string `json:"destinationTable,omitempty"`
+	Query            *string `json:"query,omitempty"`
+}
+
+type SummaryRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSummaryRulesClientWithBaseURI(endpoint string) SummaryRulesClient {
+	return SummaryRulesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/summarylogs/%s", summaryRulesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type SummaryRuleCreateOrUpdateOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type SummaryRuleDeleteOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type SummaryRuleGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *SummaryRule
+}
+
+// CreateOrUpdate ...
+func (c SummaryRulesClient) CreateOrUpdate(ctx context.Context, id parse.SummaryRuleId, input SummaryRule) (result SummaryRuleCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "CreateOrUpdate", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c SummaryRulesClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.SummaryRuleId, input SummaryRule) error {
+	result, err := c.CreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// Delete ...
+func (c SummaryRulesClient) Delete(ctx context.Context, id parse.SummaryRuleId) (result SummaryRuleDeleteOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "Delete", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c SummaryRulesClient) DeleteThenPoll(ctx context.Context, id parse.SummaryRuleId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
+
+// Get ...
+func (c SummaryRulesClient) Get(ctx context.Context, id parse.SummaryRuleId) (result SummaryRuleGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "summarylogs.SummaryRulesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c SummaryRulesClient) prepare(ctx context.Context, id parse.SummaryRuleId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": summaryRulesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
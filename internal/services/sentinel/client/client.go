@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
 	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
@@ -21,6 +22,8 @@ type Client struct {
 	AnalyticsSettingsClient  *securityinsight.SecurityMLAnalyticsSettingsClient
 	ThreatIntelligenceClient *securityinsight.ThreatIntelligenceIndicatorClient
 	MetadataClient           *metadata.MetadataClient
+	SourceControlsClient     *securityinsight.SourceControlsClient
+	SummaryRulesClient       *azuresdkhacks.SummaryRulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -54,6 +57,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	metadataClient := metadata.NewMetadataClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&metadataClient.Client, o.ResourceManagerAuthorizer)
 
+	sourceControlsClient := securityinsight.NewSourceControlsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&sourceControlsClient.Client, o.ResourceManagerAuthorizer)
+
+	summaryRulesClient := azuresdkhacks.NewSummaryRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&summaryRulesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AlertRulesClient:         &alertRulesClient,
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
@@ -65,5 +74,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		AnalyticsSettingsClient:  &analyticsSettingsClient,
 		ThreatIntelligenceClient: &threatIntelligenceClient,
 		MetadataClient:           &metadataClient,
+		SourceControlsClient:     &sourceControlsClient,
+		SummaryRulesClient:       &summaryRulesClient,
 	}
 }
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SummaryRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	WorkspaceName  string
+	SummaryLogName string
+}
+
+func NewSummaryRuleID(subscriptionId, resourceGroup, workspaceName, summaryLogName string) SummaryRuleId {
+	return SummaryRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		WorkspaceName:  workspaceName,
+		SummaryLogName: summaryLogName,
+	}
+}
+
+func (id SummaryRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Summary Log Name %q", id.SummaryLogName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Summary Rule", segmentsStr)
+}
+
+func (id SummaryRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.OperationalInsights/workspaces/%s/summaryLogs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SummaryLogName)
+}
+
+// SummaryRuleID parses a SummaryRule ID into an SummaryRuleId struct
+func SummaryRuleID(input string) (*SummaryRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an SummaryRule ID: %+v", input, err)
+	}
+
+	resourceId := SummaryRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.SummaryLogName, err = id.PopSegment("summaryLogs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -79,5 +79,7 @@ func (r Registration) Resources() []sdk.Resource {
 		MetadataResource{},
 		AlertRuleAnomalyDuplicateResource{},
 		ThreatIntelligenceIndicator{},
+		RepositoryResource{},
+		SummaryRuleResource{},
 	}
 }
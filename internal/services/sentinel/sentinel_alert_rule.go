@@ -342,6 +342,43 @@ func flattenAlertRuleAlertDynamicProperties(input *[]alertrules.AlertPropertyMap
 	return output
 }
 
+// alertRuleEntityMappingLimit is the maximum number of entities which can be mapped by a single alert rule,
+// shared between `entity_mapping` and `sentinel_entity_mapping`.
+const alertRuleEntityMappingLimit = 10
+
+func alertRuleCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	entityMappingCount := len(diff.Get("entity_mapping").([]interface{}))
+	sentinelEntityMappingCount := len(diff.Get("sentinel_entity_mapping").([]interface{}))
+	if entityMappingCount+sentinelEntityMappingCount > alertRuleEntityMappingLimit {
+		return fmt.Errorf("`entity_mapping` and `sentinel_entity_mapping` together can't exceed %d", alertRuleEntityMappingLimit)
+	}
+
+	if v, ok := diff.GetOk("alert_details_override"); ok {
+		for i, item := range v.([]interface{}) {
+			override, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			seen := make(map[string]struct{})
+			for _, p := range override["dynamic_property"].([]interface{}) {
+				property, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				name := property["name"].(string)
+				if _, exists := seen[name]; exists {
+					return fmt.Errorf("`alert_details_override.%d.dynamic_property` contains a duplicate `name` %q", i, name)
+				}
+				seen[name] = struct{}{}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandAlertRuleEntityMapping(input []interface{}) *[]alertrules.EntityMapping {
 	if len(input) == 0 {
 		return nil
@@ -0,0 +1,118 @@
+package sentinel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestAlertRuleCustomizeDiff(t *testing.T) {
+	testData := []struct {
+		name                  string
+		entityMappings        int
+		sentinelEntityMapping int
+		dynamicProperties     []string
+		expectError           bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:           "entity mappings at the limit",
+			entityMappings: 10,
+		},
+		{
+			name:                  "entity and sentinel entity mappings at the limit",
+			entityMappings:        6,
+			sentinelEntityMapping: 4,
+		},
+		{
+			name:                  "entity and sentinel entity mappings over the limit",
+			entityMappings:        6,
+			sentinelEntityMapping: 5,
+			expectError:           true,
+		},
+		{
+			name:                  "sentinel entity mappings over the limit alongside an entity mapping",
+			entityMappings:        1,
+			sentinelEntityMapping: 10,
+			expectError:           true,
+		},
+		{
+			name: "unique dynamic properties",
+			dynamicProperties: []string{
+				string(alertrules.AlertPropertyAlertLink),
+				string(alertrules.AlertPropertyProductName),
+			},
+		},
+		{
+			name: "duplicate dynamic properties",
+			dynamicProperties: []string{
+				string(alertrules.AlertPropertyAlertLink),
+				string(alertrules.AlertPropertyProductName),
+				string(alertrules.AlertPropertyAlertLink),
+			},
+			expectError: true,
+		},
+	}
+
+	resources := map[string]*pluginsdk.Resource{
+		"azurerm_sentinel_alert_rule_nrt":       resourceSentinelAlertRuleNrt(),
+		"azurerm_sentinel_alert_rule_scheduled": resourceSentinelAlertRuleScheduled(),
+	}
+
+	for resourceType, resource := range resources {
+		for _, v := range testData {
+			t.Logf("[DEBUG] Testing %q for %s", v.name, resourceType)
+
+			entityMappings := make([]interface{}, 0)
+			for i := 0; i < v.entityMappings; i++ {
+				entityMappings = append(entityMappings, map[string]interface{}{
+					"entity_type": string(alertrules.EntityMappingTypeAccount),
+					"field_mapping": []interface{}{
+						map[string]interface{}{
+							"identifier":  "FullName",
+							"column_name": "Account",
+						},
+					},
+				})
+			}
+
+			sentinelEntityMappings := make([]interface{}, 0)
+			for i := 0; i < v.sentinelEntityMapping; i++ {
+				sentinelEntityMappings = append(sentinelEntityMappings, map[string]interface{}{
+					"column_name": "Entities",
+				})
+			}
+
+			dynamicProperties := make([]interface{}, 0)
+			for _, name := range v.dynamicProperties {
+				dynamicProperties = append(dynamicProperties, map[string]interface{}{
+					"name":  name,
+					"value": "Column",
+				})
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"entity_mapping":          entityMappings,
+				"sentinel_entity_mapping": sentinelEntityMappings,
+				"alert_details_override": []interface{}{
+					map[string]interface{}{
+						"dynamic_property": dynamicProperties,
+					},
+				},
+			})
+
+			_, err := resource.SimpleDiff(context.TODO(), &terraform.InstanceState{}, config, nil)
+			if v.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !v.expectError && err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+		}
+	}
+}
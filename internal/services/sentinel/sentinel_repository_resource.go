@@ -0,0 +1,434 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
+)
+
+type RepositoryResource struct{}
+
+var _ sdk.Resource = RepositoryResource{}
+
+type RepositoryModel struct {
+	Name                           string                      `tfschema:"name"`
+	LogAnalyticsWorkspaceId        string                      `tfschema:"log_analytics_workspace_id"`
+	DisplayName                    string                      `tfschema:"display_name"`
+	RepositoryType                 string                      `tfschema:"repository_type"`
+	ContentTypes                   []string                    `tfschema:"content_types"`
+	Repository                     []RepositoryRepositoryModel `tfschema:"repository"`
+	Description                    string                      `tfschema:"description"`
+	GitHubAppInstallationId        string                      `tfschema:"github_app_installation_id"`
+	AzureDevOpsPipelineId          string                      `tfschema:"azure_devops_pipeline_id"`
+	AzureDevOpsServiceConnectionId string                      `tfschema:"azure_devops_service_connection_id"`
+	Webhook                        []RepositoryWebhookModel    `tfschema:"webhook"`
+}
+
+type RepositoryRepositoryModel struct {
+	Url               string                       `tfschema:"url"`
+	Branch            string                       `tfschema:"branch"`
+	PathMapping       []RepositoryPathMappingModel `tfschema:"path_mapping"`
+	DisplayUrl        string                       `tfschema:"display_url"`
+	DeploymentLogsUrl string                       `tfschema:"deployment_logs_url"`
+}
+
+type RepositoryPathMappingModel struct {
+	ContentType string `tfschema:"content_type"`
+	Path        string `tfschema:"path"`
+}
+
+type RepositoryWebhookModel struct {
+	Id                string `tfschema:"id"`
+	Url               string `tfschema:"url"`
+	SecretUpdatedTime string `tfschema:"secret_updated_time"`
+}
+
+func (r RepositoryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"repository_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(securityinsight.RepoTypeGithub),
+				string(securityinsight.RepoTypeDevOps),
+			}, false),
+		},
+
+		"content_types": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(securityinsight.ContentTypeAnalyticRule),
+					string(securityinsight.ContentTypeWorkbook),
+				}, false),
+			},
+		},
+
+		"repository": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"branch": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"path_mapping": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"content_type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ForceNew: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(securityinsight.ContentTypeAnalyticRule),
+										string(securityinsight.ContentTypeWorkbook),
+									}, false),
+								},
+
+								"path": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"display_url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"deployment_logs_url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r RepositoryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"github_app_installation_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"azure_devops_pipeline_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"azure_devops_service_connection_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"webhook": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"secret_updated_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r RepositoryResource) ResourceType() string {
+	return "azurerm_sentinel_repository"
+}
+
+func (r RepositoryResource) ModelObject() interface{} {
+	return &RepositoryModel{}
+}
+
+func (r RepositoryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SourceControlID
+}
+
+func (r RepositoryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			var model RepositoryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := parse.NewSourceControlID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			contentTypes := make([]securityinsight.ContentType, 0)
+			for _, v := range model.ContentTypes {
+				contentTypes = append(contentTypes, securityinsight.ContentType(v))
+			}
+
+			param := securityinsight.SourceControl{
+				SourceControlProperties: &securityinsight.SourceControlProperties{
+					DisplayName:  utils.String(model.DisplayName),
+					RepoType:     securityinsight.RepoType(model.RepositoryType),
+					ContentTypes: &contentTypes,
+					Repository:   expandSentinelRepositoryRepository(model.Repository),
+				},
+			}
+
+			if model.Description != "" {
+				param.SourceControlProperties.Description = utils.String(model.Description)
+			}
+
+			if _, err = client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.Name, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RepositoryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := parse.SourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := RepositoryModel{
+				Name:                    id.Name,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			if props := resp.SourceControlProperties; props != nil {
+				if props.DisplayName != nil {
+					state.DisplayName = *props.DisplayName
+				}
+				if props.Description != nil {
+					state.Description = *props.Description
+				}
+				state.RepositoryType = string(props.RepoType)
+
+				if props.ContentTypes != nil {
+					for _, v := range *props.ContentTypes {
+						state.ContentTypes = append(state.ContentTypes, string(v))
+					}
+				}
+
+				state.Repository = flattenSentinelRepositoryRepository(props.Repository)
+
+				if info := props.RepositoryResourceInfo; info != nil {
+					if v := info.GitHubResourceInfo; v != nil && v.AppInstallationID != nil {
+						state.GitHubAppInstallationId = *v.AppInstallationID
+					}
+					if v := info.AzureDevOpsResourceInfo; v != nil {
+						if v.PipelineID != nil {
+							state.AzureDevOpsPipelineId = *v.PipelineID
+						}
+						if v.ServiceConnectionID != nil {
+							state.AzureDevOpsServiceConnectionId = *v.ServiceConnectionID
+						}
+					}
+					state.Webhook = flattenSentinelRepositoryWebhook(info.Webhook)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RepositoryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := parse.SourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSentinelRepositoryRepository(input []RepositoryRepositoryModel) *securityinsight.Repository {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	pathMapping := make([]securityinsight.ContentPathMap, 0)
+	for _, item := range v.PathMapping {
+		pathMapping = append(pathMapping, securityinsight.ContentPathMap{
+			ContentType: securityinsight.ContentType(item.ContentType),
+			Path:        utils.String(item.Path),
+		})
+	}
+
+	return &securityinsight.Repository{
+		URL:         utils.String(v.Url),
+		Branch:      utils.String(v.Branch),
+		PathMapping: &pathMapping,
+	}
+}
+
+func flattenSentinelRepositoryRepository(input *securityinsight.Repository) []RepositoryRepositoryModel {
+	if input == nil {
+		return []RepositoryRepositoryModel{}
+	}
+
+	output := RepositoryRepositoryModel{}
+	if input.URL != nil {
+		output.Url = *input.URL
+	}
+	if input.Branch != nil {
+		output.Branch = *input.Branch
+	}
+	if input.DisplayURL != nil {
+		output.DisplayUrl = *input.DisplayURL
+	}
+	if input.DeploymentLogsURL != nil {
+		output.DeploymentLogsUrl = *input.DeploymentLogsURL
+	}
+	if input.PathMapping != nil {
+		for _, item := range *input.PathMapping {
+			pathMapping := RepositoryPathMappingModel{
+				ContentType: string(item.ContentType),
+			}
+			if item.Path != nil {
+				pathMapping.Path = *item.Path
+			}
+			output.PathMapping = append(output.PathMapping, pathMapping)
+		}
+	}
+
+	return []RepositoryRepositoryModel{output}
+}
+
+func flattenSentinelRepositoryWebhook(input *securityinsight.Webhook) []RepositoryWebhookModel {
+	if input == nil {
+		return []RepositoryWebhookModel{}
+	}
+
+	output := RepositoryWebhookModel{}
+	if input.WebhookID != nil {
+		output.Id = *input.WebhookID
+	}
+	if input.WebhookURL != nil {
+		output.Url = *input.WebhookURL
+	}
+	if input.WebhookSecretUpdateTime != nil {
+		output.SecretUpdatedTime = *input.WebhookSecretUpdateTime
+	}
+
+	return []RepositoryWebhookModel{output}
+}
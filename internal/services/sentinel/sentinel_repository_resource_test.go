@@ -0,0 +1,195 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SentinelRepositoryResource struct {
+	repositoryUrl string
+	branch        string
+}
+
+func NewSentinelRepositoryResource() SentinelRepositoryResource {
+	return SentinelRepositoryResource{
+		repositoryUrl: os.Getenv("ARM_TEST_SENTINEL_REPOSITORY_URL"),
+		branch:        os.Getenv("ARM_TEST_SENTINEL_REPOSITORY_BRANCH"),
+	}
+}
+
+func (r SentinelRepositoryResource) preCheck(t *testing.T) {
+	if r.repositoryUrl == "" {
+		t.Skipf(`"ARM_TEST_SENTINEL_REPOSITORY_URL" not specified`)
+	}
+	if r.branch == "" {
+		t.Skipf(`"ARM_TEST_SENTINEL_REPOSITORY_BRANCH" not specified`)
+	}
+}
+
+func TestAccSentinelRepository_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_repository", "test")
+	r := NewSentinelRepositoryResource()
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelRepository_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_repository", "test")
+	r := NewSentinelRepositoryResource()
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("github_app_installation_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSentinelRepository_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_repository", "test")
+	r := NewSentinelRepositoryResource()
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SentinelRepositoryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.SourceControlsClient
+
+	id, err := parse.SourceControlID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SentinelRepositoryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_repository" "test" {
+  name                       = "acctest-sr-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "acctest-sr-%[2]d"
+  repository_type            = "Github"
+  content_types              = ["AnalyticRule"]
+
+  repository {
+    url    = %q
+    branch = %q
+  }
+}
+`, r.template(data), data.RandomInteger, r.repositoryUrl, r.branch)
+}
+
+func (r SentinelRepositoryResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_repository" "test" {
+  name                       = "acctest-sr-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "acctest-sr-%[2]d"
+  description                = "Sentinel content repository"
+  repository_type            = "Github"
+  content_types              = ["AnalyticRule", "Workbook"]
+
+  repository {
+    url    = %q
+    branch = %q
+
+    path_mapping {
+      content_type = "AnalyticRule"
+      path         = "analytics-rules"
+    }
+
+    path_mapping {
+      content_type = "Workbook"
+      path         = "workbooks"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, r.repositoryUrl, r.branch)
+}
+
+func (r SentinelRepositoryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_repository" "import" {
+  name                       = azurerm_sentinel_repository.test.name
+  log_analytics_workspace_id = azurerm_sentinel_repository.test.log_analytics_workspace_id
+  display_name               = azurerm_sentinel_repository.test.display_name
+  repository_type            = azurerm_sentinel_repository.test.repository_type
+  content_types              = azurerm_sentinel_repository.test.content_types
+
+  repository {
+    url    = azurerm_sentinel_repository.test.repository.0.url
+    branch = azurerm_sentinel_repository.test.repository.0.branch
+  }
+}
+`, r.basic(data))
+}
+
+func (r SentinelRepositoryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
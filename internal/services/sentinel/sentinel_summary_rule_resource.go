@@ -0,0 +1,271 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SummaryRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = SummaryRuleResource{}
+
+type SummaryRuleModel struct {
+	Name                    string `tfschema:"name"`
+	LogAnalyticsWorkspaceId string `tfschema:"log_analytics_workspace_id"`
+	DisplayName             string `tfschema:"display_name"`
+	Description             string `tfschema:"description"`
+	Query                   string `tfschema:"query"`
+	DestinationTable        string `tfschema:"destination_table"`
+	BinSizeInMinutes        int64  `tfschema:"bin_size_in_minutes"`
+	BinDelayInMinutes       int64  `tfschema:"bin_delay_in_minutes"`
+	BinStartTime            string `tfschema:"bin_start_time"`
+	Active                  bool   `tfschema:"active"`
+}
+
+func (r SummaryRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"destination_table": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9_]{1,59}_CL$`),
+				"`destination_table` must be the name of a custom table ending in `_CL`",
+			),
+		},
+		"bin_size_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntInSlice([]int{20, 30, 60, 120, 180, 360, 720, 1440}),
+		},
+		"bin_delay_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 1440),
+		},
+		"bin_start_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SummaryRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"active": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r SummaryRuleResource) ModelObject() interface{} {
+	return &SummaryRuleModel{}
+}
+
+func (r SummaryRuleResource) ResourceType() string {
+	return "azurerm_sentinel_summary_rule"
+}
+
+func (r SummaryRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.SummaryRuleID
+}
+
+func (r SummaryRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SummaryRulesClient
+
+			var model SummaryRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := parse.NewSummaryRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandSummaryRule(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SummaryRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SummaryRulesClient
+
+			id, err := parse.SummaryRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := SummaryRuleModel{
+				Name:                    id.SummaryLogName,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Description = pointer.From(props.Description)
+					state.Active = pointer.From(props.IsActive)
+
+					if def := props.RuleDefinition; def != nil {
+						state.Query = pointer.From(def.Query)
+						state.DestinationTable = pointer.From(def.DestinationTable)
+						state.BinSizeInMinutes = pointer.From(def.BinSize)
+						state.BinDelayInMinutes = pointer.From(def.BinDelay)
+						state.BinStartTime = pointer.From(def.BinStartTime)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SummaryRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SummaryRulesClient
+
+			id, err := parse.SummaryRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SummaryRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandSummaryRule(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SummaryRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SummaryRulesClient
+
+			id, err := parse.SummaryRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSummaryRule(input SummaryRuleModel) azuresdkhacks.SummaryRule {
+	definition := azuresdkhacks.SummaryRuleDefinition{
+		Query:            pointer.To(input.Query),
+		DestinationTable: pointer.To(input.DestinationTable),
+		BinSize:          pointer.To(input.BinSizeInMinutes),
+		BinDelay:         pointer.To(input.BinDelayInMinutes),
+	}
+
+	if input.BinStartTime != "" {
+		definition.BinStartTime = pointer.To(input.BinStartTime)
+	}
+
+	props := azuresdkhacks.SummaryRuleProperties{
+		RuleType:       pointer.To(azuresdkhacks.SummaryRuleTypeUser),
+		RuleDefinition: &definition,
+	}
+
+	if input.DisplayName != "" {
+		props.DisplayName = pointer.To(input.DisplayName)
+	}
+
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+
+	return azuresdkhacks.SummaryRule{
+		Properties: &props,
+	}
+}
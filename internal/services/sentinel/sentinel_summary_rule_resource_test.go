@@ -0,0 +1,182 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SummaryRuleResource struct{}
+
+func TestAccSummaryRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_summary_rule", "test")
+	r := SummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSummaryRule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_summary_rule", "test")
+	r := SummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSummaryRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_summary_rule", "test")
+	r := SummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSummaryRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_summary_rule", "test")
+	r := SummaryRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SummaryRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.SummaryRulesClient
+
+	id, err := parse.SummaryRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SummaryRuleResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_summary_rule" "test" {
+  name                       = "acctest-SR-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  query                      = "SigninLogs | summarize Count = count() by UserPrincipalName"
+  destination_table          = "SigninSummary_CL"
+  bin_size_in_minutes        = 60
+}
+`, template, data.RandomInteger)
+}
+
+func (r SummaryRuleResource) complete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_summary_rule" "test" {
+  name                       = "acctest-SR-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  display_name               = "Sign-in summary"
+  description                = "Hourly count of sign-ins per user"
+  query                      = "SigninLogs | summarize Count = count() by UserPrincipalName, AppDisplayName"
+  destination_table          = "SigninSummary_CL"
+  bin_size_in_minutes        = 120
+  bin_delay_in_minutes       = 10
+}
+`, template, data.RandomInteger)
+}
+
+func (r SummaryRuleResource) requiresImport(data acceptance.TestData) string {
+	template := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_summary_rule" "import" {
+  name                       = azurerm_sentinel_summary_rule.test.name
+  log_analytics_workspace_id = azurerm_sentinel_summary_rule.test.log_analytics_workspace_id
+  query                      = azurerm_sentinel_summary_rule.test.query
+  destination_table          = azurerm_sentinel_summary_rule.test.destination_table
+  bin_size_in_minutes        = azurerm_sentinel_summary_rule.test.bin_size_in_minutes
+}
+`, template)
+}
+
+func (r SummaryRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  workspace_id = azurerm_log_analytics_workspace.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
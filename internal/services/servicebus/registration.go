@@ -43,15 +43,16 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_servicebus_namespace":                          resourceServiceBusNamespace(),
-		"azurerm_servicebus_namespace_disaster_recovery_config": resourceServiceBusNamespaceDisasterRecoveryConfig(),
-		"azurerm_servicebus_namespace_authorization_rule":       resourceServiceBusNamespaceAuthorizationRule(),
-		"azurerm_servicebus_namespace_network_rule_set":         resourceServiceBusNamespaceNetworkRuleSet(),
-		"azurerm_servicebus_queue":                              resourceServiceBusQueue(),
-		"azurerm_servicebus_queue_authorization_rule":           resourceServiceBusQueueAuthorizationRule(),
-		"azurerm_servicebus_subscription":                       resourceServiceBusSubscription(),
-		"azurerm_servicebus_subscription_rule":                  resourceServiceBusSubscriptionRule(),
-		"azurerm_servicebus_topic_authorization_rule":           resourceServiceBusTopicAuthorizationRule(),
-		"azurerm_servicebus_topic":                              resourceServiceBusTopic(),
+		"azurerm_servicebus_namespace":                            resourceServiceBusNamespace(),
+		"azurerm_servicebus_namespace_disaster_recovery_config":   resourceServiceBusNamespaceDisasterRecoveryConfig(),
+		"azurerm_servicebus_namespace_disaster_recovery_failover": resourceServiceBusNamespaceDisasterRecoveryFailover(),
+		"azurerm_servicebus_namespace_authorization_rule":         resourceServiceBusNamespaceAuthorizationRule(),
+		"azurerm_servicebus_namespace_network_rule_set":           resourceServiceBusNamespaceNetworkRuleSet(),
+		"azurerm_servicebus_queue":                                resourceServiceBusQueue(),
+		"azurerm_servicebus_queue_authorization_rule":             resourceServiceBusQueueAuthorizationRule(),
+		"azurerm_servicebus_subscription":                         resourceServiceBusSubscription(),
+		"azurerm_servicebus_subscription_rule":                    resourceServiceBusSubscriptionRule(),
+		"azurerm_servicebus_topic_authorization_rule":             resourceServiceBusTopicAuthorizationRule(),
+		"azurerm_servicebus_topic":                                resourceServiceBusTopic(),
 	}
 }
@@ -242,7 +242,11 @@ func resourceServiceBusNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.Resour
 
 	// @tombuildsstuff: whilst we previously checked the 200 response, since that's the only valid status
 	// code defined in the Swagger, anything else would raise an error thus the check is superfluous
-	if _, err := client.BreakPairing(ctx, *id); err != nil {
+	// once the Alias has been failed over it's owned by the partner namespace, so there's nothing to remove here
+	if resp, err := client.BreakPairing(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
 		return fmt.Errorf("breaking pairing %s: %+v", id, err)
 	}
 
@@ -0,0 +1,203 @@
+package servicebus
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceServiceBusNamespaceDisasterRecoveryFailover triggers a failover of a Geo-Disaster Recovery Alias to the
+// secondary namespace - since the failover can't be reversed this resource represents the Alias once it's been
+// failed over, which is owned by the (previously secondary) namespace
+func resourceServiceBusNamespaceDisasterRecoveryFailover() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusNamespaceDisasterRecoveryFailoverCreate,
+		Read:   resourceServiceBusNamespaceDisasterRecoveryFailoverRead,
+		Delete: resourceServiceBusNamespaceDisasterRecoveryFailoverDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"disaster_recovery_config_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: disasterrecoveryconfigs.ValidateDisasterRecoveryConfigID,
+			},
+
+			"safe_failover_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"primary_namespace_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryFailoverCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	configId, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(d.Get("disaster_recovery_config_id").(string))
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *configId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *configId, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *configId)
+	}
+	props := existing.Model.Properties
+
+	if props.Role == nil || *props.Role != disasterrecoveryconfigs.RoleDisasterRecoveryPrimary {
+		return fmt.Errorf("%s must be the Primary of a paired Geo-Disaster Recovery Alias to be failed over", *configId)
+	}
+	if props.PartnerNamespace == nil || *props.PartnerNamespace == "" {
+		return fmt.Errorf("%s has no partner namespace to fail over to", *configId)
+	}
+
+	partnerNamespaceId, err := disasterrecoveryconfigs.ParseNamespaceIDInsensitively(*props.PartnerNamespace)
+	if err != nil {
+		return fmt.Errorf("parsing the partner namespace for %s: %+v", *configId, err)
+	}
+
+	// the failover has to be triggered against the Alias within the secondary namespace, which then becomes the owner
+	// of the Alias once the failover has completed
+	id := disasterrecoveryconfigs.NewDisasterRecoveryConfigID(partnerNamespaceId.SubscriptionId, partnerNamespaceId.ResourceGroupName, partnerNamespaceId.NamespaceName, configId.DisasterRecoveryConfigName)
+
+	locks.ByName(configId.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(configId.NamespaceName, serviceBusNamespaceResourceName)
+
+	locks.ByName(id.NamespaceName, serviceBusNamespaceResourceName)
+	defer locks.UnlockByName(id.NamespaceName, serviceBusNamespaceResourceName)
+
+	parameters := disasterrecoveryconfigs.FailoverProperties{
+		Properties: &disasterrecoveryconfigs.FailoverPropertiesProperties{
+			IsSafeFailover: utils.Bool(d.Get("safe_failover_enabled").(bool)),
+		},
+	}
+
+	if _, err := client.FailOver(ctx, id, parameters); err != nil {
+		return fmt.Errorf("failing over %s to %s: %+v", *configId, id, err)
+	}
+
+	if err := resourceServiceBusNamespaceDisasterRecoveryFailoverWaitForState(ctx, client, id); err != nil {
+		return fmt.Errorf("waiting for the failover of %s to %s: %+v", *configId, id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceServiceBusNamespaceDisasterRecoveryFailoverRead(d, meta)
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryFailoverRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("primary_namespace_id", disasterrecoveryconfigs.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID())
+
+	role := ""
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Role != nil {
+		role = string(*model.Properties.Role)
+	}
+	d.Set("role", role)
+
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryFailoverDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// a failover can't be reversed, the Alias can be re-paired using the `azurerm_servicebus_namespace_disaster_recovery_config` resource
+	log.Printf("[DEBUG] the failover of %q can't be reversed - removing from state", d.Id())
+	return nil
+}
+
+func resourceServiceBusNamespaceDisasterRecoveryFailoverWaitForState(ctx context.Context, client *disasterrecoveryconfigs.DisasterRecoveryConfigsClient, id disasterrecoveryconfigs.DisasterRecoveryConfigId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(disasterrecoveryconfigs.ProvisioningStateDRAccepted),
+			string(disasterrecoveryconfigs.RoleDisasterRecoverySecondary),
+		},
+		Target:     []string{string(disasterrecoveryconfigs.RoleDisasterRecoveryPrimaryNotReplicating)},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return nil, "error", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				if props.ProvisioningState != nil {
+					switch *props.ProvisioningState {
+					case disasterrecoveryconfigs.ProvisioningStateDRFailed:
+						return resp, "failed", fmt.Errorf("failover failed for %s", id)
+					case disasterrecoveryconfigs.ProvisioningStateDRAccepted:
+						return resp, string(*props.ProvisioningState), nil
+					}
+				}
+
+				if props.Role != nil {
+					return resp, string(*props.Role), nil
+				}
+			}
+
+			return resp, string(disasterrecoveryconfigs.ProvisioningStateDRAccepted), nil
+		},
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,61 @@
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceBusNamespaceDisasterRecoveryFailoverResource struct{}
+
+func TestAccServiceBusNamespaceDisasterRecoveryFailover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace_disaster_recovery_failover", "test")
+	r := ServiceBusNamespaceDisasterRecoveryFailoverResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("PrimaryNotReplicating"),
+				check.That(data.ResourceName).Key("primary_namespace_id").MatchesOtherKey(
+					check.That("azurerm_servicebus_namespace.secondary_namespace_test").Key("id"),
+				),
+			),
+			// once failed over the Alias is no longer owned by the primary namespace, so the
+			// `azurerm_servicebus_namespace_disaster_recovery_config` resource is removed from the state
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
+func (t ServiceBusNamespaceDisasterRecoveryFailoverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceBus.DisasterRecoveryConfigsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ServiceBusNamespaceDisasterRecoveryFailoverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_failover" "test" {
+  disaster_recovery_config_id = azurerm_servicebus_namespace_disaster_recovery_config.pairing_test.id
+  safe_failover_enabled       = true
+}
+`, ServiceBusNamespaceDisasterRecoveryConfigResource{}.basic(data))
+}
@@ -0,0 +1,228 @@
+package servicenetworking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationLoadBalancerFrontendModel struct {
+	Name                      string            `tfschema:"name"`
+	ApplicationLoadBalancerId string            `tfschema:"application_load_balancer_id"`
+	FullyQualifiedDomainName  string            `tfschema:"fully_qualified_domain_name"`
+	Tags                      map[string]string `tfschema:"tags"`
+}
+
+type ApplicationLoadBalancerFrontendResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationLoadBalancerFrontendResource{}
+
+func (r ApplicationLoadBalancerFrontendResource) ResourceType() string {
+	return "azurerm_application_load_balancer_frontend"
+}
+
+func (r ApplicationLoadBalancerFrontendResource) ModelObject() interface{} {
+	return &ApplicationLoadBalancerFrontendModel{}
+}
+
+func (r ApplicationLoadBalancerFrontendResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ApplicationLoadBalancerFrontendID
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_load_balancer_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationLoadBalancerID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fully_qualified_domain_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.FrontendsClient
+			trafficControllersClient := metadata.Client.ServiceNetworking.TrafficControllersClient
+
+			var model ApplicationLoadBalancerFrontendModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			albId, err := parse.ApplicationLoadBalancerID(model.ApplicationLoadBalancerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewApplicationLoadBalancerFrontendID(albId.SubscriptionId, albId.ResourceGroup, albId.TrafficControllerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Frontend has to be created in the same location as the Application Load Balancer
+			loc, err := applicationLoadBalancerLocation(ctx, trafficControllersClient, *albId)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApplicationLoadBalancerFrontend(model, loc)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.FrontendsClient
+
+			id, err := parse.ApplicationLoadBalancerFrontendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationLoadBalancerFrontendModel{
+				Name:                      id.FrontendName,
+				ApplicationLoadBalancerId: parse.NewApplicationLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.FullyQualifiedDomainName = pointer.From(props.Fqdn)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.FrontendsClient
+
+			id, err := parse.ApplicationLoadBalancerFrontendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationLoadBalancerFrontendModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.FrontendsClient
+
+			id, err := parse.ApplicationLoadBalancerFrontendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationLoadBalancerFrontend(input ApplicationLoadBalancerFrontendModel, location string) client.Frontend {
+	return client.Frontend{
+		Location:   location,
+		Properties: &client.FrontendProperties{},
+		Tags:       pointer.To(input.Tags),
+	}
+}
+
+// applicationLoadBalancerLocation returns the location of the Application Load Balancer, which the child resources
+// have to be created in
+func applicationLoadBalancerLocation(ctx context.Context, trafficControllersClient *client.TrafficControllersClient, id parse.ApplicationLoadBalancerId) (string, error) {
+	resp, err := trafficControllersClient.Get(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil {
+		return "", fmt.Errorf("retrieving %s: model was nil", id)
+	}
+
+	return resp.Model.Location, nil
+}
@@ -0,0 +1,124 @@
+package servicenetworking_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationLoadBalancerFrontendResource struct{}
+
+func TestAccApplicationLoadBalancerFrontend_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_frontend", "test")
+	r := ApplicationLoadBalancerFrontendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fully_qualified_domain_name").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationLoadBalancerFrontend_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_frontend", "test")
+	r := ApplicationLoadBalancerFrontendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationLoadBalancerFrontend_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_frontend", "test")
+	r := ApplicationLoadBalancerFrontendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationLoadBalancerFrontendResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationLoadBalancerFrontendID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceNetworking.FrontendsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApplicationLoadBalancerFrontendResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_frontend" "test" {
+  name                         = "acctest-frontend-%d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+}
+`, ApplicationLoadBalancerResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationLoadBalancerFrontendResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_frontend" "import" {
+  name                         = azurerm_application_load_balancer_frontend.test.name
+  application_load_balancer_id = azurerm_application_load_balancer_frontend.test.application_load_balancer_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationLoadBalancerFrontendResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_frontend" "test" {
+  name                         = "acctest-frontend-%d"
+  application_load_balancer_id = azurerm_application_load_balancer.test.id
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, ApplicationLoadBalancerResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,204 @@
+package servicenetworking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationLoadBalancerModel struct {
+	Name                         string            `tfschema:"name"`
+	ResourceGroupName            string            `tfschema:"resource_group_name"`
+	Location                     string            `tfschema:"location"`
+	PrimaryConfigurationEndpoint string            `tfschema:"primary_configuration_endpoint"`
+	Tags                         map[string]string `tfschema:"tags"`
+}
+
+type ApplicationLoadBalancerResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationLoadBalancerResource{}
+
+func (r ApplicationLoadBalancerResource) ResourceType() string {
+	return "azurerm_application_load_balancer"
+}
+
+func (r ApplicationLoadBalancerResource) ModelObject() interface{} {
+	return &ApplicationLoadBalancerModel{}
+}
+
+func (r ApplicationLoadBalancerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ApplicationLoadBalancerID
+}
+
+func (r ApplicationLoadBalancerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationLoadBalancerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"primary_configuration_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.TrafficControllersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ApplicationLoadBalancerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewApplicationLoadBalancerID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApplicationLoadBalancer(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.TrafficControllersClient
+
+			id, err := parse.ApplicationLoadBalancerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationLoadBalancerModel{
+				Name:              id.TrafficControllerName,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if endpoints := pointer.From(props.ConfigurationEndpoints); len(endpoints) > 0 {
+						state.PrimaryConfigurationEndpoint = endpoints[0]
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.TrafficControllersClient
+
+			id, err := parse.ApplicationLoadBalancerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationLoadBalancerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the Security Policy attached to the Application Load Balancer is managed outside of this resource, so
+			// it's retained when the Application Load Balancer is updated
+			payload := expandApplicationLoadBalancer(model)
+			if existing.Model != nil && existing.Model.Properties != nil {
+				payload.Properties.SecurityPolicyConfigurations = existing.Model.Properties.SecurityPolicyConfigurations
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.TrafficControllersClient
+
+			id, err := parse.ApplicationLoadBalancerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationLoadBalancer(input ApplicationLoadBalancerModel) client.TrafficController {
+	return client.TrafficController{
+		Location:   location.Normalize(input.Location),
+		Properties: &client.TrafficControllerProperties{},
+		Tags:       pointer.To(input.Tags),
+	}
+}
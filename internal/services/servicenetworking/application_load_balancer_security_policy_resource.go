@@ -0,0 +1,221 @@
+package servicenetworking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationLoadBalancerSecurityPolicyModel struct {
+	Name                           string            `tfschema:"name"`
+	ApplicationLoadBalancerId      string            `tfschema:"application_load_balancer_id"`
+	WebApplicationFirewallPolicyId string            `tfschema:"web_application_firewall_policy_id"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+type ApplicationLoadBalancerSecurityPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationLoadBalancerSecurityPolicyResource{}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) ResourceType() string {
+	return "azurerm_application_load_balancer_security_policy"
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) ModelObject() interface{} {
+	return &ApplicationLoadBalancerSecurityPolicyModel{}
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ApplicationLoadBalancerSecurityPolicyID
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_load_balancer_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationLoadBalancerID,
+		},
+
+		"web_application_firewall_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.SecurityPoliciesClient
+			trafficControllersClient := metadata.Client.ServiceNetworking.TrafficControllersClient
+
+			var model ApplicationLoadBalancerSecurityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			albId, err := parse.ApplicationLoadBalancerID(model.ApplicationLoadBalancerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewApplicationLoadBalancerSecurityPolicyID(albId.SubscriptionId, albId.ResourceGroup, albId.TrafficControllerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Security Policy has to be created in the same location as the Application Load Balancer
+			loc, err := applicationLoadBalancerLocation(ctx, trafficControllersClient, *albId)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApplicationLoadBalancerSecurityPolicy(model, loc)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.SecurityPoliciesClient
+
+			id, err := parse.ApplicationLoadBalancerSecurityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationLoadBalancerSecurityPolicyModel{
+				Name:                      id.SecurityPolicyName,
+				ApplicationLoadBalancerId: parse.NewApplicationLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil && props.WafPolicy != nil {
+					wafPolicyId, err := networkParse.ApplicationGatewayWebApplicationFirewallPolicyIDInsensitively(props.WafPolicy.Id)
+					if err != nil {
+						return err
+					}
+					state.WebApplicationFirewallPolicyId = wafPolicyId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.SecurityPoliciesClient
+
+			id, err := parse.ApplicationLoadBalancerSecurityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationLoadBalancerSecurityPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApplicationLoadBalancerSecurityPolicy(model, existing.Model.Location)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.SecurityPoliciesClient
+
+			id, err := parse.ApplicationLoadBalancerSecurityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationLoadBalancerSecurityPolicy(input ApplicationLoadBalancerSecurityPolicyModel, location string) client.SecurityPolicy {
+	return client.SecurityPolicy{
+		Location: location,
+		Properties: &client.SecurityPolicyProperties{
+			PolicyType: pointer.To(client.PolicyTypeWaf),
+			WafPolicy: &client.ResourceId{
+				Id: input.WebApplicationFirewallPolicyId,
+			},
+		},
+		Tags: pointer.To(input.Tags),
+	}
+}
@@ -0,0 +1,169 @@
+package servicenetworking_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationLoadBalancerSecurityPolicyResource struct{}
+
+func TestAccApplicationLoadBalancerSecurityPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_security_policy", "test")
+	r := ApplicationLoadBalancerSecurityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationLoadBalancerSecurityPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_security_policy", "test")
+	r := ApplicationLoadBalancerSecurityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApplicationLoadBalancerSecurityPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_security_policy", "test")
+	r := ApplicationLoadBalancerSecurityPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("web_application_firewall_policy_id").MatchesOtherKey(check.That("azurerm_web_application_firewall_policy.other").Key("id")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationLoadBalancerSecurityPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceNetworking.SecurityPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  managed_rules {
+    managed_rule_set {
+      type    = "Microsoft_DefaultRuleSet"
+      version = "2.1"
+    }
+  }
+
+  policy_settings {
+    enabled = true
+    mode    = "Detection"
+  }
+}
+
+resource "azurerm_web_application_firewall_policy" "other" {
+  name                = "acctestwafpolicy2-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  managed_rules {
+    managed_rule_set {
+      type    = "Microsoft_DefaultRuleSet"
+      version = "2.1"
+    }
+  }
+
+  policy_settings {
+    enabled = true
+    mode    = "Prevention"
+  }
+}
+`, ApplicationLoadBalancerResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_security_policy" "test" {
+  name                               = "acctest-securitypolicy-%d"
+  application_load_balancer_id       = azurerm_application_load_balancer.test.id
+  web_application_firewall_policy_id = azurerm_web_application_firewall_policy.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_security_policy" "import" {
+  name                               = azurerm_application_load_balancer_security_policy.test.name
+  application_load_balancer_id       = azurerm_application_load_balancer_security_policy.test.application_load_balancer_id
+  web_application_firewall_policy_id = azurerm_application_load_balancer_security_policy.test.web_application_firewall_policy_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationLoadBalancerSecurityPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_load_balancer_security_policy" "test" {
+  name                               = "acctest-securitypolicy-%d"
+  application_load_balancer_id       = azurerm_application_load_balancer.test.id
+  web_application_firewall_policy_id = azurerm_web_application_firewall_policy.other.id
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
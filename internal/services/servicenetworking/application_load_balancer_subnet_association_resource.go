@@ -0,0 +1,227 @@
+package servicenetworking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationLoadBalancerSubnetAssociationModel struct {
+	Name                      string            `tfschema:"name"`
+	ApplicationLoadBalancerId string            `tfschema:"application_load_balancer_id"`
+	SubnetId                  string            `tfschema:"subnet_id"`
+	Tags                      map[string]string `tfschema:"tags"`
+}
+
+type ApplicationLoadBalancerSubnetAssociationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationLoadBalancerSubnetAssociationResource{}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) ResourceType() string {
+	return "azurerm_application_load_balancer_subnet_association"
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) ModelObject() interface{} {
+	return &ApplicationLoadBalancerSubnetAssociationModel{}
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ApplicationLoadBalancerSubnetAssociationID
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_load_balancer_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApplicationLoadBalancerID,
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubnetID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.AssociationsClient
+			trafficControllersClient := metadata.Client.ServiceNetworking.TrafficControllersClient
+
+			var model ApplicationLoadBalancerSubnetAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			albId, err := parse.ApplicationLoadBalancerID(model.ApplicationLoadBalancerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewApplicationLoadBalancerSubnetAssociationID(albId.SubscriptionId, albId.ResourceGroup, albId.TrafficControllerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the Association has to be created in the same location as the Application Load Balancer
+			loc, err := applicationLoadBalancerLocation(ctx, trafficControllersClient, *albId)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApplicationLoadBalancerSubnetAssociation(model, loc)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.AssociationsClient
+
+			id, err := parse.ApplicationLoadBalancerSubnetAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationLoadBalancerSubnetAssociationModel{
+				Name:                      id.AssociationName,
+				ApplicationLoadBalancerId: parse.NewApplicationLoadBalancerID(id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil && props.Subnet != nil {
+					subnetId, err := commonids.ParseSubnetIDInsensitively(props.Subnet.Id)
+					if err != nil {
+						return err
+					}
+					state.SubnetId = subnetId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.AssociationsClient
+
+			id, err := parse.ApplicationLoadBalancerSubnetAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationLoadBalancerSubnetAssociationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			// the Association can be moved to another Subnet in-place, rather than being recreated, which avoids the
+			// Application Load Balancer being left without an Association whilst the Subnet is changed
+			payload := expandApplicationLoadBalancerSubnetAssociation(model, existing.Model.Location)
+			if !metadata.ResourceData.HasChange("tags") {
+				payload.Tags = existing.Model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationLoadBalancerSubnetAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceNetworking.AssociationsClient
+
+			id, err := parse.ApplicationLoadBalancerSubnetAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApplicationLoadBalancerSubnetAssociation(input ApplicationLoadBalancerSubnetAssociationModel, location string) client.Association {
+	return client.Association{
+		Location: location,
+		Properties: &client.AssociationProperties{
+			AssociationType: client.AssociationTypeSubnets,
+			Subnet: &client.ResourceId{
+				Id: input.SubnetId,
+			},
+		},
+		Tags: pointer.To(input.Tags),
+	}
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	AssociationsClient       *AssociationsClient
+	FrontendsClient          *FrontendsClient
+	SecurityPoliciesClient   *SecurityPoliciesClient
+	TrafficControllersClient *TrafficControllersClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	associationsClient, err := NewAssociationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building associations client: %+v", err)
+	}
+	o.Configure(associationsClient.Client, o.Authorizers.ResourceManager)
+
+	frontendsClient, err := NewFrontendsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building frontends client: %+v", err)
+	}
+	o.Configure(frontendsClient.Client, o.Authorizers.ResourceManager)
+
+	securityPoliciesClient, err := NewSecurityPoliciesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building security policies client: %+v", err)
+	}
+	o.Configure(securityPoliciesClient.Client, o.Authorizers.ResourceManager)
+
+	trafficControllersClient, err := NewTrafficControllersClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building traffic controllers client: %+v", err)
+	}
+	o.Configure(trafficControllersClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		AssociationsClient:       associationsClient,
+		FrontendsClient:          frontendsClient,
+		SecurityPoliciesClient:   securityPoliciesClient,
+		TrafficControllersClient: trafficControllersClient,
+	}, nil
+}
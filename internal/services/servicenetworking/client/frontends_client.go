@@ -0,0 +1,154 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+)
+
+type FrontendsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewFrontendsClientWithBaseURI(api environments.Api) (*FrontendsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "frontends", serviceNetworkingApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating FrontendsClient: %+v", err)
+	}
+
+	return &FrontendsClient{
+		Client: client,
+	}, nil
+}
+
+type Frontend struct {
+	Id         *string             `json:"id,omitempty"`
+	Location   string              `json:"location"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *FrontendProperties `json:"properties,omitempty"`
+	Tags       *map[string]string  `json:"tags,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
+
+type FrontendProperties struct {
+	Fqdn              *string `json:"fqdn,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type FrontendsGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *Frontend
+}
+
+// Get retrieves the specified Frontend
+func (c FrontendsClient) Get(ctx context.Context, id parse.ApplicationLoadBalancerFrontendId) (result FrontendsGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the specified Frontend, then polls until it's been provisioned
+func (c FrontendsClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.ApplicationLoadBalancerFrontendId, input Frontend) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the specified Frontend, then polls until it's been removed
+func (c FrontendsClient) DeleteThenPoll(ctx context.Context, id parse.ApplicationLoadBalancerFrontendId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicenetworking/parse"
+)
+
+// Application Gateway for Containers (Traffic Controllers) isn't available in the version of the SDK currently
+// vendored, as such these resources are managed using base-layer clients until the SDK can be upgraded
+const serviceNetworkingApiVersion = "2025-01-01"
+
+type TrafficControllersClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewTrafficControllersClientWithBaseURI(api environments.Api) (*TrafficControllersClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "trafficcontrollers", serviceNetworkingApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating TrafficControllersClient: %+v", err)
+	}
+
+	return &TrafficControllersClient{
+		Client: client,
+	}, nil
+}
+
+type TrafficController struct {
+	Id         *string                      `json:"id,omitempty"`
+	Location   string                       `json:"location"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *TrafficControllerProperties `json:"properties,omitempty"`
+	Tags       *map[string]string           `json:"tags,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type TrafficControllerProperties struct {
+	Associations                 *[]ResourceId                 `json:"associations,omitempty"`
+	ConfigurationEndpoints       *[]string                     `json:"configurationEndpoints,omitempty"`
+	Frontends                    *[]ResourceId                 `json:"frontends,omitempty"`
+	ProvisioningState            *string                       `json:"provisioningState,omitempty"`
+	SecurityPolicies             *[]ResourceId                 `json:"securityPolicies,omitempty"`
+	SecurityPolicyConfigurations *SecurityPolicyConfigurations `json:"securityPolicyConfigurations,omitempty"`
+}
+
+type SecurityPolicyConfigurations struct {
+	WafSecurityPolicy *ResourceId `json:"wafSecurityPolicy,omitempty"`
+}
+
+type ResourceId struct {
+	Id string `json:"id"`
+}
+
+type TrafficControllersGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *TrafficController
+}
+
+// Get retrieves the specified Traffic Controller
+func (c TrafficControllersClient) Get(ctx context.Context, id parse.ApplicationLoadBalancerId) (result TrafficControllersGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the specified Traffic Controller, then polls until it's been provisioned
+func (c TrafficControllersClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.ApplicationLoadBalancerId, input TrafficController) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes the specified Traffic Controller, then polls until it's been removed
+func (c TrafficControllersClient) DeleteThenPoll(ctx context.Context, id parse.ApplicationLoadBalancerId) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
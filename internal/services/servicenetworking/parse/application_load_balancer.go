@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApplicationLoadBalancerId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	TrafficControllerName string
+}
+
+func NewApplicationLoadBalancerID(subscriptionId, resourceGroup, trafficControllerName string) ApplicationLoadBalancerId {
+	return ApplicationLoadBalancerId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		TrafficControllerName: trafficControllerName,
+	}
+}
+
+func (id ApplicationLoadBalancerId) String() string {
+	segments := []string{
+		fmt.Sprintf("Traffic Controller Name %q", id.TrafficControllerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Load Balancer", segmentsStr)
+}
+
+func (id ApplicationLoadBalancerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName)
+}
+
+// ApplicationLoadBalancerID parses a ApplicationLoadBalancer ID into an ApplicationLoadBalancerId struct
+func ApplicationLoadBalancerID(input string) (*ApplicationLoadBalancerId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ApplicationLoadBalancer ID: %+v", input, err)
+	}
+
+	resourceId := ApplicationLoadBalancerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficControllerName, err = id.PopSegment("trafficControllers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApplicationLoadBalancerFrontendId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	TrafficControllerName string
+	FrontendName          string
+}
+
+func NewApplicationLoadBalancerFrontendID(subscriptionId, resourceGroup, trafficControllerName, frontendName string) ApplicationLoadBalancerFrontendId {
+	return ApplicationLoadBalancerFrontendId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		TrafficControllerName: trafficControllerName,
+		FrontendName:          frontendName,
+	}
+}
+
+func (id ApplicationLoadBalancerFrontendId) String() string {
+	segments := []string{
+		fmt.Sprintf("Frontend Name %q", id.FrontendName),
+		fmt.Sprintf("Traffic Controller Name %q", id.TrafficControllerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Load Balancer Frontend", segmentsStr)
+}
+
+func (id ApplicationLoadBalancerFrontendId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s/frontends/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName, id.FrontendName)
+}
+
+// ApplicationLoadBalancerFrontendID parses a ApplicationLoadBalancerFrontend ID into an ApplicationLoadBalancerFrontendId struct
+func ApplicationLoadBalancerFrontendID(input string) (*ApplicationLoadBalancerFrontendId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ApplicationLoadBalancerFrontend ID: %+v", input, err)
+	}
+
+	resourceId := ApplicationLoadBalancerFrontendId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficControllerName, err = id.PopSegment("trafficControllers"); err != nil {
+		return nil, err
+	}
+	if resourceId.FrontendName, err = id.PopSegment("frontends"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,128 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ApplicationLoadBalancerFrontendId{}
+
+func TestApplicationLoadBalancerFrontendIDFormatter(t *testing.T) {
+	actual := NewApplicationLoadBalancerFrontendID("12345678-1234-9876-4563-123456789012", "resGroup1", "alb1", "frontend1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/frontends/frontend1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestApplicationLoadBalancerFrontendID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ApplicationLoadBalancerFrontendId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing TrafficControllerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/",
+			Error: true,
+		},
+
+		{
+			// missing value for TrafficControllerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/",
+			Error: true,
+		},
+
+		{
+			// missing FrontendName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/",
+			Error: true,
+		},
+
+		{
+			// missing value for FrontendName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/frontends/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/frontends/frontend1",
+			Expected: &ApplicationLoadBalancerFrontendId{
+				SubscriptionId:        "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:         "resGroup1",
+				TrafficControllerName: "alb1",
+				FrontendName:          "frontend1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICENETWORKING/TRAFFICCONTROLLERS/ALB1/FRONTENDS/FRONTEND1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ApplicationLoadBalancerFrontendID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.TrafficControllerName != v.Expected.TrafficControllerName {
+			t.Fatalf("Expected %q but got %q for TrafficControllerName", v.Expected.TrafficControllerName, actual.TrafficControllerName)
+		}
+		if actual.FrontendName != v.Expected.FrontendName {
+			t.Fatalf("Expected %q but got %q for FrontendName", v.Expected.FrontendName, actual.FrontendName)
+		}
+	}
+}
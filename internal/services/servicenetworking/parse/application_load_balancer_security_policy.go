@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApplicationLoadBalancerSecurityPolicyId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	TrafficControllerName string
+	SecurityPolicyName    string
+}
+
+func NewApplicationLoadBalancerSecurityPolicyID(subscriptionId, resourceGroup, trafficControllerName, securityPolicyName string) ApplicationLoadBalancerSecurityPolicyId {
+	return ApplicationLoadBalancerSecurityPolicyId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		TrafficControllerName: trafficControllerName,
+		SecurityPolicyName:    securityPolicyName,
+	}
+}
+
+func (id ApplicationLoadBalancerSecurityPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Security Policy Name %q", id.SecurityPolicyName),
+		fmt.Sprintf("Traffic Controller Name %q", id.TrafficControllerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Load Balancer Security Policy", segmentsStr)
+}
+
+func (id ApplicationLoadBalancerSecurityPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s/securityPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName, id.SecurityPolicyName)
+}
+
+// ApplicationLoadBalancerSecurityPolicyID parses a ApplicationLoadBalancerSecurityPolicy ID into an ApplicationLoadBalancerSecurityPolicyId struct
+func ApplicationLoadBalancerSecurityPolicyID(input string) (*ApplicationLoadBalancerSecurityPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ApplicationLoadBalancerSecurityPolicy ID: %+v", input, err)
+	}
+
+	resourceId := ApplicationLoadBalancerSecurityPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficControllerName, err = id.PopSegment("trafficControllers"); err != nil {
+		return nil, err
+	}
+	if resourceId.SecurityPolicyName, err = id.PopSegment("securityPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApplicationLoadBalancerSubnetAssociationId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	TrafficControllerName string
+	AssociationName       string
+}
+
+func NewApplicationLoadBalancerSubnetAssociationID(subscriptionId, resourceGroup, trafficControllerName, associationName string) ApplicationLoadBalancerSubnetAssociationId {
+	return ApplicationLoadBalancerSubnetAssociationId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		TrafficControllerName: trafficControllerName,
+		AssociationName:       associationName,
+	}
+}
+
+func (id ApplicationLoadBalancerSubnetAssociationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Association Name %q", id.AssociationName),
+		fmt.Sprintf("Traffic Controller Name %q", id.TrafficControllerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Load Balancer Subnet Association", segmentsStr)
+}
+
+func (id ApplicationLoadBalancerSubnetAssociationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceNetworking/trafficControllers/%s/associations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficControllerName, id.AssociationName)
+}
+
+// ApplicationLoadBalancerSubnetAssociationID parses a ApplicationLoadBalancerSubnetAssociation ID into an ApplicationLoadBalancerSubnetAssociationId struct
+func ApplicationLoadBalancerSubnetAssociationID(input string) (*ApplicationLoadBalancerSubnetAssociationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ApplicationLoadBalancerSubnetAssociation ID: %+v", input, err)
+	}
+
+	resourceId := ApplicationLoadBalancerSubnetAssociationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.TrafficControllerName, err = id.PopSegment("trafficControllers"); err != nil {
+		return nil, err
+	}
+	if resourceId.AssociationName, err = id.PopSegment("associations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,38 @@
+package servicenetworking
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var (
+	_ sdk.TypedServiceRegistration = Registration{}
+)
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Service Networking"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Service Networking",
+	}
+}
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ApplicationLoadBalancerResource{},
+		ApplicationLoadBalancerFrontendResource{},
+		ApplicationLoadBalancerSecurityPolicyResource{},
+		ApplicationLoadBalancerSubnetAssociationResource{},
+	}
+}
@@ -0,0 +1,6 @@
+package servicenetworking
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApplicationLoadBalancer -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApplicationLoadBalancerFrontend -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/frontends/frontend1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApplicationLoadBalancerSubnetAssociation -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/associations/association1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ApplicationLoadBalancerSecurityPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceNetworking/trafficControllers/alb1/securityPolicies/policy1
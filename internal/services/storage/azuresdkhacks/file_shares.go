@@ -0,0 +1,125 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NOTE: `provisionedIops` and `provisionedBandwidthMibps` (used by the Provisioned v2 billing model) aren't available in
+// the API versions used by the vendored SDKs, as such these are retrieved and updated here against the 2024-01-01 API
+// until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const fileSharesApiVersion = "2024-01-01"
+
+type FileSharesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFileSharesClientWithBaseURI(endpoint string) FileSharesClient {
+	return FileSharesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/fileshares/%s", fileSharesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type FileShare struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *FileShareProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+type FileShareProperties struct {
+	IncludedBurstIops         *int64 `json:"includedBurstIops,omitempty"`
+	MaxBurstCreditsForIops    *int64 `json:"maxBurstCreditsForIops,omitempty"`
+	ProvisionedBandwidthMibps *int64 `json:"provisionedBandwidthMibps,omitempty"`
+	ProvisionedIops           *int64 `json:"provisionedIops,omitempty"`
+	ShareQuota                *int64 `json:"shareQuota,omitempty"`
+}
+
+type FileShareGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *FileShare
+}
+
+type FileShareUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *FileShare
+}
+
+// Get ...
+func (c FileSharesClient) Get(ctx context.Context, id string) (result FileShareGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fileshares.FileSharesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fileshares.FileSharesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fileshares.FileSharesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Update ...
+func (c FileSharesClient) Update(ctx context.Context, id string, input FileShare) (result FileShareUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPatch(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fileshares.FileSharesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fileshares.FileSharesClient", "Update", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "fileshares.FileSharesClient", "Update", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c FileSharesClient) prepare(ctx context.Context, id string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": fileSharesApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,130 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/localusers"
+)
+
+// NOTE: `allowAclAuthorization` isn't available in the 2022-05-01 API used by the vendored SDK, as such the Local Users
+// are retrieved and created/updated here against the 2023-05-01 API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const localUsersApiVersion = "2023-05-01"
+
+type LocalUsersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLocalUsersClientWithBaseURI(endpoint string) LocalUsersClient {
+	return LocalUsersClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/localusers/%s", localUsersApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type LocalUser struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *LocalUserProperties   `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type LocalUserProperties struct {
+	AllowAclAuthorization *bool                         `json:"allowAclAuthorization,omitempty"`
+	HasSharedKey          *bool                         `json:"hasSharedKey,omitempty"`
+	HasSshKey             *bool                         `json:"hasSshKey,omitempty"`
+	HasSshPassword        *bool                         `json:"hasSshPassword,omitempty"`
+	HomeDirectory         *string                       `json:"homeDirectory,omitempty"`
+	PermissionScopes      *[]localusers.PermissionScope `json:"permissionScopes,omitempty"`
+	Sid                   *string                       `json:"sid,omitempty"`
+	SshAuthorizedKeys     *[]localusers.SshPublicKey    `json:"sshAuthorizedKeys,omitempty"`
+}
+
+type LocalUserGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *LocalUser
+}
+
+type LocalUserCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *LocalUser
+}
+
+// CreateOrUpdate ...
+func (c LocalUsersClient) CreateOrUpdate(ctx context.Context, id localusers.LocalUserId, input LocalUser) (result LocalUserCreateOrUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "localusers.LocalUsersClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "localusers.LocalUsersClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "localusers.LocalUsersClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Get ...
+func (c LocalUsersClient) Get(ctx context.Context, id localusers.LocalUserId) (result LocalUserGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "localusers.LocalUsersClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "localusers.LocalUsersClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "localusers.LocalUsersClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c LocalUsersClient) prepare(ctx context.Context, id localusers.LocalUserId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": localUsersApiVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -11,6 +11,7 @@ import (
 	storage_v2022_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/localusers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -28,6 +29,7 @@ import (
 type Client struct {
 	AccountsClient              *storage.AccountsClient
 	LocalUsersClient            *localusers.LocalUsersClient
+	LocalUsersWorkaroundClient  *azuresdkhacks.LocalUsersClient
 	FileSystemsClient           *filesystems.Client
 	ADLSGen2PathsClient         *paths.Client
 	ManagementPoliciesClient    *storage.ManagementPoliciesClient
@@ -37,6 +39,7 @@ type Client struct {
 	EncryptionScopesClient      *storage.EncryptionScopesClient
 	Environment                 azure.Environment
 	FileServicesClient          *storage.FileServicesClient
+	FileSharesWorkaroundClient  *azuresdkhacks.FileSharesClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
@@ -54,6 +57,12 @@ func NewClient(options *common.ClientOptions) *Client {
 	localUsersClient := localusers.NewLocalUsersClientWithBaseURI(options.ResourceManagerEndpoint)
 	localUsersClient.Client.Authorizer = options.ResourceManagerAuthorizer
 
+	localUsersWorkaroundClient := azuresdkhacks.NewLocalUsersClientWithBaseURI(options.ResourceManagerEndpoint)
+	localUsersWorkaroundClient.Client.Authorizer = options.ResourceManagerAuthorizer
+
+	fileSharesWorkaroundClient := azuresdkhacks.NewFileSharesClientWithBaseURI(options.ResourceManagerEndpoint)
+	fileSharesWorkaroundClient.Client.Authorizer = options.ResourceManagerAuthorizer
+
 	fileSystemsClient := filesystems.NewWithEnvironment(options.AzureEnvironment)
 	options.ConfigureClient(&fileSystemsClient.Client, options.StorageAuthorizer)
 
@@ -94,6 +103,7 @@ func NewClient(options *common.ClientOptions) *Client {
 	client := Client{
 		AccountsClient:              &accountsClient,
 		LocalUsersClient:            &localUsersClient,
+		LocalUsersWorkaroundClient:  &localUsersWorkaroundClient,
 		FileSystemsClient:           &fileSystemsClient,
 		ADLSGen2PathsClient:         &adlsGen2PathsClient,
 		ManagementPoliciesClient:    &managementPoliciesClient,
@@ -103,6 +113,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		EncryptionScopesClient:      &encryptionScopesClient,
 		Environment:                 options.AzureEnvironment,
 		FileServicesClient:          &fileServicesClient,
+		FileSharesWorkaroundClient:  &fileSharesWorkaroundClient,
 		ResourceManager:             &resourceManager,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
@@ -187,6 +198,28 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 	return shim, nil
 }
 
+func (client Client) ContainersDataPlaneClient(ctx context.Context, account accountDetails) (*containers.Client, error) {
+	if client.storageAdAuth != nil {
+		containersClient := containers.NewWithEnvironment(client.Environment)
+		containersClient.Client.Authorizer = *client.storageAdAuth
+		return &containersClient, nil
+	}
+
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKey)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	containersClient := containers.NewWithEnvironment(client.Environment)
+	containersClient.Client.Authorizer = storageAuth
+	return &containersClient, nil
+}
+
 func (client Client) FileShareDirectoriesClient(ctx context.Context, account accountDetails) (*directories.Client, error) {
 	// NOTE: Files do not support AzureAD Authentication
 
@@ -318,9 +318,21 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Computed: true,
 									},
+									"account_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"sam_account_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
+						"default_share_permission": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -8,10 +8,13 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/blobcontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/localusers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
 	computevalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -40,15 +43,16 @@ type SshAuthorizedKeyModel struct {
 	Key         string `tfschema:"key"`
 }
 type LocalUserModel struct {
-	HomeDirectory      string                  `tfschema:"home_directory"`
-	Name               string                  `tfschema:"name"`
-	Password           string                  `tfschema:"password"`
-	PermissionScope    []PermissionScopeModel  `tfschema:"permission_scope"`
-	Sid                string                  `tfschema:"sid"`
-	SshAuthorizedKey   []SshAuthorizedKeyModel `tfschema:"ssh_authorized_key"`
-	SshKeyEnabled      bool                    `tfschema:"ssh_key_enabled"`
-	SshPasswordEnabled bool                    `tfschema:"ssh_password_enabled"`
-	StorageAccountId   string                  `tfschema:"storage_account_id"`
+	AllowAclAuthorization bool                    `tfschema:"allow_acl_authorization"`
+	HomeDirectory         string                  `tfschema:"home_directory"`
+	Name                  string                  `tfschema:"name"`
+	Password              string                  `tfschema:"password"`
+	PermissionScope       []PermissionScopeModel  `tfschema:"permission_scope"`
+	Sid                   string                  `tfschema:"sid"`
+	SshAuthorizedKey      []SshAuthorizedKeyModel `tfschema:"ssh_authorized_key"`
+	SshKeyEnabled         bool                    `tfschema:"ssh_key_enabled"`
+	SshPasswordEnabled    bool                    `tfschema:"ssh_password_enabled"`
+	StorageAccountId      string                  `tfschema:"storage_account_id"`
 }
 
 func (r LocalUserResource) Arguments() map[string]*pluginsdk.Schema {
@@ -81,6 +85,11 @@ func (r LocalUserResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
 		},
+		"allow_acl_authorization": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 		"ssh_authorized_key": {
 			Type:         pluginsdk.TypeList,
 			Optional:     true,
@@ -199,6 +208,49 @@ func (r LocalUserResource) CustomizeDiff() sdk.ResourceFunc {
 					return err
 				}
 			}
+
+			// the Storage Account (and as such the Containers/Shares within it) can't be checked until it exists
+			if !diff.HasChange("permission_scope") || !diff.NewValueKnown("storage_account_id") || !diff.NewValueKnown("permission_scope") {
+				return nil
+			}
+
+			var config LocalUserModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := parse.StorageAccountID(config.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			for i, scope := range config.PermissionScope {
+				if scope.ResourceName == "" {
+					continue
+				}
+
+				switch scope.Service {
+				case "blob":
+					containerId := blobcontainers.NewContainerID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, scope.ResourceName)
+					resp, err := metadata.Client.Storage.ResourceManager.BlobContainers.Get(ctx, containerId)
+					if err != nil {
+						if response.WasNotFound(resp.HttpResponse) {
+							return fmt.Errorf("`permission_scope.%d.resource_name`: %s was not found", i, containerId)
+						}
+						return fmt.Errorf("retrieving %s: %+v", containerId, err)
+					}
+				case "file":
+					shareId := fileshares.NewShareID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, scope.ResourceName)
+					resp, err := metadata.Client.Storage.ResourceManager.FileShares.Get(ctx, shareId, fileshares.DefaultGetOperationOptions())
+					if err != nil {
+						if response.WasNotFound(resp.HttpResponse) {
+							return fmt.Errorf("`permission_scope.%d.resource_name`: %s was not found", i, shareId)
+						}
+						return fmt.Errorf("retrieving %s: %+v", shareId, err)
+					}
+				}
+			}
+
 			return nil
 		},
 		Timeout: 5 * time.Minute,
@@ -210,6 +262,7 @@ func (r LocalUserResource) Create() sdk.ResourceFunc {
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Storage.LocalUsersClient
+			workaroundClient := metadata.Client.Storage.LocalUsersWorkaroundClient
 
 			var plan LocalUserModel
 			if err := metadata.Decode(&plan); err != nil {
@@ -241,12 +294,13 @@ func (r LocalUserResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
-			params := localusers.LocalUser{
-				Properties: &localusers.LocalUserProperties{
-					PermissionScopes:  r.expandPermissionScopes(plan.PermissionScope),
-					SshAuthorizedKeys: r.expandSSHAuthorizedKeys(plan.SshAuthorizedKey),
-					HasSshKey:         pointer.To(plan.SshKeyEnabled),
-					HasSshPassword:    pointer.To(plan.SshPasswordEnabled),
+			params := azuresdkhacks.LocalUser{
+				Properties: &azuresdkhacks.LocalUserProperties{
+					AllowAclAuthorization: pointer.To(plan.AllowAclAuthorization),
+					PermissionScopes:      r.expandPermissionScopes(plan.PermissionScope),
+					SshAuthorizedKeys:     r.expandSSHAuthorizedKeys(plan.SshAuthorizedKey),
+					HasSshKey:             pointer.To(plan.SshKeyEnabled),
+					HasSshPassword:        pointer.To(plan.SshPasswordEnabled),
 				},
 			}
 
@@ -254,7 +308,7 @@ func (r LocalUserResource) Create() sdk.ResourceFunc {
 				params.Properties.HomeDirectory = utils.String(plan.HomeDirectory)
 			}
 
-			if _, err = client.CreateOrUpdate(ctx, id, params); err != nil {
+			if _, err = workaroundClient.CreateOrUpdate(ctx, id, params); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -286,7 +340,7 @@ func (r LocalUserResource) Read() sdk.ResourceFunc {
 		Timeout: 5 * time.Minute,
 
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Storage.LocalUsersClient
+			client := metadata.Client.Storage.LocalUsersWorkaroundClient
 			id, err := localusers.ParseLocalUserID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -318,6 +372,7 @@ func (r LocalUserResource) Read() sdk.ResourceFunc {
 			if existing.Model != nil && existing.Model.Properties != nil {
 				props := existing.Model.Properties
 				model.PermissionScope = r.flattenPermissionScopes(props.PermissionScopes)
+				model.AllowAclAuthorization = pointer.From(props.AllowAclAuthorization)
 				if props.HomeDirectory != nil {
 					model.HomeDirectory = *props.HomeDirectory
 				}
@@ -352,8 +407,9 @@ func (r LocalUserResource) Update() sdk.ResourceFunc {
 			}
 
 			client := metadata.Client.Storage.LocalUsersClient
+			workaroundClient := metadata.Client.Storage.LocalUsersWorkaroundClient
 
-			params, err := client.Get(ctx, *id)
+			params, err := workaroundClient.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
@@ -379,6 +435,10 @@ func (r LocalUserResource) Update() sdk.ResourceFunc {
 				props.PermissionScopes = r.expandPermissionScopes(plan.PermissionScope)
 			}
 
+			if metadata.ResourceData.HasChange("allow_acl_authorization") {
+				props.AllowAclAuthorization = pointer.To(plan.AllowAclAuthorization)
+			}
+
 			if metadata.ResourceData.HasChange("ssh_key_enabled") {
 				props.HasSshKey = &plan.SshKeyEnabled
 			}
@@ -415,7 +475,7 @@ func (r LocalUserResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if _, err := client.CreateOrUpdate(ctx, *id, localusers.LocalUser{Properties: props}); err != nil {
+			if _, err := workaroundClient.CreateOrUpdate(ctx, *id, azuresdkhacks.LocalUser{Properties: props}); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 			return nil
@@ -121,6 +121,30 @@ func TestAccLocalUser_homeDirectory(t *testing.T) {
 	})
 }
 
+func TestAccLocalUser_allowAclAuthorization(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := LocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.allowAclAuthorization(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_acl_authorization").HasValue("true"),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.allowAclAuthorization(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("allow_acl_authorization").HasValue("false"),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
 func TestAccLocalUser_permissionScope(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
 	r := LocalUserResource{}
@@ -358,6 +382,20 @@ resource "azurerm_storage_account_local_user" "test" {
 `, template, directory)
 }
 
+func (r LocalUserResource) allowAclAuthorization(data acceptance.TestData, enabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name                    = "user"
+  storage_account_id      = azurerm_storage_account.test.id
+  ssh_password_enabled    = true
+  allow_acl_authorization = %t
+}
+`, template, enabled)
+}
+
 func (r LocalUserResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -222,9 +222,36 @@ func resourceStorageAccount() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
+
+									"account_type": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(storage.AccountTypeComputer),
+											string(storage.AccountTypeUser),
+										}, false),
+									},
+
+									"sam_account_name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
 								},
 							},
 						},
+
+						"default_share_permission": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(storage.DefaultSharePermissionNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.DefaultSharePermissionNone),
+								string(storage.DefaultSharePermissionStorageFileDataSmbShareContributor),
+								string(storage.DefaultSharePermissionStorageFileDataSmbShareElevatedContributor),
+								string(storage.DefaultSharePermissionStorageFileDataSmbShareReader),
+							}, false),
+						},
 					},
 				},
 			},
@@ -1741,14 +1768,23 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	// azure_files_authentication must be the last to be updated, cause it'll occupy the storage account for several minutes after receiving the response 200 OK. Issue: https://github.com/Azure/azure-rest-api-specs/issues/11272
 	if d.HasChange("azure_files_authentication") {
-		// due to service issue: https://github.com/Azure/azure-rest-api-specs/issues/12473, we need to update to None before changing its DirectoryServiceOptions
-		old, new := d.GetChange("azure_files_authentication.0.directory_type")
-		if old != new && new != string(storage.DirectoryServiceOptionsNone) {
-			log.Print("[DEBUG] Disabling AzureFilesIdentityBasedAuthentication prior to changing DirectoryServiceOptions")
+		expandAADFilesAuthentication, err := expandArmStorageAccountAzureFilesAuthentication(d.Get("azure_files_authentication").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		// due to service issue: https://github.com/Azure/azure-rest-api-specs/issues/12473, we need to update to None before changing
+		// its DirectoryServiceOptions - the same applies when rotating the Active Directory domain configuration in place
+		oldDirectoryType, newDirectoryType := d.GetChange("azure_files_authentication.0.directory_type")
+		directoryChanged := oldDirectoryType.(string) != "" && oldDirectoryType != newDirectoryType
+		activeDirectoryChanged := oldDirectoryType == newDirectoryType && d.HasChange("azure_files_authentication.0.active_directory")
+		if (directoryChanged || activeDirectoryChanged) && expandAADFilesAuthentication.DirectoryServiceOptions != storage.DirectoryServiceOptionsNone {
+			log.Print("[DEBUG] Disabling AzureFilesIdentityBasedAuthentication prior to changing the Directory Service configuration")
 			dsNone := storage.AccountUpdateParameters{
 				AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
 					AzureFilesIdentityBasedAuthentication: &storage.AzureFilesIdentityBasedAuthentication{
 						DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+						DefaultSharePermission:  storage.DefaultSharePermissionNone,
 					},
 				},
 			}
@@ -1757,19 +1793,29 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 			}
 		}
 
-		expandAADFilesAuthentication, err := expandArmStorageAccountAzureFilesAuthentication(d.Get("azure_files_authentication").([]interface{}))
-		if err != nil {
-			return err
+		// the Default Share Permission can only be applied once the Directory Service has been configured, so when the Directory
+		// Service is being (re)configured it's applied in a subsequent call
+		defaultSharePermission := expandAADFilesAuthentication.DefaultSharePermission
+		if directoryChanged || activeDirectoryChanged || oldDirectoryType.(string) == "" {
+			expandAADFilesAuthentication.DefaultSharePermission = storage.DefaultSharePermissionNone
 		}
+
 		opts := storage.AccountUpdateParameters{
 			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
 				AzureFilesIdentityBasedAuthentication: expandAADFilesAuthentication,
 			},
 		}
-
 		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
 			return fmt.Errorf("updating Azure Storage Account azure_files_authentication %q: %+v", id.Name, err)
 		}
+
+		if expandAADFilesAuthentication.DefaultSharePermission != defaultSharePermission {
+			log.Print("[DEBUG] Updating the Default Share Permission for AzureFilesIdentityBasedAuthentication")
+			expandAADFilesAuthentication.DefaultSharePermission = defaultSharePermission
+			if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+				return fmt.Errorf("updating Azure Storage Account azure_files_authentication %q: %+v", id.Name, err)
+			}
+		}
 	}
 
 	if d.HasChange("sas_policy") {
@@ -2481,6 +2527,7 @@ func expandArmStorageAccountAzureFilesAuthentication(input []interface{}) (*stor
 	if len(input) == 0 {
 		return &storage.AzureFilesIdentityBasedAuthentication{
 			DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+			DefaultSharePermission:  storage.DefaultSharePermissionNone,
 		}, nil
 	}
 
@@ -2494,6 +2541,7 @@ func expandArmStorageAccountAzureFilesAuthentication(input []interface{}) (*stor
 	return &storage.AzureFilesIdentityBasedAuthentication{
 		DirectoryServiceOptions:   directoryOption,
 		ActiveDirectoryProperties: expandArmStorageAccountActiveDirectoryProperties(v["active_directory"].([]interface{})),
+		DefaultSharePermission:    storage.DefaultSharePermission(v["default_share_permission"].(string)),
 	}, nil
 }
 
@@ -2502,14 +2550,21 @@ func expandArmStorageAccountActiveDirectoryProperties(input []interface{}) *stor
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	return &storage.ActiveDirectoryProperties{
+	props := &storage.ActiveDirectoryProperties{
 		AzureStorageSid:   utils.String(v["storage_sid"].(string)),
 		DomainGUID:        utils.String(v["domain_guid"].(string)),
 		DomainName:        utils.String(v["domain_name"].(string)),
 		DomainSid:         utils.String(v["domain_sid"].(string)),
 		ForestName:        utils.String(v["forest_name"].(string)),
 		NetBiosDomainName: utils.String(v["netbios_domain_name"].(string)),
+		AccountType:       storage.AccountType(v["account_type"].(string)),
 	}
+
+	if samAccountName := v["sam_account_name"].(string); samAccountName != "" {
+		props.SamAccountName = utils.String(samAccountName)
+	}
+
+	return props
 }
 
 func expandArmStorageAccountRouting(input []interface{}) *storage.RoutingPreference {
@@ -2966,10 +3021,16 @@ func flattenArmStorageAccountAzureFilesAuthentication(input *storage.AzureFilesI
 		return make([]interface{}, 0)
 	}
 
+	defaultSharePermission := string(storage.DefaultSharePermissionNone)
+	if input.DefaultSharePermission != "" {
+		defaultSharePermission = string(input.DefaultSharePermission)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
-			"directory_type":   input.DirectoryServiceOptions,
-			"active_directory": flattenArmStorageAccountActiveDirectoryProperties(input.ActiveDirectoryProperties),
+			"directory_type":           input.DirectoryServiceOptions,
+			"active_directory":         flattenArmStorageAccountActiveDirectoryProperties(input.ActiveDirectoryProperties),
+			"default_share_permission": defaultSharePermission,
 		},
 	}
 }
@@ -3003,6 +3064,10 @@ func flattenArmStorageAccountActiveDirectoryProperties(input *storage.ActiveDire
 	if input.NetBiosDomainName != nil {
 		netBiosDomainName = *input.NetBiosDomainName
 	}
+	var samAccountName string
+	if input.SamAccountName != nil {
+		samAccountName = *input.SamAccountName
+	}
 	return []interface{}{
 		map[string]interface{}{
 			"storage_sid":         azureStorageSid,
@@ -3011,6 +3076,8 @@ func flattenArmStorageAccountActiveDirectoryProperties(input *storage.ActiveDire
 			"domain_sid":          domainSid,
 			"forest_name":         forestName,
 			"netbios_domain_name": netBiosDomainName,
+			"account_type":        string(input.AccountType),
+			"sam_account_name":    samAccountName,
 		},
 	}
 }
@@ -899,6 +899,14 @@ func TestAccAzureRMStorageAccount_azureFilesAuthentication(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.azureFilesAuthenticationADDefaultSharePermission(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azure_files_authentication.0.default_share_permission").HasValue("StorageFileDataSmbShareReader"),
+			),
+		},
+		data.ImportStep(),
 		{
 			Config: r.azureFilesAuthenticationAADKERB(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -3255,6 +3263,46 @@ resource "azurerm_storage_account" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
 
+func (r StorageAccountResource) azureFilesAuthenticationADDefaultSharePermission(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  azure_files_authentication {
+    directory_type           = "AD"
+    default_share_permission = "StorageFileDataSmbShareReader"
+    active_directory {
+      storage_sid         = "S-1-5-21-2400535526-2334094090-2402026252-1112"
+      domain_name         = "adtest2.com"
+      domain_sid          = "S-1-5-21-2400535526-2334094090-2402026252-1112"
+      domain_guid         = "13a20c9a-d491-47e6-8a39-299e7a32ea27"
+      forest_name         = "adtest2.com"
+      netbios_domain_name = "adtest2.com"
+      account_type        = "Computer"
+      sam_account_name    = "adtest2"
+    }
+  }
+
+  tags = {
+    environment = "production"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageAccountResource) azureFilesAuthenticationAADKERB(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
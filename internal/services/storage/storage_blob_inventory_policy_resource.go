@@ -46,6 +46,17 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 				}
 			}
 
+			// the `Name` schema field is required by the API for every rule, however this can only be checked once the rules are known
+			if !diff.NewValueKnown("rules") {
+				return nil
+			}
+			for _, rule := range rules {
+				v := rule.(map[string]interface{})
+				if !utils.SliceContainsValue(*utils.ExpandStringSlice(v["schema_fields"].([]interface{})), "Name") {
+					return fmt.Errorf("the `schema_fields` of the rule %q must contain `Name`", v["name"].(string))
+				}
+			}
+
 			return nil
 		}),
 	}
@@ -0,0 +1,272 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+func dataSourceStorageManagementPolicyEstimate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageManagementPolicyEstimateRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"prefix_match": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"blob_types": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"blockBlob", "appendBlob"}, false),
+							},
+						},
+
+						"tier_to_cool_after_days_since_modification_greater_than": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(0, 99999),
+						},
+
+						"tier_to_archive_after_days_since_modification_greater_than": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(0, 99999),
+						},
+
+						"delete_after_days_since_modification_greater_than": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      -1,
+							ValidateFunc: validation.IntBetween(0, 99999),
+						},
+					},
+				},
+			},
+
+			"blobs_evaluated": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"tier_to_cool": managementPolicyEstimateActionSchema(),
+
+			"tier_to_archive": managementPolicyEstimateActionSchema(),
+
+			"delete": managementPolicyEstimateActionSchema(),
+		},
+	}
+}
+
+func managementPolicyEstimateActionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"blob_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"size_in_bytes": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+type managementPolicyEstimateRule struct {
+	blobTypes                []string
+	tierToCoolAfterDays      int
+	tierToArchiveAfterDays   int
+	deleteAfterDays          int
+	tierToCoolBlobCount      int
+	tierToCoolSizeInBytes    int64
+	tierToArchiveBlobCount   int
+	tierToArchiveSizeInBytes int64
+	deleteBlobCount          int
+	deleteSizeInBytes        int64
+	blobsEvaluated           int
+}
+
+func dataSourceStorageManagementPolicyEstimateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate %s", *id)
+	}
+
+	client, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client for %s: %+v", *id, err)
+	}
+
+	raw := d.Get("rule").([]interface{})[0].(map[string]interface{})
+	rule := managementPolicyEstimateRule{
+		blobTypes:              *utils.ExpandStringSlice(raw["blob_types"].(*pluginsdk.Set).List()),
+		tierToCoolAfterDays:    raw["tier_to_cool_after_days_since_modification_greater_than"].(int),
+		tierToArchiveAfterDays: raw["tier_to_archive_after_days_since_modification_greater_than"].(int),
+		deleteAfterDays:        raw["delete_after_days_since_modification_greater_than"].(int),
+	}
+
+	now := time.Now().UTC()
+	for _, prefixMatch := range *utils.ExpandStringSlice(raw["prefix_match"].(*pluginsdk.Set).List()) {
+		// as with the Management Policy, a prefix match is in the format `container/blobPrefix`
+		containerName, blobPrefix, _ := strings.Cut(prefixMatch, "/")
+
+		input := containers.ListBlobsInput{
+			MaxResults: utils.Int(5000),
+		}
+		if blobPrefix != "" {
+			input.Prefix = utils.String(blobPrefix)
+		}
+
+		for {
+			resp, err := client.ListBlobs(ctx, id.Name, containerName, input)
+			if err != nil {
+				return fmt.Errorf("listing Blobs in Container %q within %s: %+v", containerName, *id, err)
+			}
+
+			for _, blob := range resp.Blobs.Blobs {
+				if err := rule.evaluate(blob, now); err != nil {
+					return fmt.Errorf("evaluating Blob %q in Container %q within %s: %+v", blob.Name, containerName, *id, err)
+				}
+			}
+
+			if resp.NextMarker == nil || *resp.NextMarker == "" {
+				break
+			}
+			input.Marker = resp.NextMarker
+		}
+	}
+
+	d.SetId(id.ID())
+	d.Set("blobs_evaluated", rule.blobsEvaluated)
+
+	if err := d.Set("tier_to_cool", flattenManagementPolicyEstimateAction(rule.tierToCoolBlobCount, rule.tierToCoolSizeInBytes)); err != nil {
+		return fmt.Errorf("setting `tier_to_cool`: %+v", err)
+	}
+	if err := d.Set("tier_to_archive", flattenManagementPolicyEstimateAction(rule.tierToArchiveBlobCount, rule.tierToArchiveSizeInBytes)); err != nil {
+		return fmt.Errorf("setting `tier_to_archive`: %+v", err)
+	}
+	if err := d.Set("delete", flattenManagementPolicyEstimateAction(rule.deleteBlobCount, rule.deleteSizeInBytes)); err != nil {
+		return fmt.Errorf("setting `delete`: %+v", err)
+	}
+
+	return nil
+}
+
+// evaluate determines which action the Management Policy would take for the specified Blob - when multiple actions
+// apply only the least expensive is taken, as such a Blob is counted against one action at most
+func (r *managementPolicyEstimateRule) evaluate(blob containers.BlobDetails, now time.Time) error {
+	props := blob.Properties
+	if props == nil || props.LastModified == nil {
+		return nil
+	}
+
+	if props.BlobType != nil && !utils.SliceContainsValue(r.blobTypes, managementPolicyBlobType(*props.BlobType)) {
+		return nil
+	}
+
+	r.blobsEvaluated++
+
+	lastModified, err := time.Parse(time.RFC1123, *props.LastModified)
+	if err != nil {
+		return fmt.Errorf("parsing `Last-Modified` %q: %+v", *props.LastModified, err)
+	}
+	daysSinceModification := int(now.Sub(lastModified).Hours() / 24)
+
+	var size int64
+	if props.ContentLength != nil {
+		size = *props.ContentLength
+	}
+
+	// only Block Blobs can be tiered, Append Blobs can only be deleted
+	canBeTiered := props.BlobType == nil || strings.EqualFold(*props.BlobType, "BlockBlob")
+
+	var accessTier string
+	if props.AccessTier != nil {
+		accessTier = strings.ToLower(*props.AccessTier)
+	}
+
+	switch {
+	case r.deleteAfterDays != -1 && daysSinceModification > r.deleteAfterDays:
+		r.deleteBlobCount++
+		r.deleteSizeInBytes += size
+	case canBeTiered && r.tierToArchiveAfterDays != -1 && daysSinceModification > r.tierToArchiveAfterDays && accessTier != "archive":
+		r.tierToArchiveBlobCount++
+		r.tierToArchiveSizeInBytes += size
+	case canBeTiered && r.tierToCoolAfterDays != -1 && daysSinceModification > r.tierToCoolAfterDays && accessTier != "cool" && accessTier != "archive":
+		r.tierToCoolBlobCount++
+		r.tierToCoolSizeInBytes += size
+	}
+
+	return nil
+}
+
+// managementPolicyBlobType maps the Blob Type returned from the Data Plane API (e.g. `BlockBlob`) to the value used
+// within the Management Policy (e.g. `blockBlob`)
+func managementPolicyBlobType(input string) string {
+	if input == "" {
+		return input
+	}
+	return strings.ToLower(input[:1]) + input[1:]
+}
+
+func flattenManagementPolicyEstimateAction(blobCount int, sizeInBytes int64) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"blob_count":    blobCount,
+			"size_in_bytes": int(sizeInBytes),
+		},
+	}
+}
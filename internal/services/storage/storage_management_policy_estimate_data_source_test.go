@@ -0,0 +1,80 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageManagementPolicyEstimateDataSource struct{}
+
+func TestAccDataSourceStorageManagementPolicyEstimate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_management_policy_estimate", "test")
+	r := StorageManagementPolicyEstimateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("blobs_evaluated").HasValue("1"),
+				check.That(data.ResourceName).Key("tier_to_cool.0.blob_count").HasValue("0"),
+				check.That(data.ResourceName).Key("tier_to_archive.0.blob_count").HasValue("0"),
+				check.That(data.ResourceName).Key("delete.0.blob_count").HasValue("0"),
+				check.That(data.ResourceName).Key("delete.0.size_in_bytes").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (StorageManagementPolicyEstimateDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "StorageV2"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "logs/example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "Hello, World!"
+}
+
+data "azurerm_storage_management_policy_estimate" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+
+  rule {
+    prefix_match = ["${azurerm_storage_container.test.name}/logs/"]
+    blob_types   = ["blockBlob"]
+
+    tier_to_cool_after_days_since_modification_greater_than    = 30
+    tier_to_archive_after_days_since_modification_greater_than = 90
+    delete_after_days_since_modification_greater_than          = 365
+  }
+
+  depends_on = [azurerm_storage_blob.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
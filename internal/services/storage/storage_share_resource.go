@@ -1,18 +1,23 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/shares"
 )
 
@@ -23,10 +28,10 @@ func resourceStorageShare() *pluginsdk.Resource {
 		Update: resourceStorageShareUpdate,
 		Delete: resourceStorageShareDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.StorageShareDataPlaneID(id)
 			return err
-		}),
+		}, importStorageShare),
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -133,8 +138,110 @@ func resourceStorageShare() *pluginsdk.Resource {
 						string(shares.TransactionOptimizedAccessTier),
 					}, false),
 			},
+
+			"provisioned_iops": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(storageShareProvisionedV2HDDLimits.minIops, storageShareProvisionedV2SSDLimits.maxIops),
+			},
+
+			"provisioned_bandwidth": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(storageShareProvisionedV2HDDLimits.minBandwidth, storageShareProvisionedV2SSDLimits.maxBandwidth),
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageShareCustomizeDiff),
+	}
+}
+
+type storageShareProvisionedV2Limits struct {
+	minIops      int
+	maxIops      int
+	minBandwidth int
+	maxBandwidth int
+}
+
+var (
+	// the limits for Provisioned v2 File Shares on HDD (Standard) media
+	storageShareProvisionedV2HDDLimits = storageShareProvisionedV2Limits{
+		minIops:      500,
+		maxIops:      50000,
+		minBandwidth: 60,
+		maxBandwidth: 5120,
+	}
+
+	// the limits for Provisioned v2 File Shares on SSD (Premium) media
+	storageShareProvisionedV2SSDLimits = storageShareProvisionedV2Limits{
+		minIops:      3000,
+		maxIops:      102400,
+		minBandwidth: 125,
+		maxBandwidth: 10340,
+	}
+)
+
+func resourceStorageShareCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("provisioned_iops", "provisioned_bandwidth") {
+		return nil
+	}
+
+	iops, iopsSet := diff.GetOk("provisioned_iops")
+	bandwidth, bandwidthSet := diff.GetOk("provisioned_bandwidth")
+	if (!iopsSet || !diff.NewValueKnown("provisioned_iops")) && (!bandwidthSet || !diff.NewValueKnown("provisioned_bandwidth")) {
+		return nil
+	}
+
+	// the media tier is determined by the Storage Account, which can't be checked until it exists
+	if !diff.NewValueKnown("storage_account_name") {
+		return nil
 	}
+
+	storageClient := meta.(*clients.Client).Storage
+	accountName := diff.Get("storage_account_name").(string)
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q: %+v", accountName, err)
+	}
+	if account == nil {
+		return nil
+	}
+
+	props, err := storageClient.AccountsClient.GetProperties(ctx, account.ResourceGroup, accountName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q (Resource Group %q): %+v", accountName, account.ResourceGroup, err)
+	}
+	if props.Sku == nil {
+		return nil
+	}
+
+	// Provisioned v2 Storage Accounts use a SKU such as `StandardV2_LRS` or `PremiumV2_ZRS`
+	if !strings.Contains(strings.ToUpper(string(props.Sku.Name)), "V2_") {
+		return fmt.Errorf("`provisioned_iops` and `provisioned_bandwidth` can only be specified for a File Share within a Provisioned v2 Storage Account but Account %q uses the SKU %q", accountName, string(props.Sku.Name))
+	}
+
+	mediaTier := "HDD"
+	limits := storageShareProvisionedV2HDDLimits
+	if props.Sku.Tier == storage.SkuTierPremium {
+		mediaTier = "SSD"
+		limits = storageShareProvisionedV2SSDLimits
+	}
+
+	if iopsSet && diff.NewValueKnown("provisioned_iops") {
+		if v := iops.(int); v < limits.minIops || v > limits.maxIops {
+			return fmt.Errorf("`provisioned_iops` must be between %d and %d for a File Share on %s media, got %d", limits.minIops, limits.maxIops, mediaTier, v)
+		}
+	}
+
+	if bandwidthSet && diff.NewValueKnown("provisioned_bandwidth") {
+		if v := bandwidth.(int); v < limits.minBandwidth || v > limits.maxBandwidth {
+			return fmt.Errorf("`provisioned_bandwidth` must be between %d and %d MiB/s for a File Share on %s media, got %d", limits.minBandwidth, limits.maxBandwidth, mediaTier, v)
+		}
+	}
+
+	return nil
 }
 
 func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -196,6 +303,14 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting ACL's for Share %q (Account %q / Resource Group %q): %+v", shareName, accountName, account.ResourceGroup, err)
 	}
 
+	// the Provisioned v2 settings aren't available in the Data Plane API, as such these are set via Resource Manager
+	if props := expandStorageShareProvisionedV2Properties(d); props != nil {
+		resourceManagerId := parse.NewStorageShareResourceManagerID(storageClient.SubscriptionId, account.ResourceGroup, accountName, "default", shareName)
+		if _, err := storageClient.FileSharesWorkaroundClient.Update(ctx, resourceManagerId.ID(), azuresdkhacks.FileShare{Properties: props}); err != nil {
+			return fmt.Errorf("setting the Provisioned IOPS/Bandwidth for %s: %+v", resourceManagerId, err)
+		}
+	}
+
 	return resourceStorageShareRead(d, meta)
 }
 
@@ -257,9 +372,67 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 	resourceManagerId := parse.NewStorageShareResourceManagerID(storageClient.SubscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
+	// the provisioned performance isn't exposed by the Data Plane API, so this is only looked up via the Workaround Client
+	// when it's been specified (or detected during import) to avoid a second request for every share
+	provisionedIops := 0
+	provisionedBandwidth := 0
+	if d.Get("provisioned_iops").(int) != 0 || d.Get("provisioned_bandwidth").(int) != 0 {
+		provisionedIops, provisionedBandwidth, err = storageShareProvisionedPerformance(ctx, storageClient.FileSharesWorkaroundClient, resourceManagerId)
+		if err != nil {
+			return err
+		}
+	}
+	d.Set("provisioned_iops", provisionedIops)
+	d.Set("provisioned_bandwidth", provisionedBandwidth)
+
 	return nil
 }
 
+func importStorageShare(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := parse.StorageShareDataPlaneID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.Name, err)
+	}
+	if account == nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	resourceManagerId := parse.NewStorageShareResourceManagerID(storageClient.SubscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
+	provisionedIops, provisionedBandwidth, err := storageShareProvisionedPerformance(ctx, storageClient.FileSharesWorkaroundClient, resourceManagerId)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+	d.Set("provisioned_iops", provisionedIops)
+	d.Set("provisioned_bandwidth", provisionedBandwidth)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func storageShareProvisionedPerformance(ctx context.Context, client *azuresdkhacks.FileSharesClient, id parse.StorageShareResourceManagerId) (iops int, bandwidth int, err error) {
+	resp, err := client.Get(ctx, id.ID())
+	if err != nil {
+		return 0, 0, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if model.Properties.ProvisionedIops != nil {
+			iops = int(*model.Properties.ProvisionedIops)
+		}
+		if model.Properties.ProvisionedBandwidthMibps != nil {
+			bandwidth = int(*model.Properties.ProvisionedBandwidthMibps)
+		}
+	}
+
+	return iops, bandwidth, nil
+}
+
 func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -331,9 +504,41 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		log.Printf("[DEBUG] Updated the Access Tier for File Share %q (Storage Account %q)", id.Name, id.AccountName)
 	}
 
+	if d.HasChanges("provisioned_iops", "provisioned_bandwidth") {
+		log.Printf("[DEBUG] Updating the Provisioned IOPS/Bandwidth for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+
+		if props := expandStorageShareProvisionedV2Properties(d); props != nil {
+			resourceManagerId := parse.NewStorageShareResourceManagerID(storageClient.SubscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
+			if _, err := storageClient.FileSharesWorkaroundClient.Update(ctx, resourceManagerId.ID(), azuresdkhacks.FileShare{Properties: props}); err != nil {
+				return fmt.Errorf("updating the Provisioned IOPS/Bandwidth for %s: %+v", resourceManagerId, err)
+			}
+		}
+
+		log.Printf("[DEBUG] Updated the Provisioned IOPS/Bandwidth for File Share %q (Storage Account %q)", id.Name, id.AccountName)
+	}
+
 	return resourceStorageShareRead(d, meta)
 }
 
+func expandStorageShareProvisionedV2Properties(d *pluginsdk.ResourceData) *azuresdkhacks.FileShareProperties {
+	var props *azuresdkhacks.FileShareProperties
+
+	if v, ok := d.GetOk("provisioned_iops"); ok {
+		props = &azuresdkhacks.FileShareProperties{
+			ProvisionedIops: utils.Int64(int64(v.(int))),
+		}
+	}
+
+	if v, ok := d.GetOk("provisioned_bandwidth"); ok {
+		if props == nil {
+			props = &azuresdkhacks.FileShareProperties{}
+		}
+		props.ProvisionedBandwidthMibps = utils.Int64(int64(v.(int)))
+	}
+
+	return props
+}
+
 func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
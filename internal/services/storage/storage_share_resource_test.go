@@ -3,6 +3,7 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -229,6 +230,25 @@ func TestAccStorageShare_accessTierPremium(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_provisionedIopsRequiresProvisionedV2Account(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessTierPremium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.provisionedIops(data),
+			ExpectError: regexp.MustCompile("can only be specified for a File Share within a Provisioned v2 Storage Account"),
+		},
+	})
+}
+
 func TestAccStorageShare_nfsProtocol(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
 	r := StorageShareResource{}
@@ -590,6 +610,38 @@ resource "azurerm_storage_share" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
 }
 
+func (r StorageShareResource) provisionedIops(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Premium"
+  account_replication_type = "LRS"
+  account_kind             = "FileStorage"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                  = "testshare%s"
+  storage_account_name  = azurerm_storage_account.test.name
+  quota                 = 100
+  enabled_protocol      = "SMB"
+  access_tier           = "Premium"
+  provisioned_iops      = 3000
+  provisioned_bandwidth = 125
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString)
+}
+
 func (r StorageShareResource) protocol(data acceptance.TestData, protocol string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
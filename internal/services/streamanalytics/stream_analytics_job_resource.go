@@ -160,6 +160,7 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 							Default:  string(streamingjobs.AuthenticationModeConnectionString),
 							ValidateFunc: validation.StringInSlice([]string{
 								string(streamingjobs.AuthenticationModeConnectionString),
+								string(streamingjobs.AuthenticationModeMsi),
 							}, false),
 						},
 
@@ -171,7 +172,7 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 
 						"account_key": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
@@ -278,7 +279,14 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 
 	if contentStoragePolicy == string(streamingjobs.ContentStoragePolicyJobStorageAccount) {
 		if v, ok := d.GetOk("job_storage_account"); ok {
-			props.Properties.JobStorageAccount = expandJobStorageAccount(v.([]interface{}))
+			jobStorageAccount, err := expandJobStorageAccount(v.([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `job_storage_account`: %+v", err)
+			}
+			if *jobStorageAccount.AuthenticationMode == streamingjobs.AuthenticationModeMsi && expandedIdentity == nil {
+				return fmt.Errorf("`identity` must be specified when `job_storage_account.0.authentication_mode` is `%s`", streamingjobs.AuthenticationModeMsi)
+			}
+			props.Properties.JobStorageAccount = jobStorageAccount
 		} else {
 			return fmt.Errorf("`job_storage_account` must be set when `content_storage_policy` is `JobStorageAccount`")
 		}
@@ -532,21 +540,30 @@ func flattenJobIdentity(identity *streamingjobs.Identity) []interface{} {
 	}
 }
 
-func expandJobStorageAccount(input []interface{}) *streamingjobs.JobStorageAccount {
+func expandJobStorageAccount(input []interface{}) (*streamingjobs.JobStorageAccount, error) {
 	if input == nil {
-		return nil
+		return nil, nil
 	}
 
 	v := input[0].(map[string]interface{})
-	authenticationMode := v["authentication_mode"].(string)
+	authenticationMode := streamingjobs.AuthenticationMode(v["authentication_mode"].(string))
 	accountName := v["account_name"].(string)
 	accountKey := v["account_key"].(string)
 
-	return &streamingjobs.JobStorageAccount{
-		AuthenticationMode: utils.ToPtr(streamingjobs.AuthenticationMode(authenticationMode)),
+	output := &streamingjobs.JobStorageAccount{
+		AuthenticationMode: utils.ToPtr(authenticationMode),
 		AccountName:        utils.String(accountName),
-		AccountKey:         utils.String(accountKey),
 	}
+
+	// the Account Key is only used when authenticating using a Connection String
+	if authenticationMode == streamingjobs.AuthenticationModeConnectionString {
+		if accountKey == "" {
+			return nil, fmt.Errorf("`account_key` must be specified when `authentication_mode` is `%s`", streamingjobs.AuthenticationModeConnectionString)
+		}
+		output.AccountKey = utils.String(accountKey)
+	}
+
+	return output, nil
 }
 
 func flattenJobStorageAccount(d *pluginsdk.ResourceData, input *streamingjobs.JobStorageAccount) []interface{} {
@@ -33,6 +33,7 @@ type OutputCosmosDBResourceModel struct {
 	ContainerName      string `tfschema:"container_name"`
 	DocumentID         string `tfschema:"document_id"`
 	PartitionKey       string `tfschema:"partition_key"`
+	AuthenticationMode string `tfschema:"authentication_mode"`
 }
 
 func (r OutputCosmosDBResource) Arguments() map[string]*pluginsdk.Schema {
@@ -53,7 +54,7 @@ func (r OutputCosmosDBResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"cosmosdb_account_key": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
@@ -81,6 +82,16 @@ func (r OutputCosmosDBResource) Arguments() map[string]*pluginsdk.Schema {
 			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+
+		"authentication_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(outputs.AuthenticationModeConnectionString),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(outputs.AuthenticationModeMsi),
+				string(outputs.AuthenticationModeConnectionString),
+			}, false),
+		},
 	}
 }
 
@@ -130,11 +141,19 @@ func (r OutputCosmosDBResource) Create() sdk.ResourceFunc {
 
 			documentDbOutputProps := &outputs.DocumentDbOutputDataSourceProperties{
 				AccountId:             utils.String(databaseId.DatabaseAccountName),
-				AccountKey:            utils.String(model.AccountKey),
 				Database:              utils.String(databaseId.Name),
 				CollectionNamePattern: utils.String(model.ContainerName),
 				DocumentId:            utils.String(model.DocumentID),
 				PartitionKey:          utils.String(model.PartitionKey),
+				AuthenticationMode:    utils.ToPtr(outputs.AuthenticationMode(model.AuthenticationMode)),
+			}
+
+			// the Account Key is only used when authenticating using a Connection String
+			if model.AuthenticationMode == string(outputs.AuthenticationModeConnectionString) {
+				if model.AccountKey == "" {
+					return fmt.Errorf("`cosmosdb_account_key` must be specified when `authentication_mode` is `%s`", outputs.AuthenticationModeConnectionString)
+				}
+				documentDbOutputProps.AccountKey = utils.String(model.AccountKey)
 			}
 
 			props := outputs.Output{
@@ -212,6 +231,12 @@ func (r OutputCosmosDBResource) Read() sdk.ResourceFunc {
 					}
 					state.PartitionKey = partitionKey
 
+					authMode := string(outputs.AuthenticationModeConnectionString)
+					if v := output.Properties.AuthenticationMode; v != nil {
+						authMode = string(*v)
+					}
+					state.AuthenticationMode = authMode
+
 					return metadata.Encode(&state)
 				}
 			}
@@ -267,16 +292,25 @@ func (r OutputCosmosDBResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChangesExcept("name", "stream_analytics_job_id") {
+				documentDbOutputProps := &outputs.DocumentDbOutputDataSourceProperties{
+					Database:              &databaseId.Name,
+					CollectionNamePattern: &state.ContainerName,
+					DocumentId:            &state.DocumentID,
+					PartitionKey:          &state.PartitionKey,
+					AuthenticationMode:    utils.ToPtr(outputs.AuthenticationMode(state.AuthenticationMode)),
+				}
+
+				if state.AuthenticationMode == string(outputs.AuthenticationModeConnectionString) {
+					if state.AccountKey == "" {
+						return fmt.Errorf("`cosmosdb_account_key` must be specified when `authentication_mode` is `%s`", outputs.AuthenticationModeConnectionString)
+					}
+					documentDbOutputProps.AccountKey = &state.AccountKey
+				}
+
 				props := outputs.Output{
 					Properties: &outputs.OutputProperties{
 						Datasource: outputs.DocumentDbOutputDataSource{
-							Properties: &outputs.DocumentDbOutputDataSourceProperties{
-								AccountKey:            &state.AccountKey,
-								Database:              &databaseId.Name,
-								CollectionNamePattern: &state.ContainerName,
-								DocumentId:            &state.DocumentID,
-								PartitionKey:          &state.PartitionKey,
-							},
+							Properties: documentDbOutputProps,
 						},
 					},
 				}
@@ -67,6 +67,22 @@ func TestAccStreamAnalyticsOutputCosmosDB_complete(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsOutputCosmosDB_authenticationModeMsi(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_cosmosdb", "test")
+	r := StreamAnalyticsOutputCosmosDBResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.authenticationModeMsi(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_mode").HasValue("Msi"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStreamAnalyticsOutputCosmosDB_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_cosmosdb", "test")
 	r := StreamAnalyticsOutputCosmosDBResource{}
@@ -159,6 +175,49 @@ resource "azurerm_stream_analytics_output_cosmosdb" "test" {
 `, template, data.RandomString, data.RandomInteger)
 }
 
+func (r StreamAnalyticsOutputCosmosDBResource) authenticationModeMsi(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stream_analytics_job" "msi" {
+  name                = "acctestjob-msi-%[3]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  streaming_units     = 3
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+
+}
+
+resource "azurerm_cosmosdb_sql_role_assignment" "test" {
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  role_definition_id  = "${azurerm_cosmosdb_account.test.id}/sqlRoleDefinitions/00000000-0000-0000-0000-000000000002"
+  principal_id        = azurerm_stream_analytics_job.msi.identity.0.principal_id
+  scope               = azurerm_cosmosdb_account.test.id
+}
+
+resource "azurerm_stream_analytics_output_cosmosdb" "test" {
+  name                     = "acctestoutput-%[3]d"
+  stream_analytics_job_id  = azurerm_stream_analytics_job.msi.id
+  cosmosdb_sql_database_id = azurerm_cosmosdb_sql_database.test.id
+  container_name           = azurerm_cosmosdb_sql_container.test.name
+  authentication_mode      = "Msi"
+
+  depends_on = [azurerm_cosmosdb_sql_role_assignment.test]
+}
+`, template, data.RandomString, data.RandomInteger)
+}
+
 func (r StreamAnalyticsOutputCosmosDBResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -85,17 +85,27 @@ func resourceStreamAnalyticsOutputSynapse() *pluginsdk.Resource {
 
 			"user": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"password": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"authentication_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(outputs.AuthenticationModeConnectionString),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(outputs.AuthenticationModeMsi),
+					string(outputs.AuthenticationModeConnectionString),
+				}, false),
+			},
 		},
 	}
 }
@@ -119,17 +129,29 @@ func resourceStreamAnalyticsOutputSynapseCreateUpdate(d *pluginsdk.ResourceData,
 		}
 	}
 
+	dataSourceProperties := &outputs.AzureSynapseDataSourceProperties{
+		Server:             utils.String(d.Get("server").(string)),
+		Database:           utils.String(d.Get("database").(string)),
+		Table:              utils.String(d.Get("table").(string)),
+		AuthenticationMode: utils.ToPtr(outputs.AuthenticationMode(d.Get("authentication_mode").(string))),
+	}
+
+	// Add user/password dataSourceProperties only if authentication mode requires them
+	if *dataSourceProperties.AuthenticationMode == outputs.AuthenticationModeConnectionString {
+		user := d.Get("user").(string)
+		password := d.Get("password").(string)
+		if user == "" || password == "" {
+			return fmt.Errorf("`user` and `password` must be specified when `authentication_mode` is `%s`", outputs.AuthenticationModeConnectionString)
+		}
+		dataSourceProperties.User = utils.String(user)
+		dataSourceProperties.Password = utils.String(password)
+	}
+
 	props := outputs.Output{
 		Name: utils.String(id.OutputName),
 		Properties: &outputs.OutputProperties{
 			Datasource: &outputs.AzureSynapseOutputDataSource{
-				Properties: &outputs.AzureSynapseDataSourceProperties{
-					Server:   utils.String(d.Get("server").(string)),
-					Database: utils.String(d.Get("database").(string)),
-					User:     utils.String(d.Get("user").(string)),
-					Password: utils.String(d.Get("password").(string)),
-					Table:    utils.String(d.Get("table").(string)),
-				},
+				Properties: dataSourceProperties,
 			},
 		},
 	}
@@ -205,6 +227,12 @@ func resourceStreamAnalyticsOutputSynapseRead(d *pluginsdk.ResourceData, meta in
 				user = *v
 			}
 			d.Set("user", user)
+
+			authMode := string(outputs.AuthenticationModeConnectionString)
+			if v := output.Properties.AuthenticationMode; v != nil {
+				authMode = string(*v)
+			}
+			d.Set("authentication_mode", authMode)
 		}
 	}
 	return nil
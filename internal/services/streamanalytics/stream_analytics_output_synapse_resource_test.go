@@ -67,6 +67,22 @@ func TestAccStreamAnalyticsOutputSynapse_update(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsOutputSynapse_authenticationModeMsi(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_synapse", "test")
+	r := StreamAnalyticsOutputSynapseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.authenticationModeMsi(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_mode").HasValue("Msi"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStreamAnalyticsOutputSynapse_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_synapse", "test")
 	r := StreamAnalyticsOutputSynapseResource{}
@@ -162,6 +178,24 @@ resource "azurerm_stream_analytics_output_synapse" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StreamAnalyticsOutputSynapseResource) authenticationModeMsi(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stream_analytics_output_synapse" "test" {
+  name                      = "acctestoutput-%[2]d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  authentication_mode       = "Msi"
+
+  server   = azurerm_synapse_workspace.test.connectivity_endpoints["sqlOnDemand"]
+  database = "master"
+  table    = "AccTestTable"
+}
+`, template, data.RandomInteger)
+}
+
 func (r StreamAnalyticsOutputSynapseResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -134,6 +134,11 @@ func resourceAzureEndpoint() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"endpoint_monitor_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -237,6 +242,12 @@ func resourceAzureEndpointRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("priority", props.Priority)
 			d.Set("geo_mappings", props.GeoMapping)
 
+			endpointMonitorStatus := ""
+			if props.EndpointMonitorStatus != nil {
+				endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+			}
+			d.Set("endpoint_monitor_status", endpointMonitorStatus)
+
 			if err := d.Set("custom_header", flattenEndpointCustomHeaderConfig(props.CustomHeaders)); err != nil {
 				return fmt.Errorf("setting `custom_header`: %s", err)
 			}
@@ -142,6 +142,11 @@ func resourceExternalEndpoint() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"endpoint_monitor_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -250,6 +255,12 @@ func resourceExternalEndpointRead(d *pluginsdk.ResourceData, meta interface{}) e
 			d.Set("endpoint_location", props.EndpointLocation)
 			d.Set("geo_mappings", props.GeoMapping)
 
+			endpointMonitorStatus := ""
+			if props.EndpointMonitorStatus != nil {
+				endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+			}
+			d.Set("endpoint_monitor_status", endpointMonitorStatus)
+
 			if err := d.Set("custom_header", flattenEndpointCustomHeaderConfig(props.CustomHeaders)); err != nil {
 				return fmt.Errorf("setting `custom_header`: %s", err)
 			}
@@ -159,6 +159,11 @@ func resourceNestedEndpoint() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"endpoint_monitor_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -282,6 +287,12 @@ func resourceNestedEndpointRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("endpoint_location", props.EndpointLocation)
 			d.Set("geo_mappings", props.GeoMapping)
 
+			endpointMonitorStatus := ""
+			if props.EndpointMonitorStatus != nil {
+				endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+			}
+			d.Set("endpoint_monitor_status", endpointMonitorStatus)
+
 			if err := d.Set("custom_header", flattenEndpointCustomHeaderConfig(props.CustomHeaders)); err != nil {
 				return fmt.Errorf("setting `custom_header`: %s", err)
 			}
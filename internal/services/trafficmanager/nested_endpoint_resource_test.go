@@ -25,6 +25,7 @@ func TestAccNestedEndpoint_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("endpoint_monitor_status").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -2,6 +2,7 @@ package trafficmanager
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -36,6 +37,11 @@ func dataSourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"profile_monitor_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"traffic_routing_method": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -121,6 +127,39 @@ func dataSourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				},
 			},
 
+			"endpoint": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"endpoint_monitor_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -170,6 +209,16 @@ func dataSourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("dns_config", flattenAzureRMTrafficManagerProfileDNSConfig(profile.DnsConfig))
 			d.Set("monitor_config", flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig))
 
+			profileMonitorStatus := ""
+			if profile.MonitorConfig != nil && profile.MonitorConfig.ProfileMonitorStatus != nil {
+				profileMonitorStatus = string(*profile.MonitorConfig.ProfileMonitorStatus)
+			}
+			d.Set("profile_monitor_status", profileMonitorStatus)
+
+			if err := d.Set("endpoint", flattenTrafficManagerProfileEndpointHealth(profile.Endpoints)); err != nil {
+				return fmt.Errorf("setting `endpoint`: %+v", err)
+			}
+
 			trafficViewEnabled := false
 			if profile.TrafficViewEnrollmentStatus != nil {
 				trafficViewEnabled = *profile.TrafficViewEnrollmentStatus == profiles.TrafficViewEnrollmentStatusEnabled
@@ -185,3 +234,50 @@ func dataSourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta inte
 	}
 	return nil
 }
+
+func flattenTrafficManagerProfileEndpointHealth(input *[]profiles.Endpoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, endpoint := range *input {
+		id := ""
+		if endpoint.Id != nil {
+			id = *endpoint.Id
+		}
+
+		name := ""
+		if endpoint.Name != nil {
+			name = *endpoint.Name
+		}
+
+		endpointType := ""
+		if endpoint.Type != nil {
+			// the type is returned as `Microsoft.Network/trafficManagerProfiles/azureEndpoints`
+			segments := strings.Split(*endpoint.Type, "/")
+			endpointType = segments[len(segments)-1]
+		}
+
+		enabled := true
+		endpointMonitorStatus := ""
+		if props := endpoint.Properties; props != nil {
+			if props.EndpointStatus != nil && *props.EndpointStatus == profiles.EndpointStatusDisabled {
+				enabled = false
+			}
+			if props.EndpointMonitorStatus != nil {
+				endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                      id,
+			"name":                    name,
+			"type":                    endpointType,
+			"enabled":                 enabled,
+			"endpoint_monitor_status": endpointMonitorStatus,
+		})
+	}
+
+	return results
+}
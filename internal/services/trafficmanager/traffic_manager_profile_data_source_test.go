@@ -23,6 +23,36 @@ func TestAccAzureRMDataSourceTrafficManagerProfile(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDataSourceTrafficManagerProfile_endpointHealth(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_profile", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: TrafficManagerProfileDataSource{}.endpointHealth(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("profile_monitor_status").Exists(),
+				check.That(data.ResourceName).Key("endpoint.#").HasValue("1"),
+				check.That(data.ResourceName).Key("endpoint.0.type").HasValue("nestedEndpoints"),
+				check.That(data.ResourceName).Key("endpoint.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("endpoint.0.endpoint_monitor_status").Exists(),
+			),
+		},
+	})
+}
+
+func (d TrafficManagerProfileDataSource) endpointHealth(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_profile" "test" {
+  name                = azurerm_traffic_manager_profile.parent.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_traffic_manager_nested_endpoint.test]
+}
+`, NestedEndpointResource{}.basic(data))
+}
+
 func (d TrafficManagerProfileDataSource) template(data acceptance.TestData) string {
 	template := TrafficManagerProfileResource{}.basic(data, "Performance")
 	return fmt.Sprintf(`
@@ -1,21 +1,30 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/addons"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/authorizations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/hcxenterprisesites"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/scripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AuthorizationClient *authorizations.AuthorizationsClient
-	ClusterClient       *clusters.ClustersClient
-	PrivateCloudClient  *privateclouds.PrivateCloudsClient
-	DataStoreClient     *datastores.DataStoresClient
+	AddonClient             *addons.AddonsClient
+	AuthorizationClient     *authorizations.AuthorizationsClient
+	ClusterClient           *clusters.ClustersClient
+	PrivateCloudClient      *privateclouds.PrivateCloudsClient
+	DataStoreClient         *datastores.DataStoresClient
+	HcxEnterpriseSiteClient *hcxenterprisesites.HcxEnterpriseSitesClient
+	ScriptsClient           *scripts.ScriptsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	addonClient := addons.NewAddonsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&addonClient.Client, o.ResourceManagerAuthorizer)
+
 	authorizationClient := authorizations.NewAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&authorizationClient.Client, o.ResourceManagerAuthorizer)
 
@@ -28,10 +37,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	dataStoresClient := datastores.NewDataStoresClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&dataStoresClient.Client, o.ResourceManagerAuthorizer)
 
+	hcxEnterpriseSiteClient := hcxenterprisesites.NewHcxEnterpriseSitesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hcxEnterpriseSiteClient.Client, o.ResourceManagerAuthorizer)
+
+	scriptsClient := scripts.NewScriptsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&scriptsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AuthorizationClient: &authorizationClient,
-		ClusterClient:       &clusterClient,
-		PrivateCloudClient:  &privateCloudClient,
-		DataStoreClient:     &dataStoresClient,
+		AddonClient:             &addonClient,
+		AuthorizationClient:     &authorizationClient,
+		ClusterClient:           &clusterClient,
+		PrivateCloudClient:      &privateCloudClient,
+		DataStoreClient:         &dataStoresClient,
+		HcxEnterpriseSiteClient: &hcxEnterpriseSiteClient,
+		ScriptsClient:           &scriptsClient,
 	}
 }
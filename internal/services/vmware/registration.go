@@ -47,6 +47,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		NetappFileVolumeAttachmentResource{},
+		PrivateCloudAddonResource{},
+		HcxEnterpriseSiteResource{},
+		ScriptExecutionResource{},
 	}
 }
 
@@ -0,0 +1,352 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/addons"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateCloudAddonModel struct {
+	PrivateCloudId string                      `tfschema:"private_cloud_id"`
+	Arc            []PrivateCloudAddonArcModel `tfschema:"arc"`
+	Hcx            []PrivateCloudAddonHcxModel `tfschema:"hcx"`
+	Srm            []PrivateCloudAddonSrmModel `tfschema:"srm"`
+	Vr             []PrivateCloudAddonVrModel  `tfschema:"vr"`
+}
+
+type PrivateCloudAddonArcModel struct {
+	VCenterId string `tfschema:"vcenter_id"`
+}
+
+type PrivateCloudAddonHcxModel struct {
+	Offer string `tfschema:"offer"`
+}
+
+type PrivateCloudAddonSrmModel struct {
+	LicenseKey string `tfschema:"license_key"`
+}
+
+type PrivateCloudAddonVrModel struct {
+	ServerCount int64 `tfschema:"server_count"`
+}
+
+type PrivateCloudAddonResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = PrivateCloudAddonResource{}
+	_ sdk.ResourceWithCustomizeDiff = PrivateCloudAddonResource{}
+)
+
+// the name of an addon is fixed by the service to the lower-cased addon type, so only one
+// addon of each type can exist within a Private Cloud
+var privateCloudAddonBlocks = []string{"arc", "hcx", "srm", "vr"}
+
+func (r PrivateCloudAddonResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_cloud_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateclouds.ValidatePrivateCloudID,
+		},
+
+		"arc": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: privateCloudAddonBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vcenter_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+			},
+		},
+
+		"hcx": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: privateCloudAddonBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"offer": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"VMware MaaS Cloud Provider",
+							"VMware MaaS Cloud Provider (Enterprise)",
+						}, false),
+					},
+				},
+			},
+		},
+
+		"srm": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: privateCloudAddonBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"license_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"vr": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: privateCloudAddonBlocks,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server_count": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 10),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PrivateCloudAddonResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r PrivateCloudAddonResource) ResourceType() string {
+	return "azurerm_vmware_private_cloud_addon"
+}
+
+func (r PrivateCloudAddonResource) ModelObject() interface{} {
+	return &PrivateCloudAddonModel{}
+}
+
+func (r PrivateCloudAddonResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return addons.ValidateAddonID
+}
+
+func (r PrivateCloudAddonResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.Logger.Infof("Decoding state...")
+			var state PrivateCloudAddonModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Vmware.AddonClient
+
+			privateCloudId, err := privateclouds.ParsePrivateCloudID(state.PrivateCloudId)
+			if err != nil {
+				return err
+			}
+
+			properties, addonType := expandPrivateCloudAddonProperties(state)
+			id := addons.NewAddonID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, strings.ToLower(string(addonType)))
+			metadata.Logger.Infof("creating %s", id)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := addons.Addon{
+				Properties: properties,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 5 * time.Hour,
+	}
+}
+
+func (r PrivateCloudAddonResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.AddonClient
+			id, err := addons.ParseAddonID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("retrieving %s", *id)
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateCloudAddonModel{
+				PrivateCloudId: privateclouds.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				switch props := model.Properties.(type) {
+				case addons.AddonArcProperties:
+					state.Arc = []PrivateCloudAddonArcModel{
+						{
+							VCenterId: pointer.From(props.VCenter),
+						},
+					}
+				case addons.AddonHcxProperties:
+					state.Hcx = []PrivateCloudAddonHcxModel{
+						{
+							Offer: props.Offer,
+						},
+					}
+				case addons.AddonSrmProperties:
+					// the license key isn't returned by the API, so we look this up from the config
+					var config PrivateCloudAddonModel
+					if err := metadata.Decode(&config); err != nil {
+						return err
+					}
+					licenseKey := pointer.From(props.LicenseKey)
+					if len(config.Srm) > 0 {
+						licenseKey = config.Srm[0].LicenseKey
+					}
+					state.Srm = []PrivateCloudAddonSrmModel{
+						{
+							LicenseKey: licenseKey,
+						},
+					}
+				case addons.AddonVrProperties:
+					state.Vr = []PrivateCloudAddonVrModel{
+						{
+							ServerCount: props.VrsCount,
+						},
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r PrivateCloudAddonResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.AddonClient
+			id, err := addons.ParseAddonID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state PrivateCloudAddonModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			properties, _ := expandPrivateCloudAddonProperties(state)
+			input := addons.Addon{
+				Properties: properties,
+			}
+
+			metadata.Logger.Infof("updating %s", *id)
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Hour,
+	}
+}
+
+func (r PrivateCloudAddonResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.AddonClient
+			id, err := addons.ParseAddonID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 5 * time.Hour,
+	}
+}
+
+func (r PrivateCloudAddonResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// switching to a different type of addon changes the name of the addon, so requires a new resource
+			for _, block := range privateCloudAddonBlocks {
+				oldVal, newVal := metadata.ResourceDiff.GetChange(block)
+				if len(oldVal.([]interface{})) != len(newVal.([]interface{})) && metadata.ResourceDiff.Id() != "" {
+					if err := metadata.ResourceDiff.ForceNew(block); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func expandPrivateCloudAddonProperties(input PrivateCloudAddonModel) (addons.AddonProperties, addons.AddonType) {
+	if len(input.Arc) > 0 {
+		return addons.AddonArcProperties{
+			VCenter: pointer.To(input.Arc[0].VCenterId),
+		}, addons.AddonTypeArc
+	}
+
+	if len(input.Hcx) > 0 {
+		return addons.AddonHcxProperties{
+			Offer: input.Hcx[0].Offer,
+		}, addons.AddonTypeHCX
+	}
+
+	if len(input.Srm) > 0 {
+		return addons.AddonSrmProperties{
+			LicenseKey: pointer.To(input.Srm[0].LicenseKey),
+		}, addons.AddonTypeSRM
+	}
+
+	vrsCount := int64(0)
+	if len(input.Vr) > 0 {
+		vrsCount = input.Vr[0].ServerCount
+	}
+	return addons.AddonVrProperties{
+		VrsCount: vrsCount,
+	}, addons.AddonTypeVR
+}
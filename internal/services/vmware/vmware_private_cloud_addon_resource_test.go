@@ -0,0 +1,125 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwarePrivateCloudAddonResource struct{}
+
+func TestAccVmwarePrivateCloudAddon_hcx(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud_addon", "test")
+	r := VmwarePrivateCloudAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hcx(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwarePrivateCloudAddon_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud_addon", "test")
+	r := VmwarePrivateCloudAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hcx(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwarePrivateCloudAddon_vrUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud_addon", "test")
+	r := VmwarePrivateCloudAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vr(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.vr(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vr.0.server_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwarePrivateCloudAddonResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := addons.ParseAddonID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.AddonClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwarePrivateCloudAddonResource) hcx(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud_addon" "test" {
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+
+  hcx {
+    offer = "VMware MaaS Cloud Provider (Enterprise)"
+  }
+}
+`, VmwarePrivateCloudResource{}.basic(data))
+}
+
+func (r VmwarePrivateCloudAddonResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud_addon" "import" {
+  private_cloud_id = azurerm_vmware_private_cloud_addon.test.private_cloud_id
+
+  hcx {
+    offer = "VMware MaaS Cloud Provider (Enterprise)"
+  }
+}
+`, r.hcx(data))
+}
+
+func (r VmwarePrivateCloudAddonResource) vr(data acceptance.TestData, serverCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud_addon" "test" {
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+
+  vr {
+    server_count = %d
+  }
+}
+`, VmwarePrivateCloudResource{}.basic(data), serverCount)
+}
@@ -0,0 +1,433 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/scripts"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ScriptExecutionModel struct {
+	Name                string                                `tfschema:"name"`
+	PrivateCloudId      string                                `tfschema:"private_cloud_id"`
+	ScriptCmdletId      string                                `tfschema:"script_cmdlet_id"`
+	Timeout             string                                `tfschema:"timeout"`
+	Retention           string                                `tfschema:"retention"`
+	Parameter           []ScriptExecutionParameterModel       `tfschema:"parameter"`
+	HiddenParameter     []ScriptExecutionHiddenParameterModel `tfschema:"hidden_parameter"`
+	CredentialParameter []ScriptExecutionCredentialModel      `tfschema:"credential_parameter"`
+	Triggers            map[string]string                     `tfschema:"triggers"`
+	Output              []string                              `tfschema:"output"`
+	Errors              []string                              `tfschema:"errors"`
+	Warnings            []string                              `tfschema:"warnings"`
+	Information         []string                              `tfschema:"information"`
+	StartedAt           string                                `tfschema:"started_at"`
+	FinishedAt          string                                `tfschema:"finished_at"`
+}
+
+type ScriptExecutionParameterModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type ScriptExecutionHiddenParameterModel struct {
+	Name        string `tfschema:"name"`
+	SecureValue string `tfschema:"secure_value"`
+}
+
+type ScriptExecutionCredentialModel struct {
+	Name     string `tfschema:"name"`
+	Username string `tfschema:"username"`
+	Password string `tfschema:"password"`
+}
+
+// ScriptExecutionResource runs a Run Command cmdlet against a Private Cloud. A Script Execution
+// can't be modified once it has been submitted, so every argument forces a new execution - with
+// `triggers` allowing the cmdlet to be re-run on demand.
+type ScriptExecutionResource struct{}
+
+var _ sdk.Resource = ScriptExecutionResource{}
+
+func (r ScriptExecutionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][-_.a-zA-Z0-9]{0,62}$`),
+				"`name` must be between 1 and 63 characters in length, begin with a letter or number and may only contain letters, numbers, underscores, periods and hyphens",
+			),
+		},
+
+		"private_cloud_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateclouds.ValidatePrivateCloudID,
+		},
+
+		"script_cmdlet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: scripts.ValidateScriptCmdletID,
+		},
+
+		"timeout": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ISO8601Duration,
+		},
+
+		"retention": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validate.ISO8601Duration,
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"hidden_parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"secure_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"credential_parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"password": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ScriptExecutionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"errors": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"warnings": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"information": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"started_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"finished_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ScriptExecutionResource) ResourceType() string {
+	return "azurerm_vmware_script_execution"
+}
+
+func (r ScriptExecutionResource) ModelObject() interface{} {
+	return &ScriptExecutionModel{}
+}
+
+func (r ScriptExecutionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return scripts.ValidateScriptExecutionID
+}
+
+func (r ScriptExecutionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.Logger.Infof("Decoding state...")
+			var state ScriptExecutionModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Vmware.ScriptsClient
+
+			privateCloudId, err := privateclouds.ParsePrivateCloudID(state.PrivateCloudId)
+			if err != nil {
+				return err
+			}
+
+			id := scripts.NewScriptExecutionID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, state.Name)
+			metadata.Logger.Infof("creating %s", id)
+
+			existing, err := client.ScriptExecutionsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := scripts.ScriptExecution{
+				Properties: &scripts.ScriptExecutionProperties{
+					ScriptCmdletId:   pointer.To(state.ScriptCmdletId),
+					Timeout:          state.Timeout,
+					Parameters:       expandScriptExecutionParameters(state.Parameter),
+					HiddenParameters: expandScriptExecutionHiddenParameters(state.HiddenParameter, state.CredentialParameter),
+				},
+			}
+
+			if state.Retention != "" {
+				input.Properties.Retention = pointer.To(state.Retention)
+			}
+
+			if err := client.ScriptExecutionsCreateOrUpdateThenPoll(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the execution exists at this point regardless of its outcome, so we track it in the state
+			// to ensure that a failed execution is re-run (as a new resource) on the next apply
+			metadata.SetID(id)
+
+			resp, err := client.ScriptExecutionsGet(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if pointer.From(model.Properties.ProvisioningState) == scripts.ScriptExecutionProvisioningStateFailed {
+					return fmt.Errorf("running %s: execution failed: %s", id, pointer.From(model.Properties.FailureReason))
+				}
+			}
+
+			return nil
+		},
+		Timeout: 2 * time.Hour,
+	}
+}
+
+func (r ScriptExecutionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.ScriptsClient
+			id, err := scripts.ParseScriptExecutionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("retrieving %s", *id)
+			resp, err := client.ScriptExecutionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// hidden parameters, credentials and triggers aren't returned by the API, so we look these up from the config
+			var config ScriptExecutionModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			state := ScriptExecutionModel{
+				Name:                id.ScriptExecutionName,
+				PrivateCloudId:      privateclouds.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID(),
+				HiddenParameter:     config.HiddenParameter,
+				CredentialParameter: config.CredentialParameter,
+				Triggers:            config.Triggers,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ScriptCmdletId = pointer.From(props.ScriptCmdletId)
+					state.Timeout = props.Timeout
+					state.Retention = pointer.From(props.Retention)
+					state.Parameter = flattenScriptExecutionParameters(props.Parameters)
+					state.Output = pointer.From(props.Output)
+					state.Errors = pointer.From(props.Errors)
+					state.Warnings = pointer.From(props.Warnings)
+					state.Information = pointer.From(props.Information)
+					state.StartedAt = pointer.From(props.StartedAt)
+					state.FinishedAt = pointer.From(props.FinishedAt)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ScriptExecutionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Vmware.ScriptsClient
+			id, err := scripts.ParseScriptExecutionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", *id)
+			if err := client.ScriptExecutionsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandScriptExecutionParameters(input []ScriptExecutionParameterModel) *[]scripts.ScriptExecutionParameter {
+	output := make([]scripts.ScriptExecutionParameter, 0)
+	for _, v := range input {
+		output = append(output, scripts.ScriptStringExecutionParameter{
+			Name:  v.Name,
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	return &output
+}
+
+func expandScriptExecutionHiddenParameters(hidden []ScriptExecutionHiddenParameterModel, credentials []ScriptExecutionCredentialModel) *[]scripts.ScriptExecutionParameter {
+	output := make([]scripts.ScriptExecutionParameter, 0)
+	for _, v := range hidden {
+		output = append(output, scripts.ScriptSecureStringExecutionParameter{
+			Name:        v.Name,
+			SecureValue: pointer.To(v.SecureValue),
+		})
+	}
+
+	for _, v := range credentials {
+		output = append(output, scripts.PSCredentialExecutionParameter{
+			Name:     v.Name,
+			Username: pointer.To(v.Username),
+			Password: pointer.To(v.Password),
+		})
+	}
+
+	return &output
+}
+
+func flattenScriptExecutionParameters(input *[]scripts.ScriptExecutionParameter) []ScriptExecutionParameterModel {
+	output := make([]ScriptExecutionParameterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if parameter, ok := v.(scripts.ScriptStringExecutionParameter); ok {
+			output = append(output, ScriptExecutionParameterModel{
+				Name:  parameter.Name,
+				Value: pointer.From(parameter.Value),
+			})
+		}
+	}
+
+	return output
+}
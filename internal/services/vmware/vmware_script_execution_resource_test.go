@@ -0,0 +1,115 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/scripts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareScriptExecutionResource struct{}
+
+func TestAccVmwareScriptExecution_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_script_execution", "test")
+	r := VmwareScriptExecutionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("finished_at").Exists(),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func TestAccVmwareScriptExecution_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_script_execution", "test")
+	r := VmwareScriptExecutionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareScriptExecution_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_script_execution", "test")
+	r := VmwareScriptExecutionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func (VmwareScriptExecutionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := scripts.ParseScriptExecutionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.ScriptsClient.ScriptExecutionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwareScriptExecutionResource) basic(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_script_execution" "test" {
+  name             = "acctest-ScriptExecution-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  script_cmdlet_id = "${azurerm_vmware_private_cloud.test.id}/scriptPackages/Microsoft.AVS.Management@5.0.0/scriptCmdlets/Get-StoragePolicies"
+  timeout          = "PT10M"
+  retention        = "P1D"
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger, trigger)
+}
+
+func (r VmwareScriptExecutionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_script_execution" "import" {
+  name             = azurerm_vmware_script_execution.test.name
+  private_cloud_id = azurerm_vmware_script_execution.test.private_cloud_id
+  script_cmdlet_id = azurerm_vmware_script_execution.test.script_cmdlet_id
+  timeout          = azurerm_vmware_script_execution.test.timeout
+  retention        = azurerm_vmware_script_execution.test.retention
+}
+`, r.basic(data, "first"))
+}
@@ -0,0 +1,253 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+)
+
+// NOTE: Linked Backends and the Enterprise-Grade Edge status of a Static Site aren't available in the 2021-02-01 API
+// used by the vendored SDK, as such they're managed here against the 2022-03-01 API until the SDK is updated
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const staticSitesApiVersion = "2022-03-01"
+
+type EnterpriseGradeCdnStatus string
+
+const (
+	EnterpriseGradeCdnStatusDisabled  EnterpriseGradeCdnStatus = "Disabled"
+	EnterpriseGradeCdnStatusDisabling EnterpriseGradeCdnStatus = "Disabling"
+	EnterpriseGradeCdnStatusEnabled   EnterpriseGradeCdnStatus = "Enabled"
+	EnterpriseGradeCdnStatusEnabling  EnterpriseGradeCdnStatus = "Enabling"
+)
+
+type StaticSite struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *StaticSiteProperties `json:"properties,omitempty"`
+}
+
+type StaticSiteProperties struct {
+	EnterpriseGradeCdnStatus *EnterpriseGradeCdnStatus `json:"enterpriseGradeCdnStatus,omitempty"`
+}
+
+type StaticSiteLinkedBackend struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *StaticSiteLinkedBackendProperties `json:"properties,omitempty"`
+}
+
+type StaticSiteLinkedBackendProperties struct {
+	BackendResourceId *string `json:"backendResourceId,omitempty"`
+	CreatedOn         *string `json:"createdOn,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	Region            *string `json:"region,omitempty"`
+}
+
+type StaticSitesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStaticSitesClientWithBaseURI(endpoint string) StaticSitesClient {
+	return StaticSitesClient{
+		Client:  autorest.NewClientWithUserAgent(fmt.Sprintf("hashicorp/go-azure-sdk/staticsites/%s", staticSitesApiVersion)),
+		baseUri: endpoint,
+	}
+}
+
+type StaticSiteGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *StaticSite
+}
+
+type StaticSiteUpdateOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+type LinkedBackendGetOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *StaticSiteLinkedBackend
+}
+
+type LinkBackendOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+type UnlinkBackendOperationResponse struct {
+	HttpResponse *http.Response
+}
+
+// Get ...
+func (c StaticSitesClient) Get(ctx context.Context, id parse.StaticSiteId) (result StaticSiteGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id.ID(), nil, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// Update ...
+func (c StaticSitesClient) Update(ctx context.Context, id parse.StaticSiteId, input StaticSite) (result StaticSiteUpdateOperationResponse, err error) {
+	req, err := c.prepare(ctx, id.ID(), nil, autorest.AsPatch(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "Update", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted, http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "Update", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetLinkedBackend ...
+func (c StaticSitesClient) GetLinkedBackend(ctx context.Context, id parse.StaticSiteLinkedBackendId) (result LinkedBackendGetOperationResponse, err error) {
+	req, err := c.prepare(ctx, id.ID(), nil, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackend", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "GetLinkedBackend", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// LinkBackend ...
+func (c StaticSitesClient) LinkBackend(ctx context.Context, id parse.StaticSiteLinkedBackendId, input StaticSiteLinkedBackend) (result LinkBackendOperationResponse, err error) {
+	req, err := c.prepare(ctx, id.ID(), nil, autorest.AsPut(), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "LinkBackend", nil, "Failure preparing request")
+		return
+	}
+
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	result.HttpResponse = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "LinkBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "LinkBackend", result.HttpResponse, "Failure polling request")
+		return
+	}
+
+	return
+}
+
+// LinkBackendThenPoll performs LinkBackend then polls until it's completed
+func (c StaticSitesClient) LinkBackendThenPoll(ctx context.Context, id parse.StaticSiteLinkedBackendId, input StaticSiteLinkedBackend) error {
+	result, err := c.LinkBackend(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing LinkBackend: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after LinkBackend: %+v", err)
+	}
+
+	return nil
+}
+
+// UnlinkBackend ...
+func (c StaticSitesClient) UnlinkBackend(ctx context.Context, id parse.StaticSiteLinkedBackendId) (result UnlinkBackendOperationResponse, err error) {
+	// also remove the authentication provider which was configured on the Static Site when the backend was linked
+	queryParameters := map[string]interface{}{
+		"isCleaningAuthConfig": true,
+	}
+
+	req, err := c.prepare(ctx, id.ID(), queryParameters, autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "staticsites.StaticSitesClient", "UnlinkBackend", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func (c StaticSitesClient) prepare(ctx context.Context, path string, queryParameters map[string]interface{}, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	if queryParameters == nil {
+		queryParameters = map[string]interface{}{}
+	}
+	queryParameters["api-version"] = staticSitesApiVersion
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters),
+	}, decorators...)
+
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/azuresdkhacks"
 )
 
 type Client struct {
@@ -13,6 +14,7 @@ type Client struct {
 	CertificatesClient           *web.CertificatesClient
 	CertificatesOrderClient      *web.AppServiceCertificateOrdersClient
 	StaticSitesClient            *web.StaticSitesClient
+	StaticSites2022Client        *azuresdkhacks.StaticSitesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -37,6 +39,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	staticSitesClient := web.NewStaticSitesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&staticSitesClient.Client, o.ResourceManagerAuthorizer)
 
+	staticSites2022Client := azuresdkhacks.NewStaticSitesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&staticSites2022Client.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AppServiceEnvironmentsClient: &appServiceEnvironmentsClient,
 		AppServicePlansClient:        &appServicePlansClient,
@@ -45,5 +50,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		CertificatesClient:           &certificatesClient,
 		CertificatesOrderClient:      &certificatesOrderClient,
 		StaticSitesClient:            &staticSitesClient,
+		StaticSites2022Client:        &staticSites2022Client,
 	}
 }
@@ -0,0 +1,170 @@
+package web
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStaticSiteFunctionAppRegistration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteFunctionAppRegistrationCreate,
+		Read:   resourceStaticSiteFunctionAppRegistrationRead,
+		Delete: resourceStaticSiteFunctionAppRegistrationDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StaticSiteUserProvidedFunctionAppID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"function_app_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FunctionAppID,
+			},
+
+			"force_registration_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceStaticSiteFunctionAppRegistrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	appsClient := meta.(*clients.Client).Web.AppServicesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Static Site Function App Registration creation.")
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	functionAppId, err := parse.FunctionAppID(d.Get("function_app_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteUserProvidedFunctionAppID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, functionAppId.SiteName)
+
+	existing, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_static_site_function_app_registration", id.ID())
+	}
+
+	functionApp, err := appsClient.Get(ctx, functionAppId.ResourceGroup, functionAppId.SiteName)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *functionAppId, err)
+	}
+
+	envelope := web.StaticSiteUserProvidedFunctionAppARMResource{
+		StaticSiteUserProvidedFunctionAppARMResourceProperties: &web.StaticSiteUserProvidedFunctionAppARMResourceProperties{
+			FunctionAppResourceID: utils.String(functionAppId.ID()),
+			FunctionAppRegion:     utils.String(location.NormalizeNilable(functionApp.Location)),
+		},
+	}
+
+	future, err := client.RegisterUserProvidedFunctionAppWithStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName, envelope, utils.Bool(d.Get("force_registration_enabled").(bool)))
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteFunctionAppRegistrationRead(d, meta)
+}
+
+func resourceStaticSiteFunctionAppRegistrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteUserProvidedFunctionAppID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetUserProvidedFunctionAppForStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+
+	functionAppId := ""
+	if props := resp.StaticSiteUserProvidedFunctionAppARMResourceProperties; props != nil && props.FunctionAppResourceID != nil {
+		parsed, err := parse.FunctionAppID(*props.FunctionAppResourceID)
+		if err != nil {
+			return fmt.Errorf("parsing `function_app_id` for %s: %+v", *id, err)
+		}
+		functionAppId = parsed.ID()
+	}
+	d.Set("function_app_id", functionAppId)
+
+	return nil
+}
+
+func resourceStaticSiteFunctionAppRegistrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteUserProvidedFunctionAppID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting %s", *id)
+
+	if resp, err := client.DetachUserProvidedFunctionAppFromStaticSite(ctx, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -78,6 +79,20 @@ func resourceStaticSite() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"enterprise_grade_cdn_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"api_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -126,6 +141,11 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("a Managed Identity cannot be used when tier is set to `Free`")
 	}
 
+	enterpriseGradeCdnEnabled := d.Get("enterprise_grade_cdn_enabled").(bool)
+	if skuName == string(web.SkuNameFree) && enterpriseGradeCdnEnabled {
+		return fmt.Errorf("`enterprise_grade_cdn_enabled` cannot be used when tier is set to `Free`")
+	}
+
 	siteEnvelope := web.StaticSiteARMResource{
 		Sku: &web.SkuDescription{
 			Name: &skuName,
@@ -145,6 +165,31 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("waiting for creation of %q: %+v", id, err)
 	}
 
+	if d.IsNewResource() || d.HasChange("app_settings") {
+		appSettings := web.StringDictionary{
+			Properties: utils.ExpandMapStringPtrString(d.Get("app_settings").(map[string]interface{})),
+		}
+		if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name, appSettings); err != nil {
+			return fmt.Errorf("updating `app_settings` for %s: %+v", id, err)
+		}
+	}
+
+	if (d.IsNewResource() && enterpriseGradeCdnEnabled) || (!d.IsNewResource() && d.HasChange("enterprise_grade_cdn_enabled")) {
+		status := azuresdkhacks.EnterpriseGradeCdnStatusDisabled
+		if enterpriseGradeCdnEnabled {
+			status = azuresdkhacks.EnterpriseGradeCdnStatusEnabled
+		}
+
+		site := azuresdkhacks.StaticSite{
+			Properties: &azuresdkhacks.StaticSiteProperties{
+				EnterpriseGradeCdnStatus: &status,
+			},
+		}
+		if _, err := meta.(*clients.Client).Web.StaticSites2022Client.Update(ctx, id, site); err != nil {
+			return fmt.Errorf("updating `enterprise_grade_cdn_enabled` for %s: %+v", id, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceStaticSiteRead(d, meta)
@@ -213,6 +258,24 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("api_key", apiKey)
 
+	appSettingsResp, err := client.ListStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing app settings for %s: %+v", id, err)
+	}
+	d.Set("app_settings", utils.FlattenMapStringPtrString(appSettingsResp.Properties))
+
+	siteResp, err := meta.(*clients.Client).Web.StaticSites2022Client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving enterprise grade edge status for %s: %+v", id, err)
+	}
+
+	enterpriseGradeCdnEnabled := false
+	if model := siteResp.Model; model != nil && model.Properties != nil && model.Properties.EnterpriseGradeCdnStatus != nil {
+		status := *model.Properties.EnterpriseGradeCdnStatus
+		enterpriseGradeCdnEnabled = status == azuresdkhacks.EnterpriseGradeCdnStatusEnabled || status == azuresdkhacks.EnterpriseGradeCdnStatusEnabling
+	}
+	d.Set("enterprise_grade_cdn_enabled", enterpriseGradeCdnEnabled)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
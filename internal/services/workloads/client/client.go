@@ -0,0 +1,33 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapcentralinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapvirtualinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	SAPCentralInstancesClient *sapcentralinstances.SAPCentralInstancesClient
+	SAPVirtualInstancesClient *sapvirtualinstances.SAPVirtualInstancesClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	sapCentralInstancesClient, err := sapcentralinstances.NewSAPCentralInstancesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building SAPCentralInstances Client: %+v", err)
+	}
+	o.Configure(sapCentralInstancesClient.Client, o.Authorizers.ResourceManager)
+
+	sapVirtualInstancesClient, err := sapvirtualinstances.NewSAPVirtualInstancesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building SAPVirtualInstances Client: %+v", err)
+	}
+	o.Configure(sapVirtualInstancesClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		SAPCentralInstancesClient: sapCentralInstancesClient,
+		SAPVirtualInstancesClient: sapVirtualInstancesClient,
+	}, nil
+}
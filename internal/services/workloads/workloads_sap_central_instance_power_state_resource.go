@@ -0,0 +1,234 @@
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapcentralinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// SAPCentralInstancePowerStateResource manages whether the Central Server (ASCS) instance of an SAP Virtual Instance is
+// running. The Central Instance itself is created by the service when the SAP Virtual Instance is registered or deployed,
+// so deleting this resource only removes it from the state and leaves the instance in its current power state.
+type SAPCentralInstancePowerStateResource struct{}
+
+type SAPCentralInstancePowerStateModel struct {
+	CentralInstanceId        string `tfschema:"central_instance_id"`
+	Running                  bool   `tfschema:"running"`
+	SoftStopTimeoutInSeconds int64  `tfschema:"soft_stop_timeout_in_seconds"`
+	Health                   string `tfschema:"health"`
+	Status                   string `tfschema:"status"`
+}
+
+var _ sdk.ResourceWithUpdate = SAPCentralInstancePowerStateResource{}
+
+func (r SAPCentralInstancePowerStateResource) ResourceType() string {
+	return "azurerm_workloads_sap_central_instance_power_state"
+}
+
+func (r SAPCentralInstancePowerStateResource) ModelObject() interface{} {
+	return &SAPCentralInstancePowerStateModel{}
+}
+
+func (r SAPCentralInstancePowerStateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sapcentralinstances.ValidateCentralInstanceID
+}
+
+func (r SAPCentralInstancePowerStateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"central_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sapcentralinstances.ValidateCentralInstanceID,
+		},
+
+		"running": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"soft_stop_timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+func (r SAPCentralInstancePowerStateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"health": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SAPCentralInstancePowerStateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SAPCentralInstancePowerStateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Workloads.SAPCentralInstancesClient
+
+			id, err := sapcentralinstances.ParseCentralInstanceID(model.CentralInstanceId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if err := setSAPCentralInstancePowerState(ctx, client, *id, existing.Model, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SAPCentralInstancePowerStateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.SAPCentralInstancesClient
+
+			id, err := sapcentralinstances.ParseCentralInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SAPCentralInstancePowerStateModel{
+				CentralInstanceId:        id.ID(),
+				SoftStopTimeoutInSeconds: int64(metadata.ResourceData.Get("soft_stop_timeout_in_seconds").(int)),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Health = string(pointer.From(props.Health))
+					state.Status = string(pointer.From(props.Status))
+					state.Running = sapCentralInstanceIsRunning(props.Status)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SAPCentralInstancePowerStateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Workloads.SAPCentralInstancesClient
+
+			id, err := sapcentralinstances.ParseCentralInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SAPCentralInstancePowerStateModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("running") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				if err := setSAPCentralInstancePowerState(ctx, client, *id, existing.Model, model); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SAPCentralInstancePowerStateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := sapcentralinstances.ParseCentralInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("removing %s from the state - the instance is left in its current power state", *id)
+			return nil
+		},
+	}
+}
+
+func setSAPCentralInstancePowerState(ctx context.Context, client *sapcentralinstances.SAPCentralInstancesClient, id sapcentralinstances.CentralInstanceId, existing *sapcentralinstances.SAPCentralServerInstance, model SAPCentralInstancePowerStateModel) error {
+	var status *sapcentralinstances.SAPVirtualInstanceStatus
+	if existing != nil && existing.Properties != nil {
+		status = existing.Properties.Status
+	}
+
+	if model.Running == sapCentralInstanceIsRunning(status) {
+		return nil
+	}
+
+	if model.Running {
+		if err := client.StartInstanceThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+		return nil
+	}
+
+	input := sapcentralinstances.StopRequest{
+		SoftStopTimeoutSeconds: pointer.To(model.SoftStopTimeoutInSeconds),
+	}
+	if err := client.StopInstanceThenPoll(ctx, id, input); err != nil {
+		return fmt.Errorf("stopping %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func sapCentralInstanceIsRunning(input *sapcentralinstances.SAPVirtualInstanceStatus) bool {
+	if input == nil {
+		return false
+	}
+
+	switch *input {
+	case sapcentralinstances.SAPVirtualInstanceStatusRunning, sapcentralinstances.SAPVirtualInstanceStatusPartiallyRunning, sapcentralinstances.SAPVirtualInstanceStatusStarting:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package workloads_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapcentralinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SAPCentralInstancePowerStateResource struct{}
+
+func TestAccSAPCentralInstancePowerState_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_SAP_CENTRAL_INSTANCE_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_SAP_CENTRAL_INSTANCE_ID is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_workloads_sap_central_instance_power_state", "test")
+	r := SAPCentralInstancePowerStateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Running"),
+			),
+		},
+		data.ImportStep("soft_stop_timeout_in_seconds"),
+		{
+			Config: r.basic(false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Offline"),
+			),
+		},
+		data.ImportStep("soft_stop_timeout_in_seconds"),
+		{
+			Config: r.basic(true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("soft_stop_timeout_in_seconds"),
+	})
+}
+
+func (SAPCentralInstancePowerStateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sapcentralinstances.ParseCentralInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Workloads.SAPCentralInstancesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (SAPCentralInstancePowerStateResource) basic(running bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_workloads_sap_central_instance_power_state" "test" {
+  central_instance_id          = "%s"
+  running                      = %t
+  soft_stop_timeout_in_seconds = 60
+}
+`, os.Getenv("ARM_TEST_SAP_CENTRAL_INSTANCE_ID"), running)
+}
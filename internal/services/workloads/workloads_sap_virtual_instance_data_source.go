@@ -0,0 +1,153 @@
+package workloads
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapvirtualinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SAPVirtualInstanceDataSource struct{}
+
+type SAPVirtualInstanceDataSourceModel struct {
+	Name                     string            `tfschema:"name"`
+	ResourceGroupName        string            `tfschema:"resource_group_name"`
+	Location                 string            `tfschema:"location"`
+	Environment              string            `tfschema:"environment"`
+	SapProduct               string            `tfschema:"sap_product"`
+	ManagedResourceGroupName string            `tfschema:"managed_resource_group_name"`
+	Health                   string            `tfschema:"health"`
+	State                    string            `tfschema:"state"`
+	Status                   string            `tfschema:"status"`
+	ProvisioningState        string            `tfschema:"provisioning_state"`
+	Tags                     map[string]string `tfschema:"tags"`
+}
+
+var _ sdk.DataSource = SAPVirtualInstanceDataSource{}
+
+func (r SAPVirtualInstanceDataSource) ResourceType() string {
+	return "azurerm_workloads_sap_virtual_instance"
+}
+
+func (r SAPVirtualInstanceDataSource) ModelObject() interface{} {
+	return &SAPVirtualInstanceDataSourceModel{}
+}
+
+func (r SAPVirtualInstanceDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sapvirtualinstances.ValidateSapVirtualInstanceID
+}
+
+func (r SAPVirtualInstanceDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+	}
+}
+
+func (r SAPVirtualInstanceDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"environment": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"sap_product": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"managed_resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"health": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": commonschema.TagsDataSource(),
+	}
+}
+
+func (r SAPVirtualInstanceDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var metaModel SAPVirtualInstanceDataSourceModel
+			if err := metadata.Decode(&metaModel); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Workloads.SAPVirtualInstancesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := sapvirtualinstances.NewSapVirtualInstanceID(subscriptionId, metaModel.ResourceGroupName, metaModel.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			props := model.Properties
+			state := SAPVirtualInstanceDataSourceModel{
+				Name:              id.SapVirtualInstanceName,
+				ResourceGroupName: id.ResourceGroupName,
+				Location:          location.Normalize(model.Location),
+				Environment:       string(props.Environment),
+				SapProduct:        string(props.SapProduct),
+				Health:            string(pointer.From(props.Health)),
+				State:             string(pointer.From(props.State)),
+				Status:            string(pointer.From(props.Status)),
+				ProvisioningState: string(pointer.From(props.ProvisioningState)),
+				Tags:              pointer.From(model.Tags),
+			}
+
+			if v := props.ManagedResourceGroupConfiguration; v != nil {
+				state.ManagedResourceGroupName = pointer.From(v.Name)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
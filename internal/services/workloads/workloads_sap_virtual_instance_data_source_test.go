@@ -0,0 +1,47 @@
+package workloads_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SAPVirtualInstanceDataSource struct{}
+
+func TestAccSAPVirtualInstanceDataSource_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_SAP_VIRTUAL_INSTANCE_NAME") == "" || os.Getenv("ARM_TEST_SAP_VIRTUAL_INSTANCE_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_SAP_VIRTUAL_INSTANCE_NAME and/or ARM_TEST_SAP_VIRTUAL_INSTANCE_RESOURCE_GROUP are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "data.azurerm_workloads_sap_virtual_instance", "test")
+	d := SAPVirtualInstanceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("environment").Exists(),
+				check.That(data.ResourceName).Key("sap_product").Exists(),
+				check.That(data.ResourceName).Key("health").Exists(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
+func (SAPVirtualInstanceDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_workloads_sap_virtual_instance" "test" {
+  name                = "%s"
+  resource_group_name = "%s"
+}
+`, os.Getenv("ARM_TEST_SAP_VIRTUAL_INSTANCE_NAME"), os.Getenv("ARM_TEST_SAP_VIRTUAL_INSTANCE_RESOURCE_GROUP"))
+}
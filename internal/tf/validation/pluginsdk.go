@@ -104,6 +104,11 @@ func IsIPv6Address(i interface{}, k string) ([]string, []error) {
 	return validation.IsIPv6Address(i, k)
 }
 
+// IsMACAddress is a SchemaValidateFunc which tests if the provided value is of type string and a valid MAC address
+func IsMACAddress(i interface{}, k string) ([]string, []error) {
+	return validation.IsMACAddress(i, k)
+}
+
 // IsMonth id a SchemaValidateFunc which tests if the provided value is of type string and a valid english month
 func IsMonth(ignoreCase bool) func(interface{}, string) ([]string, []error) {
 	return validation.IsMonth(ignoreCase)
@@ -4,15 +4,26 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+type providerDefaultsKey struct{}
+
+// WithProviderDefaults returns a copy of the context containing the default timeouts defined in the `timeouts` block
+// within the Provider's `features` block - these are used for any operation which doesn't have a timeout specified
+// in the Resource's own `timeouts` block.
+func WithProviderDefaults(ctx context.Context, input features.TimeoutsFeatures) context.Context {
+	return context.WithValue(ctx, providerDefaultsKey{}, input)
+}
+
 // ForCreate returns the context wrapped with the timeout for an Create operation
 //
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForCreate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutCreate))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, pluginsdk.TimeoutCreate))
 }
 
 // ForCreateUpdate returns the context wrapped with the timeout for an combined Create/Update operation
@@ -32,7 +43,7 @@ func ForCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Co
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForDelete(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutDelete))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, pluginsdk.TimeoutDelete))
 }
 
 // ForRead returns the context wrapped with the timeout for an Read operation
@@ -40,7 +51,7 @@ func ForDelete(ctx context.Context, d *pluginsdk.ResourceData) (context.Context,
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForRead(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutRead))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, pluginsdk.TimeoutRead))
 }
 
 // ForUpdate returns the context wrapped with the timeout for an Update operation
@@ -48,9 +59,66 @@ func ForRead(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, c
 // If the 'SupportsCustomTimeouts' feature toggle is enabled - this is wrapped with a context
 // Otherwise this returns the default context
 func ForUpdate(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc) {
-	return buildWithTimeout(ctx, d.Timeout(pluginsdk.TimeoutUpdate))
+	return buildWithTimeout(ctx, determineTimeout(ctx, d, pluginsdk.TimeoutUpdate))
 }
 
 func buildWithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(ctx, timeout)
 }
+
+// resourceData is the subset of pluginsdk.ResourceData used to determine the timeout for an operation
+type resourceData interface {
+	GetRawConfig() cty.Value
+	GetRawPlan() cty.Value
+	GetRawState() cty.Value
+	Timeout(key string) time.Duration
+}
+
+// determineTimeout returns the timeout for the operation - a value specified in the Resource's `timeouts` block takes
+// precedence over the Provider's default timeouts, which in turn take precedence over the Resource's own defaults
+func determineTimeout(ctx context.Context, d resourceData, key string) time.Duration {
+	if timeoutSpecifiedInResource(d, key) {
+		return d.Timeout(key)
+	}
+
+	defaults, ok := ctx.Value(providerDefaultsKey{}).(features.TimeoutsFeatures)
+	if !ok {
+		return d.Timeout(key)
+	}
+
+	override := map[string]time.Duration{
+		pluginsdk.TimeoutCreate: defaults.Create,
+		pluginsdk.TimeoutRead:   defaults.Read,
+		pluginsdk.TimeoutUpdate: defaults.Update,
+		pluginsdk.TimeoutDelete: defaults.Delete,
+	}[key]
+	if override == 0 {
+		return d.Timeout(key)
+	}
+
+	return override
+}
+
+// timeoutSpecifiedInResource returns whether a timeout for the operation is specified within the Resource's `timeouts`
+// block, using the configuration when available (e.g. during an apply) and otherwise the plan or state (e.g. during a
+// refresh or destroy)
+func timeoutSpecifiedInResource(d resourceData, key string) bool {
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawPlan(), d.GetRawState()} {
+		if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() {
+			continue
+		}
+
+		if !raw.Type().HasAttribute("timeouts") {
+			return false
+		}
+
+		timeouts := raw.GetAttr("timeouts")
+		if timeouts.IsNull() || !timeouts.IsKnown() || !timeouts.Type().IsObjectType() || !timeouts.Type().HasAttribute(key) {
+			return false
+		}
+
+		return !timeouts.GetAttr(key).IsNull()
+	}
+
+	return false
+}
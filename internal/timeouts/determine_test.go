@@ -0,0 +1,153 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type fakeResourceData struct {
+	config   cty.Value
+	state    cty.Value
+	timeouts map[string]time.Duration
+}
+
+func (f fakeResourceData) GetRawConfig() cty.Value {
+	return f.config
+}
+
+func (f fakeResourceData) GetRawPlan() cty.Value {
+	return cty.NullVal(f.config.Type())
+}
+
+func (f fakeResourceData) GetRawState() cty.Value {
+	return f.state
+}
+
+func (f fakeResourceData) Timeout(key string) time.Duration {
+	return f.timeouts[key]
+}
+
+func TestDetermineTimeout(t *testing.T) {
+	timeoutsType := cty.Object(map[string]cty.Type{
+		"create": cty.String,
+		"read":   cty.String,
+		"delete": cty.String,
+	})
+	resourceType := cty.Object(map[string]cty.Type{
+		"name":     cty.String,
+		"timeouts": timeoutsType,
+	})
+	withTimeouts := func(create string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal("example"),
+			"timeouts": cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal(create),
+				"read":   cty.NullVal(cty.String),
+				"delete": cty.NullVal(cty.String),
+			}),
+		})
+	}
+	withoutTimeouts := cty.ObjectVal(map[string]cty.Value{
+		"name":     cty.StringVal("example"),
+		"timeouts": cty.NullVal(timeoutsType),
+	})
+
+	// the Resource's `timeouts` block (or default) is exposed via `Timeout`
+	resourceTimeouts := map[string]time.Duration{
+		pluginsdk.TimeoutCreate: 30 * time.Minute,
+		pluginsdk.TimeoutRead:   5 * time.Minute,
+		pluginsdk.TimeoutDelete: 30 * time.Minute,
+	}
+	providerDefaults := features.TimeoutsFeatures{
+		Create: 2 * time.Hour,
+		Read:   10 * time.Minute,
+	}
+
+	testData := []struct {
+		name             string
+		config           cty.Value
+		state            cty.Value
+		providerDefaults *features.TimeoutsFeatures
+		key              string
+		expected         time.Duration
+	}{
+		{
+			name:     "no provider defaults",
+			config:   withoutTimeouts,
+			state:    cty.NullVal(resourceType),
+			key:      pluginsdk.TimeoutCreate,
+			expected: 30 * time.Minute,
+		},
+		{
+			name:             "provider default used when not specified in the resource",
+			config:           withoutTimeouts,
+			state:            cty.NullVal(resourceType),
+			providerDefaults: &providerDefaults,
+			key:              pluginsdk.TimeoutCreate,
+			expected:         2 * time.Hour,
+		},
+		{
+			name:             "resource timeout takes precedence over the provider default",
+			config:           withTimeouts("45m"),
+			state:            cty.NullVal(resourceType),
+			providerDefaults: &providerDefaults,
+			key:              pluginsdk.TimeoutCreate,
+			expected:         30 * time.Minute,
+		},
+		{
+			name:             "provider default used for an operation not specified in the resource",
+			config:           withTimeouts("45m"),
+			state:            cty.NullVal(resourceType),
+			providerDefaults: &providerDefaults,
+			key:              pluginsdk.TimeoutRead,
+			expected:         10 * time.Minute,
+		},
+		{
+			name:             "resource default used when the provider default isn't set for the operation",
+			config:           withoutTimeouts,
+			state:            cty.NullVal(resourceType),
+			providerDefaults: &providerDefaults,
+			key:              pluginsdk.TimeoutDelete,
+			expected:         30 * time.Minute,
+		},
+		{
+			name:             "resource timeout from the state during a refresh",
+			config:           cty.NullVal(resourceType),
+			state:            withTimeouts("45m"),
+			providerDefaults: &providerDefaults,
+			key:              pluginsdk.TimeoutCreate,
+			expected:         30 * time.Minute,
+		},
+		{
+			name:             "provider default during a refresh",
+			config:           cty.NullVal(resourceType),
+			state:            withoutTimeouts,
+			providerDefaults: &providerDefaults,
+			key:              pluginsdk.TimeoutRead,
+			expected:         10 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		ctx := context.TODO()
+		if v.providerDefaults != nil {
+			ctx = WithProviderDefaults(ctx, *v.providerDefaults)
+		}
+
+		d := fakeResourceData{
+			config:   v.config,
+			state:    v.state,
+			timeouts: resourceTimeouts,
+		}
+		if actual := determineTimeout(ctx, d, v.key); actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+}